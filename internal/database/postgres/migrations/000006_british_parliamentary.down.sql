@@ -0,0 +1,191 @@
+-- Restore the two-team versions of the team score functions
+
+CREATE OR REPLACE FUNCTION initialize_team_scores()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    -- Get the debate information
+    SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+    -- Create initial records with zero scores
+    INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, IsElimination)
+    VALUES
+        (debate_record.Team1ID, NEW.DebateID, 0, 0, debate_record.IsEliminationRound),
+        (debate_record.Team2ID, NEW.DebateID, 0, 0, debate_record.IsEliminationRound)
+    ON CONFLICT (TeamID, DebateID) DO NOTHING;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION calculate_team_average_rank()
+RETURNS TRIGGER AS $$
+DECLARE
+    avg_rank FLOAT;
+    debate_record RECORD;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        -- Calculate and insert/update Team 1 scores
+        SELECT AVG(ss.SpeakerRank)::FLOAT INTO avg_rank
+        FROM SpeakerScores ss
+        JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
+        WHERE tm.TeamID = debate_record.Team1ID AND ss.BallotID = NEW.BallotID;
+
+        -- Insert if not exists, update if exists
+        INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, IsElimination)
+        VALUES (
+            debate_record.Team1ID,
+            NEW.DebateID,
+            NEW.Team1TotalScore,
+            avg_rank,
+            debate_record.IsEliminationRound
+        )
+        ON CONFLICT (TeamID, DebateID)
+        DO UPDATE SET
+            TotalScore = NEW.Team1TotalScore,
+            Rank = avg_rank;
+
+        -- Calculate and insert/update Team 2 scores
+        SELECT AVG(ss.SpeakerRank)::FLOAT INTO avg_rank
+        FROM SpeakerScores ss
+        JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
+        WHERE tm.TeamID = debate_record.Team2ID AND ss.BallotID = NEW.BallotID;
+
+        INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, IsElimination)
+        VALUES (
+            debate_record.Team2ID,
+            NEW.DebateID,
+            NEW.Team2TotalScore,
+            avg_rank,
+            debate_record.IsEliminationRound
+        )
+        ON CONFLICT (TeamID, DebateID)
+        DO UPDATE SET
+            TotalScore = NEW.Team2TotalScore,
+            Rank = avg_rank;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_team_stats()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        -- Update stats for Team 1
+        WITH team_stats AS (
+            SELECT
+                t.TeamID,
+                t.TournamentID,
+                COUNT(CASE WHEN b.Verdict = t.Name THEN 1 ELSE NULL END) AS TotalWins,
+                AVG(ts.Rank) AS AvgRank,
+                SUM(ts.TotalScore::NUMERIC) AS TotalSpeakerPoints
+            FROM
+                Teams t
+            JOIN
+                Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID) AND t.TournamentID = d.TournamentID
+            JOIN
+                Ballots b ON d.DebateID = b.DebateID
+            JOIN
+                TeamScores ts ON t.TeamID = ts.TeamID AND d.DebateID = ts.DebateID
+            WHERE
+                t.TeamID = debate_record.Team1ID AND t.TournamentID = debate_record.TournamentID
+            GROUP BY
+                t.TeamID, t.TournamentID
+        )
+        UPDATE Teams
+        SET
+            TotalWins = team_stats.TotalWins,
+            AverageRank = team_stats.AvgRank,
+            TotalSpeakerPoints = team_stats.TotalSpeakerPoints
+        FROM
+            team_stats
+        WHERE
+            Teams.TeamID = team_stats.TeamID AND Teams.TournamentID = team_stats.TournamentID;
+
+        -- Update stats for Team 2
+        WITH team_stats AS (
+            SELECT
+                t.TeamID,
+                t.TournamentID,
+                COUNT(CASE WHEN b.Verdict = t.Name THEN 1 ELSE NULL END) AS TotalWins,
+                AVG(ts.Rank) AS AvgRank,
+                SUM(ts.TotalScore::NUMERIC) AS TotalSpeakerPoints
+            FROM
+                Teams t
+            JOIN
+                Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID) AND t.TournamentID = d.TournamentID
+            JOIN
+                Ballots b ON d.DebateID = b.DebateID
+            JOIN
+                TeamScores ts ON t.TeamID = ts.TeamID AND d.DebateID = ts.DebateID
+            WHERE
+                t.TeamID = debate_record.Team2ID AND t.TournamentID = debate_record.TournamentID
+            GROUP BY
+                t.TeamID, t.TournamentID
+        )
+        UPDATE Teams
+        SET
+            TotalWins = team_stats.TotalWins,
+            AverageRank = team_stats.AvgRank,
+            TotalSpeakerPoints = team_stats.TotalSpeakerPoints
+        FROM
+            team_stats
+        WHERE
+            Teams.TeamID = team_stats.TeamID AND Teams.TournamentID = team_stats.TournamentID;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION handle_public_speaking_team()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        -- Set rank to 99 for "Public Speaking" teams
+        UPDATE TeamScores ts
+        SET Rank = 99
+        WHERE ts.DebateID = NEW.DebateID
+          AND ts.TeamID IN (
+              SELECT t.TeamID
+              FROM Teams t
+              WHERE t.TeamID IN (debate_record.Team1ID, debate_record.Team2ID)
+                AND t.Name = 'Public Speaking'
+          );
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS idx_debates_team4;
+DROP INDEX IF EXISTS idx_debates_team3;
+
+ALTER TABLE Teams DROP COLUMN IF EXISTS TotalTeamPoints;
+
+ALTER TABLE TeamScores DROP COLUMN IF EXISTS TeamPoints;
+
+ALTER TABLE Ballots
+    DROP COLUMN IF EXISTS Team4Feedback,
+    DROP COLUMN IF EXISTS Team4TotalScore,
+    DROP COLUMN IF EXISTS Team3Feedback,
+    DROP COLUMN IF EXISTS Team3TotalScore;
+
+ALTER TABLE Debates
+    DROP COLUMN IF EXISTS Team4ID,
+    DROP COLUMN IF EXISTS Team3ID;
+
+ALTER TABLE TournamentFormats DROP COLUMN IF EXISTS TeamsPerDebate;
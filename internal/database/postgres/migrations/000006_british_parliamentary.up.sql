@@ -0,0 +1,193 @@
+-- British Parliamentary support: four teams per debate (OG/OO/CG/CO).
+-- Team1 and Team2 keep their meaning for two-team formats (proposition and
+-- opposition) and become Opening Government and Opening Opposition in BP.
+-- Team3 and Team4 are Closing Government and Closing Opposition.
+
+ALTER TABLE TournamentFormats
+    ADD COLUMN TeamsPerDebate INTEGER NOT NULL DEFAULT 2 CHECK (TeamsPerDebate IN (2, 4));
+
+ALTER TABLE Debates
+    ADD COLUMN Team3ID INTEGER REFERENCES Teams(TeamID),
+    ADD COLUMN Team4ID INTEGER REFERENCES Teams(TeamID);
+
+ALTER TABLE Ballots
+    ADD COLUMN Team3TotalScore NUMERIC,
+    ADD COLUMN Team3Feedback TEXT,
+    ADD COLUMN Team4TotalScore NUMERIC,
+    ADD COLUMN Team4Feedback TEXT;
+
+-- Team points: 3/2/1/0 by placement in BP, 1 for a win in two-team formats
+ALTER TABLE TeamScores
+    ADD COLUMN TeamPoints INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE Teams
+    ADD COLUMN TotalTeamPoints INTEGER DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_debates_team3 ON Debates(Team3ID) WHERE Team3ID IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_debates_team4 ON Debates(Team4ID) WHERE Team4ID IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION initialize_team_scores()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    -- Get the debate information
+    SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+    -- Create initial records with zero scores for every team in the room
+    INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, IsElimination)
+    SELECT slots.TeamID, NEW.DebateID, 0, 0, debate_record.IsEliminationRound
+    FROM (VALUES
+        (debate_record.Team1ID),
+        (debate_record.Team2ID),
+        (debate_record.Team3ID),
+        (debate_record.Team4ID)
+    ) AS slots(TeamID)
+    WHERE slots.TeamID IS NOT NULL
+    ON CONFLICT (TeamID, DebateID) DO NOTHING;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- In BP the team rank is the team's placement in the room (1-4) and team
+-- points are 3/2/1/0. Two-team formats keep the average speaker rank and
+-- score one team point for a win.
+CREATE OR REPLACE FUNCTION calculate_team_average_rank()
+RETURNS TRIGGER AS $$
+DECLARE
+    avg_rank FLOAT;
+    debate_record RECORD;
+    team_slot RECORD;
+    is_four_team BOOLEAN;
+    team_points INTEGER;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+        is_four_team := debate_record.Team3ID IS NOT NULL AND debate_record.Team4ID IS NOT NULL;
+
+        FOR team_slot IN
+            SELECT slots.TeamID, slots.TotalScore, t.Name,
+                   RANK() OVER (ORDER BY slots.TotalScore DESC NULLS LAST) AS Placement
+            FROM (VALUES
+                (debate_record.Team1ID, NEW.Team1TotalScore),
+                (debate_record.Team2ID, NEW.Team2TotalScore),
+                (debate_record.Team3ID, NEW.Team3TotalScore),
+                (debate_record.Team4ID, NEW.Team4TotalScore)
+            ) AS slots(TeamID, TotalScore)
+            JOIN Teams t ON t.TeamID = slots.TeamID
+        LOOP
+            IF is_four_team THEN
+                avg_rank := team_slot.Placement;
+                team_points := 4 - team_slot.Placement;
+            ELSE
+                SELECT AVG(ss.SpeakerRank)::FLOAT INTO avg_rank
+                FROM SpeakerScores ss
+                JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
+                WHERE tm.TeamID = team_slot.TeamID AND ss.BallotID = NEW.BallotID;
+
+                team_points := CASE WHEN NEW.Verdict = team_slot.Name THEN 1 ELSE 0 END;
+            END IF;
+
+            -- Insert if not exists, update if exists
+            INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, TeamPoints, IsElimination)
+            VALUES (
+                team_slot.TeamID,
+                NEW.DebateID,
+                team_slot.TotalScore,
+                avg_rank,
+                team_points,
+                debate_record.IsEliminationRound
+            )
+            ON CONFLICT (TeamID, DebateID)
+            DO UPDATE SET
+                TotalScore = EXCLUDED.TotalScore,
+                Rank = EXCLUDED.Rank,
+                TeamPoints = EXCLUDED.TeamPoints;
+        END LOOP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_team_stats()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+    debate_team_id INTEGER;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        FOR debate_team_id IN
+            SELECT slots.TeamID
+            FROM (VALUES
+                (debate_record.Team1ID),
+                (debate_record.Team2ID),
+                (debate_record.Team3ID),
+                (debate_record.Team4ID)
+            ) AS slots(TeamID)
+            WHERE slots.TeamID IS NOT NULL
+        LOOP
+            WITH team_stats AS (
+                SELECT
+                    t.TeamID,
+                    t.TournamentID,
+                    COUNT(CASE WHEN b.Verdict = t.Name THEN 1 ELSE NULL END) AS TotalWins,
+                    AVG(ts.Rank) AS AvgRank,
+                    SUM(ts.TotalScore::NUMERIC) AS TotalSpeakerPoints,
+                    SUM(ts.TeamPoints) AS TotalTeamPoints
+                FROM
+                    Teams t
+                JOIN
+                    Debates d ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID) AND t.TournamentID = d.TournamentID
+                JOIN
+                    Ballots b ON d.DebateID = b.DebateID
+                JOIN
+                    TeamScores ts ON t.TeamID = ts.TeamID AND d.DebateID = ts.DebateID
+                WHERE
+                    t.TeamID = debate_team_id AND t.TournamentID = debate_record.TournamentID
+                GROUP BY
+                    t.TeamID, t.TournamentID
+            )
+            UPDATE Teams
+            SET
+                TotalWins = team_stats.TotalWins,
+                AverageRank = team_stats.AvgRank,
+                TotalSpeakerPoints = team_stats.TotalSpeakerPoints,
+                TotalTeamPoints = team_stats.TotalTeamPoints
+            FROM
+                team_stats
+            WHERE
+                Teams.TeamID = team_stats.TeamID AND Teams.TournamentID = team_stats.TournamentID;
+        END LOOP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION handle_public_speaking_team()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        -- Set rank to 99 for "Public Speaking" teams
+        UPDATE TeamScores ts
+        SET Rank = 99
+        WHERE ts.DebateID = NEW.DebateID
+          AND ts.TeamID IN (
+              SELECT t.TeamID
+              FROM Teams t
+              WHERE t.TeamID IN (debate_record.Team1ID, debate_record.Team2ID, debate_record.Team3ID, debate_record.Team4ID)
+                AND t.Name = 'Public Speaking'
+          );
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,4 @@
+ALTER TABLE Volunteers DROP COLUMN IF EXISTS MaxTimesJudgingTeam;
+
+DROP INDEX IF EXISTS idx_judgeconflicts_judge;
+DROP TABLE IF EXISTS JudgeConflicts;
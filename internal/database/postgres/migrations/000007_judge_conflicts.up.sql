@@ -0,0 +1,25 @@
+-- Judge conflicts of interest. A conflict keeps a judge out of any room with a
+-- team from the given school, the given team, or a team fielding the given
+-- student. JudgeID is the judge's UserID, as in JudgeAssignments.
+CREATE TABLE JudgeConflicts (
+    ConflictID SERIAL PRIMARY KEY,
+    JudgeID INTEGER NOT NULL REFERENCES Users(UserID) ON DELETE CASCADE,
+    ConflictType VARCHAR(10) NOT NULL CHECK (ConflictType IN ('school', 'team', 'student')),
+    SchoolID INTEGER REFERENCES Schools(SchoolID) ON DELETE CASCADE,
+    TeamID INTEGER REFERENCES Teams(TeamID) ON DELETE CASCADE,
+    StudentID INTEGER REFERENCES Students(StudentID) ON DELETE CASCADE,
+    Reason TEXT,
+    CreatedAt TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (ConflictType = 'school' AND SchoolID IS NOT NULL AND TeamID IS NULL AND StudentID IS NULL) OR
+        (ConflictType = 'team' AND TeamID IS NOT NULL AND SchoolID IS NULL AND StudentID IS NULL) OR
+        (ConflictType = 'student' AND StudentID IS NOT NULL AND SchoolID IS NULL AND TeamID IS NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_judgeconflicts_judge ON JudgeConflicts(JudgeID);
+
+-- How many debates of the same team a judge may adjudicate in one tournament.
+-- NULL means no limit.
+ALTER TABLE Volunteers
+    ADD COLUMN MaxTimesJudgingTeam INTEGER CHECK (MaxTimesJudgingTeam > 0);
@@ -0,0 +1 @@
+ALTER TABLE Debates DROP COLUMN IF EXISTS PanelQuality;
@@ -0,0 +1,4 @@
+-- Average judge score (0-100) of the panel allocated to each debate.
+-- NULL for debates paired before panel quality was tracked.
+ALTER TABLE Debates
+    ADD COLUMN PanelQuality NUMERIC(5,2);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_eliminationbracketnodes_tournament;
+DROP TABLE IF EXISTS EliminationBracketNodes;
@@ -0,0 +1,21 @@
+-- Elimination brackets for two-team formats, stored as a tree. Each node is
+-- one debate. A side is either seeded straight from the break (TeamXID and
+-- TeamXSeed set when the bracket is built) or filled later by the winner of a
+-- child node, whose ParentNodeID and ParentSlot point at this node.
+CREATE TABLE EliminationBracketNodes (
+    NodeID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    Position INTEGER NOT NULL,
+    ParentNodeID INTEGER REFERENCES EliminationBracketNodes(NodeID) ON DELETE CASCADE,
+    ParentSlot INTEGER CHECK (ParentSlot IN (1, 2)),
+    Team1ID INTEGER REFERENCES Teams(TeamID),
+    Team1Seed INTEGER,
+    Team2ID INTEGER REFERENCES Teams(TeamID),
+    Team2Seed INTEGER,
+    DebateID INTEGER REFERENCES Debates(DebateID) ON DELETE SET NULL,
+    WinnerTeamID INTEGER REFERENCES Teams(TeamID),
+    UNIQUE (TournamentID, RoundNumber, Position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_eliminationbracketnodes_tournament ON EliminationBracketNodes(TournamentID, RoundNumber);
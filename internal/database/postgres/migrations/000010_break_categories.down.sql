@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_eliminationbracketnodes_position;
+DELETE FROM EliminationBracketNodes WHERE CategoryID IS NOT NULL;
+ALTER TABLE EliminationBracketNodes DROP COLUMN IF EXISTS CategoryID;
+ALTER TABLE EliminationBracketNodes
+    ADD CONSTRAINT eliminationbracketnodes_tournamentid_roundnumber_position_key UNIQUE (TournamentID, RoundNumber, Position);
+
+DROP TABLE IF EXISTS StudentBreakCategories;
+DROP TABLE IF EXISTS TeamBreakCategories;
+DROP TABLE IF EXISTS BreakCategories;
@@ -0,0 +1,36 @@
+-- Break categories such as open, novice and ESL. Each category breaks its own
+-- teams into a separate elimination bracket. Priority ranks the categories, 1
+-- being the highest: a team that breaks in several categories goes to the one
+-- with the highest priority. Every team is eligible for a general category.
+CREATE TABLE BreakCategories (
+    CategoryID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    Name VARCHAR(50) NOT NULL,
+    BreakSize INTEGER NOT NULL CHECK (BreakSize >= 2),
+    Priority INTEGER NOT NULL DEFAULT 1,
+    IsGeneral BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (TournamentID, Name)
+);
+
+-- Teams eligible to break in a category
+CREATE TABLE TeamBreakCategories (
+    TeamID INTEGER NOT NULL REFERENCES Teams(TeamID) ON DELETE CASCADE,
+    CategoryID INTEGER NOT NULL REFERENCES BreakCategories(CategoryID) ON DELETE CASCADE,
+    PRIMARY KEY (TeamID, CategoryID)
+);
+
+-- Speakers eligible for a category's speaker awards
+CREATE TABLE StudentBreakCategories (
+    StudentID INTEGER NOT NULL REFERENCES Students(StudentID) ON DELETE CASCADE,
+    CategoryID INTEGER NOT NULL REFERENCES BreakCategories(CategoryID) ON DELETE CASCADE,
+    PRIMARY KEY (StudentID, CategoryID)
+);
+
+-- Each category has its own elimination bracket. NULL is the bracket of a
+-- tournament without break categories.
+ALTER TABLE EliminationBracketNodes
+    ADD COLUMN CategoryID INTEGER REFERENCES BreakCategories(CategoryID);
+ALTER TABLE EliminationBracketNodes
+    DROP CONSTRAINT IF EXISTS eliminationbracketnodes_tournamentid_roundnumber_position_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_eliminationbracketnodes_position
+    ON EliminationBracketNodes(TournamentID, COALESCE(CategoryID, 0), RoundNumber, Position);
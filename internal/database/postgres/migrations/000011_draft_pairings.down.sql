@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ReleasedDraws;
+ALTER TABLE Rounds DROP COLUMN IF EXISTS ReleasedAt;
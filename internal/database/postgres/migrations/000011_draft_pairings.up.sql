@@ -0,0 +1,31 @@
+-- Generated draws are drafts until an admin releases them. ReleasedAt is NULL
+-- for rounds that were never released.
+ALTER TABLE Rounds
+    ADD COLUMN ReleasedAt TIMESTAMP;
+
+-- Snapshot of each round's draw as last released. Students and judges see the
+-- released draw, admins edit the draft in Debates and JudgeAssignments.
+CREATE TABLE ReleasedDraws (
+    RoundID INTEGER NOT NULL REFERENCES Rounds(RoundID) ON DELETE CASCADE,
+    DebateID INTEGER NOT NULL REFERENCES Debates(DebateID) ON DELETE CASCADE,
+    RoomID INTEGER NOT NULL REFERENCES Rooms(RoomID),
+    Team1ID INTEGER NOT NULL REFERENCES Teams(TeamID),
+    Team2ID INTEGER NOT NULL REFERENCES Teams(TeamID),
+    Team3ID INTEGER REFERENCES Teams(TeamID),
+    Team4ID INTEGER REFERENCES Teams(TeamID),
+    HeadJudgeID INTEGER REFERENCES Users(UserID),
+    JudgeIDs INTEGER[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (RoundID, DebateID)
+);
+
+-- Draws paired before drafts existed were already live
+UPDATE Rounds r
+SET ReleasedAt = CURRENT_TIMESTAMP
+WHERE EXISTS (SELECT 1 FROM Debates d WHERE d.RoundID = r.RoundID);
+
+INSERT INTO ReleasedDraws (RoundID, DebateID, RoomID, Team1ID, Team2ID, Team3ID, Team4ID, HeadJudgeID, JudgeIDs)
+SELECT d.RoundID, d.DebateID, d.RoomID, d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID,
+       (SELECT ja.JudgeID FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID AND ja.IsHeadJudge LIMIT 1),
+       COALESCE((SELECT array_agg(ja.JudgeID ORDER BY ja.JudgeID) FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID), '{}')
+FROM Debates d
+JOIN Rounds r ON d.RoundID = r.RoundID;
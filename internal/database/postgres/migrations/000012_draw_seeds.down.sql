@@ -0,0 +1,3 @@
+ALTER TABLE Rounds
+    DROP COLUMN IF EXISTS DrawInput,
+    DROP COLUMN IF EXISTS DrawSeed;
@@ -0,0 +1,6 @@
+-- The random seed and the pairing algorithm input each round was drawn from,
+-- so a disputed draw can be generated again. NULL for rounds drawn before
+-- they were saved.
+ALTER TABLE Rounds
+    ADD COLUMN DrawSeed BIGINT,
+    ADD COLUMN DrawInput JSONB;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS JudgeSpeakerScores;
+DROP TABLE IF EXISTS JudgeBallots;
@@ -0,0 +1,55 @@
+-- Every judge on a panel submits their own ballot. The debate's row in Ballots
+-- keeps the panel decision (majority verdict, speaker points averaged over the
+-- judges who voted), which is what team and speaker rankings read.
+CREATE TABLE JudgeBallots (
+    JudgeBallotID SERIAL PRIMARY KEY,
+    BallotID INTEGER NOT NULL REFERENCES Ballots(BallotID) ON DELETE CASCADE,
+    JudgeID INTEGER NOT NULL REFERENCES Users(UserID),
+    Team1TotalScore NUMERIC,
+    Team1Feedback TEXT,
+    Team2TotalScore NUMERIC,
+    Team2Feedback TEXT,
+    Team3TotalScore NUMERIC,
+    Team3Feedback TEXT,
+    Team4TotalScore NUMERIC,
+    Team4Feedback TEXT,
+    Verdict VARCHAR(255) NOT NULL,
+    SubmittedAt TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(BallotID, JudgeID)
+);
+
+CREATE TABLE JudgeSpeakerScores (
+    ScoreID SERIAL PRIMARY KEY,
+    JudgeBallotID INTEGER NOT NULL REFERENCES JudgeBallots(JudgeBallotID) ON DELETE CASCADE,
+    SpeakerID INTEGER NOT NULL REFERENCES Students(StudentID),
+    SpeakerRank INTEGER NOT NULL,
+    SpeakerPoints NUMERIC(5,2) NOT NULL,
+    Feedback TEXT,
+    UNIQUE(JudgeBallotID, SpeakerID)
+);
+
+CREATE INDEX IF NOT EXISTS idx_judge_ballots_judge ON JudgeBallots(JudgeID, BallotID);
+
+-- Ballots recorded before panels voted were the head judge's, on behalf of the
+-- whole panel, so every judge of the panel is taken to have voted for it and
+-- none of them is left with a pending ballot on a decided debate
+INSERT INTO JudgeBallots (BallotID, JudgeID, Team1TotalScore, Team1Feedback, Team2TotalScore, Team2Feedback,
+                          Team3TotalScore, Team3Feedback, Team4TotalScore, Team4Feedback, Verdict, SubmittedAt)
+SELECT b.BallotID, b.JudgeID, b.Team1TotalScore, b.Team1Feedback, b.Team2TotalScore, b.Team2Feedback,
+       b.Team3TotalScore, b.Team3Feedback, b.Team4TotalScore, b.Team4Feedback, b.Verdict, b.last_updated_at
+FROM Ballots b
+WHERE b.RecordingStatus = 'Recorded';
+
+INSERT INTO JudgeBallots (BallotID, JudgeID, Team1TotalScore, Team1Feedback, Team2TotalScore, Team2Feedback,
+                          Team3TotalScore, Team3Feedback, Team4TotalScore, Team4Feedback, Verdict, SubmittedAt)
+SELECT b.BallotID, ja.JudgeID, b.Team1TotalScore, b.Team1Feedback, b.Team2TotalScore, b.Team2Feedback,
+       b.Team3TotalScore, b.Team3Feedback, b.Team4TotalScore, b.Team4Feedback, b.Verdict, b.last_updated_at
+FROM Ballots b
+JOIN JudgeAssignments ja ON ja.DebateID = b.DebateID
+WHERE b.RecordingStatus = 'Recorded'
+ON CONFLICT (BallotID, JudgeID) DO NOTHING;
+
+INSERT INTO JudgeSpeakerScores (JudgeBallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback)
+SELECT jb.JudgeBallotID, ss.SpeakerID, ss.SpeakerRank, ss.SpeakerPoints, ss.Feedback
+FROM SpeakerScores ss
+JOIN JudgeBallots jb ON ss.BallotID = jb.BallotID;
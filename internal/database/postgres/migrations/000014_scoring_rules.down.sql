@@ -0,0 +1,17 @@
+ALTER TABLE JudgeSpeakerScores
+    DROP COLUMN IF EXISTS ReplyPoints;
+
+ALTER TABLE SpeakerScores
+    DROP COLUMN IF EXISTS ReplyPoints;
+
+ALTER TABLE TournamentFormats
+    DROP CONSTRAINT IF EXISTS tournamentformats_reply_points_range,
+    DROP CONSTRAINT IF EXISTS tournamentformats_speaker_points_range,
+    DROP COLUMN IF EXISTS TieHandling,
+    DROP COLUMN IF EXISTS MaxReplyPoints,
+    DROP COLUMN IF EXISTS MinReplyPoints,
+    DROP COLUMN IF EXISTS ReplySpeeches,
+    DROP COLUMN IF EXISTS AllowLowPointWins,
+    DROP COLUMN IF EXISTS SpeakerPointStep,
+    DROP COLUMN IF EXISTS MaxSpeakerPoints,
+    DROP COLUMN IF EXISTS MinSpeakerPoints;
@@ -0,0 +1,21 @@
+-- Scoring rules ballots are checked against. A speaker point step of 0 accepts
+-- any value. TieHandling 'reject' refuses tied team totals, 'verdict' lets the
+-- verdict decide between them; British Parliamentary rooms never allow ties.
+ALTER TABLE TournamentFormats
+    ADD COLUMN MinSpeakerPoints NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD COLUMN MaxSpeakerPoints NUMERIC(5,2) NOT NULL DEFAULT 100,
+    ADD COLUMN SpeakerPointStep NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD COLUMN AllowLowPointWins BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN ReplySpeeches BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN MinReplyPoints NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD COLUMN MaxReplyPoints NUMERIC(5,2) NOT NULL DEFAULT 50,
+    ADD COLUMN TieHandling VARCHAR(20) NOT NULL DEFAULT 'reject' CHECK (TieHandling IN ('reject', 'verdict')),
+    ADD CONSTRAINT tournamentformats_speaker_points_range CHECK (MinSpeakerPoints <= MaxSpeakerPoints),
+    ADD CONSTRAINT tournamentformats_reply_points_range CHECK (MinReplyPoints <= MaxReplyPoints);
+
+-- Points of the reply speech a speaker gave, 0 if they gave none
+ALTER TABLE SpeakerScores
+    ADD COLUMN ReplyPoints NUMERIC(5,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE JudgeSpeakerScores
+    ADD COLUMN ReplyPoints NUMERIC(5,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,57 @@
+-- Every ballot a judge submits, and every admin edit or revert of it, is kept
+-- as an immutable version. Snapshot is the judge's ballot as submitted, with
+-- its speaker scores.
+CREATE TABLE BallotVersions (
+    VersionID SERIAL PRIMARY KEY,
+    BallotID INTEGER NOT NULL REFERENCES Ballots(BallotID) ON DELETE CASCADE,
+    VersionNumber INTEGER NOT NULL,
+    JudgeID INTEGER NOT NULL REFERENCES Users(UserID),
+    Snapshot JSONB NOT NULL,
+    CreatedBy INTEGER NOT NULL REFERENCES Users(UserID),
+    CreatedAt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    Reason TEXT,
+    RevertedFrom INTEGER,
+    UNIQUE(BallotID, VersionNumber)
+);
+
+-- Judge ballots submitted before versions were kept become their first version
+INSERT INTO BallotVersions (BallotID, VersionNumber, JudgeID, Snapshot, CreatedBy, CreatedAt, Reason)
+SELECT jb.BallotID,
+       ROW_NUMBER() OVER (PARTITION BY jb.BallotID ORDER BY jb.SubmittedAt, jb.JudgeBallotID),
+       jb.JudgeID,
+       jsonb_strip_nulls(jsonb_build_object(
+           'ballot_id', jb.BallotID,
+           'verdict', jb.Verdict,
+           'team1', jsonb_build_object('team_id', d.Team1ID, 'total_points', jb.Team1TotalScore, 'feedback', jb.Team1Feedback,
+                                       'speakers', speakers.Team1),
+           'team2', jsonb_build_object('team_id', d.Team2ID, 'total_points', jb.Team2TotalScore, 'feedback', jb.Team2Feedback,
+                                       'speakers', speakers.Team2),
+           'team3', CASE WHEN d.Team3ID IS NOT NULL THEN
+                    jsonb_build_object('team_id', d.Team3ID, 'total_points', jb.Team3TotalScore, 'feedback', jb.Team3Feedback,
+                                       'speakers', speakers.Team3) END,
+           'team4', CASE WHEN d.Team4ID IS NOT NULL THEN
+                    jsonb_build_object('team_id', d.Team4ID, 'total_points', jb.Team4TotalScore, 'feedback', jb.Team4Feedback,
+                                       'speakers', speakers.Team4) END
+       )),
+       jb.JudgeID,
+       COALESCE(jb.SubmittedAt, CURRENT_TIMESTAMP),
+       'Submitted before ballot history was kept'
+FROM JudgeBallots jb
+JOIN Ballots b ON jb.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+CROSS JOIN LATERAL (
+    SELECT
+        COALESCE(jsonb_agg(s.Speaker) FILTER (WHERE s.TeamID = d.Team1ID), '[]') AS Team1,
+        COALESCE(jsonb_agg(s.Speaker) FILTER (WHERE s.TeamID = d.Team2ID), '[]') AS Team2,
+        COALESCE(jsonb_agg(s.Speaker) FILTER (WHERE s.TeamID = d.Team3ID), '[]') AS Team3,
+        COALESCE(jsonb_agg(s.Speaker) FILTER (WHERE s.TeamID = d.Team4ID), '[]') AS Team4
+    FROM (
+        SELECT tm.TeamID,
+               jsonb_build_object('speaker_id', jss.SpeakerID, 'rank', jss.SpeakerRank, 'points', jss.SpeakerPoints,
+                                  'feedback', COALESCE(jss.Feedback, ''), 'reply_points', jss.ReplyPoints) AS Speaker
+        FROM JudgeSpeakerScores jss
+        JOIN TeamMembers tm ON jss.SpeakerID = tm.StudentID
+        WHERE jss.JudgeBallotID = jb.JudgeBallotID
+          AND tm.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+    ) s
+) speakers;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS BallotEntries;
+ALTER TABLE Tournaments DROP COLUMN IF EXISTS DoubleEntryBallots;
@@ -0,0 +1,16 @@
+-- Tournaments can require paper ballots to be keyed in twice. Each keyed-in
+-- ballot waits in BallotEntries until a different user keys the same judge's
+-- ballot identically; the matching entries are then confirmed together.
+ALTER TABLE Tournaments ADD COLUMN DoubleEntryBallots BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE BallotEntries (
+    EntryID SERIAL PRIMARY KEY,
+    BallotID INTEGER NOT NULL REFERENCES Ballots(BallotID) ON DELETE CASCADE,
+    JudgeID INTEGER NOT NULL REFERENCES Users(UserID),
+    EnteredBy INTEGER NOT NULL REFERENCES Users(UserID),
+    Snapshot JSONB NOT NULL,
+    EnteredAt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ConfirmedAt TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_ballotentries_pending ON BallotEntries(BallotID, JudgeID) WHERE ConfirmedAt IS NULL;
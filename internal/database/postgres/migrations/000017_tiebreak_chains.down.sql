@@ -0,0 +1,7 @@
+ALTER TABLE Tournaments
+    DROP COLUMN IF EXISTS SpeakerTiebreaks,
+    DROP COLUMN IF EXISTS TeamTiebreaks;
+
+ALTER TABLE TournamentFormats
+    DROP COLUMN IF EXISTS SpeakerTiebreaks,
+    DROP COLUMN IF EXISTS TeamTiebreaks;
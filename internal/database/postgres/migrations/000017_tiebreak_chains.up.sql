@@ -0,0 +1,10 @@
+-- Ordered tiebreak chains for the team and speaker rankings and the break.
+-- Each format defines its chains; a tournament may override them, NULL
+-- meaning the format's chain. The defaults rank as before the chains existed.
+ALTER TABLE TournamentFormats
+    ADD COLUMN TeamTiebreaks TEXT[] NOT NULL DEFAULT '{team_points,wins,speaker_points,average_rank}',
+    ADD COLUMN SpeakerTiebreaks TEXT[] NOT NULL DEFAULT '{speaker_points,wins,average_rank}';
+
+ALTER TABLE Tournaments
+    ADD COLUMN TeamTiebreaks TEXT[],
+    ADD COLUMN SpeakerTiebreaks TEXT[];
@@ -0,0 +1,83 @@
+-- Restore the team average rank over team members' scores
+CREATE OR REPLACE FUNCTION calculate_team_average_rank()
+RETURNS TRIGGER AS $$
+DECLARE
+    avg_rank FLOAT;
+    debate_record RECORD;
+    team_slot RECORD;
+    is_four_team BOOLEAN;
+    team_points INTEGER;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+        is_four_team := debate_record.Team3ID IS NOT NULL AND debate_record.Team4ID IS NOT NULL;
+
+        FOR team_slot IN
+            SELECT slots.TeamID, slots.TotalScore, t.Name,
+                   RANK() OVER (ORDER BY slots.TotalScore DESC NULLS LAST) AS Placement
+            FROM (VALUES
+                (debate_record.Team1ID, NEW.Team1TotalScore),
+                (debate_record.Team2ID, NEW.Team2TotalScore),
+                (debate_record.Team3ID, NEW.Team3TotalScore),
+                (debate_record.Team4ID, NEW.Team4TotalScore)
+            ) AS slots(TeamID, TotalScore)
+            JOIN Teams t ON t.TeamID = slots.TeamID
+        LOOP
+            IF is_four_team THEN
+                avg_rank := team_slot.Placement;
+                team_points := 4 - team_slot.Placement;
+            ELSE
+                SELECT AVG(ss.SpeakerRank)::FLOAT INTO avg_rank
+                FROM SpeakerScores ss
+                JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
+                WHERE tm.TeamID = team_slot.TeamID AND ss.BallotID = NEW.BallotID;
+
+                team_points := CASE WHEN NEW.Verdict = team_slot.Name THEN 1 ELSE 0 END;
+            END IF;
+
+            -- Insert if not exists, update if exists
+            INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, TeamPoints, IsElimination)
+            VALUES (
+                team_slot.TeamID,
+                NEW.DebateID,
+                team_slot.TotalScore,
+                avg_rank,
+                team_points,
+                debate_record.IsEliminationRound
+            )
+            ON CONFLICT (TeamID, DebateID)
+            DO UPDATE SET
+                TotalScore = EXCLUDED.TotalScore,
+                Rank = EXCLUDED.Rank,
+                TeamPoints = EXCLUDED.TeamPoints;
+        END LOOP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE TournamentFormats
+    DROP COLUMN IF EXISTS SubstituteSpeeches,
+    DROP COLUMN IF EXISTS IronSpeeches;
+
+-- A speaker keeps one score per ballot: their first speech
+DELETE FROM JudgeSpeakerScores jss
+USING JudgeSpeakerScores earlier
+WHERE jss.JudgeBallotID = earlier.JudgeBallotID AND jss.SpeakerID = earlier.SpeakerID AND jss.ScoreID > earlier.ScoreID;
+
+ALTER TABLE JudgeSpeakerScores
+    DROP CONSTRAINT IF EXISTS judgespeakerscores_judgeballotid_teamid_position_key,
+    ADD CONSTRAINT judgespeakerscores_judgeballotid_speakerid_key UNIQUE (JudgeBallotID, SpeakerID),
+    DROP COLUMN IF EXISTS Position,
+    DROP COLUMN IF EXISTS TeamID;
+
+DELETE FROM SpeakerScores ss
+USING SpeakerScores earlier
+WHERE ss.BallotID = earlier.BallotID AND ss.SpeakerID = earlier.SpeakerID AND ss.ScoreID > earlier.ScoreID;
+
+ALTER TABLE SpeakerScores
+    DROP CONSTRAINT IF EXISTS speakerscores_ballotid_teamid_position_key,
+    ADD CONSTRAINT speakerscores_ballotid_speakerid_key UNIQUE (BallotID, SpeakerID),
+    DROP COLUMN IF EXISTS Position,
+    DROP COLUMN IF EXISTS TeamID;
@@ -0,0 +1,121 @@
+-- Speeches are recorded by speaking position rather than by speaker, so one
+-- speaker can give two speeches for a team short a member (an iron-person)
+-- and a student who is not on the team can substitute. TeamID is the team the
+-- speech was given for.
+ALTER TABLE SpeakerScores
+    ADD COLUMN TeamID INTEGER REFERENCES Teams(TeamID),
+    ADD COLUMN Position INTEGER;
+
+UPDATE SpeakerScores ss
+SET TeamID = speeches.TeamID, Position = speeches.Position
+FROM (
+    SELECT s.ScoreID, tm.TeamID,
+           ROW_NUMBER() OVER (PARTITION BY s.BallotID, tm.TeamID ORDER BY s.ScoreID) AS Position
+    FROM SpeakerScores s
+    JOIN Ballots b ON s.BallotID = b.BallotID
+    JOIN Debates d ON b.DebateID = d.DebateID
+    JOIN TeamMembers tm ON s.SpeakerID = tm.StudentID
+    WHERE tm.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+) speeches
+WHERE ss.ScoreID = speeches.ScoreID;
+
+-- Scores of students no longer on a team of the debate were never shown
+DELETE FROM SpeakerScores WHERE TeamID IS NULL;
+
+ALTER TABLE SpeakerScores
+    ALTER COLUMN TeamID SET NOT NULL,
+    ALTER COLUMN Position SET NOT NULL,
+    DROP CONSTRAINT IF EXISTS speakerscores_ballotid_speakerid_key,
+    ADD CONSTRAINT speakerscores_ballotid_teamid_position_key UNIQUE (BallotID, TeamID, Position);
+
+ALTER TABLE JudgeSpeakerScores
+    ADD COLUMN TeamID INTEGER REFERENCES Teams(TeamID),
+    ADD COLUMN Position INTEGER;
+
+UPDATE JudgeSpeakerScores jss
+SET TeamID = speeches.TeamID, Position = speeches.Position
+FROM (
+    SELECT s.ScoreID, tm.TeamID,
+           ROW_NUMBER() OVER (PARTITION BY s.JudgeBallotID, tm.TeamID ORDER BY s.ScoreID) AS Position
+    FROM JudgeSpeakerScores s
+    JOIN JudgeBallots jb ON s.JudgeBallotID = jb.JudgeBallotID
+    JOIN Ballots b ON jb.BallotID = b.BallotID
+    JOIN Debates d ON b.DebateID = d.DebateID
+    JOIN TeamMembers tm ON s.SpeakerID = tm.StudentID
+    WHERE tm.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+) speeches
+WHERE jss.ScoreID = speeches.ScoreID;
+
+DELETE FROM JudgeSpeakerScores WHERE TeamID IS NULL;
+
+ALTER TABLE JudgeSpeakerScores
+    ALTER COLUMN TeamID SET NOT NULL,
+    ALTER COLUMN Position SET NOT NULL,
+    DROP CONSTRAINT IF EXISTS judgespeakerscores_judgeballotid_speakerid_key,
+    ADD CONSTRAINT judgespeakerscores_judgeballotid_teamid_position_key UNIQUE (JudgeBallotID, TeamID, Position);
+
+-- How a speaker's second speech in a debate counts toward the speaker tab:
+-- 'all' counts both speeches, 'best' only the higher-scoring one and
+-- 'exclude' neither. SubstituteSpeeches is whether speeches given for a team
+-- the student is not on count toward their tab.
+ALTER TABLE TournamentFormats
+    ADD COLUMN IronSpeeches VARCHAR(20) NOT NULL DEFAULT 'best' CHECK (IronSpeeches IN ('all', 'best', 'exclude')),
+    ADD COLUMN SubstituteSpeeches VARCHAR(20) NOT NULL DEFAULT 'count' CHECK (SubstituteSpeeches IN ('count', 'exclude'));
+
+-- Team ranks average the speeches given for the team, substitutes included
+CREATE OR REPLACE FUNCTION calculate_team_average_rank()
+RETURNS TRIGGER AS $$
+DECLARE
+    avg_rank FLOAT;
+    debate_record RECORD;
+    team_slot RECORD;
+    is_four_team BOOLEAN;
+    team_points INTEGER;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+        is_four_team := debate_record.Team3ID IS NOT NULL AND debate_record.Team4ID IS NOT NULL;
+
+        FOR team_slot IN
+            SELECT slots.TeamID, slots.TotalScore, t.Name,
+                   RANK() OVER (ORDER BY slots.TotalScore DESC NULLS LAST) AS Placement
+            FROM (VALUES
+                (debate_record.Team1ID, NEW.Team1TotalScore),
+                (debate_record.Team2ID, NEW.Team2TotalScore),
+                (debate_record.Team3ID, NEW.Team3TotalScore),
+                (debate_record.Team4ID, NEW.Team4TotalScore)
+            ) AS slots(TeamID, TotalScore)
+            JOIN Teams t ON t.TeamID = slots.TeamID
+        LOOP
+            IF is_four_team THEN
+                avg_rank := team_slot.Placement;
+                team_points := 4 - team_slot.Placement;
+            ELSE
+                SELECT AVG(ss.SpeakerRank)::FLOAT INTO avg_rank
+                FROM SpeakerScores ss
+                WHERE ss.TeamID = team_slot.TeamID AND ss.BallotID = NEW.BallotID;
+
+                team_points := CASE WHEN NEW.Verdict = team_slot.Name THEN 1 ELSE 0 END;
+            END IF;
+
+            -- Insert if not exists, update if exists
+            INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, TeamPoints, IsElimination)
+            VALUES (
+                team_slot.TeamID,
+                NEW.DebateID,
+                team_slot.TotalScore,
+                avg_rank,
+                team_points,
+                debate_record.IsEliminationRound
+            )
+            ON CONFLICT (TeamID, DebateID)
+            DO UPDATE SET
+                TotalScore = EXCLUDED.TotalScore,
+                Rank = EXCLUDED.Rank,
+                TeamPoints = EXCLUDED.TeamPoints;
+        END LOOP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
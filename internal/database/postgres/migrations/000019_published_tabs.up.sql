@@ -0,0 +1,12 @@
+-- Each publication of a tournament's tab is kept as an immutable, numbered
+-- version. Snapshot is the tab as published: the team and speaker standings,
+-- the break and every round's results and motion.
+CREATE TABLE PublishedTabs (
+    TabID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    Version INTEGER NOT NULL,
+    Snapshot JSONB NOT NULL,
+    PublishedBy INTEGER NOT NULL REFERENCES Users(UserID),
+    PublishedAt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(TournamentID, Version)
+);
@@ -0,0 +1,34 @@
+-- Byes are dropped rather than turned back into "Public Speaking" debates
+DROP TABLE IF EXISTS Byes;
+
+ALTER TABLE TournamentFormats
+    DROP COLUMN IF EXISTS ByeResult;
+
+CREATE OR REPLACE FUNCTION handle_public_speaking_team()
+RETURNS TRIGGER AS $$
+DECLARE
+    debate_record RECORD;
+BEGIN
+    IF NEW.RecordingStatus = 'Recorded' THEN
+        -- Get the debate information
+        SELECT * INTO debate_record FROM Debates WHERE DebateID = NEW.DebateID;
+
+        -- Set rank to 99 for "Public Speaking" teams
+        UPDATE TeamScores ts
+        SET Rank = 99
+        WHERE ts.DebateID = NEW.DebateID
+          AND ts.TeamID IN (
+              SELECT t.TeamID
+              FROM Teams t
+              WHERE t.TeamID IN (debate_record.Team1ID, debate_record.Team2ID, debate_record.Team3ID, debate_record.Team4ID)
+                AND t.Name = 'Public Speaking'
+          );
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER handle_public_speaking_team_trigger
+    AFTER UPDATE OF RecordingStatus ON Ballots
+    FOR EACH ROW
+    EXECUTE FUNCTION handle_public_speaking_team();
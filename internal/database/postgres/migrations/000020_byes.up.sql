@@ -0,0 +1,54 @@
+-- A team left without an opponent by an odd number of teams has a bye: it is
+-- not drawn into a debate, so no room or judge is spent on it. A team gets at
+-- most one bye in a tournament.
+CREATE TABLE Byes (
+    ByeID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    RoundID INTEGER NOT NULL REFERENCES Rounds(RoundID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    TeamID INTEGER NOT NULL REFERENCES Teams(TeamID) ON DELETE CASCADE,
+    UNIQUE(TournamentID, TeamID)
+);
+
+-- What a bye counts for in the team standings: the team's 'average' points
+-- and rank over the debates it held, a 'win' on top of the average, or
+-- 'none'.
+ALTER TABLE TournamentFormats
+    ADD COLUMN ByeResult VARCHAR(20) NOT NULL DEFAULT 'average' CHECK (ByeResult IN ('average', 'win', 'none'));
+
+-- Odd fields used to be evened out with a "Public Speaking" team. Its
+-- opponents are given a bye instead, and the team and its debates removed.
+CREATE TEMP TABLE PublicSpeakingDebates AS
+SELECT d.DebateID, d.TournamentID, d.RoundID, d.RoundNumber, d.IsEliminationRound,
+       CASE WHEN t1.Name = 'Public Speaking' THEN d.Team2ID ELSE d.Team1ID END AS OpponentID
+FROM Debates d
+JOIN Teams t1 ON d.Team1ID = t1.TeamID
+JOIN Teams t2 ON d.Team2ID = t2.TeamID
+WHERE t1.Name = 'Public Speaking' OR t2.Name = 'Public Speaking';
+
+INSERT INTO Byes (TournamentID, RoundID, RoundNumber, TeamID)
+SELECT DISTINCT ON (psd.TournamentID, psd.OpponentID) psd.TournamentID, psd.RoundID, psd.RoundNumber, psd.OpponentID
+FROM PublicSpeakingDebates psd
+JOIN Teams t ON psd.OpponentID = t.TeamID
+WHERE NOT psd.IsEliminationRound AND t.Name <> 'Public Speaking'
+ORDER BY psd.TournamentID, psd.OpponentID, psd.RoundNumber;
+
+DELETE FROM SpeakerScores
+WHERE BallotID IN (SELECT b.BallotID FROM Ballots b JOIN PublicSpeakingDebates psd ON b.DebateID = psd.DebateID);
+DELETE FROM TeamScores WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM Ballots WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM JudgeAssignments WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM JudgeFeedback WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM DebateJudges WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM Schedules WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DELETE FROM Debates WHERE DebateID IN (SELECT DebateID FROM PublicSpeakingDebates);
+DROP TABLE PublicSpeakingDebates;
+
+DELETE FROM PairingHistory
+WHERE Team1ID IN (SELECT TeamID FROM Teams WHERE Name = 'Public Speaking')
+   OR Team2ID IN (SELECT TeamID FROM Teams WHERE Name = 'Public Speaking');
+DELETE FROM TeamMembers WHERE TeamID IN (SELECT TeamID FROM Teams WHERE Name = 'Public Speaking');
+DELETE FROM Teams WHERE Name = 'Public Speaking';
+
+DROP TRIGGER IF EXISTS handle_public_speaking_team_trigger ON Ballots;
+DROP FUNCTION IF EXISTS handle_public_speaking_team();
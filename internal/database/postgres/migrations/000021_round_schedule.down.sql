@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS Schedules;
+
+CREATE TABLE Schedules (
+   ScheduleID SERIAL PRIMARY KEY,
+   TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID),
+   RoundID INTEGER NOT NULL REFERENCES Rounds(RoundID),
+   DebateID INTEGER NOT NULL REFERENCES Debates(DebateID),
+   ScheduledTime TIMESTAMP NOT NULL
+);
+
+ALTER TABLE Tournaments
+    DROP CONSTRAINT IF EXISTS tournaments_schedule_day_check,
+    DROP COLUMN IF EXISTS RoundMinutes,
+    DROP COLUMN IF EXISTS BreakMinutes,
+    DROP COLUMN IF EXISTS DayStartTime,
+    DROP COLUMN IF EXISTS DayEndTime,
+    DROP COLUMN IF EXISTS LunchStartTime,
+    DROP COLUMN IF EXISTS LunchMinutes;
@@ -0,0 +1,29 @@
+-- Tournaments are timetabled between their start and end dates. Each day
+-- runs from DayStartTime to DayEndTime, the cutoff no round may run past, with
+-- a lunch slot no round may overlap unless LunchStartTime is NULL or
+-- LunchMinutes 0. Rounds last RoundMinutes with BreakMinutes between them.
+ALTER TABLE Tournaments
+    ADD COLUMN RoundMinutes INTEGER NOT NULL DEFAULT 90 CHECK (RoundMinutes > 0),
+    ADD COLUMN BreakMinutes INTEGER NOT NULL DEFAULT 15 CHECK (BreakMinutes >= 0),
+    ADD COLUMN DayStartTime TIME NOT NULL DEFAULT '08:00',
+    ADD COLUMN DayEndTime TIME NOT NULL DEFAULT '18:00',
+    ADD COLUMN LunchStartTime TIME DEFAULT '12:00',
+    ADD COLUMN LunchMinutes INTEGER NOT NULL DEFAULT 60 CHECK (LunchMinutes >= 0),
+    ADD CONSTRAINT tournaments_schedule_day_check CHECK (DayStartTime < DayEndTime);
+
+-- Schedules held a time per debate but was never written. It now holds the
+-- timetable, one slot per round, planned from the settings above. Pinned
+-- slots were set by hand and are kept when the timetable is planned again.
+DROP TABLE IF EXISTS Schedules;
+
+CREATE TABLE Schedules (
+    ScheduleID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    IsEliminationRound BOOLEAN NOT NULL DEFAULT FALSE,
+    StartTime TIMESTAMP NOT NULL,
+    EndTime TIMESTAMP NOT NULL,
+    IsPinned BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (TournamentID, RoundNumber, IsEliminationRound),
+    CHECK (StartTime < EndTime)
+);
@@ -0,0 +1,9 @@
+ALTER TABLE Teams DROP COLUMN IF EXISTS NeedsAccessibleRoom;
+
+DROP TABLE IF EXISTS RoomAvailability;
+
+ALTER TABLE Rooms
+    DROP COLUMN IF EXISTS BuildingID,
+    DROP COLUMN IF EXISTS IsAccessible;
+
+DROP TABLE IF EXISTS Buildings;
@@ -0,0 +1,28 @@
+-- Venue management. Rooms belong to the tournament's buildings and carry
+-- whether they are accessible; their Capacity counts speakers and judges.
+-- A room with availability windows can only be drawn for a round whose
+-- timetabled slot falls inside one of them; a room without any is always
+-- available. Teams can need an accessible room for all their debates.
+CREATE TABLE Buildings (
+    BuildingID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    Name VARCHAR(255) NOT NULL,
+    Address VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (TournamentID, Name)
+);
+
+ALTER TABLE Rooms
+    ADD COLUMN BuildingID INTEGER REFERENCES Buildings(BuildingID) ON DELETE SET NULL,
+    ADD COLUMN IsAccessible BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE RoomAvailability (
+    AvailabilityID SERIAL PRIMARY KEY,
+    RoomID INTEGER NOT NULL REFERENCES Rooms(RoomID) ON DELETE CASCADE,
+    StartTime TIMESTAMP NOT NULL,
+    EndTime TIMESTAMP NOT NULL,
+    CHECK (StartTime < EndTime)
+);
+
+CREATE INDEX IF NOT EXISTS idx_roomavailability_room ON RoomAvailability(RoomID);
+
+ALTER TABLE Teams ADD COLUMN NeedsAccessibleRoom BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,20 @@
+-- Motions stay hidden from everyone but admins until their round is released.
+-- A release records when the motion went out and when prep time ends.
+CREATE TABLE MotionReleases (
+    ReleaseID SERIAL PRIMARY KEY,
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    IsEliminationRound BOOLEAN NOT NULL,
+    ReleasedAt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PrepEndsAt TIMESTAMP NOT NULL,
+    ReleasedBy INTEGER REFERENCES Users(UserID),
+    UNIQUE (TournamentID, RoundNumber, IsEliminationRound),
+    CHECK (ReleasedAt <= PrepEndsAt)
+);
+
+-- Rounds already debated keep their motions public
+INSERT INTO MotionReleases (TournamentID, RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt)
+SELECT DISTINCT d.TournamentID, d.RoundNumber, d.IsEliminationRound, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+FROM Debates d
+JOIN Ballots b ON b.DebateID = d.DebateID
+WHERE b.RecordingStatus = 'Recorded';
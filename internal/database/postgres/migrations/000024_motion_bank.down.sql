@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS RoundMotions;
+DROP TABLE IF EXISTS MotionTags;
+DROP TABLE IF EXISTS MotionBank;
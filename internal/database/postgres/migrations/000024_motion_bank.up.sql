@@ -0,0 +1,29 @@
+-- A motion bank shared across tournaments. Attaching a bank motion to a round
+-- copies it into the tournament's Motions and records the link, which is
+-- what the side balance of the motion is computed from.
+CREATE TABLE MotionBank (
+    MotionID SERIAL PRIMARY KEY,
+    Text TEXT NOT NULL,
+    InfoSlide TEXT NOT NULL DEFAULT '',
+    Difficulty VARCHAR(10) NOT NULL DEFAULT 'medium' CHECK (Difficulty IN ('easy', 'medium', 'hard')),
+    CreatedBy INTEGER REFERENCES Users(UserID),
+    CreatedAt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE MotionTags (
+    MotionID INTEGER NOT NULL REFERENCES MotionBank(MotionID) ON DELETE CASCADE,
+    Tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (MotionID, Tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_motiontags_tag ON MotionTags(Tag);
+
+CREATE TABLE RoundMotions (
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    IsEliminationRound BOOLEAN NOT NULL,
+    MotionID INTEGER NOT NULL REFERENCES MotionBank(MotionID),
+    PRIMARY KEY (TournamentID, RoundNumber, IsEliminationRound)
+);
+
+CREATE INDEX IF NOT EXISTS idx_roundmotions_motion ON RoundMotions(MotionID);
@@ -0,0 +1,16 @@
+-- Judges declare for each round whether they are available, and check in for
+-- it on the day. A judge without a row for a round is available and not
+-- checked in. Once any judge has checked in for a round, only the judges
+-- checked in are allocated to it. A judge marked absent is unavailable for the
+-- round and their seat is given to another judge.
+CREATE TABLE JudgeAvailability (
+    TournamentID INTEGER NOT NULL REFERENCES Tournaments(TournamentID) ON DELETE CASCADE,
+    JudgeID INTEGER NOT NULL REFERENCES Users(UserID) ON DELETE CASCADE,
+    RoundNumber INTEGER NOT NULL,
+    IsEliminationRound BOOLEAN NOT NULL,
+    IsAvailable BOOLEAN NOT NULL DEFAULT TRUE,
+    CheckedInAt TIMESTAMP,
+    MarkedAbsentAt TIMESTAMP,
+    PRIMARY KEY (TournamentID, JudgeID, RoundNumber, IsEliminationRound),
+    CHECK (IsAvailable OR CheckedInAt IS NULL)
+);
@@ -1,5 +1,5 @@
 -- name: GetJudgesByTournamentAndRound :many
-SELECT u.UserID, u.Name, u.Email, ja.IsHeadJudge
+SELECT u.UserID, u.Name, u.Email, ja.IsHeadJudge, ja.DebateID
 FROM Users u
 JOIN JudgeAssignments ja ON u.UserID = ja.JudgeID
 WHERE ja.TournamentID = $1 AND ja.RoundNumber = $2 AND ja.IsElimination = $3;
@@ -14,7 +14,7 @@ INSERT INTO JudgeAssignments (TournamentID, JudgeID, DebateID, RoundNumber, IsEl
 VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetAvailableJudges :many
-SELECT DISTINCT u.UserID, u.Name, u.Email, v.iDebateVolunteerID
+SELECT DISTINCT u.UserID, u.Name, u.Email, v.iDebateVolunteerID, v.MaxTimesJudgingTeam
 FROM Users u
          JOIN Volunteers v ON u.UserID = v.UserID
          JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
@@ -28,21 +28,26 @@ WHERE TournamentID = $1;
 
 -- name: UpdatePairing :exec
 UPDATE Debates
-SET Team1ID = $2, Team2ID = $3
+SET Team1ID = $2, Team2ID = $3, Team3ID = $4, Team4ID = $5
 WHERE DebateID = $1;
 
 -- name: GetBallotsByTournamentAndRound :many
 SELECT b.BallotID, d.RoundNumber, d.IsEliminationRound, r.RoomName,
        u.Name AS HeadJudgeName, b.RecordingStatus, b.Verdict,
        t1.TeamID as Team1ID, t1.Name as Team1Name,
-       t2.TeamID as Team2ID, t2.Name as Team2Name
+       t2.TeamID as Team2ID, t2.Name as Team2Name,
+       d.Team3ID, t3.Name as Team3Name,
+       d.Team4ID, t4.Name as Team4Name
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
 JOIN Rooms r ON d.RoomID = r.RoomID
 JOIN Users u ON b.JudgeID = u.UserID
 JOIN Teams t1 ON d.Team1ID = t1.TeamID
 JOIN Teams t2 ON d.Team2ID = t2.TeamID
-WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3;
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+  AND (NOT $4::bool OR EXISTS (SELECT 1 FROM ReleasedDraws rd WHERE rd.DebateID = d.DebateID));
 
 -- name: GetBallotByID :one
 SELECT b.BallotID, d.DebateID, d.RoundNumber, d.IsEliminationRound,
@@ -50,13 +55,17 @@ SELECT b.BallotID, d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
        b.Team1TotalScore, b.Team2TotalScore, b.RecordingStatus, b.Verdict,
        b.Team1Feedback, b.Team2Feedback, b.last_updated_by, b.last_updated_at,
-       b.head_judge_submitted
+       b.head_judge_submitted,
+       d.Team3ID, t3.Name AS Team3Name, d.Team4ID, t4.Name AS Team4Name,
+       b.Team3TotalScore, b.Team4TotalScore, b.Team3Feedback, b.Team4Feedback
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
 LEFT JOIN Rooms r ON d.RoomID = r.RoomID
 JOIN Users u ON b.JudgeID = u.UserID
 JOIN Teams t1 ON d.Team1ID = t1.TeamID
 JOIN Teams t2 ON d.Team2ID = t2.TeamID
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
 WHERE b.BallotID = $1;
 
 -- name: GetBallotByJudgeID :one
@@ -65,14 +74,20 @@ SELECT b.BallotID, d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
        b.Team1TotalScore, b.Team2TotalScore, b.RecordingStatus, b.Verdict,
        b.Team1Feedback, b.Team2Feedback, b.last_updated_by, b.last_updated_at,
-       b.head_judge_submitted
+       b.head_judge_submitted,
+       d.Team3ID, t3.Name AS Team3Name, d.Team4ID, t4.Name AS Team4Name,
+       b.Team3TotalScore, b.Team4TotalScore, b.Team3Feedback, b.Team4Feedback
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
 LEFT JOIN Rooms r ON d.RoomID = r.RoomID
 JOIN Users u ON b.JudgeID = u.UserID
 JOIN Teams t1 ON d.Team1ID = t1.TeamID
 JOIN Teams t2 ON d.Team2ID = t2.TeamID
-WHERE b.JudgeID = $1 AND d.TournamentID = $2
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
+JOIN JudgeAssignments ja ON ja.DebateID = d.DebateID
+WHERE ja.JudgeID = $1 AND d.TournamentID = $2
+  AND EXISTS (SELECT 1 FROM ReleasedDraws rd WHERE rd.DebateID = d.DebateID)
 ORDER BY d.RoundNumber DESC
 LIMIT 1;
 
@@ -80,12 +95,13 @@ LIMIT 1;
 UPDATE Ballots
 SET Team1TotalScore = $2, Team2TotalScore = $3, RecordingStatus = $4, Verdict = $5,
     Team1Feedback = $6, Team2Feedback = $7, last_updated_by = $8,
-    last_updated_at = CURRENT_TIMESTAMP, head_judge_submitted = $9
+    last_updated_at = CURRENT_TIMESTAMP, head_judge_submitted = $9,
+    Team3TotalScore = $10, Team4TotalScore = $11, Team3Feedback = $12, Team4Feedback = $13
 WHERE BallotID = $1;
 
 -- name: CreateInitialSpeakerScores :exec
 WITH ballot_info AS (
-    SELECT b.BallotID, d.Team1ID, d.Team2ID
+    SELECT b.BallotID, d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
     WHERE d.DebateID = $1
@@ -96,38 +112,43 @@ team_speakers AS (
            CASE
                WHEN t.TeamID = bi.Team1ID THEN 1
                WHEN t.TeamID = bi.Team2ID THEN 2
+               WHEN t.TeamID = bi.Team3ID THEN 3
+               WHEN t.TeamID = bi.Team4ID THEN 4
            END as TeamNumber
     FROM TeamMembers tm
     JOIN Teams t ON tm.TeamID = t.TeamID
-    JOIN ballot_info bi ON t.TeamID IN (bi.Team1ID, bi.Team2ID)
+    JOIN ballot_info bi ON t.TeamID IN (bi.Team1ID, bi.Team2ID, bi.Team3ID, bi.Team4ID)
 )
-INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints)
+INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, TeamID, Position)
 SELECT bi.BallotID, ts.SpeakerID,
        ROW_NUMBER() OVER (PARTITION BY bi.BallotID, ts.TeamNumber ORDER BY ts.SpeakerID) as SpeakerRank,
-       0 as SpeakerPoints
+       0 as SpeakerPoints, ts.TeamID,
+       ROW_NUMBER() OVER (PARTITION BY bi.BallotID, ts.TeamNumber ORDER BY ts.SpeakerID) as Position
 FROM ballot_info bi
 JOIN team_speakers ts ON (ts.TeamNumber = 1 AND bi.Team1ID = ts.TeamID)
-                      OR (ts.TeamNumber = 2 AND bi.Team2ID = ts.TeamID);
+                      OR (ts.TeamNumber = 2 AND bi.Team2ID = ts.TeamID)
+                      OR (ts.TeamNumber = 3 AND bi.Team3ID = ts.TeamID)
+                      OR (ts.TeamNumber = 4 AND bi.Team4ID = ts.TeamID);
 
 -- name: GetSpeakerScoresByBallot :many
+-- IsSubstitute marks speeches given by a student who is not on the team.
 SELECT ss.ScoreID, ss.SpeakerID, s.FirstName, s.LastName,
        ss.SpeakerRank, ss.SpeakerPoints, ss.Feedback,
-       t.TeamID, t.Name AS TeamName
+       t.TeamID, t.Name AS TeamName, ss.ReplyPoints, ss.Position,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = ss.TeamID AND tm.StudentID = ss.SpeakerID) AS IsSubstitute
 FROM SpeakerScores ss
          JOIN Students s ON ss.SpeakerID = s.StudentID
-         JOIN Ballots b ON ss.BallotID = b.BallotID
-         JOIN Debates d ON b.DebateID = d.DebateID
-         JOIN TeamMembers tm ON s.StudentID = tm.StudentID
-         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN Teams t ON ss.TeamID = t.TeamID
 WHERE ss.BallotID = $1
-  AND (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-ORDER BY t.TeamID, ss.SpeakerRank;
+ORDER BY t.TeamID, ss.Position;
 
 
--- name: UpdateSpeakerScore :exec
-UPDATE SpeakerScores
-SET SpeakerRank = $3, SpeakerPoints = $4, Feedback = $5
-WHERE BallotID = $1 AND SpeakerID = $2;
+-- name: UpsertSpeakerScore :exec
+INSERT INTO SpeakerScores (BallotID, TeamID, Position, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, ReplyPoints)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (BallotID, TeamID, Position)
+    DO UPDATE SET SpeakerID = EXCLUDED.SpeakerID, SpeakerRank = EXCLUDED.SpeakerRank, SpeakerPoints = EXCLUDED.SpeakerPoints,
+                  Feedback = EXCLUDED.Feedback, ReplyPoints = EXCLUDED.ReplyPoints;
 
 -- name: IsHeadJudgeForBallot :one
 SELECT COUNT(*) > 0 as is_head_judge
@@ -136,6 +157,166 @@ JOIN Debates d ON b.DebateID = d.DebateID
 JOIN JudgeAssignments ja ON d.DebateID = ja.DebateID
 WHERE b.BallotID = $1 AND ja.JudgeID = $2 AND ja.IsHeadJudge = true;
 
+-- name: GetBallotPanel :many
+SELECT ja.JudgeID, u.Name AS JudgeName, ja.IsHeadJudge, jb.Verdict
+FROM Ballots b
+JOIN JudgeAssignments ja ON ja.DebateID = b.DebateID
+JOIN Users u ON ja.JudgeID = u.UserID
+LEFT JOIN JudgeBallots jb ON jb.BallotID = b.BallotID AND jb.JudgeID = ja.JudgeID
+WHERE b.BallotID = $1
+ORDER BY ja.IsHeadJudge DESC, u.Name;
+
+-- name: GetBallotPanelsByTournamentAndRound :many
+SELECT b.BallotID, ja.JudgeID, u.Name AS JudgeName, ja.IsHeadJudge, jb.Verdict
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN JudgeAssignments ja ON ja.DebateID = b.DebateID
+JOIN Users u ON ja.JudgeID = u.UserID
+LEFT JOIN JudgeBallots jb ON jb.BallotID = b.BallotID AND jb.JudgeID = ja.JudgeID
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+ORDER BY b.BallotID, ja.IsHeadJudge DESC, u.Name;
+
+-- name: GetBallotScoringRules :one
+SELECT tf.SpeakersPerTeam, tf.MinSpeakerPoints, tf.MaxSpeakerPoints, tf.SpeakerPointStep,
+       tf.AllowLowPointWins, tf.ReplySpeeches, tf.MinReplyPoints, tf.MaxReplyPoints, tf.TieHandling
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Tournaments t ON d.TournamentID = t.TournamentID
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE b.BallotID = $1;
+
+-- name: GetBallotTeamMembers :many
+SELECT tm.TeamID, tm.StudentID
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN TeamMembers tm ON tm.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+WHERE b.BallotID = $1;
+
+-- name: CreateBallotVersion :one
+INSERT INTO BallotVersions (BallotID, VersionNumber, JudgeID, Snapshot, CreatedBy, Reason, RevertedFrom)
+VALUES ($1, (SELECT COALESCE(MAX(bv.VersionNumber), 0) + 1 FROM BallotVersions bv WHERE bv.BallotID = $1),
+        $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetBallotVersions :many
+SELECT bv.VersionID, bv.BallotID, bv.VersionNumber, bv.JudgeID, j.Name AS JudgeName,
+       bv.Snapshot, bv.CreatedBy, c.Name AS CreatedByName, bv.CreatedAt, bv.Reason, bv.RevertedFrom
+FROM BallotVersions bv
+JOIN Users j ON bv.JudgeID = j.UserID
+JOIN Users c ON bv.CreatedBy = c.UserID
+WHERE bv.BallotID = $1
+ORDER BY bv.VersionNumber;
+
+-- name: GetBallotVersion :one
+SELECT * FROM BallotVersions
+WHERE BallotID = $1 AND VersionNumber = $2;
+
+-- name: GetJudgeBallots :many
+SELECT * FROM JudgeBallots
+WHERE BallotID = $1;
+
+-- name: UpsertJudgeBallot :one
+INSERT INTO JudgeBallots (BallotID, JudgeID, Team1TotalScore, Team1Feedback, Team2TotalScore, Team2Feedback,
+                          Team3TotalScore, Team3Feedback, Team4TotalScore, Team4Feedback, Verdict)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (BallotID, JudgeID) DO UPDATE SET
+    Team1TotalScore = EXCLUDED.Team1TotalScore,
+    Team1Feedback = EXCLUDED.Team1Feedback,
+    Team2TotalScore = EXCLUDED.Team2TotalScore,
+    Team2Feedback = EXCLUDED.Team2Feedback,
+    Team3TotalScore = EXCLUDED.Team3TotalScore,
+    Team3Feedback = EXCLUDED.Team3Feedback,
+    Team4TotalScore = EXCLUDED.Team4TotalScore,
+    Team4Feedback = EXCLUDED.Team4Feedback,
+    Verdict = EXCLUDED.Verdict,
+    SubmittedAt = CURRENT_TIMESTAMP
+RETURNING JudgeBallotID;
+
+-- name: DeleteJudgeBallots :exec
+DELETE FROM JudgeBallots
+WHERE BallotID = $1;
+
+-- name: SetDoubleEntryBallots :one
+UPDATE Tournaments
+SET DoubleEntryBallots = $2
+WHERE TournamentID = $1 AND deleted_at IS NULL
+RETURNING DoubleEntryBallots;
+
+-- name: GetBallotEntryMode :one
+SELECT t.DoubleEntryBallots
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Tournaments t ON d.TournamentID = t.TournamentID
+WHERE b.BallotID = $1;
+
+-- name: CreateBallotEntry :one
+INSERT INTO BallotEntries (BallotID, JudgeID, EnteredBy, Snapshot)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetPendingBallotEntries :many
+SELECT be.EntryID, be.BallotID, be.JudgeID, be.EnteredBy, u.Name AS EnteredByName, be.Snapshot, be.EnteredAt
+FROM BallotEntries be
+JOIN Users u ON be.EnteredBy = u.UserID
+WHERE be.BallotID = $1 AND be.JudgeID = $2 AND be.ConfirmedAt IS NULL
+ORDER BY be.EnteredAt, be.EntryID;
+
+-- name: GetPendingBallotEntriesByTournamentAndRound :many
+SELECT be.EntryID, be.BallotID, be.JudgeID, j.Name AS JudgeName, be.EnteredBy, u.Name AS EnteredByName,
+       be.Snapshot, be.EnteredAt
+FROM BallotEntries be
+JOIN Ballots b ON be.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Users j ON be.JudgeID = j.UserID
+JOIN Users u ON be.EnteredBy = u.UserID
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+  AND be.ConfirmedAt IS NULL
+ORDER BY be.BallotID, be.JudgeID, be.EnteredAt, be.EntryID;
+
+-- name: ConfirmBallotEntries :exec
+UPDATE BallotEntries
+SET ConfirmedAt = CURRENT_TIMESTAMP
+WHERE BallotID = $1 AND JudgeID = $2 AND ConfirmedAt IS NULL;
+
+-- name: DeletePendingBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1 AND JudgeID = $2 AND ConfirmedAt IS NULL;
+
+-- name: DeleteUserPendingBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1 AND JudgeID = $2 AND EnteredBy = $3 AND ConfirmedAt IS NULL;
+
+-- name: DeleteBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1;
+
+-- name: DeleteJudgeSpeakerScores :exec
+DELETE FROM JudgeSpeakerScores
+WHERE JudgeBallotID = $1;
+
+-- name: CreateJudgeSpeakerScore :exec
+INSERT INTO JudgeSpeakerScores (JudgeBallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, ReplyPoints, TeamID, Position)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetPanelSpeakerScores :many
+SELECT jb.JudgeID, jss.TeamID, jss.Position, jss.SpeakerID, jss.SpeakerRank, jss.SpeakerPoints, jss.Feedback, jss.ReplyPoints
+FROM JudgeSpeakerScores jss
+JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+WHERE jb.BallotID = $1
+ORDER BY jss.TeamID, jss.Position;
+
+-- name: GetJudgeSpeakerScores :many
+SELECT jss.ScoreID, jss.SpeakerID, s.FirstName, s.LastName,
+       jss.SpeakerRank, jss.SpeakerPoints, jss.Feedback,
+       t.TeamID, t.Name AS TeamName, jss.ReplyPoints, jss.Position,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = jss.TeamID AND tm.StudentID = jss.SpeakerID) AS IsSubstitute
+FROM JudgeSpeakerScores jss
+         JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+         JOIN Students s ON jss.SpeakerID = s.StudentID
+         JOIN Teams t ON jss.TeamID = t.TeamID
+WHERE jb.BallotID = $1 AND jb.JudgeID = $2
+ORDER BY t.TeamID, jss.SpeakerRank;
+
 -- name: GetTeamWins :one
 SELECT COUNT(*) as wins
 FROM Debates d
@@ -145,8 +326,8 @@ WHERE (d.Team1ID = $1 AND b.Team1TotalScore > b.Team2TotalScore)
    AND d.TournamentID = $2;
 
 -- name: CreateDebate :one
-INSERT INTO Debates (TournamentID, RoundID, RoundNumber, IsEliminationRound, Team1ID, Team2ID, RoomID, StartTime)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO Debates (TournamentID, RoundID, RoundNumber, IsEliminationRound, Team1ID, Team2ID, RoomID, StartTime, Team3ID, Team4ID, PanelQuality, EndTime)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING DebateID;
 
 -- name: CreateBallot :one
@@ -169,13 +350,13 @@ SELECT t.TeamID, t.Name, t.TournamentID,
         WHERE ((d.Team1ID = t.TeamID AND b.Team1TotalScore > b.Team2TotalScore)
            OR (d.Team2ID = t.TeamID AND b.Team2TotalScore > b.Team1TotalScore))
            AND d.TournamentID = $1) as Wins,
-       l.Name as LeagueName
+       l.Name as LeagueName, t.NeedsAccessibleRoom
 FROM Teams t
 LEFT JOIN TeamMembers tm ON t.TeamID = tm.TeamID
 JOIN Tournaments tour ON t.TournamentID = tour.TournamentID
 JOIN Leagues l ON tour.LeagueID = l.LeagueID
 WHERE t.TournamentID = $1
-GROUP BY t.TeamID, t.Name, t.TournamentID, l.Name;
+GROUP BY t.TeamID, t.Name, t.TournamentID, l.Name, t.NeedsAccessibleRoom;
 
 
 -- name: GetPreviousPairings :many
@@ -188,9 +369,9 @@ INSERT INTO PairingHistory (TournamentID, Team1ID, Team2ID, RoundNumber, IsElimi
 VALUES ($1, $2, $3, $4, $5);
 
 -- name: CreateTeam :one
-INSERT INTO Teams (Name, TournamentID)
-VALUES ($1, $2)
-RETURNING TeamID, Name, TournamentID;
+INSERT INTO Teams (Name, TournamentID, NeedsAccessibleRoom)
+VALUES ($1, $2, $3)
+RETURNING TeamID, Name, TournamentID, NeedsAccessibleRoom;
 
 -- name: AddTeamMember :one
 INSERT INTO TeamMembers (TeamID, StudentID)
@@ -204,18 +385,23 @@ JOIN Teams t ON tm.TeamID = t.TeamID
 WHERE t.TournamentID = $1 AND tm.StudentID = $2;
 
 -- name: GetTeamByID :one
-SELECT t.TeamID, t.Name, t.TournamentID,
+SELECT t.TeamID, t.Name, t.TournamentID, t.NeedsAccessibleRoom,
        array_agg(tm.StudentID) as SpeakerIDs
 FROM Teams t
 LEFT JOIN TeamMembers tm ON t.TeamID = tm.TeamID
 WHERE t.TeamID = $1
-GROUP BY t.TeamID, t.Name, t.TournamentID;
+GROUP BY t.TeamID, t.Name, t.TournamentID, t.NeedsAccessibleRoom;
 
 -- name: UpdateTeam :exec
 UPDATE Teams
 SET Name = $2
 WHERE TeamID = $1;
 
+-- name: UpdateTeamNeedsAccessibleRoom :exec
+UPDATE Teams
+SET NeedsAccessibleRoom = $2
+WHERE TeamID = $1;
+
 -- name: RemoveTeamMembers :exec
 DELETE FROM TeamMembers
 WHERE TeamID = $1;
@@ -264,13 +450,12 @@ WHERE TournamentID = $1;
 
 
 -- name: GetRoomByID :one
-SELECT RoomID, RoomName, TournamentID, Location, Capacity
-FROM Rooms
+SELECT * FROM Rooms
 WHERE RoomID = $1;
 
 -- name: CreateRoom :one
-INSERT INTO Rooms (RoomName, Location, Capacity, TournamentID)
-VALUES ($1, $2, $3, $4)
+INSERT INTO Rooms (RoomName, Location, Capacity, TournamentID, BuildingID, IsAccessible)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateRoom :one
@@ -279,6 +464,68 @@ SET RoomName = $2
 WHERE RoomID = $1
 RETURNING *;
 
+-- name: UpdateRoomVenue :one
+UPDATE Rooms
+SET Location = $2, Capacity = $3, BuildingID = $4, IsAccessible = $5
+WHERE RoomID = $1
+RETURNING *;
+
+-- name: DeleteRoom :execrows
+DELETE FROM Rooms r
+WHERE r.RoomID = $1
+  AND NOT EXISTS (SELECT 1 FROM Debates d WHERE d.RoomID = r.RoomID);
+
+-- name: GetAvailableRooms :many
+-- Rooms without availability windows are always available; the others only
+-- when one of their windows covers the whole slot.
+SELECT r.*
+FROM Rooms r
+WHERE r.TournamentID = $1
+  AND r.RoomName <> 'Unassigned'
+  AND (NOT EXISTS (SELECT 1 FROM RoomAvailability ra WHERE ra.RoomID = r.RoomID)
+       OR EXISTS (SELECT 1 FROM RoomAvailability ra
+                  WHERE ra.RoomID = r.RoomID AND ra.StartTime <= $2 AND ra.EndTime >= $3))
+ORDER BY r.RoomID;
+
+-- name: GetRoomAvailability :many
+SELECT ra.AvailabilityID, ra.RoomID, ra.StartTime, ra.EndTime
+FROM RoomAvailability ra
+JOIN Rooms r ON ra.RoomID = r.RoomID
+WHERE r.TournamentID = $1
+ORDER BY ra.RoomID, ra.StartTime;
+
+-- name: CreateRoomAvailability :exec
+INSERT INTO RoomAvailability (RoomID, StartTime, EndTime)
+VALUES ($1, $2, $3);
+
+-- name: DeleteRoomAvailability :exec
+DELETE FROM RoomAvailability
+WHERE RoomID = $1;
+
+-- name: CreateBuilding :one
+INSERT INTO Buildings (TournamentID, Name, Address)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetBuildingsByTournament :many
+SELECT * FROM Buildings
+WHERE TournamentID = $1
+ORDER BY Name;
+
+-- name: UpdateBuilding :one
+UPDATE Buildings
+SET Name = $2, Address = $3
+WHERE BuildingID = $1
+RETURNING *;
+
+-- name: DeleteBuilding :exec
+DELETE FROM Buildings
+WHERE BuildingID = $1;
+
+-- name: CountBuildingsByTournament :one
+SELECT COUNT(*) FROM Buildings
+WHERE TournamentID = $1;
+
 
 -- name: AssignRoomToDebate :exec
 UPDATE Debates
@@ -354,14 +601,27 @@ SELECT
     t1.name AS team1name,
     t2.teamid AS team2id,
     t2.name AS team2name,
-    j.name AS headjudgename
+    j.name AS headjudgename,
+    d.team3id,
+    t3.name AS team3name,
+    d.team4id,
+    t4.name AS team4name,
+    d.panelquality,
+    bc.name AS breakcategoryname,
+    ARRAY(SELECT aj.judgeid FROM JudgeAssignments aj WHERE aj.debateid = d.debateid ORDER BY aj.judgeid)::int[] AS judgeids,
+    d.starttime,
+    d.endtime
 FROM
     Debates d
     JOIN Rooms r ON d.roomid = r.roomid
     JOIN Teams t1 ON d.team1id = t1.teamid
     JOIN Teams t2 ON d.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON d.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON d.team4id = t4.teamid
     LEFT JOIN JudgeAssignments ja ON d.debateid = ja.debateid AND ja.isheadjudge = true
     LEFT JOIN Users j ON ja.judgeid = j.userid
+    LEFT JOIN EliminationBracketNodes n ON d.debateid = n.debateid
+    LEFT JOIN BreakCategories bc ON n.categoryid = bc.categoryid
 WHERE
     d.tournamentid = $1
     AND d.roundnumber = $2
@@ -369,6 +629,79 @@ WHERE
 ORDER BY
     d.debateid;
 
+-- name: GetReleasedPairings :many
+SELECT
+    d.debateid,
+    d.roundnumber,
+    d.iseliminationround,
+    rd.roomid,
+    r.roomname,
+    t1.teamid AS team1id,
+    t1.name AS team1name,
+    t2.teamid AS team2id,
+    t2.name AS team2name,
+    j.name AS headjudgename,
+    rd.team3id,
+    t3.name AS team3name,
+    rd.team4id,
+    t4.name AS team4name,
+    d.panelquality,
+    bc.name AS breakcategoryname,
+    rd.judgeids,
+    d.starttime,
+    d.endtime
+FROM
+    ReleasedDraws rd
+    JOIN Rounds ro ON rd.roundid = ro.roundid
+    JOIN Debates d ON rd.debateid = d.debateid
+    JOIN Rooms r ON rd.roomid = r.roomid
+    JOIN Teams t1 ON rd.team1id = t1.teamid
+    JOIN Teams t2 ON rd.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON rd.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON rd.team4id = t4.teamid
+    LEFT JOIN Users j ON rd.headjudgeid = j.userid
+    LEFT JOIN EliminationBracketNodes n ON d.debateid = n.debateid
+    LEFT JOIN BreakCategories bc ON n.categoryid = bc.categoryid
+WHERE
+    ro.tournamentid = $1
+    AND ro.roundnumber = $2
+    AND ro.iseliminationround = $3
+ORDER BY
+    d.debateid;
+
+-- name: GetReleasedDebateIDs :many
+SELECT rd.DebateID
+FROM ReleasedDraws rd
+JOIN Rounds r ON rd.RoundID = r.RoundID
+WHERE r.TournamentID = $1;
+
+-- name: DeleteReleasedDraw :exec
+DELETE FROM ReleasedDraws
+WHERE RoundID = $1;
+
+-- name: ReleaseRoundDraw :exec
+INSERT INTO ReleasedDraws (RoundID, DebateID, RoomID, Team1ID, Team2ID, Team3ID, Team4ID, HeadJudgeID, JudgeIDs)
+SELECT d.RoundID, d.DebateID, d.RoomID, d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID,
+       (SELECT ja.JudgeID FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID AND ja.IsHeadJudge LIMIT 1),
+       ARRAY(SELECT ja.JudgeID FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID ORDER BY ja.JudgeID)
+FROM Debates d
+WHERE d.RoundID = $1;
+
+-- name: SetRoundReleased :exec
+UPDATE Rounds
+SET ReleasedAt = CURRENT_TIMESTAMP
+WHERE RoundID = $1;
+
+-- name: SetRoundDraw :exec
+UPDATE Rounds
+SET DrawSeed = $2, DrawInput = $3
+WHERE RoundID = $1;
+
+-- name: GetOtherPreliminaryDebates :many
+SELECT Team1ID, Team2ID, Team3ID, Team4ID
+FROM Debates
+WHERE TournamentID = $1 AND IsEliminationRound = false AND RoundNumber <> $2;
+
 -- name: GetPairingByID :one
 SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
@@ -434,12 +767,19 @@ SELECT
     t1.name AS team1name,
     t2.teamid AS team2id,
     t2.name AS team2name,
-    j.name AS headjudgename
+    j.name AS headjudgename,
+    d.team3id,
+    t3.name AS team3name,
+    d.team4id,
+    t4.name AS team4name,
+    d.panelquality
 FROM
     Debates d
     JOIN Rooms r ON d.roomid = r.roomid
     JOIN Teams t1 ON d.team1id = t1.teamid
     JOIN Teams t2 ON d.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON d.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON d.team4id = t4.teamid
     LEFT JOIN JudgeAssignments ja ON d.debateid = ja.debateid AND ja.isheadjudge = true
     LEFT JOIN Users j ON ja.judgeid = j.userid
 WHERE
@@ -510,7 +850,8 @@ WHERE
 SELECT
     u.UserID as JudgeID,
     u.Name,
-    v.iDebateVolunteerID
+    v.iDebateVolunteerID,
+    v.MaxTimesJudgingTeam
 FROM
     Users u
 JOIN
@@ -518,6 +859,94 @@ JOIN
 WHERE
     u.UserID = $1;
 
+-- name: GetJudgeConflicts :many
+SELECT
+    jc.ConflictID,
+    jc.ConflictType,
+    jc.SchoolID,
+    s.SchoolName,
+    jc.TeamID,
+    t.Name AS TeamName,
+    jc.StudentID,
+    COALESCE(st.FirstName || ' ' || st.LastName, '')::text AS StudentName,
+    jc.Reason
+FROM
+    JudgeConflicts jc
+LEFT JOIN
+    Schools s ON jc.SchoolID = s.SchoolID
+LEFT JOIN
+    Teams t ON jc.TeamID = t.TeamID
+LEFT JOIN
+    Students st ON jc.StudentID = st.StudentID
+WHERE
+    jc.JudgeID = $1
+ORDER BY
+    jc.ConflictID;
+
+-- name: GetJudgeStrengths :many
+SELECT DISTINCT
+    u.UserID AS JudgeID,
+    COALESCE((SELECT AVG(jf.AverageRating) FROM JudgeFeedback jf WHERE jf.JudgeID = u.UserID), 0)::float8 AS AverageRating,
+    (SELECT COUNT(jf.AverageRating) FROM JudgeFeedback jf WHERE jf.JudgeID = u.UserID) AS RatingCount,
+    (SELECT COUNT(*) FROM JudgeAssignments ja WHERE ja.JudgeID = u.UserID AND ja.IsElimination = true) AS EliminationDebates
+FROM Users u
+         JOIN Volunteers v ON u.UserID = v.UserID
+         JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer';
+
+-- name: GetJudgeConflictsByTournament :many
+SELECT DISTINCT jc.ConflictID, jc.JudgeID, jc.ConflictType, jc.SchoolID, jc.TeamID, jc.StudentID
+FROM JudgeConflicts jc
+         JOIN Volunteers v ON jc.JudgeID = v.UserID
+         JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer';
+
+-- name: CreateJudgeConflict :one
+INSERT INTO JudgeConflicts (JudgeID, ConflictType, SchoolID, TeamID, StudentID, Reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: DeleteJudgeConflicts :exec
+DELETE FROM JudgeConflicts
+WHERE JudgeID = $1;
+
+-- name: UpdateJudgeMaxTimesJudgingTeam :exec
+UPDATE Volunteers
+SET MaxTimesJudgingTeam = $2
+WHERE UserID = $1;
+
+-- name: GetJudgedTeamCounts :many
+SELECT judged.JudgeID, judged.TeamID, COUNT(*) AS TimesJudged
+FROM (
+    SELECT ja.JudgeID, d.Team1ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1
+    UNION ALL
+    SELECT ja.JudgeID, d.Team2ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1
+    UNION ALL
+    SELECT ja.JudgeID, d.Team3ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1 AND d.Team3ID IS NOT NULL
+    UNION ALL
+    SELECT ja.JudgeID, d.Team4ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1 AND d.Team4ID IS NOT NULL
+) judged
+GROUP BY judged.JudgeID, judged.TeamID;
+
+-- name: GetTeamAffiliations :many
+SELECT tm.TeamID, tm.StudentID, s.SchoolID, t.NeedsAccessibleRoom
+FROM TeamMembers tm
+         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN Students s ON tm.StudentID = s.StudentID
+WHERE t.TournamentID = $1;
+
 -- name: GetJudgeRooms :many
 SELECT
     d.RoundNumber,
@@ -563,77 +992,202 @@ AND ja.TournamentID = $2
 AND ja.DebateID = oa.DebateID
 AND nd.DebateID IS NOT NULL;
 
--- name: GetEliminationRoundTeams :many
-SELECT
-    CASE
-        WHEN b.Verdict = t1.Name THEN d.Team1ID
-        WHEN b.Verdict = t2.Name THEN d.Team2ID
-    END AS TeamID,
-    CASE
-        WHEN b.Verdict = t1.Name THEN t1.Name
-        WHEN b.Verdict = t2.Name THEN t2.Name
-    END AS TeamName,
-    d.TournamentID,
-    CASE
-        WHEN b.Verdict = t1.Name THEN b.Team1TotalScore
-        WHEN b.Verdict = t2.Name THEN b.Team2TotalScore
-    END AS TotalScore
-FROM
-    Debates d
-JOIN
-    Ballots b ON d.DebateID = b.DebateID
-JOIN
-    Teams t1 ON d.Team1ID = t1.TeamID
-JOIN
-    Teams t2 ON d.Team2ID = t2.TeamID
-WHERE
-    d.TournamentID = $1
-    AND d.RoundNumber = $2
-    AND d.IsEliminationRound = true
-    AND b.RecordingStatus = 'Recorded'
-    AND (b.Verdict = t1.Name OR b.Verdict = t2.Name)
-ORDER BY
-    CASE
-        WHEN b.Verdict = t1.Name THEN b.Team1TotalScore
-        WHEN b.Verdict = t2.Name THEN b.Team2TotalScore
-    END DESC
-LIMIT $3;
-
--- name: GetTopPerformingTeams :many
-WITH ballot_check AS (
-    SELECT COUNT(*) = 0 AS all_recorded
-    FROM Ballots b
-             JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1 AND d.IsEliminationRound = false AND b.RecordingStatus != 'Recorded'
-),
-     team_performance AS (
-         SELECT
-             t.TeamID,
-             t.Name,
-             t.TournamentID,
-             COALESCE(t.TotalWins, 0) as Wins,
-             COALESCE(t.TotalSpeakerPoints, 0) as TotalSpeakerPoints,
-             COALESCE(t.AverageRank, 99) as AverageRank  -- Use 99 as default for ranking purposes
-         FROM
-             Teams t
-         WHERE
-             t.TournamentID = $1
-           AND EXISTS (
-             -- Only include teams that have participated in debates
-             SELECT 1 FROM Debates d
-             WHERE (d.Team1ID = t.TeamID OR d.Team2ID = t.TeamID)
-               AND d.IsEliminationRound = false
-         )
-     )
-SELECT
-    tp.TeamID, tp.Name, tp.TournamentID, tp.Wins, tp.TotalSpeakerPoints, tp.AverageRank
-FROM
-    team_performance tp, ballot_check
-WHERE
-    ballot_check.all_recorded = true
-ORDER BY
-    tp.Wins DESC, tp.TotalSpeakerPoints DESC, tp.AverageRank ASC
-LIMIT $2;
+-- name: CreateEliminationBracketNode :one
+INSERT INTO EliminationBracketNodes (TournamentID, RoundNumber, Position, ParentNodeID, ParentSlot, Team1ID, Team1Seed, Team2ID, Team2Seed, CategoryID)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING NodeID;
+
+-- name: GetEliminationBracket :many
+-- A zero category returns every bracket of the tournament: the one bracket of
+-- a tournament without break categories, or one per category.
+SELECT n.NodeID, n.RoundNumber, n.Position, n.ParentNodeID, n.ParentSlot,
+       n.Team1ID, t1.Name AS Team1Name, n.Team1Seed,
+       n.Team2ID, t2.Name AS Team2Name, n.Team2Seed,
+       n.DebateID, n.WinnerTeamID, d.RoomID, r.RoomName,
+       COALESCE(n.CategoryID, 0)::int AS CategoryID
+FROM EliminationBracketNodes n
+         LEFT JOIN Teams t1 ON n.Team1ID = t1.TeamID
+         LEFT JOIN Teams t2 ON n.Team2ID = t2.TeamID
+         LEFT JOIN Debates d ON n.DebateID = d.DebateID
+         LEFT JOIN Rooms r ON d.RoomID = r.RoomID
+WHERE n.TournamentID = $1
+  AND ($2::int = 0 OR n.CategoryID = $2::int)
+ORDER BY COALESCE(n.CategoryID, 0), n.RoundNumber, n.Position;
+
+-- name: GetEliminationBracketNodeByDebate :one
+SELECT n.NodeID, n.ParentNodeID, n.ParentSlot,
+       n.Team1ID, n.Team1Seed, n.Team2ID, n.Team2Seed,
+       n.WinnerTeamID, p.DebateID AS ParentDebateID
+FROM EliminationBracketNodes n
+         LEFT JOIN EliminationBracketNodes p ON n.ParentNodeID = p.NodeID
+WHERE n.DebateID = $1;
+
+-- name: GetEliminationBracketResults :many
+SELECT n.NodeID,
+       CASE
+           WHEN b.Verdict = t1.Name THEN d.Team1ID
+           WHEN b.Verdict = t2.Name THEN d.Team2ID
+       END::int AS WinnerTeamID
+FROM EliminationBracketNodes n
+         JOIN Debates d ON n.DebateID = d.DebateID
+         JOIN Ballots b ON d.DebateID = b.DebateID
+         JOIN Teams t1 ON d.Team1ID = t1.TeamID
+         JOIN Teams t2 ON d.Team2ID = t2.TeamID
+WHERE n.TournamentID = $1
+  AND COALESCE(n.CategoryID, 0) = $2::int
+  AND n.WinnerTeamID IS NULL
+  AND b.RecordingStatus = 'Recorded'
+  AND (b.Verdict = t1.Name OR b.Verdict = t2.Name);
+
+-- name: GetBreakCategories :many
+SELECT CategoryID, TournamentID, Name, BreakSize, Priority, IsGeneral
+FROM BreakCategories
+WHERE TournamentID = $1
+ORDER BY Priority, CategoryID;
+
+-- name: CreateBreakCategory :one
+INSERT INTO BreakCategories (TournamentID, Name, BreakSize, Priority, IsGeneral)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateBreakCategory :one
+UPDATE BreakCategories
+SET Name = $3, BreakSize = $4, Priority = $5, IsGeneral = $6
+WHERE CategoryID = $1 AND TournamentID = $2
+RETURNING *;
+
+-- name: DeleteBreakCategory :exec
+DELETE FROM BreakCategories
+WHERE CategoryID = $1;
+
+-- name: BreakCategoryHasBracket :one
+SELECT EXISTS (
+    SELECT 1 FROM EliminationBracketNodes WHERE CategoryID = $1
+) AS has_bracket;
+
+-- name: GetTeamBreakCategories :many
+SELECT CategoryID
+FROM TeamBreakCategories
+WHERE TeamID = $1
+ORDER BY CategoryID;
+
+-- name: GetTeamBreakCategoriesByTournament :many
+SELECT tbc.TeamID, tbc.CategoryID
+FROM TeamBreakCategories tbc
+         JOIN BreakCategories bc ON tbc.CategoryID = bc.CategoryID
+WHERE bc.TournamentID = $1;
+
+-- name: AddTeamBreakCategory :exec
+INSERT INTO TeamBreakCategories (TeamID, CategoryID)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTeamBreakCategories :exec
+DELETE FROM TeamBreakCategories
+WHERE TeamID = $1;
+
+-- name: GetStudentBreakCategoriesByTeam :many
+SELECT sbc.StudentID, sbc.CategoryID
+FROM StudentBreakCategories sbc
+         JOIN TeamMembers tm ON sbc.StudentID = tm.StudentID
+         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN BreakCategories bc ON sbc.CategoryID = bc.CategoryID AND bc.TournamentID = t.TournamentID
+WHERE tm.TeamID = $1
+ORDER BY sbc.StudentID, sbc.CategoryID;
+
+-- name: AddStudentBreakCategory :exec
+INSERT INTO StudentBreakCategories (StudentID, CategoryID)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteStudentBreakCategories :exec
+DELETE FROM StudentBreakCategories
+WHERE StudentID = $1
+  AND CategoryID IN (SELECT CategoryID FROM BreakCategories WHERE TournamentID = $2);
+
+-- name: SetEliminationBracketNodeDebate :exec
+UPDATE EliminationBracketNodes
+SET DebateID = $2
+WHERE NodeID = $1;
+
+-- name: SetEliminationBracketNodeWinner :exec
+UPDATE EliminationBracketNodes
+SET WinnerTeamID = $2
+WHERE NodeID = $1;
+
+-- name: SetEliminationBracketNodeTeam1 :exec
+UPDATE EliminationBracketNodes
+SET Team1ID = $2, Team1Seed = $3
+WHERE NodeID = $1;
+
+-- name: SetEliminationBracketNodeTeam2 :exec
+UPDATE EliminationBracketNodes
+SET Team2ID = $2, Team2Seed = $3
+WHERE NodeID = $1;
+
+-- name: CountUnrecordedPreliminaryBallots :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1 AND d.IsEliminationRound = false AND b.RecordingStatus != 'Recorded';
+
+-- name: GetTiebreakChains :one
+-- The tournament's tiebreak chains, or its format's when it has none.
+SELECT CAST(COALESCE(t.TeamTiebreaks, tf.TeamTiebreaks) AS TEXT[]) AS TeamTiebreaks,
+       CAST(COALESCE(t.SpeakerTiebreaks, tf.SpeakerTiebreaks) AS TEXT[]) AS SpeakerTiebreaks
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1;
+
+-- name: GetSpeechCountingRules :one
+SELECT tf.IronSpeeches, tf.SubstituteSpeeches
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1;
+
+-- name: GetTeamBallotResults :many
+-- One row per team of each recorded debate, read from the ballot itself
+-- rather than the TeamScores the triggers keep. SpeakerRank is the average
+-- rank of the team's speeches on the ballot, 0 without any. Eligible is
+-- whether the team may break in category $2, always true for 0.
+SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
+       COALESCE(r.RoomName, '') AS RoomName,
+       t.TeamID, t.Name AS TeamName,
+       (CASE t.TeamID WHEN d.Team1ID THEN 1 WHEN d.Team2ID THEN 2 WHEN d.Team3ID THEN 3 ELSE 4 END)::integer AS Side,
+       CAST(COALESCE(CASE t.TeamID
+                         WHEN d.Team1ID THEN b.Team1TotalScore
+                         WHEN d.Team2ID THEN b.Team2TotalScore
+                         WHEN d.Team3ID THEN b.Team3TotalScore
+                         ELSE b.Team4TotalScore
+                     END, 0) AS TEXT) AS TotalScore,
+       b.Verdict = t.Name AS Won,
+       CAST(COALESCE((SELECT AVG(ss.SpeakerRank)
+                      FROM SpeakerScores ss
+                      WHERE ss.BallotID = b.BallotID AND ss.TeamID = t.TeamID), 0) AS TEXT) AS SpeakerRank,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM TeamBreakCategories tbc WHERE tbc.TeamID = t.TeamID AND tbc.CategoryID = $2)) AS Eligible
+FROM Debates d
+JOIN Ballots b ON d.DebateID = b.DebateID
+JOIN Teams t ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+LEFT JOIN Rooms r ON d.RoomID = r.RoomID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY d.IsEliminationRound, d.RoundNumber, d.DebateID, Side;
+
+-- name: GetBritishParliamentaryAdvancingTeams :many
+-- The top two teams of each room of a BP elimination round advance.
+SELECT ts.TeamID, t.Name AS TeamName, d.TournamentID, ts.TotalScore, ts.Rank
+FROM TeamScores ts
+JOIN Debates d ON ts.DebateID = d.DebateID
+JOIN Teams t ON ts.TeamID = t.TeamID
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE d.TournamentID = $1
+  AND d.RoundNumber = $2
+  AND d.IsEliminationRound = true
+  AND b.RecordingStatus = 'Recorded'
+  AND ts.Rank IN (1, 2)
+ORDER BY d.DebateID, ts.Rank;
 
 -- name: GetDebateByBallotID :one
 SELECT d.DebateID, d.Team1ID, d.Team2ID, d.IsEliminationRound, d.TournamentID
@@ -662,6 +1216,10 @@ SET Team1TotalScore = $2,
     Team1Feedback = $6,
     Team2Feedback = $7,
     head_judge_submitted = $8,
+    Team3TotalScore = $9,
+    Team4TotalScore = $10,
+    Team3Feedback = $11,
+    Team4Feedback = $12,
     last_updated_at = CURRENT_TIMESTAMP
 WHERE BallotID = $1;
 
@@ -682,8 +1240,7 @@ WHERE NOT EXISTS (
 WITH speaker_ranks AS (
     SELECT ss.SpeakerRank
     FROM SpeakerScores ss
-    JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-    WHERE tm.TeamID = $1 AND ss.BallotID = $2
+    WHERE ss.TeamID = $1 AND ss.BallotID = $2
 )
 SELECT
     AVG(SpeakerRank)::FLOAT as AvgRank,
@@ -727,75 +1284,28 @@ FROM
 WHERE
     Teams.TeamID = team_stats.TeamID AND Teams.TournamentID = team_stats.TournamentID;
 
--- name: GetTournamentStudentRanking :many
-WITH PrelimDebates AS (
-    -- First identify all preliminary debates for this tournament
-    SELECT d.DebateID
-    FROM Debates d
-    WHERE d.TournamentID = $1
-      AND (d.IsEliminationRound = false OR d.IsEliminationRound IS NULL)
-),
-     StudentScores AS (
-         -- Get speaker scores for each student in preliminary debates only
-         SELECT
-             s.StudentID,
-             s.FirstName || ' ' || s.LastName AS StudentName,
-             sch.SchoolName,
-             t.TeamID,
-             COUNT(DISTINCT CASE WHEN (
-                 (d.Team1ID = t.TeamID AND b.Verdict = (SELECT tm1.Name FROM Teams tm1 WHERE tm1.TeamID = d.Team1ID)) OR
-                 (d.Team2ID = t.TeamID AND b.Verdict = (SELECT tm2.Name FROM Teams tm2 WHERE tm2.TeamID = d.Team2ID))
-                 ) THEN d.DebateID END) AS Wins,
-             CAST(SUM(ss.SpeakerPoints) AS DECIMAL(10,2)) AS TotalPoints,
-             AVG(ss.SpeakerRank) AS AverageRank
-         FROM Students s
-                  JOIN TeamMembers tm ON s.StudentID = tm.StudentID
-                  JOIN Teams t ON tm.TeamID = t.TeamID
-                  JOIN Schools sch ON s.SchoolID = sch.SchoolID
-                  JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-                  JOIN PrelimDebates pd ON d.DebateID = pd.DebateID
-                  JOIN Ballots b ON d.DebateID = b.DebateID
-                  JOIN SpeakerScores ss ON b.BallotID = ss.BallotID AND s.StudentID = ss.SpeakerID
-         WHERE t.TournamentID = $1
-         GROUP BY s.StudentID, StudentName, sch.SchoolName, t.TeamID
-     ),
-     RankedStudents AS (
-         SELECT
-             StudentID,
-             StudentName,
-             SchoolName,
-             Wins,
-             TotalPoints,
-             AverageRank,
-             -- Always use ROW_NUMBER for unique sequential ranking
-             ROW_NUMBER() OVER (ORDER BY
-                 TotalPoints DESC,
-                 Wins DESC,
-                 AverageRank ASC) as place,
-             -- Use DENSE_RANK for the original ranking logic (used in filtering)
-             DENSE_RANK() OVER (ORDER BY
-                 TotalPoints DESC,
-                 Wins DESC,
-                 AverageRank ASC) as dense_place
-         FROM StudentScores
-     )
-SELECT
-    StudentID,
-    StudentName,
-    SchoolName,
-    Wins,
-    TotalPoints,
-    AverageRank,
-    place
-FROM RankedStudents
-WHERE
-   -- Handle NULL search and provide search criteria
-    ($4::text IS NULL) OR
-    (dense_place <= 3) OR
-    (StudentName ILIKE '%' || COALESCE($4, '') || '%') OR
-    (SchoolName ILIKE '%' || COALESCE($4, '') || '%')
-ORDER BY place
-LIMIT $2 OFFSET $3;
+-- name: GetSpeakerPreliminaryResults :many
+-- One row per speech in a recorded preliminary debate. Eligible is whether
+-- the speaker may break in category $2, always true for 0. IsSubstitute marks
+-- speeches given for a team the student is not on.
+SELECT s.StudentID, s.FirstName || ' ' || s.LastName AS StudentName, sch.SchoolName, d.DebateID,
+       ss.TeamID, ss.Position,
+       CAST(ss.SpeakerPoints AS TEXT) AS SpeakerPoints, ss.SpeakerRank,
+       b.Verdict = t.Name AS Won,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = ss.TeamID AND tm.StudentID = ss.SpeakerID) AS IsSubstitute,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM StudentBreakCategories sbc WHERE sbc.StudentID = s.StudentID AND sbc.CategoryID = $2)) AS Eligible
+FROM SpeakerScores ss
+JOIN Ballots b ON ss.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Teams t ON ss.TeamID = t.TeamID
+JOIN Students s ON ss.SpeakerID = s.StudentID
+JOIN Schools sch ON s.SchoolID = sch.SchoolID
+WHERE d.TournamentID = $1
+  AND d.IsEliminationRound = false
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY s.StudentID, d.DebateID, ss.Position;
 
 -- name: GetOverallStudentRanking :many
 WITH student_ranking AS (
@@ -859,106 +1369,14 @@ FROM
 ORDER BY
     StartDate;
 
--- name: GetTournamentTeamsRanking :many
-WITH TeamScoreData AS (
-    SELECT
-        t.TeamID,
-        t.Name AS TeamName,
-        -- Count wins from ballots where debate is not elimination round
-        COUNT(DISTINCT CASE WHEN
-                                ((d.Team1ID = t.TeamID AND b.Verdict = t1.Name) OR
-                                 (d.Team2ID = t.TeamID AND b.Verdict = t2.Name)) AND
-                                d.IsEliminationRound = false
-                                THEN d.DebateID END) AS Wins,
-        -- Sum speaker points from non-elimination debates
-        CAST(SUM(CASE WHEN d.IsEliminationRound = false THEN
-                          CASE
-                              WHEN d.Team1ID = t.TeamID THEN COALESCE(b.Team1TotalScore, 0)
-                              WHEN d.Team2ID = t.TeamID THEN COALESCE(b.Team2TotalScore, 0)
-                              ELSE 0
-                              END
-                      ELSE 0 END) AS DECIMAL(10,2)) AS TotalPoints,
-        -- Calculate average rank correctly - average of speaker averages per debate
-        CAST(AVG(
-            -- Get the average speaker rank within each debate
-                (SELECT AVG(ss.SpeakerRank)
-                 FROM SpeakerScores ss
-                          JOIN Ballots b2 ON ss.BallotID = b2.BallotID
-                          JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-                 WHERE b2.DebateID = d.DebateID
-                   AND tm.TeamID = t.TeamID
-                   AND d.IsEliminationRound = false)
-             ) AS DECIMAL(5,2)) AS AverageRank
-    FROM Teams t
-             JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-             JOIN Teams t1 ON d.Team1ID = t1.TeamID
-             JOIN Teams t2 ON d.Team2ID = t2.TeamID
-             LEFT JOIN Ballots b ON d.DebateID = b.DebateID
-    WHERE t.TournamentID = $1
-      AND d.TournamentID = $1
-      AND b.RecordingStatus = 'Recorded'
-    GROUP BY t.TeamID, t.Name
-),
-     TeamSchools AS (
-         SELECT
-             tsd.TeamID,
-             tsd.TeamName,
-             tsd.Wins,
-             tsd.TotalPoints,
-             COALESCE(tsd.AverageRank, 99) AS AverageRank,
-             ARRAY_AGG(DISTINCT s.SchoolName) FILTER (WHERE s.SchoolName IS NOT NULL) AS SchoolNames
-         FROM
-             TeamScoreData tsd
-                 LEFT JOIN TeamMembers tm ON tsd.TeamID = tm.TeamID
-                 LEFT JOIN Students stu ON tm.StudentID = stu.StudentID
-                 LEFT JOIN Schools s ON stu.SchoolID = s.SchoolID
-         GROUP BY
-             tsd.TeamID, tsd.TeamName, tsd.Wins, tsd.TotalPoints, tsd.AverageRank
-     ),
-     RankedTeams AS (
-         SELECT
-             TeamID,
-             TeamName,
-             SchoolNames,
-             Wins,
-             CAST(TotalPoints AS text) AS TotalPoints,
-             CAST(AverageRank AS text) AS AverageRank,
-             RANK() OVER (ORDER BY Wins DESC,
-                 TotalPoints DESC,
-                 AverageRank ASC) as place
-         FROM
-             TeamSchools
-     ),
-     TopThree AS (
-         SELECT * FROM RankedTeams WHERE place <= 3
-     ),
-     FilteredTeams AS (
-         SELECT * FROM RankedTeams
-         WHERE $4::text IS NULL
-            OR TeamName ILIKE '%' || $4 || '%'
-            OR EXISTS (
-             SELECT 1
-             FROM unnest(SchoolNames) AS school
-             WHERE school ILIKE '%' || $4 || '%'
-         )
-     )
-SELECT * FROM (
-                  SELECT * FROM TopThree
-                  UNION ALL
-                  SELECT * FROM FilteredTeams WHERE place > 3
-              ) combined
-ORDER BY place
-LIMIT $2 OFFSET $3;
-
--- name: GetTournamentTeamsRankingCount :one
-SELECT COUNT(DISTINCT t.TeamID)
-FROM Teams t
-         JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-         JOIN Ballots b ON d.DebateID = b.DebateID
+-- name: GetTeamSchoolNames :many
+SELECT DISTINCT tm.TeamID, sch.SchoolName
+FROM TeamMembers tm
+JOIN Teams t ON tm.TeamID = t.TeamID
+JOIN Students stu ON tm.StudentID = stu.StudentID
+JOIN Schools sch ON stu.SchoolID = sch.SchoolID
 WHERE t.TournamentID = $1
-  AND d.TournamentID = $1
-  AND d.IsEliminationRound = false
-  AND b.RecordingStatus = 'Recorded';
+ORDER BY tm.TeamID, sch.SchoolName;
 
 -- name: GetTournamentSchoolRanking :many
 WITH TeamDebateScores AS (
@@ -1005,8 +1423,7 @@ WITH TeamDebateScores AS (
                           FROM SpeakerScores ss
                                    JOIN Ballots b ON ss.BallotID = b.BallotID
                                    JOIN Debates d ON b.DebateID = d.DebateID
-                                   JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-                          WHERE tm.TeamID = tds.TeamID
+                          WHERE ss.TeamID = tds.TeamID
                             AND d.IsEliminationRound = false
                             AND d.TournamentID = $1
                           GROUP BY b.DebateID
@@ -1435,15 +1852,14 @@ ON CONFLICT (TournamentID, RankingType, VisibleTo)
 
 -- name: GetSpeakerScoresByBallotAndTeam :many
 SELECT ss.ScoreID, ss.SpeakerID, ss.BallotID, ss.SpeakerRank,
-       ss.SpeakerPoints, ss.Feedback, tm.TeamID
+       ss.SpeakerPoints, ss.Feedback, ss.TeamID, ss.Position
 FROM SpeakerScores ss
-         JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-WHERE ss.BallotID = $1 AND tm.TeamID = $2
-ORDER BY ss.SpeakerRank;
+WHERE ss.BallotID = $1 AND ss.TeamID = $2
+ORDER BY ss.Position;
 
 -- name: CreateSpeakerScore :exec
-INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, TeamID, Position)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: TeamHasDebates :one
 SELECT EXISTS (
@@ -1457,9 +1873,174 @@ WHERE Team1ID = $1 OR Team2ID = $1;
 
 -- name: DeleteSpeakerScoresByBallotAndTeam :exec
 DELETE FROM SpeakerScores
-WHERE BallotID = $1
-  AND SpeakerID IN (
-    SELECT tm.StudentID
-    FROM TeamMembers tm
-    WHERE tm.TeamID = $2
-);
\ No newline at end of file
+WHERE BallotID = $1 AND TeamID = $2;
+-- name: GetTeamStandingsForPairing :many
+SELECT TeamID, Name, TotalWins, TotalSpeakerPoints, AverageRank, TotalTeamPoints
+FROM Teams
+WHERE TournamentID = $1
+ORDER BY TeamID;
+
+-- name: GetPreliminaryPairingHistory :many
+SELECT Team1ID, Team2ID, RoundNumber
+FROM PairingHistory
+WHERE TournamentID = $1 AND IsElimination = false
+ORDER BY RoundNumber;
+
+-- name: GetTeamSideCounts :many
+SELECT t.TeamID, t.Name,
+       COUNT(d.DebateID) FILTER (WHERE d.Team1ID = t.TeamID)::integer AS PropositionCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team2ID = t.TeamID)::integer AS OppositionCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team3ID = t.TeamID)::integer AS ClosingGovernmentCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team4ID = t.TeamID)::integer AS ClosingOppositionCount
+FROM Teams t
+LEFT JOIN Debates d ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+    AND d.TournamentID = t.TournamentID
+    AND d.IsEliminationRound = false
+WHERE t.TournamentID = $1
+GROUP BY t.TeamID, t.Name
+ORDER BY t.TeamID;
+
+-- name: CountUnrecordedBallots :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus <> 'Recorded';
+
+-- name: GetTabTournament :one
+SELECT TournamentID, Name, Motions, EndDate
+FROM Tournaments
+WHERE TournamentID = $1 AND deleted_at IS NULL;
+
+-- name: GetBreakingTeams :many
+-- The teams of the first elimination round, with the break category and seed
+-- of their bracket slot. Rounds without a bracket, as in BP, leave both empty.
+SELECT t.TeamID, t.Name AS TeamName,
+       COALESCE(bc.Name, '') AS CategoryName,
+       COALESCE(bc.Priority, 0)::integer AS CategoryPriority,
+       COALESCE(CASE WHEN n.Team1ID = t.TeamID THEN n.Team1Seed ELSE n.Team2Seed END, 0)::integer AS Seed
+FROM Debates d
+JOIN Teams t ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+LEFT JOIN EliminationBracketNodes n ON n.DebateID = d.DebateID
+LEFT JOIN BreakCategories bc ON n.CategoryID = bc.CategoryID
+WHERE d.TournamentID = $1
+  AND d.IsEliminationRound = true
+  AND d.RoundNumber = 1
+ORDER BY CategoryPriority, CategoryName, Seed, t.TeamID;
+
+-- name: CreatePublishedTab :one
+INSERT INTO PublishedTabs (TournamentID, Version, Snapshot, PublishedBy)
+SELECT $1, COALESCE(MAX(Version), 0) + 1, $2, $3
+FROM PublishedTabs
+WHERE TournamentID = $1
+RETURNING *;
+
+-- name: GetPublishedTab :one
+-- The given version of the tournament's tab, or the latest for version 0.
+SELECT *
+FROM PublishedTabs
+WHERE TournamentID = $1
+  AND ($2::int = 0 OR Version = $2)
+ORDER BY Version DESC
+LIMIT 1;
+
+-- name: GetPublishedTabVersions :many
+SELECT Version
+FROM PublishedTabs
+WHERE TournamentID = $1
+ORDER BY Version;
+
+-- name: CountBallotsChangedSince :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1
+  AND b.last_updated_at > $2;
+
+-- name: GetStoredTeamScores :many
+-- The TeamScores of the tournament's recorded debates.
+SELECT ts.TeamID, ts.DebateID,
+       CAST(COALESCE(ts.TotalScore, 0) AS TEXT) AS TotalScore,
+       COALESCE(ts.Rank, 0)::integer AS Rank,
+       ts.TeamPoints
+FROM TeamScores ts
+JOIN Debates d ON ts.DebateID = d.DebateID
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY ts.DebateID, ts.TeamID;
+
+-- name: GetStoredTeamTotals :many
+SELECT TeamID, Name,
+       COALESCE(TotalWins, 0)::integer AS TotalWins,
+       CAST(COALESCE(TotalSpeakerPoints, 0) AS TEXT) AS TotalSpeakerPoints,
+       CAST(COALESCE(AverageRank, 0) AS TEXT) AS AverageRank,
+       COALESCE(TotalTeamPoints, 0)::integer AS TotalTeamPoints
+FROM Teams
+WHERE TournamentID = $1
+ORDER BY TeamID;
+
+-- name: UpsertTeamScore :exec
+INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, TeamPoints, IsElimination)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (TeamID, DebateID)
+DO UPDATE SET
+    TotalScore = EXCLUDED.TotalScore,
+    Rank = EXCLUDED.Rank,
+    TeamPoints = EXCLUDED.TeamPoints,
+    IsElimination = EXCLUDED.IsElimination;
+
+-- name: DeleteTeamScore :exec
+DELETE FROM TeamScores
+WHERE TeamID = $1 AND DebateID = $2;
+
+-- name: SetTeamTotals :exec
+UPDATE Teams
+SET TotalWins = $2,
+    TotalSpeakerPoints = $3,
+    AverageRank = $4,
+    TotalTeamPoints = $5
+WHERE TeamID = $1;
+
+-- name: GetJudgeSpeechesByTournament :many
+-- The points each judge gave each speech of the tournament's recorded debates.
+SELECT jb.JudgeBallotID, jb.JudgeID, u.Name AS JudgeName,
+       d.DebateID, d.RoundNumber, d.IsEliminationRound,
+       jss.TeamID, jss.Position,
+       CAST(jss.SpeakerPoints AS TEXT) AS SpeakerPoints
+FROM JudgeSpeakerScores jss
+JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+JOIN Ballots b ON jb.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Users u ON jb.JudgeID = u.UserID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY d.DebateID, jb.JudgeBallotID, jss.TeamID, jss.Position;
+
+-- name: CreateBye :exec
+INSERT INTO Byes (TournamentID, RoundID, RoundNumber, TeamID)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetRoundByes :many
+SELECT b.TeamID, t.Name AS TeamName
+FROM Byes b
+JOIN Teams t ON b.TeamID = t.TeamID
+WHERE b.TournamentID = $1 AND b.RoundNumber = $2
+ORDER BY t.Name;
+
+-- name: GetTournamentByes :many
+-- Eligible is whether the team may break in category $2, always true for 0.
+SELECT b.RoundNumber, b.TeamID, t.Name AS TeamName,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM TeamBreakCategories tbc WHERE tbc.TeamID = t.TeamID AND tbc.CategoryID = $2)) AS Eligible
+FROM Byes b
+JOIN Teams t ON b.TeamID = t.TeamID
+WHERE b.TournamentID = $1
+ORDER BY b.RoundNumber, t.Name;
+
+-- name: GetByeResult :one
+SELECT tf.ByeResult
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1;
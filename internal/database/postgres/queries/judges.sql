@@ -176,4 +176,93 @@ WITH existing_room AS (
      )
 SELECT er.roomid FROM existing_room er
 UNION ALL
-SELECT ir.roomid FROM inserted_room ir;
\ No newline at end of file
+SELECT ir.roomid FROM inserted_room ir;
+-- name: IsTournamentJudge :one
+SELECT EXISTS (
+    SELECT 1
+    FROM Volunteers v
+    JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+    WHERE v.UserID = $2
+      AND ti.TournamentID = $1
+      AND ti.Status = 'accepted'
+      AND ti.InviteeRole = 'volunteer'
+);
+
+-- name: GetJudgeAvailabilityByTournament :many
+SELECT * FROM JudgeAvailability
+WHERE TournamentID = $1 AND IsEliminationRound = $2;
+
+-- name: SetJudgeAvailability :one
+-- Declaring a judge unavailable undoes their check-in; declaring them
+-- available again clears an absence.
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = EXCLUDED.IsAvailable,
+    CheckedInAt = CASE WHEN EXCLUDED.IsAvailable THEN JudgeAvailability.CheckedInAt END,
+    MarkedAbsentAt = CASE WHEN EXCLUDED.IsAvailable THEN NULL ELSE JudgeAvailability.MarkedAbsentAt END
+RETURNING *;
+
+-- name: CheckInJudge :one
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable, CheckedInAt)
+VALUES ($1, $2, $3, $4, TRUE, CURRENT_TIMESTAMP)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = TRUE,
+    CheckedInAt = CURRENT_TIMESTAMP,
+    MarkedAbsentAt = NULL
+RETURNING *;
+
+-- name: MarkJudgeAbsent :one
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable, MarkedAbsentAt)
+VALUES ($1, $2, $3, $4, FALSE, CURRENT_TIMESTAMP)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = FALSE,
+    CheckedInAt = NULL,
+    MarkedAbsentAt = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetJudgeRoster :many
+SELECT DISTINCT ON (u.UserID)
+    u.UserID AS JudgeID, u.Name, v.iDebateVolunteerID,
+    COALESCE(av.IsAvailable, TRUE) AS IsAvailable, av.CheckedInAt, av.MarkedAbsentAt,
+    d.DebateID, d.RoomID, r.RoomName, ja.IsHeadJudge
+FROM Users u
+JOIN Volunteers v ON u.UserID = v.UserID
+JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+LEFT JOIN JudgeAvailability av ON av.TournamentID = ti.TournamentID AND av.JudgeID = u.UserID
+    AND av.RoundNumber = @round_number AND av.IsEliminationRound = @is_elimination_round
+LEFT JOIN JudgeAssignments ja ON ja.TournamentID = ti.TournamentID AND ja.JudgeID = u.UserID
+    AND ja.RoundNumber = @round_number AND ja.IsElimination = @is_elimination_round
+LEFT JOIN Debates d ON d.DebateID = ja.DebateID
+LEFT JOIN Rooms r ON r.RoomID = d.RoomID
+WHERE ti.TournamentID = @tournament_id
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
+ORDER BY u.UserID;
+
+-- name: SetBallotJudge :exec
+UPDATE Ballots
+SET JudgeID = $2,
+    last_updated_by = $2,
+    last_updated_at = CURRENT_TIMESTAMP
+WHERE DebateID = $1;
+
+-- name: SetHeadJudge :exec
+UPDATE JudgeAssignments
+SET IsHeadJudge = TRUE
+WHERE DebateID = $1 AND JudgeID = $2;
+
+-- name: ReplaceReleasedDrawJudge :exec
+-- Moves the seat of a judge taken off a released debate to their replacement,
+-- or drops it when there is none (replacement 0). When the judge chaired the
+-- debate, the head judge given takes the chair.
+UPDATE ReleasedDraws rd
+SET JudgeIDs = ARRAY(
+        SELECT DISTINCT j
+        FROM unnest(array_append(array_remove(rd.JudgeIDs, @judge_id::int), NULLIF(@replacement_id::int, 0))) AS j
+        WHERE j IS NOT NULL
+        ORDER BY j
+    ),
+    HeadJudgeID = CASE WHEN rd.HeadJudgeID = @judge_id::int THEN NULLIF(@head_judge_id::int, 0) ELSE rd.HeadJudgeID END
+FROM Rounds r
+WHERE rd.DebateID = @debate_id::int AND rd.RoundID = r.RoundID AND r.ReleasedAt IS NOT NULL;
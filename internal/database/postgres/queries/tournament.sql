@@ -29,8 +29,11 @@ WHERE LeagueID = $1;
 
 -- Tournament Format Queries
 -- name: CreateTournamentFormat :one
-INSERT INTO TournamentFormats (FormatName, Description, SpeakersPerTeam)
-VALUES ($1, $2, $3)
+INSERT INTO TournamentFormats (FormatName, Description, SpeakersPerTeam, TeamsPerDebate,
+                               MinSpeakerPoints, MaxSpeakerPoints, SpeakerPointStep, AllowLowPointWins,
+                               ReplySpeeches, MinReplyPoints, MaxReplyPoints, TieHandling, TeamTiebreaks, SpeakerTiebreaks,
+                               IronSpeeches, SubstituteSpeeches, ByeResult)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 RETURNING *;
 
 -- name: GetTournamentFormatByID :one
@@ -48,7 +51,11 @@ ORDER BY FormatID
 
 -- name: UpdateTournamentFormatDetails :one
 UPDATE TournamentFormats
-SET FormatName = $2, Description = $3, SpeakersPerTeam = $4
+SET FormatName = $2, Description = $3, SpeakersPerTeam = $4, TeamsPerDebate = $5,
+    MinSpeakerPoints = $6, MaxSpeakerPoints = $7, SpeakerPointStep = $8, AllowLowPointWins = $9,
+    ReplySpeeches = $10, MinReplyPoints = $11, MaxReplyPoints = $12, TieHandling = $13,
+    TeamTiebreaks = $14, SpeakerTiebreaks = $15, IronSpeeches = $16, SubstituteSpeeches = $17,
+    ByeResult = $18
 WHERE FormatID = $1
 RETURNING *;
 
@@ -63,15 +70,15 @@ INSERT INTO Tournaments (
     Name, StartDate, EndDate, Location, FormatID, LeagueID,
     CoordinatorID, NumberOfPreliminaryRounds, NumberOfEliminationRounds,
     JudgesPerDebatePreliminary, JudgesPerDebateElimination, TournamentFee,
-    ImageUrl, Motions
+    ImageUrl, Motions, TeamTiebreaks, SpeakerTiebreaks
 )
 VALUES (
-           $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+           $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
        )
 RETURNING *;
 
 -- name: GetTournamentByID :one
-SELECT t.*, tf.FormatName, tf.Description AS FormatDescription, tf.SpeakersPerTeam,
+SELECT t.*, tf.FormatName, tf.Description AS FormatDescription, tf.SpeakersPerTeam, tf.TeamsPerDebate,
        l.Name AS LeagueName, l.LeagueType, l.Details AS LeagueDetails,
        u.Name AS CoordinatorName, u.UserID AS CoordinatorID
 FROM Tournaments t
@@ -140,7 +147,9 @@ SET Name = $2,
     JudgesPerDebateElimination = $11,
     TournamentFee = $12,
     ImageUrl = $13,
-    Motions = $14
+    Motions = $14,
+    TeamTiebreaks = $15,
+    SpeakerTiebreaks = $16
 FROM debate_check
 WHERE t.TournamentID = $1
   AND NOT debate_check.has_debates
@@ -432,4 +441,218 @@ FROM Tournaments
 WHERE LOWER(Name) LIKE LOWER($1)
   AND deleted_at IS NULL
 ORDER BY Name
-LIMIT 10;
\ No newline at end of file
+LIMIT 10;
+-- name: GetTournamentScheduleSettings :one
+SELECT TournamentID, StartDate, EndDate, NumberOfPreliminaryRounds, NumberOfEliminationRounds,
+       RoundMinutes, BreakMinutes,
+       to_char(DayStartTime, 'HH24:MI')::text AS DayStartTime,
+       to_char(DayEndTime, 'HH24:MI')::text AS DayEndTime,
+       COALESCE(to_char(LunchStartTime, 'HH24:MI'), '')::text AS LunchStartTime,
+       LunchMinutes
+FROM Tournaments
+WHERE TournamentID = $1 AND deleted_at IS NULL;
+
+-- name: UpdateTournamentScheduleSettings :exec
+UPDATE Tournaments
+SET RoundMinutes = $2,
+    BreakMinutes = $3,
+    DayStartTime = CAST($4::text AS TIME),
+    DayEndTime = CAST($5::text AS TIME),
+    LunchStartTime = CAST(NULLIF($6::text, '') AS TIME),
+    LunchMinutes = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE TournamentID = $1;
+
+-- name: GetTournamentSchedule :many
+SELECT RoundNumber, IsEliminationRound, StartTime, EndTime, IsPinned
+FROM Schedules
+WHERE TournamentID = $1
+ORDER BY IsEliminationRound, RoundNumber;
+
+-- name: GetRoundSchedule :one
+SELECT StartTime, EndTime
+FROM Schedules
+WHERE TournamentID = $1 AND RoundNumber = $2 AND IsEliminationRound = $3;
+
+-- name: CreateRoundSchedule :exec
+INSERT INTO Schedules (TournamentID, RoundNumber, IsEliminationRound, StartTime, EndTime, IsPinned)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: DeleteTournamentSchedule :exec
+DELETE FROM Schedules
+WHERE TournamentID = $1;
+
+-- name: DeletePlannedSchedule :exec
+DELETE FROM Schedules
+WHERE TournamentID = $1 AND NOT IsPinned;
+
+-- name: StampDebateTimes :exec
+UPDATE Debates d
+SET StartTime = s.StartTime, EndTime = s.EndTime
+FROM Schedules s
+WHERE s.TournamentID = d.TournamentID
+  AND s.RoundNumber = d.RoundNumber
+  AND s.IsEliminationRound = d.IsEliminationRound
+  AND d.TournamentID = $1;
+
+-- name: GetMotionReleases :many
+SELECT RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt
+FROM MotionReleases
+WHERE TournamentID = $1
+ORDER BY IsEliminationRound, RoundNumber;
+
+-- name: CreateMotionRelease :one
+INSERT INTO MotionReleases (TournamentID, RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt, ReleasedBy)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (TournamentID, RoundNumber, IsEliminationRound) DO NOTHING
+RETURNING RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt;
+
+-- name: GetTournamentParticipantUserIDs :many
+-- The students on the tournament's teams and its accepted volunteers.
+SELECT s.UserID
+FROM Students s
+JOIN TeamMembers tm ON tm.StudentID = s.StudentID
+JOIN Teams t ON t.TeamID = tm.TeamID
+WHERE t.TournamentID = $1
+UNION
+SELECT v.UserID
+FROM Volunteers v
+JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer';
+
+-- name: SearchMotionArchive :many
+-- Every motion of the tournaments that have ended, newest tournament first.
+SELECT t.TournamentID, t.Name AS TournamentName, t.StartDate,
+       CAST(m.Motion->>'roundNumber' AS INTEGER) AS RoundNumber,
+       m.IsEliminationRound,
+       CAST(COALESCE(m.Motion->>'text', '') AS TEXT) AS MotionText,
+       CAST(COALESCE(m.Motion->>'infoSlide', '') AS TEXT) AS InfoSlide
+FROM Tournaments t
+CROSS JOIN LATERAL (
+    SELECT p.Motion, FALSE AS IsEliminationRound
+    FROM jsonb_array_elements(COALESCE(t.Motions->'preliminary', '[]'::jsonb)) AS p(Motion)
+    UNION ALL
+    SELECT e.Motion, TRUE
+    FROM jsonb_array_elements(COALESCE(t.Motions->'elimination', '[]'::jsonb)) AS e(Motion)
+) m
+WHERE t.deleted_at IS NULL
+  AND t.EndDate < CURRENT_TIMESTAMP
+  AND (LOWER(m.Motion->>'text') LIKE LOWER('%' || COALESCE(@search_query::text, '') || '%')
+    OR LOWER(m.Motion->>'infoSlide') LIKE LOWER('%' || COALESCE(@search_query::text, '') || '%')
+    OR LOWER(t.Name) LIKE LOWER('%' || COALESCE(@search_query::text, '') || '%')
+    OR COALESCE(@search_query::text, '') = '')
+ORDER BY t.StartDate DESC, t.TournamentID, m.IsEliminationRound, RoundNumber
+LIMIT @page_size OFFSET @page_offset;
+
+-- name: CreateBankMotion :one
+INSERT INTO MotionBank (Text, InfoSlide, Difficulty, CreatedBy)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetBankMotion :one
+SELECT * FROM MotionBank
+WHERE MotionID = $1;
+
+-- name: UpdateBankMotion :one
+UPDATE MotionBank
+SET Text = $2, InfoSlide = $3, Difficulty = $4
+WHERE MotionID = $1
+RETURNING *;
+
+-- name: DeleteBankMotion :execrows
+DELETE FROM MotionBank m
+WHERE m.MotionID = $1
+  AND NOT EXISTS (SELECT 1 FROM RoundMotions rm WHERE rm.MotionID = m.MotionID);
+
+-- name: GetBankMotionTags :many
+SELECT Tag FROM MotionTags
+WHERE MotionID = $1
+ORDER BY Tag;
+
+-- name: AddBankMotionTag :exec
+INSERT INTO MotionTags (MotionID, Tag)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteBankMotionTags :exec
+DELETE FROM MotionTags
+WHERE MotionID = $1;
+
+-- name: CountBankMotionUses :one
+SELECT COUNT(*) FROM RoundMotions
+WHERE MotionID = $1;
+
+-- name: ListBankMotions :many
+-- Debates and PropositionWins count the recorded two-team debates of the
+-- rounds the motion was attached to, and the ones the first team won. With
+-- balanced_first, motions closest to an even split come first, then those
+-- never debated.
+SELECT m.MotionID, m.Text, m.InfoSlide, m.Difficulty,
+       ARRAY(SELECT mt.Tag FROM MotionTags mt WHERE mt.MotionID = m.MotionID ORDER BY mt.Tag)::text[] AS Tags,
+       (SELECT COUNT(*) FROM RoundMotions rm WHERE rm.MotionID = m.MotionID) AS TimesUsed,
+       EXISTS (SELECT 1
+               FROM RoundMotions rm
+               JOIN Tournaments t ON rm.TournamentID = t.TournamentID
+               WHERE rm.MotionID = m.MotionID AND t.LeagueID = @league_id::int AND t.deleted_at IS NULL) AS UsedInLeague,
+       COALESCE(s.Debates, 0)::int AS Debates,
+       COALESCE(s.PropositionWins, 0)::int AS PropositionWins
+FROM MotionBank m
+LEFT JOIN (
+    SELECT rm.MotionID,
+           COUNT(DISTINCT d.DebateID) AS Debates,
+           COUNT(DISTINCT d.DebateID) FILTER (WHERE b.Verdict = t1.Name) AS PropositionWins
+    FROM RoundMotions rm
+    JOIN Debates d ON d.TournamentID = rm.TournamentID
+                  AND d.RoundNumber = rm.RoundNumber
+                  AND d.IsEliminationRound = rm.IsEliminationRound
+    JOIN Ballots b ON b.DebateID = d.DebateID
+    JOIN Teams t1 ON d.Team1ID = t1.TeamID
+    WHERE d.Team3ID IS NULL
+      AND b.RecordingStatus = 'Recorded'
+    GROUP BY rm.MotionID
+) s ON s.MotionID = m.MotionID
+WHERE (@tag::text = '' OR EXISTS (SELECT 1 FROM MotionTags mt WHERE mt.MotionID = m.MotionID AND mt.Tag = LOWER(@tag::text)))
+  AND (@difficulty::text = '' OR m.Difficulty = @difficulty::text)
+  AND (@search_query::text = ''
+    OR LOWER(m.Text) LIKE LOWER('%' || @search_query::text || '%')
+    OR LOWER(m.InfoSlide) LIKE LOWER('%' || @search_query::text || '%'))
+  AND NOT (@exclude_used_in_league::bool AND EXISTS (
+      SELECT 1
+      FROM RoundMotions rm
+      JOIN Tournaments t ON rm.TournamentID = t.TournamentID
+      WHERE rm.MotionID = m.MotionID AND t.LeagueID = @league_id::int AND t.deleted_at IS NULL))
+ORDER BY CASE
+             WHEN @balanced_first::bool AND COALESCE(s.Debates, 0) > 0
+                 THEN ABS(s.PropositionWins::float / s.Debates - 0.5)
+             WHEN @balanced_first::bool THEN 1
+             ELSE 0
+         END,
+         m.MotionID
+LIMIT @page_size OFFSET @page_offset;
+
+-- name: UpsertRoundMotion :exec
+INSERT INTO RoundMotions (TournamentID, RoundNumber, IsEliminationRound, MotionID)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (TournamentID, RoundNumber, IsEliminationRound) DO UPDATE
+SET MotionID = EXCLUDED.MotionID;
+
+-- name: UpdateTournamentMotions :exec
+UPDATE Tournaments
+SET Motions = $2
+WHERE TournamentID = $1;
+
+-- name: DeleteDetachedRoundMotions :exec
+-- Drops the links of rounds whose motion was since edited away from the bank
+-- motion's text.
+DELETE FROM RoundMotions rm
+USING MotionBank mb, Tournaments t
+WHERE rm.MotionID = mb.MotionID
+  AND rm.TournamentID = t.TournamentID
+  AND rm.TournamentID = $1
+  AND NOT EXISTS (
+      SELECT 1
+      FROM jsonb_array_elements(COALESCE(t.Motions->(CASE WHEN rm.IsEliminationRound THEN 'elimination' ELSE 'preliminary' END), '[]'::jsonb)) AS m(Motion)
+      WHERE CAST(m.Motion->>'roundNumber' AS INTEGER) = rm.RoundNumber
+        AND m.Motion->>'text' = mb.Text);
@@ -27,7 +27,11 @@ type Room struct {
 	RoomId        int32                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
 	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`                       // Speakers and judges the room seats
+	BuildingId    int32                  `protobuf:"varint,5,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"` // 0 when the room is in no building
+	BuildingName  string                 `protobuf:"bytes,6,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	IsAccessible  bool                   `protobuf:"varint,7,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"`
+	Availability  []*AvailabilityWindow  `protobuf:"bytes,8,rep,name=availability,proto3" json:"availability,omitempty"` // Empty when the room is always available
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +94,87 @@ func (x *Room) GetCapacity() int32 {
 	return 0
 }
 
+func (x *Room) GetBuildingId() int32 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *Room) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *Room) GetIsAccessible() bool {
+	if x != nil {
+		return x.IsAccessible
+	}
+	return false
+}
+
+func (x *Room) GetAvailability() []*AvailabilityWindow {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// A window a room can be drawn in, as "YYYY-MM-DD HH:MM"
+type AvailabilityWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityWindow) Reset() {
+	*x = AvailabilityWindow{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityWindow) ProtoMessage() {}
+
+func (x *AvailabilityWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityWindow.ProtoReflect.Descriptor instead.
+func (*AvailabilityWindow) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AvailabilityWindow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *AvailabilityWindow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type RoundStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
@@ -100,7 +185,7 @@ type RoundStatus struct {
 
 func (x *RoundStatus) Reset() {
 	*x = RoundStatus{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[1]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -112,7 +197,7 @@ func (x *RoundStatus) String() string {
 func (*RoundStatus) ProtoMessage() {}
 
 func (x *RoundStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[1]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,7 +210,7 @@ func (x *RoundStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundStatus.ProtoReflect.Descriptor instead.
 func (*RoundStatus) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{1}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RoundStatus) GetRound() int32 {
@@ -148,13 +233,14 @@ type RoomStatus struct {
 	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
 	Preliminary   string                 `protobuf:"bytes,3,opt,name=preliminary,proto3" json:"preliminary,omitempty"`
 	Elimination   string                 `protobuf:"bytes,4,opt,name=elimination,proto3" json:"elimination,omitempty"`
+	Room          *Room                  `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RoomStatus) Reset() {
 	*x = RoomStatus{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[2]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -166,7 +252,7 @@ func (x *RoomStatus) String() string {
 func (*RoomStatus) ProtoMessage() {}
 
 func (x *RoomStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[2]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +265,7 @@ func (x *RoomStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomStatus.ProtoReflect.Descriptor instead.
 func (*RoomStatus) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{2}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RoomStatus) GetRoomId() int32 {
@@ -210,6 +296,13 @@ func (x *RoomStatus) GetElimination() string {
 	return ""
 }
 
+func (x *RoomStatus) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
 type GetRoomsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
@@ -220,7 +313,7 @@ type GetRoomsRequest struct {
 
 func (x *GetRoomsRequest) Reset() {
 	*x = GetRoomsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[3]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +325,7 @@ func (x *GetRoomsRequest) String() string {
 func (*GetRoomsRequest) ProtoMessage() {}
 
 func (x *GetRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[3]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +338,7 @@ func (x *GetRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomsRequest.ProtoReflect.Descriptor instead.
 func (*GetRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{3}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetRoomsRequest) GetTournamentId() int32 {
@@ -271,7 +364,7 @@ type GetRoomsResponse struct {
 
 func (x *GetRoomsResponse) Reset() {
 	*x = GetRoomsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[4]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +376,7 @@ func (x *GetRoomsResponse) String() string {
 func (*GetRoomsResponse) ProtoMessage() {}
 
 func (x *GetRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[4]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +389,7 @@ func (x *GetRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomsResponse.ProtoReflect.Descriptor instead.
 func (*GetRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{4}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetRoomsResponse) GetRooms() []*RoomStatus {
@@ -317,7 +410,7 @@ type GetRoomRequest struct {
 
 func (x *GetRoomRequest) Reset() {
 	*x = GetRoomRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[5]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +422,7 @@ func (x *GetRoomRequest) String() string {
 func (*GetRoomRequest) ProtoMessage() {}
 
 func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[5]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +435,7 @@ func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
 func (*GetRoomRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{5}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetRoomRequest) GetRoomId() int32 {
@@ -378,7 +471,7 @@ type GetRoomResponse struct {
 
 func (x *GetRoomResponse) Reset() {
 	*x = GetRoomResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[6]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +483,7 @@ func (x *GetRoomResponse) String() string {
 func (*GetRoomResponse) ProtoMessage() {}
 
 func (x *GetRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[6]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +496,7 @@ func (x *GetRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomResponse.ProtoReflect.Descriptor instead.
 func (*GetRoomResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{6}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetRoomResponse) GetRoomId() int32 {
@@ -438,13 +531,14 @@ type UpdateRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	UpdateVenue   bool                   `protobuf:"varint,3,opt,name=update_venue,json=updateVenue,proto3" json:"update_venue,omitempty"` // Replace the room's location, capacity, building and accessibility
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateRoomRequest) Reset() {
 	*x = UpdateRoomRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[7]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +550,7 @@ func (x *UpdateRoomRequest) String() string {
 func (*UpdateRoomRequest) ProtoMessage() {}
 
 func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[7]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +563,7 @@ func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{7}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateRoomRequest) GetRoom() *Room {
@@ -486,6 +580,13 @@ func (x *UpdateRoomRequest) GetToken() string {
 	return ""
 }
 
+func (x *UpdateRoomRequest) GetUpdateVenue() bool {
+	if x != nil {
+		return x.UpdateVenue
+	}
+	return false
+}
+
 type UpdateRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
@@ -495,7 +596,7 @@ type UpdateRoomResponse struct {
 
 func (x *UpdateRoomResponse) Reset() {
 	*x = UpdateRoomResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[8]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +608,7 @@ func (x *UpdateRoomResponse) String() string {
 func (*UpdateRoomResponse) ProtoMessage() {}
 
 func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[8]
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +621,7 @@ func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{8}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateRoomResponse) GetRoom() *Room {
@@ -530,33 +631,30 @@ func (x *UpdateRoomResponse) GetRoom() *Room {
 	return nil
 }
 
-// Judge messages
-type Judge struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId            int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IdebateId          string                 `protobuf:"bytes,3,opt,name=idebate_id,json=idebateId,proto3" json:"idebate_id,omitempty"`
-	PreliminaryDebates int32                  `protobuf:"varint,4,opt,name=preliminary_debates,json=preliminaryDebates,proto3" json:"preliminary_debates,omitempty"`
-	EliminationDebates int32                  `protobuf:"varint,5,opt,name=elimination_debates,json=eliminationDebates,proto3" json:"elimination_debates,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Room          *Room                  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Judge) Reset() {
-	*x = Judge{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[9]
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Judge) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Judge) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *Judge) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[9]
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,69 +665,99 @@ func (x *Judge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Judge.ProtoReflect.Descriptor instead.
-func (*Judge) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Judge) GetJudgeId() int32 {
+func (x *CreateRoomRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *Judge) GetName() string {
+func (x *CreateRoomRequest) GetRoom() *Room {
 	if x != nil {
-		return x.Name
+		return x.Room
 	}
-	return ""
+	return nil
 }
 
-func (x *Judge) GetIdebateId() string {
+func (x *CreateRoomRequest) GetToken() string {
 	if x != nil {
-		return x.IdebateId
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Judge) GetPreliminaryDebates() int32 {
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[11]
 	if x != nil {
-		return x.PreliminaryDebates
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Judge) GetEliminationDebates() int32 {
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateRoomResponse) GetRoom() *Room {
 	if x != nil {
-		return x.EliminationDebates
+		return x.Room
 	}
-	return 0
+	return nil
 }
 
-type GetJudgesRequest struct {
+type DeleteRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoomId        int32                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgesRequest) Reset() {
-	*x = GetJudgesRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[10]
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJudgesRequest) String() string {
+func (x *DeleteRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJudgesRequest) ProtoMessage() {}
+func (*DeleteRoomRequest) ProtoMessage() {}
 
-func (x *GetJudgesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[10]
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,47 +768,48 @@ func (x *GetJudgesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJudgesRequest.ProtoReflect.Descriptor instead.
-func (*GetJudgesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetJudgesRequest) GetTournamentId() int32 {
+func (x *DeleteRoomRequest) GetRoomId() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.RoomId
 	}
 	return 0
 }
 
-func (x *GetJudgesRequest) GetToken() string {
+func (x *DeleteRoomRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type GetJudgesResponse struct {
+type DeleteRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Judges        []*Judge               `protobuf:"bytes,1,rep,name=judges,proto3" json:"judges,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgesResponse) Reset() {
-	*x = GetJudgesResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[11]
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJudgesResponse) String() string {
+func (x *DeleteRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJudgesResponse) ProtoMessage() {}
+func (*DeleteRoomResponse) ProtoMessage() {}
 
-func (x *GetJudgesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[11]
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,42 +820,49 @@ func (x *GetJudgesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJudgesResponse.ProtoReflect.Descriptor instead.
-func (*GetJudgesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetJudgesResponse) GetJudges() []*Judge {
+func (x *DeleteRoomResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Judges
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type RoomInfo struct {
+func (x *DeleteRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetRoomAvailabilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        int32                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	IsHeadJudge   bool                   `protobuf:"varint,3,opt,name=is_head_judge,json=isHeadJudge,proto3" json:"is_head_judge,omitempty"`
+	Availability  []*AvailabilityWindow  `protobuf:"bytes,2,rep,name=availability,proto3" json:"availability,omitempty"` // Replaces the room's windows; empty makes it always available
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomInfo) Reset() {
-	*x = RoomInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[12]
+func (x *SetRoomAvailabilityRequest) Reset() {
+	*x = SetRoomAvailabilityRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomInfo) String() string {
+func (x *SetRoomAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomInfo) ProtoMessage() {}
+func (*SetRoomAvailabilityRequest) ProtoMessage() {}
 
-func (x *RoomInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[12]
+func (x *SetRoomAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,54 +873,54 @@ func (x *RoomInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
-func (*RoomInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetRoomAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RoomInfo) GetRoomId() int32 {
+func (x *SetRoomAvailabilityRequest) GetRoomId() int32 {
 	if x != nil {
 		return x.RoomId
 	}
 	return 0
 }
 
-func (x *RoomInfo) GetRoomName() string {
+func (x *SetRoomAvailabilityRequest) GetAvailability() []*AvailabilityWindow {
 	if x != nil {
-		return x.RoomName
+		return x.Availability
 	}
-	return ""
+	return nil
 }
 
-func (x *RoomInfo) GetIsHeadJudge() bool {
+func (x *SetRoomAvailabilityRequest) GetToken() string {
 	if x != nil {
-		return x.IsHeadJudge
+		return x.Token
 	}
-	return false
+	return ""
 }
 
-type RoundInfo struct {
+type SetRoomAvailabilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         map[int32]*RoomInfo    `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoundInfo) Reset() {
-	*x = RoundInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[13]
+func (x *SetRoomAvailabilityResponse) Reset() {
+	*x = SetRoomAvailabilityResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoundInfo) String() string {
+func (x *SetRoomAvailabilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundInfo) ProtoMessage() {}
+func (*SetRoomAvailabilityResponse) ProtoMessage() {}
 
-func (x *RoundInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[13]
+func (x *SetRoomAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,42 +931,44 @@ func (x *RoundInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
-func (*RoundInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetRoomAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RoundInfo) GetRooms() map[int32]*RoomInfo {
+func (x *SetRoomAvailabilityResponse) GetRoom() *Room {
 	if x != nil {
-		return x.Rooms
+		return x.Room
 	}
 	return nil
 }
 
-type GetJudgeRequest struct {
+// Building messages
+type Building struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	BuildingId    int32                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
 	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgeRequest) Reset() {
-	*x = GetJudgeRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[14]
+func (x *Building) Reset() {
+	*x = Building{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJudgeRequest) String() string {
+func (x *Building) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJudgeRequest) ProtoMessage() {}
+func (*Building) ProtoMessage() {}
 
-func (x *GetJudgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[14]
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,58 +979,62 @@ func (x *GetJudgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJudgeRequest.ProtoReflect.Descriptor instead.
-func (*GetJudgeRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetJudgeRequest) GetJudgeId() int32 {
+func (x *Building) GetBuildingId() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.BuildingId
 	}
 	return 0
 }
 
-func (x *GetJudgeRequest) GetTournamentId() int32 {
+func (x *Building) GetTournamentId() int32 {
 	if x != nil {
 		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GetJudgeRequest) GetToken() string {
+func (x *Building) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
 	}
 	return ""
 }
 
-type GetJudgeResponse struct {
+func (x *Building) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type CreateBuildingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IdebateId     string                 `protobuf:"bytes,3,opt,name=idebate_id,json=idebateId,proto3" json:"idebate_id,omitempty"`
-	Preliminary   map[int32]*RoomInfo    `protobuf:"bytes,4,rep,name=preliminary,proto3" json:"preliminary,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Elimination   map[int32]*RoomInfo    `protobuf:"bytes,5,rep,name=elimination,proto3" json:"elimination,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgeResponse) Reset() {
-	*x = GetJudgeResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[15]
+func (x *CreateBuildingRequest) Reset() {
+	*x = CreateBuildingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJudgeResponse) String() string {
+func (x *CreateBuildingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJudgeResponse) ProtoMessage() {}
+func (*CreateBuildingRequest) ProtoMessage() {}
 
-func (x *GetJudgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[15]
+func (x *CreateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,72 +1045,92 @@ func (x *GetJudgeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJudgeResponse.ProtoReflect.Descriptor instead.
-func (*GetJudgeResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CreateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetJudgeResponse) GetJudgeId() int32 {
+func (x *CreateBuildingRequest) GetBuilding() *Building {
 	if x != nil {
-		return x.JudgeId
+		return x.Building
 	}
-	return 0
+	return nil
 }
 
-func (x *GetJudgeResponse) GetName() string {
+func (x *CreateBuildingRequest) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *GetJudgeResponse) GetIdebateId() string {
-	if x != nil {
-		return x.IdebateId
-	}
-	return ""
+type CreateBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgeResponse) GetPreliminary() map[int32]*RoomInfo {
+func (x *CreateBuildingResponse) Reset() {
+	*x = CreateBuildingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingResponse) ProtoMessage() {}
+
+func (x *CreateBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[18]
 	if x != nil {
-		return x.Preliminary
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetJudgeResponse) GetElimination() map[int32]*RoomInfo {
+// Deprecated: Use CreateBuildingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateBuildingResponse) GetBuilding() *Building {
 	if x != nil {
-		return x.Elimination
+		return x.Building
 	}
 	return nil
 }
 
-type UpdateJudgeRequest struct {
+type GetBuildingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Preliminary   map[int32]*RoomInfo    `protobuf:"bytes,3,rep,name=preliminary,proto3" json:"preliminary,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Elimination   map[int32]*RoomInfo    `protobuf:"bytes,4,rep,name=elimination,proto3" json:"elimination,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJudgeRequest) Reset() {
-	*x = UpdateJudgeRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[16]
+func (x *GetBuildingsRequest) Reset() {
+	*x = GetBuildingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJudgeRequest) String() string {
+func (x *GetBuildingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJudgeRequest) ProtoMessage() {}
+func (*GetBuildingsRequest) ProtoMessage() {}
 
-func (x *UpdateJudgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[16]
+func (x *GetBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,69 +1141,92 @@ func (x *UpdateJudgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJudgeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJudgeRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateJudgeRequest) GetJudgeId() int32 {
+func (x *GetBuildingsRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *UpdateJudgeRequest) GetTournamentId() int32 {
+func (x *GetBuildingsRequest) GetToken() string {
 	if x != nil {
-		return x.TournamentId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateJudgeRequest) GetPreliminary() map[int32]*RoomInfo {
-	if x != nil {
-		return x.Preliminary
-	}
-	return nil
+type GetBuildingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJudgeRequest) GetElimination() map[int32]*RoomInfo {
+func (x *GetBuildingsResponse) Reset() {
+	*x = GetBuildingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildingsResponse) ProtoMessage() {}
+
+func (x *GetBuildingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[20]
 	if x != nil {
-		return x.Elimination
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateJudgeRequest) GetToken() string {
+// Deprecated: Use GetBuildingsResponse.ProtoReflect.Descriptor instead.
+func (*GetBuildingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBuildingsResponse) GetBuildings() []*Building {
 	if x != nil {
-		return x.Token
+		return x.Buildings
 	}
-	return ""
+	return nil
 }
 
-type UpdateJudgeResponse struct {
+type UpdateBuildingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJudgeResponse) Reset() {
-	*x = UpdateJudgeResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[17]
+func (x *UpdateBuildingRequest) Reset() {
+	*x = UpdateBuildingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJudgeResponse) String() string {
+func (x *UpdateBuildingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJudgeResponse) ProtoMessage() {}
+func (*UpdateBuildingRequest) ProtoMessage() {}
 
-func (x *UpdateJudgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[17]
+func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,56 +1237,47 @@ func (x *UpdateJudgeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJudgeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJudgeResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateJudgeResponse) GetSuccess() bool {
+func (x *UpdateBuildingRequest) GetBuilding() *Building {
 	if x != nil {
-		return x.Success
+		return x.Building
 	}
-	return false
+	return nil
 }
 
-func (x *UpdateJudgeResponse) GetMessage() string {
+func (x *UpdateBuildingRequest) GetToken() string {
 	if x != nil {
-		return x.Message
+		return x.Token
 	}
 	return ""
 }
 
-// Pairing messages
-type Pairing struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PairingId          int32                  `protobuf:"varint,1,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"`
-	RoundNumber        int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsEliminationRound bool                   `protobuf:"varint,3,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
-	RoomId             int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	RoomName           string                 `protobuf:"bytes,5,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	Team1              *Team                  `protobuf:"bytes,6,opt,name=team1,proto3" json:"team1,omitempty"`
-	Team2              *Team                  `protobuf:"bytes,7,opt,name=team2,proto3" json:"team2,omitempty"`
-	HeadJudgeName      string                 `protobuf:"bytes,8,opt,name=head_judge_name,json=headJudgeName,proto3" json:"head_judge_name,omitempty"`
-	Judges             []*Judge               `protobuf:"bytes,9,rep,name=judges,proto3" json:"judges,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type UpdateBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pairing) Reset() {
-	*x = Pairing{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[18]
+func (x *UpdateBuildingResponse) Reset() {
+	*x = UpdateBuildingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pairing) String() string {
+func (x *UpdateBuildingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pairing) ProtoMessage() {}
+func (*UpdateBuildingResponse) ProtoMessage() {}
 
-func (x *Pairing) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[18]
+func (x *UpdateBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,102 +1288,92 @@ func (x *Pairing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pairing.ProtoReflect.Descriptor instead.
-func (*Pairing) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateBuildingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Pairing) GetPairingId() int32 {
+func (x *UpdateBuildingResponse) GetBuilding() *Building {
 	if x != nil {
-		return x.PairingId
+		return x.Building
 	}
-	return 0
+	return nil
 }
 
-func (x *Pairing) GetRoundNumber() int32 {
-	if x != nil {
-		return x.RoundNumber
-	}
-	return 0
+type DeleteBuildingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId    int32                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pairing) GetIsEliminationRound() bool {
-	if x != nil {
-		return x.IsEliminationRound
-	}
-	return false
+func (x *DeleteBuildingRequest) Reset() {
+	*x = DeleteBuildingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pairing) GetRoomId() int32 {
-	if x != nil {
-		return x.RoomId
-	}
-	return 0
+func (x *DeleteBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Pairing) GetRoomName() string {
+func (*DeleteBuildingRequest) ProtoMessage() {}
+
+func (x *DeleteBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[23]
 	if x != nil {
-		return x.RoomName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Pairing) GetTeam1() *Team {
-	if x != nil {
-		return x.Team1
-	}
-	return nil
+// Deprecated: Use DeleteBuildingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Pairing) GetTeam2() *Team {
+func (x *DeleteBuildingRequest) GetBuildingId() int32 {
 	if x != nil {
-		return x.Team2
+		return x.BuildingId
 	}
-	return nil
+	return 0
 }
 
-func (x *Pairing) GetHeadJudgeName() string {
+func (x *DeleteBuildingRequest) GetToken() string {
 	if x != nil {
-		return x.HeadJudgeName
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Pairing) GetJudges() []*Judge {
-	if x != nil {
-		return x.Judges
-	}
-	return nil
-}
-
-type Team struct {
+type DeleteBuildingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Speakers      []*Speaker             `protobuf:"bytes,3,rep,name=speakers,proto3" json:"speakers,omitempty"`
-	SpeakerNames  []string               `protobuf:"bytes,4,rep,name=speaker_names,json=speakerNames,proto3" json:"speaker_names,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	LeagueName    string                 `protobuf:"bytes,6,opt,name=league_name,json=leagueName,proto3" json:"league_name,omitempty"`
-	Feedback      string                 `protobuf:"bytes,7,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Team) Reset() {
-	*x = Team{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[19]
+func (x *DeleteBuildingResponse) Reset() {
+	*x = DeleteBuildingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Team) String() string {
+func (x *DeleteBuildingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Team) ProtoMessage() {}
+func (*DeleteBuildingResponse) ProtoMessage() {}
 
-func (x *Team) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[19]
+func (x *DeleteBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,89 +1384,45 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Team.ProtoReflect.Descriptor instead.
-func (*Team) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *Team) GetTeamId() int32 {
-	if x != nil {
-		return x.TeamId
-	}
-	return 0
-}
-
-func (x *Team) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Team) GetSpeakers() []*Speaker {
-	if x != nil {
-		return x.Speakers
-	}
-	return nil
-}
-
-func (x *Team) GetSpeakerNames() []string {
-	if x != nil {
-		return x.SpeakerNames
-	}
-	return nil
-}
-
-func (x *Team) GetTotalPoints() float64 {
-	if x != nil {
-		return x.TotalPoints
-	}
-	return 0
-}
-
-func (x *Team) GetLeagueName() string {
-	if x != nil {
-		return x.LeagueName
-	}
-	return ""
+// Deprecated: Use DeleteBuildingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Team) GetFeedback() string {
+func (x *DeleteBuildingResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Feedback
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type Speaker struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SpeakerId     int32                  `protobuf:"varint,1,opt,name=speaker_id,json=speakerId,proto3" json:"speaker_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ScoreId       int32                  `protobuf:"varint,3,opt,name=score_id,json=scoreId,proto3" json:"score_id,omitempty"`
-	Rank          int32                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
-	Points        float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points,omitempty"`
-	Feedback      string                 `protobuf:"bytes,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	TeamId        int32                  `protobuf:"varint,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	TeamName      string                 `protobuf:"bytes,8,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Judge messages
+type Judge struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId            int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IdebateId          string                 `protobuf:"bytes,3,opt,name=idebate_id,json=idebateId,proto3" json:"idebate_id,omitempty"`
+	PreliminaryDebates int32                  `protobuf:"varint,4,opt,name=preliminary_debates,json=preliminaryDebates,proto3" json:"preliminary_debates,omitempty"`
+	EliminationDebates int32                  `protobuf:"varint,5,opt,name=elimination_debates,json=eliminationDebates,proto3" json:"elimination_debates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Speaker) Reset() {
-	*x = Speaker{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[20]
+func (x *Judge) Reset() {
+	*x = Judge{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Speaker) String() string {
+func (x *Judge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Speaker) ProtoMessage() {}
+func (*Judge) ProtoMessage() {}
 
-func (x *Speaker) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[20]
+func (x *Judge) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,92 +1433,69 @@ func (x *Speaker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Speaker.ProtoReflect.Descriptor instead.
-func (*Speaker) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Judge.ProtoReflect.Descriptor instead.
+func (*Judge) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Speaker) GetSpeakerId() int32 {
+func (x *Judge) GetJudgeId() int32 {
 	if x != nil {
-		return x.SpeakerId
+		return x.JudgeId
 	}
 	return 0
 }
 
-func (x *Speaker) GetName() string {
+func (x *Judge) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Speaker) GetScoreId() int32 {
-	if x != nil {
-		return x.ScoreId
-	}
-	return 0
-}
-
-func (x *Speaker) GetRank() int32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
-}
-
-func (x *Speaker) GetPoints() float64 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
-}
-
-func (x *Speaker) GetFeedback() string {
+func (x *Judge) GetIdebateId() string {
 	if x != nil {
-		return x.Feedback
+		return x.IdebateId
 	}
 	return ""
 }
 
-func (x *Speaker) GetTeamId() int32 {
+func (x *Judge) GetPreliminaryDebates() int32 {
 	if x != nil {
-		return x.TeamId
+		return x.PreliminaryDebates
 	}
 	return 0
 }
 
-func (x *Speaker) GetTeamName() string {
+func (x *Judge) GetEliminationDebates() int32 {
 	if x != nil {
-		return x.TeamName
+		return x.EliminationDebates
 	}
-	return ""
+	return 0
 }
 
-type GetPairingsRequest struct {
+type GetJudgesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPairingsRequest) Reset() {
-	*x = GetPairingsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[21]
+func (x *GetJudgesRequest) Reset() {
+	*x = GetJudgesRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPairingsRequest) String() string {
+func (x *GetJudgesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPairingsRequest) ProtoMessage() {}
+func (*GetJudgesRequest) ProtoMessage() {}
 
-func (x *GetPairingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[21]
+func (x *GetJudgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,61 +1506,47 @@ func (x *GetPairingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPairingsRequest.ProtoReflect.Descriptor instead.
-func (*GetPairingsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetJudgesRequest.ProtoReflect.Descriptor instead.
+func (*GetJudgesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPairingsRequest) GetTournamentId() int32 {
+func (x *GetJudgesRequest) GetTournamentId() int32 {
 	if x != nil {
 		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GetPairingsRequest) GetRoundNumber() int32 {
-	if x != nil {
-		return x.RoundNumber
-	}
-	return 0
-}
-
-func (x *GetPairingsRequest) GetIsElimination() bool {
-	if x != nil {
-		return x.IsElimination
-	}
-	return false
-}
-
-func (x *GetPairingsRequest) GetToken() string {
+func (x *GetJudgesRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type GetPairingsResponse struct {
+type GetJudgesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	Judges        []*Judge               `protobuf:"bytes,1,rep,name=judges,proto3" json:"judges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPairingsResponse) Reset() {
-	*x = GetPairingsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[22]
+func (x *GetJudgesResponse) Reset() {
+	*x = GetJudgesResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPairingsResponse) String() string {
+func (x *GetJudgesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPairingsResponse) ProtoMessage() {}
+func (*GetJudgesResponse) ProtoMessage() {}
 
-func (x *GetPairingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[22]
+func (x *GetJudgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,41 +1557,42 @@ func (x *GetPairingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPairingsResponse.ProtoReflect.Descriptor instead.
-func (*GetPairingsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetJudgesResponse.ProtoReflect.Descriptor instead.
+func (*GetJudgesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPairingsResponse) GetPairings() []*Pairing {
+func (x *GetJudgesResponse) GetJudges() []*Judge {
 	if x != nil {
-		return x.Pairings
+		return x.Judges
 	}
 	return nil
 }
 
-type UpdatePairingsRequest struct {
+type RoomInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RoomId        int32                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	IsHeadJudge   bool                   `protobuf:"varint,3,opt,name=is_head_judge,json=isHeadJudge,proto3" json:"is_head_judge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePairingsRequest) Reset() {
-	*x = UpdatePairingsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[23]
+func (x *RoomInfo) Reset() {
+	*x = RoomInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePairingsRequest) String() string {
+func (x *RoomInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePairingsRequest) ProtoMessage() {}
+func (*RoomInfo) ProtoMessage() {}
 
-func (x *UpdatePairingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[23]
+func (x *RoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,47 +1603,54 @@ func (x *UpdatePairingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePairingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePairingsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
+func (*RoomInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdatePairingsRequest) GetPairings() []*Pairing {
+func (x *RoomInfo) GetRoomId() int32 {
 	if x != nil {
-		return x.Pairings
+		return x.RoomId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdatePairingsRequest) GetToken() string {
+func (x *RoomInfo) GetRoomName() string {
 	if x != nil {
-		return x.Token
+		return x.RoomName
 	}
 	return ""
 }
 
-type UpdatePairingsResponse struct {
+func (x *RoomInfo) GetIsHeadJudge() bool {
+	if x != nil {
+		return x.IsHeadJudge
+	}
+	return false
+}
+
+type RoundInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	Rooms         map[int32]*RoomInfo    `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePairingsResponse) Reset() {
-	*x = UpdatePairingsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[24]
+func (x *RoundInfo) Reset() {
+	*x = RoundInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePairingsResponse) String() string {
+func (x *RoundInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePairingsResponse) ProtoMessage() {}
+func (*RoundInfo) ProtoMessage() {}
 
-func (x *UpdatePairingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[24]
+func (x *RoundInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,53 +1661,42 @@ func (x *UpdatePairingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePairingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePairingsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
+func (*RoundInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdatePairingsResponse) GetPairings() []*Pairing {
+func (x *RoundInfo) GetRooms() map[int32]*RoomInfo {
 	if x != nil {
-		return x.Pairings
+		return x.Rooms
 	}
 	return nil
 }
 
-// Ballot messages
-type Ballot struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	BallotId           int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
-	RoundNumber        int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsElimination      bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
-	RoomId             int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	RoomName           string                 `protobuf:"bytes,5,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	Judges             []*Judge               `protobuf:"bytes,6,rep,name=judges,proto3" json:"judges,omitempty"`
-	Team1              *Team                  `protobuf:"bytes,7,opt,name=team1,proto3" json:"team1,omitempty"`
-	Team2              *Team                  `protobuf:"bytes,8,opt,name=team2,proto3" json:"team2,omitempty"`
-	RecordingStatus    string                 `protobuf:"bytes,9,opt,name=recording_status,json=recordingStatus,proto3" json:"recording_status,omitempty"`
-	Verdict            string                 `protobuf:"bytes,10,opt,name=verdict,proto3" json:"verdict,omitempty"`
-	LastUpdatedBy      int32                  `protobuf:"varint,11,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
-	LastUpdatedAt      string                 `protobuf:"bytes,12,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
-	HeadJudgeSubmitted bool                   `protobuf:"varint,13,opt,name=head_judge_submitted,json=headJudgeSubmitted,proto3" json:"head_judge_submitted,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetJudgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ballot) Reset() {
-	*x = Ballot{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[25]
+func (x *GetJudgeRequest) Reset() {
+	*x = GetJudgeRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ballot) String() string {
+func (x *GetJudgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ballot) ProtoMessage() {}
+func (*GetJudgeRequest) ProtoMessage() {}
 
-func (x *Ballot) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[25]
+func (x *GetJudgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1625,127 +1707,153 @@ func (x *Ballot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ballot.ProtoReflect.Descriptor instead.
-func (*Ballot) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetJudgeRequest.ProtoReflect.Descriptor instead.
+func (*GetJudgeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Ballot) GetBallotId() int32 {
+func (x *GetJudgeRequest) GetJudgeId() int32 {
 	if x != nil {
-		return x.BallotId
+		return x.JudgeId
 	}
 	return 0
 }
 
-func (x *Ballot) GetRoundNumber() int32 {
+func (x *GetJudgeRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.RoundNumber
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *Ballot) GetIsElimination() bool {
+func (x *GetJudgeRequest) GetToken() string {
 	if x != nil {
-		return x.IsElimination
+		return x.Token
 	}
-	return false
+	return ""
 }
 
-func (x *Ballot) GetRoomId() int32 {
-	if x != nil {
-		return x.RoomId
-	}
-	return 0
+type GetJudgeResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId             int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IdebateId           string                 `protobuf:"bytes,3,opt,name=idebate_id,json=idebateId,proto3" json:"idebate_id,omitempty"`
+	Preliminary         map[int32]*RoomInfo    `protobuf:"bytes,4,rep,name=preliminary,proto3" json:"preliminary,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Elimination         map[int32]*RoomInfo    `protobuf:"bytes,5,rep,name=elimination,proto3" json:"elimination,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Conflicts           []*JudgeConflict       `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	MaxTimesJudgingTeam int32                  `protobuf:"varint,7,opt,name=max_times_judging_team,json=maxTimesJudgingTeam,proto3" json:"max_times_judging_team,omitempty"` // 0 means no limit
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *Ballot) GetRoomName() string {
-	if x != nil {
-		return x.RoomName
-	}
-	return ""
+func (x *GetJudgeResponse) Reset() {
+	*x = GetJudgeResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ballot) GetJudges() []*Judge {
+func (x *GetJudgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJudgeResponse) ProtoMessage() {}
+
+func (x *GetJudgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[31]
 	if x != nil {
-		return x.Judges
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Ballot) GetTeam1() *Team {
+// Deprecated: Use GetJudgeResponse.ProtoReflect.Descriptor instead.
+func (*GetJudgeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetJudgeResponse) GetJudgeId() int32 {
 	if x != nil {
-		return x.Team1
+		return x.JudgeId
 	}
-	return nil
+	return 0
 }
 
-func (x *Ballot) GetTeam2() *Team {
+func (x *GetJudgeResponse) GetName() string {
 	if x != nil {
-		return x.Team2
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Ballot) GetRecordingStatus() string {
+func (x *GetJudgeResponse) GetIdebateId() string {
 	if x != nil {
-		return x.RecordingStatus
+		return x.IdebateId
 	}
 	return ""
 }
 
-func (x *Ballot) GetVerdict() string {
+func (x *GetJudgeResponse) GetPreliminary() map[int32]*RoomInfo {
 	if x != nil {
-		return x.Verdict
+		return x.Preliminary
 	}
-	return ""
+	return nil
 }
 
-func (x *Ballot) GetLastUpdatedBy() int32 {
+func (x *GetJudgeResponse) GetElimination() map[int32]*RoomInfo {
 	if x != nil {
-		return x.LastUpdatedBy
+		return x.Elimination
 	}
-	return 0
+	return nil
 }
 
-func (x *Ballot) GetLastUpdatedAt() string {
+func (x *GetJudgeResponse) GetConflicts() []*JudgeConflict {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Conflicts
 	}
-	return ""
+	return nil
 }
 
-func (x *Ballot) GetHeadJudgeSubmitted() bool {
+func (x *GetJudgeResponse) GetMaxTimesJudgingTeam() int32 {
 	if x != nil {
-		return x.HeadJudgeSubmitted
+		return x.MaxTimesJudgingTeam
 	}
-	return false
+	return 0
 }
 
-type GetBallotsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateJudgeRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId             int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	TournamentId        int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Preliminary         map[int32]*RoomInfo    `protobuf:"bytes,3,rep,name=preliminary,proto3" json:"preliminary,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Elimination         map[int32]*RoomInfo    `protobuf:"bytes,4,rep,name=elimination,proto3" json:"elimination,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Token               string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	UpdateConflicts     bool                   `protobuf:"varint,6,opt,name=update_conflicts,json=updateConflicts,proto3" json:"update_conflicts,omitempty"` // replace the judge's conflicts and team limit with the values below
+	Conflicts           []*JudgeConflict       `protobuf:"bytes,7,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	MaxTimesJudgingTeam int32                  `protobuf:"varint,8,opt,name=max_times_judging_team,json=maxTimesJudgingTeam,proto3" json:"max_times_judging_team,omitempty"` // 0 means no limit
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetBallotsRequest) Reset() {
-	*x = GetBallotsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[26]
+func (x *UpdateJudgeRequest) Reset() {
+	*x = UpdateJudgeRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBallotsRequest) String() string {
+func (x *UpdateJudgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBallotsRequest) ProtoMessage() {}
+func (*UpdateJudgeRequest) ProtoMessage() {}
 
-func (x *GetBallotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[26]
+func (x *UpdateJudgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,61 +1864,99 @@ func (x *GetBallotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBallotsRequest.ProtoReflect.Descriptor instead.
-func (*GetBallotsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdateJudgeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJudgeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetBallotsRequest) GetTournamentId() int32 {
+func (x *UpdateJudgeRequest) GetJudgeId() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.JudgeId
 	}
 	return 0
 }
 
-func (x *GetBallotsRequest) GetRoundNumber() int32 {
+func (x *UpdateJudgeRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.RoundNumber
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GetBallotsRequest) GetIsElimination() bool {
+func (x *UpdateJudgeRequest) GetPreliminary() map[int32]*RoomInfo {
 	if x != nil {
-		return x.IsElimination
+		return x.Preliminary
 	}
-	return false
+	return nil
 }
 
-func (x *GetBallotsRequest) GetToken() string {
+func (x *UpdateJudgeRequest) GetElimination() map[int32]*RoomInfo {
+	if x != nil {
+		return x.Elimination
+	}
+	return nil
+}
+
+func (x *UpdateJudgeRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type GetBallotsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ballots       []*Ballot              `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateJudgeRequest) GetUpdateConflicts() bool {
+	if x != nil {
+		return x.UpdateConflicts
+	}
+	return false
 }
 
-func (x *GetBallotsResponse) Reset() {
-	*x = GetBallotsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[27]
+func (x *UpdateJudgeRequest) GetConflicts() []*JudgeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *UpdateJudgeRequest) GetMaxTimesJudgingTeam() int32 {
+	if x != nil {
+		return x.MaxTimesJudgingTeam
+	}
+	return 0
+}
+
+// A conflict of interest that keeps a judge out of a room. Exactly one of
+// school_id, team_id or student_id is set, matching conflict_type.
+type JudgeConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConflictId    int32                  `protobuf:"varint,1,opt,name=conflict_id,json=conflictId,proto3" json:"conflict_id,omitempty"`
+	ConflictType  string                 `protobuf:"bytes,2,opt,name=conflict_type,json=conflictType,proto3" json:"conflict_type,omitempty"` // "school", "team" or "student"
+	SchoolId      int32                  `protobuf:"varint,3,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	SchoolName    string                 `protobuf:"bytes,4,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	TeamId        int32                  `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,6,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	StudentId     int32                  `protobuf:"varint,7,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName   string                 `protobuf:"bytes,8,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JudgeConflict) Reset() {
+	*x = JudgeConflict{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBallotsResponse) String() string {
+func (x *JudgeConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBallotsResponse) ProtoMessage() {}
+func (*JudgeConflict) ProtoMessage() {}
 
-func (x *GetBallotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[27]
+func (x *JudgeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,92 +1967,97 @@ func (x *GetBallotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBallotsResponse.ProtoReflect.Descriptor instead.
-func (*GetBallotsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use JudgeConflict.ProtoReflect.Descriptor instead.
+func (*JudgeConflict) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetBallotsResponse) GetBallots() []*Ballot {
+func (x *JudgeConflict) GetConflictId() int32 {
 	if x != nil {
-		return x.Ballots
+		return x.ConflictId
 	}
-	return nil
+	return 0
 }
 
-type GetBallotRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BallotId      int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JudgeConflict) GetConflictType() string {
+	if x != nil {
+		return x.ConflictType
+	}
+	return ""
 }
 
-func (x *GetBallotRequest) Reset() {
-	*x = GetBallotRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JudgeConflict) GetSchoolId() int32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
 }
 
-func (x *GetBallotRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JudgeConflict) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
 }
 
-func (*GetBallotRequest) ProtoMessage() {}
-
-func (x *GetBallotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[28]
+func (x *JudgeConflict) GetTeamId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TeamId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetBallotRequest.ProtoReflect.Descriptor instead.
-func (*GetBallotRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{28}
+func (x *JudgeConflict) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
 }
 
-func (x *GetBallotRequest) GetBallotId() int32 {
+func (x *JudgeConflict) GetStudentId() int32 {
 	if x != nil {
-		return x.BallotId
+		return x.StudentId
 	}
 	return 0
 }
 
-func (x *GetBallotRequest) GetToken() string {
+func (x *JudgeConflict) GetStudentName() string {
 	if x != nil {
-		return x.Token
+		return x.StudentName
 	}
 	return ""
 }
 
-type GetBallotResponse struct {
+func (x *JudgeConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateJudgeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBallotResponse) Reset() {
-	*x = GetBallotResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[29]
+func (x *UpdateJudgeResponse) Reset() {
+	*x = UpdateJudgeResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBallotResponse) String() string {
+func (x *UpdateJudgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBallotResponse) ProtoMessage() {}
+func (*UpdateJudgeResponse) ProtoMessage() {}
 
-func (x *GetBallotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[29]
+func (x *UpdateJudgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,42 +2068,49 @@ func (x *GetBallotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBallotResponse.ProtoReflect.Descriptor instead.
-func (*GetBallotResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UpdateJudgeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJudgeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetBallotResponse) GetBallot() *Ballot {
+func (x *UpdateJudgeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ballot
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetBallotByJudgeIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateJudgeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *GetBallotByJudgeIDRequest) Reset() {
-	*x = GetBallotByJudgeIDRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[30]
+type RoundAvailability struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RoundNumber        int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,2,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	IsAvailable        bool                   `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RoundAvailability) Reset() {
+	*x = RoundAvailability{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBallotByJudgeIDRequest) String() string {
+func (x *RoundAvailability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBallotByJudgeIDRequest) ProtoMessage() {}
+func (*RoundAvailability) ProtoMessage() {}
 
-func (x *GetBallotByJudgeIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[30]
+func (x *RoundAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,54 +2121,57 @@ func (x *GetBallotByJudgeIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBallotByJudgeIDRequest.ProtoReflect.Descriptor instead.
-func (*GetBallotByJudgeIDRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RoundAvailability.ProtoReflect.Descriptor instead.
+func (*RoundAvailability) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetBallotByJudgeIDRequest) GetJudgeId() int32 {
+func (x *RoundAvailability) GetRoundNumber() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.RoundNumber
 	}
 	return 0
 }
 
-func (x *GetBallotByJudgeIDRequest) GetTournamentId() int32 {
+func (x *RoundAvailability) GetIsEliminationRound() bool {
 	if x != nil {
-		return x.TournamentId
+		return x.IsEliminationRound
 	}
-	return 0
+	return false
 }
 
-func (x *GetBallotByJudgeIDRequest) GetToken() string {
+func (x *RoundAvailability) GetIsAvailable() bool {
 	if x != nil {
-		return x.Token
+		return x.IsAvailable
 	}
-	return ""
+	return false
 }
 
-type GetBallotByJudgeIDResponse struct {
+type SetJudgeAvailabilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	Rounds        []*RoundAvailability   `protobuf:"bytes,3,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBallotByJudgeIDResponse) Reset() {
-	*x = GetBallotByJudgeIDResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[31]
+func (x *SetJudgeAvailabilityRequest) Reset() {
+	*x = SetJudgeAvailabilityRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBallotByJudgeIDResponse) String() string {
+func (x *SetJudgeAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBallotByJudgeIDResponse) ProtoMessage() {}
+func (*SetJudgeAvailabilityRequest) ProtoMessage() {}
 
-func (x *GetBallotByJudgeIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[31]
+func (x *SetJudgeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,41 +2182,62 @@ func (x *GetBallotByJudgeIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBallotByJudgeIDResponse.ProtoReflect.Descriptor instead.
-func (*GetBallotByJudgeIDResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SetJudgeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetJudgeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetBallotByJudgeIDResponse) GetBallot() *Ballot {
+func (x *SetJudgeAvailabilityRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.Ballot
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *SetJudgeAvailabilityRequest) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *SetJudgeAvailabilityRequest) GetRounds() []*RoundAvailability {
+	if x != nil {
+		return x.Rounds
 	}
 	return nil
 }
 
-type UpdateBallotRequest struct {
+func (x *SetJudgeAvailabilityRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SetJudgeAvailabilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBallotRequest) Reset() {
-	*x = UpdateBallotRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[32]
+func (x *SetJudgeAvailabilityResponse) Reset() {
+	*x = SetJudgeAvailabilityResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBallotRequest) String() string {
+func (x *SetJudgeAvailabilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBallotRequest) ProtoMessage() {}
+func (*SetJudgeAvailabilityResponse) ProtoMessage() {}
 
-func (x *UpdateBallotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[32]
+func (x *SetJudgeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,47 +2248,51 @@ func (x *UpdateBallotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBallotRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBallotRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SetJudgeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetJudgeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateBallotRequest) GetBallot() *Ballot {
+func (x *SetJudgeAvailabilityResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ballot
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateBallotRequest) GetToken() string {
+func (x *SetJudgeAvailabilityResponse) GetMessage() string {
 	if x != nil {
-		return x.Token
+		return x.Message
 	}
 	return ""
 }
 
-type UpdateBallotResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CheckInJudgeRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId       int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	JudgeId            int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	RoundNumber        int32                  `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,4,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	Token              string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateBallotResponse) Reset() {
-	*x = UpdateBallotResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[33]
+func (x *CheckInJudgeRequest) Reset() {
+	*x = CheckInJudgeRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBallotResponse) String() string {
+func (x *CheckInJudgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBallotResponse) ProtoMessage() {}
+func (*CheckInJudgeRequest) ProtoMessage() {}
 
-func (x *UpdateBallotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[33]
+func (x *CheckInJudgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,42 +2303,70 @@ func (x *UpdateBallotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBallotResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBallotResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CheckInJudgeRequest.ProtoReflect.Descriptor instead.
+func (*CheckInJudgeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdateBallotResponse) GetBallot() *Ballot {
+func (x *CheckInJudgeRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.Ballot
+		return x.TournamentId
 	}
-	return nil
+	return 0
 }
 
-// Algorithm integration messages
-type GeneratePreliminaryPairingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *CheckInJudgeRequest) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
 
-func (x *GeneratePreliminaryPairingsRequest) Reset() {
-	*x = GeneratePreliminaryPairingsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[34]
+func (x *CheckInJudgeRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *CheckInJudgeRequest) GetIsEliminationRound() bool {
+	if x != nil {
+		return x.IsEliminationRound
+	}
+	return false
+}
+
+func (x *CheckInJudgeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CheckInJudgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CheckedInAt   string                 `protobuf:"bytes,3,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInJudgeResponse) Reset() {
+	*x = CheckInJudgeResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeneratePreliminaryPairingsRequest) String() string {
+func (x *CheckInJudgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePreliminaryPairingsRequest) ProtoMessage() {}
+func (*CheckInJudgeResponse) ProtoMessage() {}
 
-func (x *GeneratePreliminaryPairingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[34]
+func (x *CheckInJudgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,49 +2377,57 @@ func (x *GeneratePreliminaryPairingsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePreliminaryPairingsRequest.ProtoReflect.Descriptor instead.
-func (*GeneratePreliminaryPairingsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use CheckInJudgeResponse.ProtoReflect.Descriptor instead.
+func (*CheckInJudgeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GeneratePreliminaryPairingsRequest) GetTournamentId() int32 {
+func (x *CheckInJudgeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TournamentId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GeneratePreliminaryPairingsRequest) GetToken() string {
+func (x *CheckInJudgeResponse) GetMessage() string {
 	if x != nil {
-		return x.Token
+		return x.Message
 	}
 	return ""
 }
 
-type GenerateEliminationPairingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CheckInJudgeResponse) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
 }
 
-func (x *GenerateEliminationPairingsRequest) Reset() {
-	*x = GenerateEliminationPairingsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[35]
+type GetJudgeRosterRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId       int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber        int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,3,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	Token              string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetJudgeRosterRequest) Reset() {
+	*x = GetJudgeRosterRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateEliminationPairingsRequest) String() string {
+func (x *GetJudgeRosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateEliminationPairingsRequest) ProtoMessage() {}
+func (*GetJudgeRosterRequest) ProtoMessage() {}
 
-func (x *GenerateEliminationPairingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[35]
+func (x *GetJudgeRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,54 +2438,68 @@ func (x *GenerateEliminationPairingsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateEliminationPairingsRequest.ProtoReflect.Descriptor instead.
-func (*GenerateEliminationPairingsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetJudgeRosterRequest.ProtoReflect.Descriptor instead.
+func (*GetJudgeRosterRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GenerateEliminationPairingsRequest) GetTournamentId() int32 {
+func (x *GetJudgeRosterRequest) GetTournamentId() int32 {
 	if x != nil {
 		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GenerateEliminationPairingsRequest) GetRoundNumber() int32 {
+func (x *GetJudgeRosterRequest) GetRoundNumber() int32 {
 	if x != nil {
 		return x.RoundNumber
 	}
 	return 0
 }
 
-func (x *GenerateEliminationPairingsRequest) GetToken() string {
+func (x *GetJudgeRosterRequest) GetIsEliminationRound() bool {
+	if x != nil {
+		return x.IsEliminationRound
+	}
+	return false
+}
+
+func (x *GetJudgeRosterRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type GeneratePairingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type JudgeRosterEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId        int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IdebateId      string                 `protobuf:"bytes,3,opt,name=idebate_id,json=idebateId,proto3" json:"idebate_id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "checked_in", "not_checked_in", "unavailable" or "absent"
+	CanBeAllocated bool                   `protobuf:"varint,5,opt,name=can_be_allocated,json=canBeAllocated,proto3" json:"can_be_allocated,omitempty"`
+	CheckedInAt    string                 `protobuf:"bytes,6,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	MarkedAbsentAt string                 `protobuf:"bytes,7,opt,name=marked_absent_at,json=markedAbsentAt,proto3" json:"marked_absent_at,omitempty"`
+	Room           *RoomInfo              `protobuf:"bytes,8,opt,name=room,proto3" json:"room,omitempty"` // Unset when the judge has no room in the round
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GeneratePairingsResponse) Reset() {
-	*x = GeneratePairingsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[36]
+func (x *JudgeRosterEntry) Reset() {
+	*x = JudgeRosterEntry{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeneratePairingsResponse) String() string {
+func (x *JudgeRosterEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePairingsResponse) ProtoMessage() {}
+func (*JudgeRosterEntry) ProtoMessage() {}
 
-func (x *GeneratePairingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[36]
+func (x *JudgeRosterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,43 +2510,94 @@ func (x *GeneratePairingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePairingsResponse.ProtoReflect.Descriptor instead.
-func (*GeneratePairingsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use JudgeRosterEntry.ProtoReflect.Descriptor instead.
+func (*JudgeRosterEntry) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GeneratePairingsResponse) GetPairings() []*Pairing {
+func (x *JudgeRosterEntry) GetJudgeId() int32 {
 	if x != nil {
-		return x.Pairings
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *JudgeRosterEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JudgeRosterEntry) GetIdebateId() string {
+	if x != nil {
+		return x.IdebateId
+	}
+	return ""
+}
+
+func (x *JudgeRosterEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JudgeRosterEntry) GetCanBeAllocated() bool {
+	if x != nil {
+		return x.CanBeAllocated
+	}
+	return false
+}
+
+func (x *JudgeRosterEntry) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *JudgeRosterEntry) GetMarkedAbsentAt() string {
+	if x != nil {
+		return x.MarkedAbsentAt
+	}
+	return ""
+}
+
+func (x *JudgeRosterEntry) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
 	}
 	return nil
 }
 
-type CreateTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Speakers      []*Speaker             `protobuf:"bytes,3,rep,name=speakers,proto3" json:"speakers,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetJudgeRosterResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Judges          []*JudgeRosterEntry    `protobuf:"bytes,1,rep,name=judges,proto3" json:"judges,omitempty"`
+	CheckInRequired bool                   `protobuf:"varint,2,opt,name=check_in_required,json=checkInRequired,proto3" json:"check_in_required,omitempty"` // Someone has checked in, so only checked-in judges are allocated
+	CheckedIn       int32                  `protobuf:"varint,3,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	NotCheckedIn    int32                  `protobuf:"varint,4,opt,name=not_checked_in,json=notCheckedIn,proto3" json:"not_checked_in,omitempty"`
+	Unavailable     int32                  `protobuf:"varint,5,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	Absent          int32                  `protobuf:"varint,6,opt,name=absent,proto3" json:"absent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateTeamRequest) Reset() {
-	*x = CreateTeamRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[37]
+func (x *GetJudgeRosterResponse) Reset() {
+	*x = GetJudgeRosterResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTeamRequest) String() string {
+func (x *GetJudgeRosterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTeamRequest) ProtoMessage() {}
+func (*GetJudgeRosterResponse) ProtoMessage() {}
 
-func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[37]
+func (x *GetJudgeRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,62 +2608,79 @@ func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
-func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetJudgeRosterResponse.ProtoReflect.Descriptor instead.
+func (*GetJudgeRosterResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CreateTeamRequest) GetName() string {
+func (x *GetJudgeRosterResponse) GetJudges() []*JudgeRosterEntry {
 	if x != nil {
-		return x.Name
+		return x.Judges
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTeamRequest) GetTournamentId() int32 {
+func (x *GetJudgeRosterResponse) GetCheckInRequired() bool {
 	if x != nil {
-		return x.TournamentId
+		return x.CheckInRequired
+	}
+	return false
+}
+
+func (x *GetJudgeRosterResponse) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
 	}
 	return 0
 }
 
-func (x *CreateTeamRequest) GetSpeakers() []*Speaker {
+func (x *GetJudgeRosterResponse) GetNotCheckedIn() int32 {
 	if x != nil {
-		return x.Speakers
+		return x.NotCheckedIn
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateTeamRequest) GetToken() string {
+func (x *GetJudgeRosterResponse) GetUnavailable() int32 {
 	if x != nil {
-		return x.Token
+		return x.Unavailable
 	}
-	return ""
+	return 0
 }
 
-type GetTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetJudgeRosterResponse) GetAbsent() int32 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
 }
 
-func (x *GetTeamRequest) Reset() {
-	*x = GetTeamRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[38]
+type MarkJudgeAbsentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId       int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	JudgeId            int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	RoundNumber        int32                  `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,4,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	Token              string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MarkJudgeAbsentRequest) Reset() {
+	*x = MarkJudgeAbsentRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamRequest) String() string {
+func (x *MarkJudgeAbsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamRequest) ProtoMessage() {}
+func (*MarkJudgeAbsentRequest) ProtoMessage() {}
 
-func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[38]
+func (x *MarkJudgeAbsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2387,48 +2691,3975 @@ func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use MarkJudgeAbsentRequest.ProtoReflect.Descriptor instead.
+func (*MarkJudgeAbsentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetTeamRequest) GetTeamId() int32 {
+func (x *MarkJudgeAbsentRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.TeamId
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GetTeamRequest) GetToken() string {
+func (x *MarkJudgeAbsentRequest) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *MarkJudgeAbsentRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *MarkJudgeAbsentRequest) GetIsEliminationRound() bool {
+	if x != nil {
+		return x.IsEliminationRound
+	}
+	return false
+}
+
+func (x *MarkJudgeAbsentRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type UpdateTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MarkJudgeAbsentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PairingId        int32                  `protobuf:"varint,3,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"` // The debate the judge was allocated to, 0 if none
+	Room             *RoomInfo              `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	ReplacementJudge *Judge                 `protobuf:"bytes,5,opt,name=replacement_judge,json=replacementJudge,proto3" json:"replacement_judge,omitempty"`    // Unset when nobody could take the seat
+	RoomWithoutJudge bool                   `protobuf:"varint,6,opt,name=room_without_judge,json=roomWithoutJudge,proto3" json:"room_without_judge,omitempty"` // The room has no judge left; its ballot stays with the absent judge until an admin reallocates it
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateTeamRequest) Reset() {
-	*x = UpdateTeamRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[39]
+func (x *MarkJudgeAbsentResponse) Reset() {
+	*x = MarkJudgeAbsentResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTeamRequest) String() string {
+func (x *MarkJudgeAbsentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamRequest) ProtoMessage() {}
+func (*MarkJudgeAbsentResponse) ProtoMessage() {}
 
-func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[39]
+func (x *MarkJudgeAbsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkJudgeAbsentResponse.ProtoReflect.Descriptor instead.
+func (*MarkJudgeAbsentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MarkJudgeAbsentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MarkJudgeAbsentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MarkJudgeAbsentResponse) GetPairingId() int32 {
+	if x != nil {
+		return x.PairingId
+	}
+	return 0
+}
+
+func (x *MarkJudgeAbsentResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *MarkJudgeAbsentResponse) GetReplacementJudge() *Judge {
+	if x != nil {
+		return x.ReplacementJudge
+	}
+	return nil
+}
+
+func (x *MarkJudgeAbsentResponse) GetRoomWithoutJudge() bool {
+	if x != nil {
+		return x.RoomWithoutJudge
+	}
+	return false
+}
+
+// Pairing messages
+type Pairing struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PairingId          int32                  `protobuf:"varint,1,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"`
+	RoundNumber        int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,3,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	RoomId             int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName           string                 `protobuf:"bytes,5,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	Team1              *Team                  `protobuf:"bytes,6,opt,name=team1,proto3" json:"team1,omitempty"`
+	Team2              *Team                  `protobuf:"bytes,7,opt,name=team2,proto3" json:"team2,omitempty"`
+	HeadJudgeName      string                 `protobuf:"bytes,8,opt,name=head_judge_name,json=headJudgeName,proto3" json:"head_judge_name,omitempty"`
+	Judges             []*Judge               `protobuf:"bytes,9,rep,name=judges,proto3" json:"judges,omitempty"`
+	Team3              *Team                  `protobuf:"bytes,10,opt,name=team3,proto3" json:"team3,omitempty"`                                      // Closing Government, British Parliamentary only
+	Team4              *Team                  `protobuf:"bytes,11,opt,name=team4,proto3" json:"team4,omitempty"`                                      // Closing Opposition, British Parliamentary only
+	PanelQuality       float64                `protobuf:"fixed64,12,opt,name=panel_quality,json=panelQuality,proto3" json:"panel_quality,omitempty"`  // Average judge score (0-100) of the allocated panel
+	BreakCategory      string                 `protobuf:"bytes,13,opt,name=break_category,json=breakCategory,proto3" json:"break_category,omitempty"` // Elimination rounds of tournaments with break categories
+	StartTime          string                 `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`             // "2006-01-02 15:04", from the tournament's timetable
+	EndTime            string                 `protobuf:"bytes,15,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                   // "2006-01-02 15:04", from the tournament's timetable
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Pairing) Reset() {
+	*x = Pairing{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pairing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pairing) ProtoMessage() {}
+
+func (x *Pairing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pairing.ProtoReflect.Descriptor instead.
+func (*Pairing) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Pairing) GetPairingId() int32 {
+	if x != nil {
+		return x.PairingId
+	}
+	return 0
+}
+
+func (x *Pairing) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *Pairing) GetIsEliminationRound() bool {
+	if x != nil {
+		return x.IsEliminationRound
+	}
+	return false
+}
+
+func (x *Pairing) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *Pairing) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *Pairing) GetTeam1() *Team {
+	if x != nil {
+		return x.Team1
+	}
+	return nil
+}
+
+func (x *Pairing) GetTeam2() *Team {
+	if x != nil {
+		return x.Team2
+	}
+	return nil
+}
+
+func (x *Pairing) GetHeadJudgeName() string {
+	if x != nil {
+		return x.HeadJudgeName
+	}
+	return ""
+}
+
+func (x *Pairing) GetJudges() []*Judge {
+	if x != nil {
+		return x.Judges
+	}
+	return nil
+}
+
+func (x *Pairing) GetTeam3() *Team {
+	if x != nil {
+		return x.Team3
+	}
+	return nil
+}
+
+func (x *Pairing) GetTeam4() *Team {
+	if x != nil {
+		return x.Team4
+	}
+	return nil
+}
+
+func (x *Pairing) GetPanelQuality() float64 {
+	if x != nil {
+		return x.PanelQuality
+	}
+	return 0
+}
+
+func (x *Pairing) GetBreakCategory() string {
+	if x != nil {
+		return x.BreakCategory
+	}
+	return ""
+}
+
+func (x *Pairing) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Pairing) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type Team struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TeamId              int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Speakers            []*Speaker             `protobuf:"bytes,3,rep,name=speakers,proto3" json:"speakers,omitempty"`
+	SpeakerNames        []string               `protobuf:"bytes,4,rep,name=speaker_names,json=speakerNames,proto3" json:"speaker_names,omitempty"`
+	TotalPoints         float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	LeagueName          string                 `protobuf:"bytes,6,opt,name=league_name,json=leagueName,proto3" json:"league_name,omitempty"`
+	Feedback            string                 `protobuf:"bytes,7,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	TeamPoints          int32                  `protobuf:"varint,8,opt,name=team_points,json=teamPoints,proto3" json:"team_points,omitempty"` // 3/2/1/0 by placement in British Parliamentary
+	BreakCategoryIds    []int32                `protobuf:"varint,9,rep,packed,name=break_category_ids,json=breakCategoryIds,proto3" json:"break_category_ids,omitempty"`
+	NeedsAccessibleRoom bool                   `protobuf:"varint,10,opt,name=needs_accessible_room,json=needsAccessibleRoom,proto3" json:"needs_accessible_room,omitempty"` // Every debate of the team is held in an accessible room
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Team) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetSpeakers() []*Speaker {
+	if x != nil {
+		return x.Speakers
+	}
+	return nil
+}
+
+func (x *Team) GetSpeakerNames() []string {
+	if x != nil {
+		return x.SpeakerNames
+	}
+	return nil
+}
+
+func (x *Team) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *Team) GetLeagueName() string {
+	if x != nil {
+		return x.LeagueName
+	}
+	return ""
+}
+
+func (x *Team) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Team) GetTeamPoints() int32 {
+	if x != nil {
+		return x.TeamPoints
+	}
+	return 0
+}
+
+func (x *Team) GetBreakCategoryIds() []int32 {
+	if x != nil {
+		return x.BreakCategoryIds
+	}
+	return nil
+}
+
+func (x *Team) GetNeedsAccessibleRoom() bool {
+	if x != nil {
+		return x.NeedsAccessibleRoom
+	}
+	return false
+}
+
+type Speaker struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SpeakerId        int32                  `protobuf:"varint,1,opt,name=speaker_id,json=speakerId,proto3" json:"speaker_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ScoreId          int32                  `protobuf:"varint,3,opt,name=score_id,json=scoreId,proto3" json:"score_id,omitempty"`
+	Rank             int32                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Points           float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points,omitempty"`
+	Feedback         string                 `protobuf:"bytes,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	TeamId           int32                  `protobuf:"varint,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName         string                 `protobuf:"bytes,8,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	BreakCategoryIds []int32                `protobuf:"varint,9,rep,packed,name=break_category_ids,json=breakCategoryIds,proto3" json:"break_category_ids,omitempty"` // Speaker award categories
+	ReplyPoints      float64                `protobuf:"fixed64,10,opt,name=reply_points,json=replyPoints,proto3" json:"reply_points,omitempty"`                       // Reply speech points, 0 if the speaker gave no reply
+	Position         int32                  `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`                                                 // Speaking position in the team from 1; 0 takes the order of the list
+	IsSubstitute     bool                   `protobuf:"varint,12,opt,name=is_substitute,json=isSubstitute,proto3" json:"is_substitute,omitempty"`                     // Spoke for a team they are not a member of
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Speaker) Reset() {
+	*x = Speaker{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Speaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Speaker) ProtoMessage() {}
+
+func (x *Speaker) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Speaker.ProtoReflect.Descriptor instead.
+func (*Speaker) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Speaker) GetSpeakerId() int32 {
+	if x != nil {
+		return x.SpeakerId
+	}
+	return 0
+}
+
+func (x *Speaker) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Speaker) GetScoreId() int32 {
+	if x != nil {
+		return x.ScoreId
+	}
+	return 0
+}
+
+func (x *Speaker) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Speaker) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Speaker) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Speaker) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Speaker) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Speaker) GetBreakCategoryIds() []int32 {
+	if x != nil {
+		return x.BreakCategoryIds
+	}
+	return nil
+}
+
+func (x *Speaker) GetReplyPoints() float64 {
+	if x != nil {
+		return x.ReplyPoints
+	}
+	return 0
+}
+
+func (x *Speaker) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Speaker) GetIsSubstitute() bool {
+	if x != nil {
+		return x.IsSubstitute
+	}
+	return false
+}
+
+type GetPairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairingsRequest) Reset() {
+	*x = GetPairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairingsRequest) ProtoMessage() {}
+
+func (x *GetPairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetPairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GetPairingsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *GetPairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetPairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	IsReleased    bool                   `protobuf:"varint,2,opt,name=is_released,json=isReleased,proto3" json:"is_released,omitempty"` // Admins see the draft, everyone else the released draw
+	Byes          []*Bye                 `protobuf:"bytes,3,rep,name=byes,proto3" json:"byes,omitempty"`                                // Teams without a debate this round
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairingsResponse) Reset() {
+	*x = GetPairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairingsResponse) ProtoMessage() {}
+
+func (x *GetPairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPairingsResponse) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *GetPairingsResponse) GetIsReleased() bool {
+	if x != nil {
+		return x.IsReleased
+	}
+	return false
+}
+
+func (x *GetPairingsResponse) GetByes() []*Bye {
+	if x != nil {
+		return x.Byes
+	}
+	return nil
+}
+
+// A team left without an opponent in a preliminary round
+type Bye struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bye) Reset() {
+	*x = Bye{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bye) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bye) ProtoMessage() {}
+
+func (x *Bye) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bye.ProtoReflect.Descriptor instead.
+func (*Bye) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Bye) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Bye) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Bye) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+type UpdatePairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePairingsRequest) Reset() {
+	*x = UpdatePairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePairingsRequest) ProtoMessage() {}
+
+func (x *UpdatePairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePairingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdatePairingsRequest) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *UpdatePairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdatePairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePairingsResponse) Reset() {
+	*x = UpdatePairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePairingsResponse) ProtoMessage() {}
+
+func (x *UpdatePairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePairingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdatePairingsResponse) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+type PairingIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // rematch, side_imbalance, judge_conflict, room_double_booked, judge_double_booked or team_double_booked
+	PairingId     int32                  `protobuf:"varint,2,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairingIssue) Reset() {
+	*x = PairingIssue{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairingIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingIssue) ProtoMessage() {}
+
+func (x *PairingIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingIssue.ProtoReflect.Descriptor instead.
+func (*PairingIssue) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PairingIssue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PairingIssue) GetPairingId() int32 {
+	if x != nil {
+		return x.PairingId
+	}
+	return 0
+}
+
+func (x *PairingIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidatePairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePairingsRequest) Reset() {
+	*x = ValidatePairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePairingsRequest) ProtoMessage() {}
+
+func (x *ValidatePairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePairingsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ValidatePairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *ValidatePairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ValidatePairingsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *ValidatePairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ValidatePairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*PairingIssue        `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePairingsResponse) Reset() {
+	*x = ValidatePairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePairingsResponse) ProtoMessage() {}
+
+func (x *ValidatePairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePairingsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ValidatePairingsResponse) GetIssues() []*PairingIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type PairingChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PairingId     int32                  `protobuf:"varint,1,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"`
+	Change        string                 `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"` // added, removed or changed
+	ChangedFields []string               `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Released      *Pairing               `protobuf:"bytes,4,opt,name=released,proto3" json:"released,omitempty"`
+	Draft         *Pairing               `protobuf:"bytes,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairingChange) Reset() {
+	*x = PairingChange{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingChange) ProtoMessage() {}
+
+func (x *PairingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingChange.ProtoReflect.Descriptor instead.
+func (*PairingChange) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PairingChange) GetPairingId() int32 {
+	if x != nil {
+		return x.PairingId
+	}
+	return 0
+}
+
+func (x *PairingChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *PairingChange) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *PairingChange) GetReleased() *Pairing {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+func (x *PairingChange) GetDraft() *Pairing {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type GetPairingsDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairingsDiffRequest) Reset() {
+	*x = GetPairingsDiffRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairingsDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairingsDiffRequest) ProtoMessage() {}
+
+func (x *GetPairingsDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairingsDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetPairingsDiffRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPairingsDiffRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetPairingsDiffRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GetPairingsDiffRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *GetPairingsDiffRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetPairingsDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*PairingChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	IsReleased    bool                   `protobuf:"varint,2,opt,name=is_released,json=isReleased,proto3" json:"is_released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairingsDiffResponse) Reset() {
+	*x = GetPairingsDiffResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairingsDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairingsDiffResponse) ProtoMessage() {}
+
+func (x *GetPairingsDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairingsDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetPairingsDiffResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPairingsDiffResponse) GetChanges() []*PairingChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetPairingsDiffResponse) GetIsReleased() bool {
+	if x != nil {
+		return x.IsReleased
+	}
+	return false
+}
+
+type ReleasePairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	IgnoreIssues  bool                   `protobuf:"varint,4,opt,name=ignore_issues,json=ignoreIssues,proto3" json:"ignore_issues,omitempty"` // Release even when validation reports issues
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePairingsRequest) Reset() {
+	*x = ReleasePairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePairingsRequest) ProtoMessage() {}
+
+func (x *ReleasePairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePairingsRequest.ProtoReflect.Descriptor instead.
+func (*ReleasePairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReleasePairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *ReleasePairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ReleasePairingsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *ReleasePairingsRequest) GetIgnoreIssues() bool {
+	if x != nil {
+		return x.IgnoreIssues
+	}
+	return false
+}
+
+func (x *ReleasePairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReleasePairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Issues        []*PairingIssue        `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePairingsResponse) Reset() {
+	*x = ReleasePairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePairingsResponse) ProtoMessage() {}
+
+func (x *ReleasePairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePairingsResponse.ProtoReflect.Descriptor instead.
+func (*ReleasePairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReleasePairingsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleasePairingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleasePairingsResponse) GetIssues() []*PairingIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ReplayPairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayPairingsRequest) Reset() {
+	*x = ReplayPairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayPairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayPairingsRequest) ProtoMessage() {}
+
+func (x *ReplayPairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayPairingsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayPairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReplayPairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *ReplayPairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ReplayPairingsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *ReplayPairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PairingDifference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PairingId     int32                  `protobuf:"varint,1,opt,name=pairing_id,json=pairingId,proto3" json:"pairing_id,omitempty"` // 0 when only the regenerated draw has the debate
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`                    // Place of the debate in the draw, from 1
+	ChangedFields []string               `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairingDifference) Reset() {
+	*x = PairingDifference{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairingDifference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingDifference) ProtoMessage() {}
+
+func (x *PairingDifference) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingDifference.ProtoReflect.Descriptor instead.
+func (*PairingDifference) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PairingDifference) GetPairingId() int32 {
+	if x != nil {
+		return x.PairingId
+	}
+	return 0
+}
+
+func (x *PairingDifference) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PairingDifference) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type ReplayPairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seed          int64                  `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Matches       bool                   `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"` // The regenerated draw is the saved draw
+	Saved         []*Pairing             `protobuf:"bytes,3,rep,name=saved,proto3" json:"saved,omitempty"`
+	Regenerated   []*Pairing             `protobuf:"bytes,4,rep,name=regenerated,proto3" json:"regenerated,omitempty"`
+	Differences   []*PairingDifference   `protobuf:"bytes,5,rep,name=differences,proto3" json:"differences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayPairingsResponse) Reset() {
+	*x = ReplayPairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayPairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayPairingsResponse) ProtoMessage() {}
+
+func (x *ReplayPairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayPairingsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayPairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReplayPairingsResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *ReplayPairingsResponse) GetMatches() bool {
+	if x != nil {
+		return x.Matches
+	}
+	return false
+}
+
+func (x *ReplayPairingsResponse) GetSaved() []*Pairing {
+	if x != nil {
+		return x.Saved
+	}
+	return nil
+}
+
+func (x *ReplayPairingsResponse) GetRegenerated() []*Pairing {
+	if x != nil {
+		return x.Regenerated
+	}
+	return nil
+}
+
+func (x *ReplayPairingsResponse) GetDifferences() []*PairingDifference {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+// Ballot messages
+type Ballot struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BallotId           int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	RoundNumber        int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination      bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	RoomId             int32                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName           string                 `protobuf:"bytes,5,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	Judges             []*Judge               `protobuf:"bytes,6,rep,name=judges,proto3" json:"judges,omitempty"`
+	Team1              *Team                  `protobuf:"bytes,7,opt,name=team1,proto3" json:"team1,omitempty"`
+	Team2              *Team                  `protobuf:"bytes,8,opt,name=team2,proto3" json:"team2,omitempty"`
+	RecordingStatus    string                 `protobuf:"bytes,9,opt,name=recording_status,json=recordingStatus,proto3" json:"recording_status,omitempty"`
+	Verdict            string                 `protobuf:"bytes,10,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	LastUpdatedBy      int32                  `protobuf:"varint,11,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	LastUpdatedAt      string                 `protobuf:"bytes,12,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
+	HeadJudgeSubmitted bool                   `protobuf:"varint,13,opt,name=head_judge_submitted,json=headJudgeSubmitted,proto3" json:"head_judge_submitted,omitempty"`
+	Team3              *Team                  `protobuf:"bytes,14,opt,name=team3,proto3" json:"team3,omitempty"`                             // Closing Government, British Parliamentary only
+	Team4              *Team                  `protobuf:"bytes,15,opt,name=team4,proto3" json:"team4,omitempty"`                             // Closing Opposition, British Parliamentary only
+	PanelSplit         string                 `protobuf:"bytes,16,opt,name=panel_split,json=panelSplit,proto3" json:"panel_split,omitempty"` // e.g. "2-1": judges for the decision against dissenting judges
+	DissentingJudges   []*Judge               `protobuf:"bytes,17,rep,name=dissenting_judges,json=dissentingJudges,proto3" json:"dissenting_judges,omitempty"`
+	PendingJudges      []*Judge               `protobuf:"bytes,18,rep,name=pending_judges,json=pendingJudges,proto3" json:"pending_judges,omitempty"` // panel judges who have not submitted their ballot yet
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Ballot) Reset() {
+	*x = Ballot{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ballot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ballot) ProtoMessage() {}
+
+func (x *Ballot) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ballot.ProtoReflect.Descriptor instead.
+func (*Ballot) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Ballot) GetBallotId() int32 {
+	if x != nil {
+		return x.BallotId
+	}
+	return 0
+}
+
+func (x *Ballot) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *Ballot) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *Ballot) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *Ballot) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *Ballot) GetJudges() []*Judge {
+	if x != nil {
+		return x.Judges
+	}
+	return nil
+}
+
+func (x *Ballot) GetTeam1() *Team {
+	if x != nil {
+		return x.Team1
+	}
+	return nil
+}
+
+func (x *Ballot) GetTeam2() *Team {
+	if x != nil {
+		return x.Team2
+	}
+	return nil
+}
+
+func (x *Ballot) GetRecordingStatus() string {
+	if x != nil {
+		return x.RecordingStatus
+	}
+	return ""
+}
+
+func (x *Ballot) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *Ballot) GetLastUpdatedBy() int32 {
+	if x != nil {
+		return x.LastUpdatedBy
+	}
+	return 0
+}
+
+func (x *Ballot) GetLastUpdatedAt() string {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return ""
+}
+
+func (x *Ballot) GetHeadJudgeSubmitted() bool {
+	if x != nil {
+		return x.HeadJudgeSubmitted
+	}
+	return false
+}
+
+func (x *Ballot) GetTeam3() *Team {
+	if x != nil {
+		return x.Team3
+	}
+	return nil
+}
+
+func (x *Ballot) GetTeam4() *Team {
+	if x != nil {
+		return x.Team4
+	}
+	return nil
+}
+
+func (x *Ballot) GetPanelSplit() string {
+	if x != nil {
+		return x.PanelSplit
+	}
+	return ""
+}
+
+func (x *Ballot) GetDissentingJudges() []*Judge {
+	if x != nil {
+		return x.DissentingJudges
+	}
+	return nil
+}
+
+func (x *Ballot) GetPendingJudges() []*Judge {
+	if x != nil {
+		return x.PendingJudges
+	}
+	return nil
+}
+
+type GetBallotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotsRequest) Reset() {
+	*x = GetBallotsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotsRequest) ProtoMessage() {}
+
+func (x *GetBallotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotsRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBallotsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetBallotsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GetBallotsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *GetBallotsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBallotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballots       []*Ballot              `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotsResponse) Reset() {
+	*x = GetBallotsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotsResponse) ProtoMessage() {}
+
+func (x *GetBallotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotsResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetBallotsResponse) GetBallots() []*Ballot {
+	if x != nil {
+		return x.Ballots
+	}
+	return nil
+}
+
+type GetBallotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BallotId      int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotRequest) Reset() {
+	*x = GetBallotRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotRequest) ProtoMessage() {}
+
+func (x *GetBallotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetBallotRequest) GetBallotId() int32 {
+	if x != nil {
+		return x.BallotId
+	}
+	return 0
+}
+
+func (x *GetBallotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBallotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotResponse) Reset() {
+	*x = GetBallotResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotResponse) ProtoMessage() {}
+
+func (x *GetBallotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetBallotResponse) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+type GetBallotByJudgeIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotByJudgeIDRequest) Reset() {
+	*x = GetBallotByJudgeIDRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotByJudgeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotByJudgeIDRequest) ProtoMessage() {}
+
+func (x *GetBallotByJudgeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotByJudgeIDRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotByJudgeIDRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetBallotByJudgeIDRequest) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *GetBallotByJudgeIDRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetBallotByJudgeIDRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBallotByJudgeIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotByJudgeIDResponse) Reset() {
+	*x = GetBallotByJudgeIDResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotByJudgeIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotByJudgeIDResponse) ProtoMessage() {}
+
+func (x *GetBallotByJudgeIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotByJudgeIDResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotByJudgeIDResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetBallotByJudgeIDResponse) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+type UpdateBallotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,3,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"` // admins only: the panel judge to submit for, defaults to the head judge
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                   // optional, kept in the ballot history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBallotRequest) Reset() {
+	*x = UpdateBallotRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBallotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBallotRequest) ProtoMessage() {}
+
+func (x *UpdateBallotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBallotRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBallotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateBallotRequest) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+func (x *UpdateBallotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateBallotRequest) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *UpdateBallotRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateBallotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	EntryStatus   string                 `protobuf:"bytes,2,opt,name=entry_status,json=entryStatus,proto3" json:"entry_status,omitempty"` // double entry only: "awaiting_confirmation", "conflict" or "confirmed"
+	Conflicts     []*BallotChange        `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`                        // double entry only: how this entry differs from another user's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBallotResponse) Reset() {
+	*x = UpdateBallotResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBallotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBallotResponse) ProtoMessage() {}
+
+func (x *UpdateBallotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBallotResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBallotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateBallotResponse) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+func (x *UpdateBallotResponse) GetEntryStatus() string {
+	if x != nil {
+		return x.EntryStatus
+	}
+	return ""
+}
+
+func (x *UpdateBallotResponse) GetConflicts() []*BallotChange {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// A judge's ballot as it was submitted, edited or reverted
+type BallotVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionNumber int32                  `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	JudgeName     string                 `protobuf:"bytes,3,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
+	CreatedBy     int32                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,5,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	RevertedFrom  int32                  `protobuf:"varint,8,opt,name=reverted_from,json=revertedFrom,proto3" json:"reverted_from,omitempty"` // the version this one restored, 0 if none
+	Ballot        *Ballot                `protobuf:"bytes,9,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	Changes       []*BallotChange        `protobuf:"bytes,10,rep,name=changes,proto3" json:"changes,omitempty"` // against the judge's previous version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BallotVersion) Reset() {
+	*x = BallotVersion{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BallotVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BallotVersion) ProtoMessage() {}
+
+func (x *BallotVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BallotVersion.ProtoReflect.Descriptor instead.
+func (*BallotVersion) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BallotVersion) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *BallotVersion) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *BallotVersion) GetJudgeName() string {
+	if x != nil {
+		return x.JudgeName
+	}
+	return ""
+}
+
+func (x *BallotVersion) GetCreatedBy() int32 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *BallotVersion) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *BallotVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BallotVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BallotVersion) GetRevertedFrom() int32 {
+	if x != nil {
+		return x.RevertedFrom
+	}
+	return 0
+}
+
+func (x *BallotVersion) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+func (x *BallotVersion) GetChanges() []*BallotChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type BallotChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                           // e.g. "verdict", "team1.total_points" or "speaker.points"
+	SpeakerId     int32                  `protobuf:"varint,2,opt,name=speaker_id,json=speakerId,proto3" json:"speaker_id,omitempty"` // set for speaker fields
+	Before        string                 `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // speaking position, set for speaker fields
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BallotChange) Reset() {
+	*x = BallotChange{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BallotChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BallotChange) ProtoMessage() {}
+
+func (x *BallotChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BallotChange.ProtoReflect.Descriptor instead.
+func (*BallotChange) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BallotChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *BallotChange) GetSpeakerId() int32 {
+	if x != nil {
+		return x.SpeakerId
+	}
+	return 0
+}
+
+func (x *BallotChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *BallotChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *BallotChange) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type GetBallotHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BallotId      int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotHistoryRequest) Reset() {
+	*x = GetBallotHistoryRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotHistoryRequest) ProtoMessage() {}
+
+func (x *GetBallotHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetBallotHistoryRequest) GetBallotId() int32 {
+	if x != nil {
+		return x.BallotId
+	}
+	return 0
+}
+
+func (x *GetBallotHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBallotHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*BallotVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotHistoryResponse) Reset() {
+	*x = GetBallotHistoryResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotHistoryResponse) ProtoMessage() {}
+
+func (x *GetBallotHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetBallotHistoryResponse) GetVersions() []*BallotVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RevertBallotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BallotId      int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	VersionNumber int32                  `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertBallotRequest) Reset() {
+	*x = RevertBallotRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertBallotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertBallotRequest) ProtoMessage() {}
+
+func (x *RevertBallotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertBallotRequest.ProtoReflect.Descriptor instead.
+func (*RevertBallotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RevertBallotRequest) GetBallotId() int32 {
+	if x != nil {
+		return x.BallotId
+	}
+	return 0
+}
+
+func (x *RevertBallotRequest) GetVersionNumber() int32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *RevertBallotRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RevertBallotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevertBallotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballot        *Ballot                `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertBallotResponse) Reset() {
+	*x = RevertBallotResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertBallotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertBallotResponse) ProtoMessage() {}
+
+func (x *RevertBallotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertBallotResponse.ProtoReflect.Descriptor instead.
+func (*RevertBallotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevertBallotResponse) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+type SetBallotEntryModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	DoubleEntry   bool                   `protobuf:"varint,2,opt,name=double_entry,json=doubleEntry,proto3" json:"double_entry,omitempty"` // ballots keyed in for a judge must be entered twice, by different users
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBallotEntryModeRequest) Reset() {
+	*x = SetBallotEntryModeRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBallotEntryModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBallotEntryModeRequest) ProtoMessage() {}
+
+func (x *SetBallotEntryModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBallotEntryModeRequest.ProtoReflect.Descriptor instead.
+func (*SetBallotEntryModeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetBallotEntryModeRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *SetBallotEntryModeRequest) GetDoubleEntry() bool {
+	if x != nil {
+		return x.DoubleEntry
+	}
+	return false
+}
+
+func (x *SetBallotEntryModeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SetBallotEntryModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBallotEntryModeResponse) Reset() {
+	*x = SetBallotEntryModeResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBallotEntryModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBallotEntryModeResponse) ProtoMessage() {}
+
+func (x *SetBallotEntryModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBallotEntryModeResponse.ProtoReflect.Descriptor instead.
+func (*SetBallotEntryModeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetBallotEntryModeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetBallotEntryModeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A judge's ballot keyed in by one user, waiting for a matching second entry
+type BallotEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	EnteredBy     int32                  `protobuf:"varint,2,opt,name=entered_by,json=enteredBy,proto3" json:"entered_by,omitempty"`
+	EnteredByName string                 `protobuf:"bytes,3,opt,name=entered_by_name,json=enteredByName,proto3" json:"entered_by_name,omitempty"`
+	EnteredAt     string                 `protobuf:"bytes,4,opt,name=entered_at,json=enteredAt,proto3" json:"entered_at,omitempty"`
+	Ballot        *Ballot                `protobuf:"bytes,5,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BallotEntry) Reset() {
+	*x = BallotEntry{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BallotEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BallotEntry) ProtoMessage() {}
+
+func (x *BallotEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BallotEntry.ProtoReflect.Descriptor instead.
+func (*BallotEntry) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BallotEntry) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *BallotEntry) GetEnteredBy() int32 {
+	if x != nil {
+		return x.EnteredBy
+	}
+	return 0
+}
+
+func (x *BallotEntry) GetEnteredByName() string {
+	if x != nil {
+		return x.EnteredByName
+	}
+	return ""
+}
+
+func (x *BallotEntry) GetEnteredAt() string {
+	if x != nil {
+		return x.EnteredAt
+	}
+	return ""
+}
+
+func (x *BallotEntry) GetBallot() *Ballot {
+	if x != nil {
+		return x.Ballot
+	}
+	return nil
+}
+
+// Entries of the same judge's ballot by different users that do not match.
+// Changes compare the two latest entries.
+type BallotConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BallotId      int32                  `protobuf:"varint,1,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	JudgeName     string                 `protobuf:"bytes,3,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
+	Entries       []*BallotEntry         `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	Changes       []*BallotChange        `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BallotConflict) Reset() {
+	*x = BallotConflict{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BallotConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BallotConflict) ProtoMessage() {}
+
+func (x *BallotConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BallotConflict.ProtoReflect.Descriptor instead.
+func (*BallotConflict) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BallotConflict) GetBallotId() int32 {
+	if x != nil {
+		return x.BallotId
+	}
+	return 0
+}
+
+func (x *BallotConflict) GetJudgeId() int32 {
+	if x != nil {
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *BallotConflict) GetJudgeName() string {
+	if x != nil {
+		return x.JudgeName
+	}
+	return ""
+}
+
+func (x *BallotConflict) GetEntries() []*BallotEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BallotConflict) GetChanges() []*BallotChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetBallotConflictsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,3,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotConflictsRequest) Reset() {
+	*x = GetBallotConflictsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotConflictsRequest) ProtoMessage() {}
+
+func (x *GetBallotConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotConflictsRequest.ProtoReflect.Descriptor instead.
+func (*GetBallotConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetBallotConflictsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetBallotConflictsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GetBallotConflictsRequest) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *GetBallotConflictsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBallotConflictsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*BallotConflict      `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBallotConflictsResponse) Reset() {
+	*x = GetBallotConflictsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBallotConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBallotConflictsResponse) ProtoMessage() {}
+
+func (x *GetBallotConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBallotConflictsResponse.ProtoReflect.Descriptor instead.
+func (*GetBallotConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetBallotConflictsResponse) GetConflicts() []*BallotConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// Algorithm integration messages
+type GeneratePreliminaryPairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"` // When set, only this round is generated, power-paired from the current standings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePreliminaryPairingsRequest) Reset() {
+	*x = GeneratePreliminaryPairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePreliminaryPairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePreliminaryPairingsRequest) ProtoMessage() {}
+
+func (x *GeneratePreliminaryPairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePreliminaryPairingsRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePreliminaryPairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GeneratePreliminaryPairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GeneratePreliminaryPairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GeneratePreliminaryPairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+type GenerateEliminationPairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	BreakSize     int32                  `protobuf:"varint,4,opt,name=break_size,json=breakSize,proto3" json:"break_size,omitempty"`      // Round 1 only: teams breaking, defaults to 2^elimination rounds
+	BracketMode   string                 `protobuf:"bytes,5,opt,name=bracket_mode,json=bracketMode,proto3" json:"bracket_mode,omitempty"` // Round 1 only: "byes" (default) or "partial" for partial double-octofinals
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`   // Required when the tournament has break categories
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateEliminationPairingsRequest) Reset() {
+	*x = GenerateEliminationPairingsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateEliminationPairingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEliminationPairingsRequest) ProtoMessage() {}
+
+func (x *GenerateEliminationPairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEliminationPairingsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateEliminationPairingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GenerateEliminationPairingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GenerateEliminationPairingsRequest) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GenerateEliminationPairingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateEliminationPairingsRequest) GetBreakSize() int32 {
+	if x != nil {
+		return x.BreakSize
+	}
+	return 0
+}
+
+func (x *GenerateEliminationPairingsRequest) GetBracketMode() string {
+	if x != nil {
+		return x.BracketMode
+	}
+	return ""
+}
+
+func (x *GenerateEliminationPairingsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GeneratePairingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairings      []*Pairing             `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	SideCounts    []*TeamSideCount       `protobuf:"bytes,2,rep,name=side_counts,json=sideCounts,proto3" json:"side_counts,omitempty"` // Preliminary proposition/opposition totals per team
+	Byes          []*Bye                 `protobuf:"bytes,3,rep,name=byes,proto3" json:"byes,omitempty"`                               // Every preliminary bye of the tournament so far
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePairingsResponse) Reset() {
+	*x = GeneratePairingsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePairingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePairingsResponse) ProtoMessage() {}
+
+func (x *GeneratePairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePairingsResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePairingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GeneratePairingsResponse) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *GeneratePairingsResponse) GetSideCounts() []*TeamSideCount {
+	if x != nil {
+		return x.SideCounts
+	}
+	return nil
+}
+
+func (x *GeneratePairingsResponse) GetByes() []*Bye {
+	if x != nil {
+		return x.Byes
+	}
+	return nil
+}
+
+type GetEliminationBracketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEliminationBracketRequest) Reset() {
+	*x = GetEliminationBracketRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEliminationBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEliminationBracketRequest) ProtoMessage() {}
+
+func (x *GetEliminationBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEliminationBracketRequest.ProtoReflect.Descriptor instead.
+func (*GetEliminationBracketRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetEliminationBracketRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetEliminationBracketRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetEliminationBracketRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GetEliminationBracketResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Nodes          []*BracketNode         `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`                                                                                                              // Ordered by category, round, then position
+	RootNodeId     int32                  `protobuf:"varint,2,opt,name=root_node_id,json=rootNodeId,proto3" json:"root_node_id,omitempty"`                                                                               // The final; 0 when several brackets are returned
+	NumberOfRounds int32                  `protobuf:"varint,3,opt,name=number_of_rounds,json=numberOfRounds,proto3" json:"number_of_rounds,omitempty"`                                                                   // Rounds of the longest bracket returned
+	RootNodeIds    map[int32]int32        `protobuf:"bytes,4,rep,name=root_node_ids,json=rootNodeIds,proto3" json:"root_node_ids,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // The final of each bracket, by category ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEliminationBracketResponse) Reset() {
+	*x = GetEliminationBracketResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEliminationBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEliminationBracketResponse) ProtoMessage() {}
+
+func (x *GetEliminationBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEliminationBracketResponse.ProtoReflect.Descriptor instead.
+func (*GetEliminationBracketResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetEliminationBracketResponse) GetNodes() []*BracketNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetEliminationBracketResponse) GetRootNodeId() int32 {
+	if x != nil {
+		return x.RootNodeId
+	}
+	return 0
+}
+
+func (x *GetEliminationBracketResponse) GetNumberOfRounds() int32 {
+	if x != nil {
+		return x.NumberOfRounds
+	}
+	return 0
+}
+
+func (x *GetEliminationBracketResponse) GetRootNodeIds() map[int32]int32 {
+	if x != nil {
+		return x.RootNodeIds
+	}
+	return nil
+}
+
+type BracketNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int32                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`                                      // Order within the round, top of the bracket first
+	ParentNodeId  int32                  `protobuf:"varint,4,opt,name=parent_node_id,json=parentNodeId,proto3" json:"parent_node_id,omitempty"`        // 0 for the final
+	ChildNodeIds  []int32                `protobuf:"varint,5,rep,packed,name=child_node_ids,json=childNodeIds,proto3" json:"child_node_ids,omitempty"` // Debates whose winners fill this one
+	Team1         *BracketTeam           `protobuf:"bytes,6,opt,name=team1,proto3" json:"team1,omitempty"`                                             // Unset until the team is known
+	Team2         *BracketTeam           `protobuf:"bytes,7,opt,name=team2,proto3" json:"team2,omitempty"`
+	DebateId      int32                  `protobuf:"varint,8,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"` // 0 until the round is paired
+	RoomId        int32                  `protobuf:"varint,9,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,10,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	WinnerTeamId  int32                  `protobuf:"varint,11,opt,name=winner_team_id,json=winnerTeamId,proto3" json:"winner_team_id,omitempty"` // 0 until the ballot is recorded
+	CategoryId    int32                  `protobuf:"varint,12,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`         // 0 without break categories
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketNode) Reset() {
+	*x = BracketNode{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketNode) ProtoMessage() {}
+
+func (x *BracketNode) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketNode.ProtoReflect.Descriptor instead.
+func (*BracketNode) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BracketNode) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *BracketNode) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *BracketNode) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BracketNode) GetParentNodeId() int32 {
+	if x != nil {
+		return x.ParentNodeId
+	}
+	return 0
+}
+
+func (x *BracketNode) GetChildNodeIds() []int32 {
+	if x != nil {
+		return x.ChildNodeIds
+	}
+	return nil
+}
+
+func (x *BracketNode) GetTeam1() *BracketTeam {
+	if x != nil {
+		return x.Team1
+	}
+	return nil
+}
+
+func (x *BracketNode) GetTeam2() *BracketTeam {
+	if x != nil {
+		return x.Team2
+	}
+	return nil
+}
+
+func (x *BracketNode) GetDebateId() int32 {
+	if x != nil {
+		return x.DebateId
+	}
+	return 0
+}
+
+func (x *BracketNode) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BracketNode) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *BracketNode) GetWinnerTeamId() int32 {
+	if x != nil {
+		return x.WinnerTeamId
+	}
+	return 0
+}
+
+func (x *BracketNode) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type BracketTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seed          int32                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketTeam) Reset() {
+	*x = BracketTeam{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketTeam) ProtoMessage() {}
+
+func (x *BracketTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketTeam.ProtoReflect.Descriptor instead.
+func (*BracketTeam) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *BracketTeam) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *BracketTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BracketTeam) GetSeed() int32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type BreakCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BreakSize     int32                  `protobuf:"varint,3,opt,name=break_size,json=breakSize,proto3" json:"break_size,omitempty"`
+	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`                    // 1 is the highest; a team breaking in several categories goes to the highest
+	IsGeneral     bool                   `protobuf:"varint,5,opt,name=is_general,json=isGeneral,proto3" json:"is_general,omitempty"` // Every team is eligible, e.g. the open break
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreakCategory) Reset() {
+	*x = BreakCategory{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakCategory) ProtoMessage() {}
+
+func (x *BreakCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakCategory.ProtoReflect.Descriptor instead.
+func (*BreakCategory) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *BreakCategory) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BreakCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BreakCategory) GetBreakSize() int32 {
+	if x != nil {
+		return x.BreakSize
+	}
+	return 0
+}
+
+func (x *BreakCategory) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BreakCategory) GetIsGeneral() bool {
+	if x != nil {
+		return x.IsGeneral
+	}
+	return false
+}
+
+type GetBreakCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBreakCategoriesRequest) Reset() {
+	*x = GetBreakCategoriesRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBreakCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBreakCategoriesRequest) ProtoMessage() {}
+
+func (x *GetBreakCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBreakCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetBreakCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetBreakCategoriesRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetBreakCategoriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBreakCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*BreakCategory       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBreakCategoriesResponse) Reset() {
+	*x = GetBreakCategoriesResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBreakCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBreakCategoriesResponse) ProtoMessage() {}
+
+func (x *GetBreakCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBreakCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetBreakCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetBreakCategoriesResponse) GetCategories() []*BreakCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type UpdateBreakCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Categories    []*BreakCategory       `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // Replaces the tournament's categories; category_id 0 creates one
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBreakCategoriesRequest) Reset() {
+	*x = UpdateBreakCategoriesRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBreakCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBreakCategoriesRequest) ProtoMessage() {}
+
+func (x *UpdateBreakCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBreakCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBreakCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateBreakCategoriesRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *UpdateBreakCategoriesRequest) GetCategories() []*BreakCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateBreakCategoriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateBreakCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*BreakCategory       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBreakCategoriesResponse) Reset() {
+	*x = UpdateBreakCategoriesResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBreakCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBreakCategoriesResponse) ProtoMessage() {}
+
+func (x *UpdateBreakCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBreakCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBreakCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateBreakCategoriesResponse) GetCategories() []*BreakCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type TeamSideCount struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TeamId                 int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName               string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PropositionCount       int32                  `protobuf:"varint,3,opt,name=proposition_count,json=propositionCount,proto3" json:"proposition_count,omitempty"` // Opening Government in British Parliamentary
+	OppositionCount        int32                  `protobuf:"varint,4,opt,name=opposition_count,json=oppositionCount,proto3" json:"opposition_count,omitempty"`    // Opening Opposition in British Parliamentary
+	ClosingGovernmentCount int32                  `protobuf:"varint,5,opt,name=closing_government_count,json=closingGovernmentCount,proto3" json:"closing_government_count,omitempty"`
+	ClosingOppositionCount int32                  `protobuf:"varint,6,opt,name=closing_opposition_count,json=closingOppositionCount,proto3" json:"closing_opposition_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TeamSideCount) Reset() {
+	*x = TeamSideCount{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSideCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSideCount) ProtoMessage() {}
+
+func (x *TeamSideCount) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSideCount.ProtoReflect.Descriptor instead.
+func (*TeamSideCount) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TeamSideCount) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamSideCount) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSideCount) GetPropositionCount() int32 {
+	if x != nil {
+		return x.PropositionCount
+	}
+	return 0
+}
+
+func (x *TeamSideCount) GetOppositionCount() int32 {
+	if x != nil {
+		return x.OppositionCount
+	}
+	return 0
+}
+
+func (x *TeamSideCount) GetClosingGovernmentCount() int32 {
+	if x != nil {
+		return x.ClosingGovernmentCount
+	}
+	return 0
+}
+
+func (x *TeamSideCount) GetClosingOppositionCount() int32 {
+	if x != nil {
+		return x.ClosingOppositionCount
+	}
+	return 0
+}
+
+type CreateTeamRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TournamentId        int32                  `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Speakers            []*Speaker             `protobuf:"bytes,3,rep,name=speakers,proto3" json:"speakers,omitempty"`
+	Token               string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	BreakCategoryIds    []int32                `protobuf:"varint,5,rep,packed,name=break_category_ids,json=breakCategoryIds,proto3" json:"break_category_ids,omitempty"`
+	NeedsAccessibleRoom bool                   `protobuf:"varint,6,opt,name=needs_accessible_room,json=needsAccessibleRoom,proto3" json:"needs_accessible_room,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *CreateTeamRequest) GetSpeakers() []*Speaker {
+	if x != nil {
+		return x.Speakers
+	}
+	return nil
+}
+
+func (x *CreateTeamRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetBreakCategoryIds() []int32 {
+	if x != nil {
+		return x.BreakCategoryIds
+	}
+	return nil
+}
+
+func (x *CreateTeamRequest) GetNeedsAccessibleRoom() bool {
+	if x != nil {
+		return x.NeedsAccessibleRoom
+	}
+	return false
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetTeamRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetTeamRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateTeamRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Team                  *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Token                 string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	UpdateBreakCategories bool                   `protobuf:"varint,3,opt,name=update_break_categories,json=updateBreakCategories,proto3" json:"update_break_categories,omitempty"` // Replace the team's and speakers' break categories
+	UpdateAccessibility   bool                   `protobuf:"varint,4,opt,name=update_accessibility,json=updateAccessibility,proto3" json:"update_accessibility,omitempty"`         // Replace whether the team needs an accessible room
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2441,46 +6672,1063 @@ func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{39}
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetUpdateBreakCategories() bool {
+	if x != nil {
+		return x.UpdateBreakCategories
+	}
+	return false
+}
+
+func (x *UpdateTeamRequest) GetUpdateAccessibility() bool {
+	if x != nil {
+		return x.UpdateAccessibility
+	}
+	return false
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteTeamRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *DeleteTeamRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTeamResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTeamsByTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByTournamentRequest) Reset() {
+	*x = GetTeamsByTournamentRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByTournamentRequest) ProtoMessage() {}
+
+func (x *GetTeamsByTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamsByTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetTeamsByTournamentRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GetTeamsByTournamentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetTeamsByTournamentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByTournamentResponse) Reset() {
+	*x = GetTeamsByTournamentResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByTournamentResponse) ProtoMessage() {}
+
+func (x *GetTeamsByTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByTournamentResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamsByTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetTeamsByTournamentResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type OverallRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverallRankingRequest) Reset() {
+	*x = OverallRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverallRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverallRankingRequest) ProtoMessage() {}
+
+func (x *OverallRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverallRankingRequest.ProtoReflect.Descriptor instead.
+func (*OverallRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *OverallRankingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OverallRankingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type OverallRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentRank   int32                  `protobuf:"varint,1,opt,name=student_rank,json=studentRank,proto3" json:"student_rank,omitempty"`
+	TotalStudents int32                  `protobuf:"varint,2,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	RankChange    int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"` // Positive for improvement, negative for decline
+	TopStudents   []*TopStudent          `protobuf:"bytes,4,rep,name=top_students,json=topStudents,proto3" json:"top_students,omitempty"`
+	StudentInfo   *StudentInfo           `protobuf:"bytes,5,opt,name=student_info,json=studentInfo,proto3" json:"student_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverallRankingResponse) Reset() {
+	*x = OverallRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverallRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverallRankingResponse) ProtoMessage() {}
+
+func (x *OverallRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverallRankingResponse.ProtoReflect.Descriptor instead.
+func (*OverallRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *OverallRankingResponse) GetStudentRank() int32 {
+	if x != nil {
+		return x.StudentRank
+	}
+	return 0
+}
+
+func (x *OverallRankingResponse) GetTotalStudents() int32 {
+	if x != nil {
+		return x.TotalStudents
+	}
+	return 0
+}
+
+func (x *OverallRankingResponse) GetRankChange() int32 {
+	if x != nil {
+		return x.RankChange
+	}
+	return 0
+}
+
+func (x *OverallRankingResponse) GetTopStudents() []*TopStudent {
+	if x != nil {
+		return x.TopStudents
+	}
+	return nil
+}
+
+func (x *OverallRankingResponse) GetStudentInfo() *StudentInfo {
+	if x != nil {
+		return x.StudentInfo
+	}
+	return nil
+}
+
+type TopStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalPoints   float64                `protobuf:"fixed64,3,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	RankChange    int32                  `protobuf:"varint,4,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopStudent) Reset() {
+	*x = TopStudent{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopStudent) ProtoMessage() {}
+
+func (x *TopStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopStudent.ProtoReflect.Descriptor instead.
+func (*TopStudent) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TopStudent) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TopStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopStudent) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *TopStudent) GetRankChange() int32 {
+	if x != nil {
+		return x.RankChange
+	}
+	return 0
+}
+
+type StudentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TotalPoints   float64                `protobuf:"fixed64,2,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentInfo) Reset() {
+	*x = StudentInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentInfo) ProtoMessage() {}
+
+func (x *StudentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentInfo.ProtoReflect.Descriptor instead.
+func (*StudentInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StudentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudentInfo) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+type PerformanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformanceRequest) Reset() {
+	*x = PerformanceRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceRequest) ProtoMessage() {}
+
+func (x *PerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceRequest.ProtoReflect.Descriptor instead.
+func (*PerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PerformanceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PerformanceRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PerformanceRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PerformanceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PerformanceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PerformanceData []*PerformanceData     `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PerformanceResponse) Reset() {
+	*x = PerformanceResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceResponse) ProtoMessage() {}
+
+func (x *PerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceResponse.ProtoReflect.Descriptor instead.
+func (*PerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PerformanceResponse) GetPerformanceData() []*PerformanceData {
+	if x != nil {
+		return x.PerformanceData
+	}
+	return nil
+}
+
+type PerformanceData struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TournamentDate       string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
+	StudentTotalPoints   float64                `protobuf:"fixed64,2,opt,name=student_total_points,json=studentTotalPoints,proto3" json:"student_total_points,omitempty"`
+	StudentAveragePoints float64                `protobuf:"fixed64,3,opt,name=student_average_points,json=studentAveragePoints,proto3" json:"student_average_points,omitempty"`
+	TournamentRank       int32                  `protobuf:"varint,6,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PerformanceData) Reset() {
+	*x = PerformanceData{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceData) ProtoMessage() {}
+
+func (x *PerformanceData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceData.ProtoReflect.Descriptor instead.
+func (*PerformanceData) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PerformanceData) GetTournamentDate() string {
+	if x != nil {
+		return x.TournamentDate
+	}
+	return ""
+}
+
+func (x *PerformanceData) GetStudentTotalPoints() float64 {
+	if x != nil {
+		return x.StudentTotalPoints
+	}
+	return 0
+}
+
+func (x *PerformanceData) GetStudentAveragePoints() float64 {
+	if x != nil {
+		return x.StudentAveragePoints
+	}
+	return 0
+}
+
+func (x *PerformanceData) GetTournamentRank() int32 {
+	if x != nil {
+		return x.TournamentRank
+	}
+	return 0
+}
+
+type TournamentRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Only rank those eligible for this break category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRankingRequest) Reset() {
+	*x = TournamentRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRankingRequest) ProtoMessage() {}
+
+func (x *TournamentRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRankingRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *TournamentRankingRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *TournamentRankingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TournamentRankingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TournamentRankingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TournamentRankingRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *TournamentRankingRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type TournamentRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rankings      []*StudentRanking      `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRankingResponse) Reset() {
+	*x = TournamentRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRankingResponse) ProtoMessage() {}
+
+func (x *TournamentRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRankingResponse.ProtoReflect.Descriptor instead.
+func (*TournamentRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *TournamentRankingResponse) GetRankings() []*StudentRanking {
+	if x != nil {
+		return x.Rankings
+	}
+	return nil
+}
+
+type StudentRanking struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	SchoolName  string                 `protobuf:"bytes,3,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	TotalWins   int32                  `protobuf:"varint,4,opt,name=total_wins,json=totalWins,proto3" json:"total_wins,omitempty"`
+	TotalPoints float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	AverageRank float64                `protobuf:"fixed64,6,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
+	Place       int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
+	// The tiebreak that separated the student from the neighbouring places,
+	// empty when the student shares their place
+	DecidedBy     string `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentRanking) Reset() {
+	*x = StudentRanking{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentRanking) ProtoMessage() {}
+
+func (x *StudentRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentRanking.ProtoReflect.Descriptor instead.
+func (*StudentRanking) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *StudentRanking) GetStudentId() int32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *StudentRanking) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentRanking) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *StudentRanking) GetTotalWins() int32 {
+	if x != nil {
+		return x.TotalWins
+	}
+	return 0
+}
+
+func (x *StudentRanking) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *StudentRanking) GetAverageRank() float64 {
+	if x != nil {
+		return x.AverageRank
+	}
+	return 0
+}
+
+func (x *StudentRanking) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *StudentRanking) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+type TournamentTeamsRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Only rank those eligible for this break category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentTeamsRankingRequest) Reset() {
+	*x = TournamentTeamsRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTeamsRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTeamsRankingRequest) ProtoMessage() {}
+
+func (x *TournamentTeamsRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTeamsRankingRequest.ProtoReflect.Descriptor instead.
+func (*TournamentTeamsRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *TournamentTeamsRankingRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *TournamentTeamsRankingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TournamentTeamsRankingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TournamentTeamsRankingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TournamentTeamsRankingRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *TournamentTeamsRankingRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type TournamentTeamsRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rankings      []*TeamRanking         `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentTeamsRankingResponse) Reset() {
+	*x = TournamentTeamsRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTeamsRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTeamsRankingResponse) ProtoMessage() {}
+
+func (x *TournamentTeamsRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTeamRequest) GetTeam() *Team {
+// Deprecated: Use TournamentTeamsRankingResponse.ProtoReflect.Descriptor instead.
+func (*TournamentTeamsRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TournamentTeamsRankingResponse) GetRankings() []*TeamRanking {
 	if x != nil {
-		return x.Team
+		return x.Rankings
 	}
 	return nil
 }
 
-func (x *UpdateTeamRequest) GetToken() string {
+func (x *TournamentTeamsRankingResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Token
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type DeleteTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+type TeamRanking struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TeamId      int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName    string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	SchoolNames []string               `protobuf:"bytes,3,rep,name=school_names,json=schoolNames,proto3" json:"school_names,omitempty"`
+	Wins        int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	TotalPoints float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	AverageRank float64                `protobuf:"fixed64,6,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
+	Place       int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
+	TeamPoints  int32                  `protobuf:"varint,8,opt,name=team_points,json=teamPoints,proto3" json:"team_points,omitempty"`
+	// The tiebreak that separated the team from the neighbouring places,
+	// empty when the team shares its place
+	DecidedBy     string `protobuf:"bytes,9,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeamRequest) Reset() {
-	*x = DeleteTeamRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[40]
+func (x *TeamRanking) Reset() {
+	*x = TeamRanking{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamRequest) String() string {
+func (x *TeamRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamRequest) ProtoMessage() {}
+func (*TeamRanking) ProtoMessage() {}
 
-func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[40]
+func (x *TeamRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,48 +7739,100 @@ func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TeamRanking.ProtoReflect.Descriptor instead.
+func (*TeamRanking) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DeleteTeamRequest) GetTeamId() int32 {
+func (x *TeamRanking) GetTeamId() int32 {
 	if x != nil {
 		return x.TeamId
 	}
 	return 0
 }
 
-func (x *DeleteTeamRequest) GetToken() string {
+func (x *TeamRanking) GetTeamName() string {
 	if x != nil {
-		return x.Token
+		return x.TeamName
 	}
 	return ""
 }
 
-type DeleteTeamResponse struct {
+func (x *TeamRanking) GetSchoolNames() []string {
+	if x != nil {
+		return x.SchoolNames
+	}
+	return nil
+}
+
+func (x *TeamRanking) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TeamRanking) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *TeamRanking) GetAverageRank() float64 {
+	if x != nil {
+		return x.AverageRank
+	}
+	return 0
+}
+
+func (x *TeamRanking) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *TeamRanking) GetTeamPoints() int32 {
+	if x != nil {
+		return x.TeamPoints
+	}
+	return 0
+}
+
+func (x *TeamRanking) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+type TournamentSchoolRankingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeamResponse) Reset() {
-	*x = DeleteTeamResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[41]
+func (x *TournamentSchoolRankingRequest) Reset() {
+	*x = TournamentSchoolRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamResponse) String() string {
+func (x *TournamentSchoolRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamResponse) ProtoMessage() {}
+func (*TournamentSchoolRankingRequest) ProtoMessage() {}
 
-func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[41]
+func (x *TournamentSchoolRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,48 +7843,69 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use TournamentSchoolRankingRequest.ProtoReflect.Descriptor instead.
+func (*TournamentSchoolRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *DeleteTeamResponse) GetSuccess() bool {
+func (x *TournamentSchoolRankingRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.Success
+		return x.TournamentId
 	}
-	return false
+	return 0
 }
 
-func (x *DeleteTeamResponse) GetMessage() string {
+func (x *TournamentSchoolRankingRequest) GetToken() string {
 	if x != nil {
-		return x.Message
+		return x.Token
 	}
 	return ""
 }
 
-type GetTeamsByTournamentRequest struct {
+func (x *TournamentSchoolRankingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TournamentSchoolRankingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TournamentSchoolRankingRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type TournamentSchoolRankingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Rankings      []*SchoolRanking       `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByTournamentRequest) Reset() {
-	*x = GetTeamsByTournamentRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[42]
+func (x *TournamentSchoolRankingResponse) Reset() {
+	*x = TournamentSchoolRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByTournamentRequest) String() string {
+func (x *TournamentSchoolRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByTournamentRequest) ProtoMessage() {}
+func (*TournamentSchoolRankingResponse) ProtoMessage() {}
 
-func (x *GetTeamsByTournamentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[42]
+func (x *TournamentSchoolRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,47 +7916,52 @@ func (x *GetTeamsByTournamentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByTournamentRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamsByTournamentRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use TournamentSchoolRankingResponse.ProtoReflect.Descriptor instead.
+func (*TournamentSchoolRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetTeamsByTournamentRequest) GetTournamentId() int32 {
+func (x *TournamentSchoolRankingResponse) GetRankings() []*SchoolRanking {
 	if x != nil {
-		return x.TournamentId
+		return x.Rankings
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTeamsByTournamentRequest) GetToken() string {
+func (x *TournamentSchoolRankingResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Token
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type GetTeamsByTournamentResponse struct {
+type SchoolRanking struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	SchoolName    string                 `protobuf:"bytes,1,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	TeamCount     int32                  `protobuf:"varint,2,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
+	TotalWins     int32                  `protobuf:"varint,3,opt,name=total_wins,json=totalWins,proto3" json:"total_wins,omitempty"`
+	AverageRank   float64                `protobuf:"fixed64,4,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
+	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	Place         int32                  `protobuf:"varint,6,opt,name=place,proto3" json:"place,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByTournamentResponse) Reset() {
-	*x = GetTeamsByTournamentResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[43]
+func (x *SchoolRanking) Reset() {
+	*x = SchoolRanking{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByTournamentResponse) String() string {
+func (x *SchoolRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByTournamentResponse) ProtoMessage() {}
+func (*SchoolRanking) ProtoMessage() {}
 
-func (x *GetTeamsByTournamentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[43]
+func (x *SchoolRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2646,19 +7972,54 @@ func (x *GetTeamsByTournamentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByTournamentResponse.ProtoReflect.Descriptor instead.
-func (*GetTeamsByTournamentResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SchoolRanking.ProtoReflect.Descriptor instead.
+func (*SchoolRanking) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetTeamsByTournamentResponse) GetTeams() []*Team {
+func (x *SchoolRanking) GetSchoolName() string {
 	if x != nil {
-		return x.Teams
+		return x.SchoolName
 	}
-	return nil
+	return ""
 }
 
-type OverallRankingRequest struct {
+func (x *SchoolRanking) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *SchoolRanking) GetTotalWins() int32 {
+	if x != nil {
+		return x.TotalWins
+	}
+	return 0
+}
+
+func (x *SchoolRanking) GetAverageRank() float64 {
+	if x != nil {
+		return x.AverageRank
+	}
+	return 0
+}
+
+func (x *SchoolRanking) GetTotalPoints() float64 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *SchoolRanking) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+type OverallSchoolRankingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -2666,21 +8027,21 @@ type OverallRankingRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverallRankingRequest) Reset() {
-	*x = OverallRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[44]
+func (x *OverallSchoolRankingRequest) Reset() {
+	*x = OverallSchoolRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverallRankingRequest) String() string {
+func (x *OverallSchoolRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverallRankingRequest) ProtoMessage() {}
+func (*OverallSchoolRankingRequest) ProtoMessage() {}
 
-func (x *OverallRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[44]
+func (x *OverallSchoolRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,51 +8052,51 @@ func (x *OverallRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverallRankingRequest.ProtoReflect.Descriptor instead.
-func (*OverallRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use OverallSchoolRankingRequest.ProtoReflect.Descriptor instead.
+func (*OverallSchoolRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *OverallRankingRequest) GetUserId() int32 {
+func (x *OverallSchoolRankingRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *OverallRankingRequest) GetToken() string {
+func (x *OverallSchoolRankingRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type OverallRankingResponse struct {
+type OverallSchoolRankingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentRank   int32                  `protobuf:"varint,1,opt,name=student_rank,json=studentRank,proto3" json:"student_rank,omitempty"`
-	TotalStudents int32                  `protobuf:"varint,2,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
-	RankChange    int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"` // Positive for improvement, negative for decline
-	TopStudents   []*TopStudent          `protobuf:"bytes,4,rep,name=top_students,json=topStudents,proto3" json:"top_students,omitempty"`
-	StudentInfo   *StudentInfo           `protobuf:"bytes,5,opt,name=student_info,json=studentInfo,proto3" json:"student_info,omitempty"`
+	SchoolRank    int32                  `protobuf:"varint,1,opt,name=school_rank,json=schoolRank,proto3" json:"school_rank,omitempty"`
+	TotalSchools  int32                  `protobuf:"varint,2,opt,name=total_schools,json=totalSchools,proto3" json:"total_schools,omitempty"`
+	RankChange    int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	TopSchools    []*TopSchool           `protobuf:"bytes,4,rep,name=top_schools,json=topSchools,proto3" json:"top_schools,omitempty"`
+	SchoolInfo    *SchoolInfo            `protobuf:"bytes,5,opt,name=school_info,json=schoolInfo,proto3" json:"school_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverallRankingResponse) Reset() {
-	*x = OverallRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[45]
+func (x *OverallSchoolRankingResponse) Reset() {
+	*x = OverallSchoolRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverallRankingResponse) String() string {
+func (x *OverallSchoolRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverallRankingResponse) ProtoMessage() {}
+func (*OverallSchoolRankingResponse) ProtoMessage() {}
 
-func (x *OverallRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[45]
+func (x *OverallSchoolRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,47 +8107,47 @@ func (x *OverallRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverallRankingResponse.ProtoReflect.Descriptor instead.
-func (*OverallRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use OverallSchoolRankingResponse.ProtoReflect.Descriptor instead.
+func (*OverallSchoolRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *OverallRankingResponse) GetStudentRank() int32 {
+func (x *OverallSchoolRankingResponse) GetSchoolRank() int32 {
 	if x != nil {
-		return x.StudentRank
+		return x.SchoolRank
 	}
 	return 0
 }
 
-func (x *OverallRankingResponse) GetTotalStudents() int32 {
+func (x *OverallSchoolRankingResponse) GetTotalSchools() int32 {
 	if x != nil {
-		return x.TotalStudents
+		return x.TotalSchools
 	}
 	return 0
 }
 
-func (x *OverallRankingResponse) GetRankChange() int32 {
+func (x *OverallSchoolRankingResponse) GetRankChange() int32 {
 	if x != nil {
 		return x.RankChange
 	}
 	return 0
 }
 
-func (x *OverallRankingResponse) GetTopStudents() []*TopStudent {
+func (x *OverallSchoolRankingResponse) GetTopSchools() []*TopSchool {
 	if x != nil {
-		return x.TopStudents
+		return x.TopSchools
 	}
 	return nil
 }
 
-func (x *OverallRankingResponse) GetStudentInfo() *StudentInfo {
+func (x *OverallSchoolRankingResponse) GetSchoolInfo() *SchoolInfo {
 	if x != nil {
-		return x.StudentInfo
+		return x.SchoolInfo
 	}
 	return nil
 }
 
-type TopStudent struct {
+type TopSchool struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2796,21 +8157,21 @@ type TopStudent struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopStudent) Reset() {
-	*x = TopStudent{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[46]
+func (x *TopSchool) Reset() {
+	*x = TopSchool{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopStudent) String() string {
+func (x *TopSchool) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopStudent) ProtoMessage() {}
+func (*TopSchool) ProtoMessage() {}
 
-func (x *TopStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[46]
+func (x *TopSchool) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2821,40 +8182,40 @@ func (x *TopStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopStudent.ProtoReflect.Descriptor instead.
-func (*TopStudent) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use TopSchool.ProtoReflect.Descriptor instead.
+func (*TopSchool) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *TopStudent) GetRank() int32 {
+func (x *TopSchool) GetRank() int32 {
 	if x != nil {
 		return x.Rank
 	}
 	return 0
 }
 
-func (x *TopStudent) GetName() string {
+func (x *TopSchool) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *TopStudent) GetTotalPoints() float64 {
+func (x *TopSchool) GetTotalPoints() float64 {
 	if x != nil {
 		return x.TotalPoints
 	}
 	return 0
 }
 
-func (x *TopStudent) GetRankChange() int32 {
+func (x *TopSchool) GetRankChange() int32 {
 	if x != nil {
 		return x.RankChange
 	}
 	return 0
 }
 
-type StudentInfo struct {
+type SchoolInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	TotalPoints   float64                `protobuf:"fixed64,2,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
@@ -2862,21 +8223,21 @@ type StudentInfo struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentInfo) Reset() {
-	*x = StudentInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[47]
+func (x *SchoolInfo) Reset() {
+	*x = SchoolInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentInfo) String() string {
+func (x *SchoolInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentInfo) ProtoMessage() {}
+func (*SchoolInfo) ProtoMessage() {}
 
-func (x *StudentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[47]
+func (x *SchoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,26 +8248,26 @@ func (x *StudentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentInfo.ProtoReflect.Descriptor instead.
-func (*StudentInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SchoolInfo.ProtoReflect.Descriptor instead.
+func (*SchoolInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *StudentInfo) GetName() string {
+func (x *SchoolInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *StudentInfo) GetTotalPoints() float64 {
+func (x *SchoolInfo) GetTotalPoints() float64 {
 	if x != nil {
 		return x.TotalPoints
 	}
 	return 0
 }
 
-type PerformanceRequest struct {
+type SchoolPerformanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
@@ -2916,21 +8277,21 @@ type PerformanceRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PerformanceRequest) Reset() {
-	*x = PerformanceRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[48]
+func (x *SchoolPerformanceRequest) Reset() {
+	*x = SchoolPerformanceRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PerformanceRequest) String() string {
+func (x *SchoolPerformanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerformanceRequest) ProtoMessage() {}
+func (*SchoolPerformanceRequest) ProtoMessage() {}
 
-func (x *PerformanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[48]
+func (x *SchoolPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2941,61 +8302,61 @@ func (x *PerformanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerformanceRequest.ProtoReflect.Descriptor instead.
-func (*PerformanceRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SchoolPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*SchoolPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *PerformanceRequest) GetUserId() int32 {
+func (x *SchoolPerformanceRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *PerformanceRequest) GetStartDate() string {
+func (x *SchoolPerformanceRequest) GetStartDate() string {
 	if x != nil {
 		return x.StartDate
 	}
 	return ""
 }
 
-func (x *PerformanceRequest) GetEndDate() string {
+func (x *SchoolPerformanceRequest) GetEndDate() string {
 	if x != nil {
 		return x.EndDate
 	}
 	return ""
 }
 
-func (x *PerformanceRequest) GetToken() string {
+func (x *SchoolPerformanceRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type PerformanceResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PerformanceData []*PerformanceData     `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
+type SchoolPerformanceResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	PerformanceData []*SchoolPerformanceData `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PerformanceResponse) Reset() {
-	*x = PerformanceResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[49]
+func (x *SchoolPerformanceResponse) Reset() {
+	*x = SchoolPerformanceResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PerformanceResponse) String() string {
+func (x *SchoolPerformanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerformanceResponse) ProtoMessage() {}
+func (*SchoolPerformanceResponse) ProtoMessage() {}
 
-func (x *PerformanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[49]
+func (x *SchoolPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,43 +8367,109 @@ func (x *PerformanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerformanceResponse.ProtoReflect.Descriptor instead.
-func (*PerformanceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SchoolPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*SchoolPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *PerformanceResponse) GetPerformanceData() []*PerformanceData {
+func (x *SchoolPerformanceResponse) GetPerformanceData() []*SchoolPerformanceData {
 	if x != nil {
 		return x.PerformanceData
 	}
 	return nil
 }
 
-type PerformanceData struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TournamentDate       string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
-	StudentTotalPoints   float64                `protobuf:"fixed64,2,opt,name=student_total_points,json=studentTotalPoints,proto3" json:"student_total_points,omitempty"`
-	StudentAveragePoints float64                `protobuf:"fixed64,3,opt,name=student_average_points,json=studentAveragePoints,proto3" json:"student_average_points,omitempty"`
-	TournamentRank       int32                  `protobuf:"varint,6,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type SchoolPerformanceData struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TournamentDate      string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
+	SchoolTotalPoints   float64                `protobuf:"fixed64,2,opt,name=school_total_points,json=schoolTotalPoints,proto3" json:"school_total_points,omitempty"`
+	SchoolAveragePoints float64                `protobuf:"fixed64,3,opt,name=school_average_points,json=schoolAveragePoints,proto3" json:"school_average_points,omitempty"`
+	TournamentRank      int32                  `protobuf:"varint,4,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *PerformanceData) Reset() {
-	*x = PerformanceData{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[50]
+func (x *SchoolPerformanceData) Reset() {
+	*x = SchoolPerformanceData{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PerformanceData) String() string {
+func (x *SchoolPerformanceData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerformanceData) ProtoMessage() {}
+func (*SchoolPerformanceData) ProtoMessage() {}
 
-func (x *PerformanceData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[50]
+func (x *SchoolPerformanceData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchoolPerformanceData.ProtoReflect.Descriptor instead.
+func (*SchoolPerformanceData) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SchoolPerformanceData) GetTournamentDate() string {
+	if x != nil {
+		return x.TournamentDate
+	}
+	return ""
+}
+
+func (x *SchoolPerformanceData) GetSchoolTotalPoints() float64 {
+	if x != nil {
+		return x.SchoolTotalPoints
+	}
+	return 0
+}
+
+func (x *SchoolPerformanceData) GetSchoolAveragePoints() float64 {
+	if x != nil {
+		return x.SchoolAveragePoints
+	}
+	return 0
+}
+
+func (x *SchoolPerformanceData) GetTournamentRank() int32 {
+	if x != nil {
+		return x.TournamentRank
+	}
+	return 0
+}
+
+type StudentTournamentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentTournamentStatsRequest) Reset() {
+	*x = StudentTournamentStatsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentTournamentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentTournamentStatsRequest) ProtoMessage() {}
+
+func (x *StudentTournamentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3053,65 +8480,52 @@ func (x *PerformanceData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerformanceData.ProtoReflect.Descriptor instead.
-func (*PerformanceData) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *PerformanceData) GetTournamentDate() string {
-	if x != nil {
-		return x.TournamentDate
-	}
-	return ""
-}
-
-func (x *PerformanceData) GetStudentTotalPoints() float64 {
-	if x != nil {
-		return x.StudentTotalPoints
-	}
-	return 0
+// Deprecated: Use StudentTournamentStatsRequest.ProtoReflect.Descriptor instead.
+func (*StudentTournamentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *PerformanceData) GetStudentAveragePoints() float64 {
+func (x *StudentTournamentStatsRequest) GetStudentId() int32 {
 	if x != nil {
-		return x.StudentAveragePoints
+		return x.StudentId
 	}
 	return 0
 }
 
-func (x *PerformanceData) GetTournamentRank() int32 {
+func (x *StudentTournamentStatsRequest) GetToken() string {
 	if x != nil {
-		return x.TournamentRank
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type TournamentRankingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StudentTournamentStatsResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TotalTournaments          int32                  `protobuf:"varint,1,opt,name=total_tournaments,json=totalTournaments,proto3" json:"total_tournaments,omitempty"`
+	TotalTournamentsChange    string                 `protobuf:"bytes,2,opt,name=total_tournaments_change,json=totalTournamentsChange,proto3" json:"total_tournaments_change,omitempty"`
+	AttendedTournaments       int32                  `protobuf:"varint,3,opt,name=attended_tournaments,json=attendedTournaments,proto3" json:"attended_tournaments,omitempty"`
+	AttendedTournamentsChange string                 `protobuf:"bytes,4,opt,name=attended_tournaments_change,json=attendedTournamentsChange,proto3" json:"attended_tournaments_change,omitempty"`
+	UpcomingTournaments       int32                  `protobuf:"varint,5,opt,name=upcoming_tournaments,json=upcomingTournaments,proto3" json:"upcoming_tournaments,omitempty"`
+	UpcomingTournamentsChange string                 `protobuf:"bytes,6,opt,name=upcoming_tournaments_change,json=upcomingTournamentsChange,proto3" json:"upcoming_tournaments_change,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *TournamentRankingRequest) Reset() {
-	*x = TournamentRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[51]
+func (x *StudentTournamentStatsResponse) Reset() {
+	*x = StudentTournamentStatsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentRankingRequest) String() string {
+func (x *StudentTournamentStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentRankingRequest) ProtoMessage() {}
+func (*StudentTournamentStatsResponse) ProtoMessage() {}
 
-func (x *TournamentRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[51]
+func (x *StudentTournamentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,68 +8536,75 @@ func (x *TournamentRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentRankingRequest.ProtoReflect.Descriptor instead.
-func (*TournamentRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use StudentTournamentStatsResponse.ProtoReflect.Descriptor instead.
+func (*StudentTournamentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *TournamentRankingRequest) GetTournamentId() int32 {
+func (x *StudentTournamentStatsResponse) GetTotalTournaments() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.TotalTournaments
 	}
 	return 0
 }
 
-func (x *TournamentRankingRequest) GetToken() string {
+func (x *StudentTournamentStatsResponse) GetTotalTournamentsChange() string {
 	if x != nil {
-		return x.Token
+		return x.TotalTournamentsChange
 	}
 	return ""
 }
 
-func (x *TournamentRankingRequest) GetPage() int32 {
+func (x *StudentTournamentStatsResponse) GetAttendedTournaments() int32 {
 	if x != nil {
-		return x.Page
+		return x.AttendedTournaments
 	}
 	return 0
 }
 
-func (x *TournamentRankingRequest) GetPageSize() int32 {
+func (x *StudentTournamentStatsResponse) GetAttendedTournamentsChange() string {
 	if x != nil {
-		return x.PageSize
+		return x.AttendedTournamentsChange
+	}
+	return ""
+}
+
+func (x *StudentTournamentStatsResponse) GetUpcomingTournaments() int32 {
+	if x != nil {
+		return x.UpcomingTournaments
 	}
 	return 0
 }
 
-func (x *TournamentRankingRequest) GetSearch() string {
+func (x *StudentTournamentStatsResponse) GetUpcomingTournamentsChange() string {
 	if x != nil {
-		return x.Search
+		return x.UpcomingTournamentsChange
 	}
 	return ""
 }
 
-type TournamentRankingResponse struct {
+type VolunteerTournamentStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rankings      []*StudentRanking      `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentRankingResponse) Reset() {
-	*x = TournamentRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[52]
+func (x *VolunteerTournamentStatsRequest) Reset() {
+	*x = VolunteerTournamentStatsRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentRankingResponse) String() string {
+func (x *VolunteerTournamentStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentRankingResponse) ProtoMessage() {}
+func (*VolunteerTournamentStatsRequest) ProtoMessage() {}
 
-func (x *TournamentRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[52]
+func (x *VolunteerTournamentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3194,46 +8615,45 @@ func (x *TournamentRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentRankingResponse.ProtoReflect.Descriptor instead.
-func (*TournamentRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use VolunteerTournamentStatsRequest.ProtoReflect.Descriptor instead.
+func (*VolunteerTournamentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *TournamentRankingResponse) GetRankings() []*StudentRanking {
+func (x *VolunteerTournamentStatsRequest) GetToken() string {
 	if x != nil {
-		return x.Rankings
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type StudentRanking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	StudentName   string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
-	SchoolName    string                 `protobuf:"bytes,3,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	TotalWins     int32                  `protobuf:"varint,4,opt,name=total_wins,json=totalWins,proto3" json:"total_wins,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	AverageRank   float64                `protobuf:"fixed64,6,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
-	Place         int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type VolunteerTournamentStatsResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TotalRoundsJudged         int32                  `protobuf:"varint,1,opt,name=total_rounds_judged,json=totalRoundsJudged,proto3" json:"total_rounds_judged,omitempty"`
+	RoundsJudgedChange        string                 `protobuf:"bytes,2,opt,name=rounds_judged_change,json=roundsJudgedChange,proto3" json:"rounds_judged_change,omitempty"`
+	TournamentsAttended       int32                  `protobuf:"varint,3,opt,name=tournaments_attended,json=tournamentsAttended,proto3" json:"tournaments_attended,omitempty"`
+	TournamentsAttendedChange string                 `protobuf:"bytes,4,opt,name=tournaments_attended_change,json=tournamentsAttendedChange,proto3" json:"tournaments_attended_change,omitempty"`
+	UpcomingTournaments       int32                  `protobuf:"varint,5,opt,name=upcoming_tournaments,json=upcomingTournaments,proto3" json:"upcoming_tournaments,omitempty"`
+	UpcomingTournamentsChange string                 `protobuf:"bytes,6,opt,name=upcoming_tournaments_change,json=upcomingTournamentsChange,proto3" json:"upcoming_tournaments_change,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *StudentRanking) Reset() {
-	*x = StudentRanking{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[53]
+func (x *VolunteerTournamentStatsResponse) Reset() {
+	*x = VolunteerTournamentStatsResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentRanking) String() string {
+func (x *VolunteerTournamentStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentRanking) ProtoMessage() {}
+func (*VolunteerTournamentStatsResponse) ProtoMessage() {}
 
-func (x *StudentRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[53]
+func (x *VolunteerTournamentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,86 +8664,78 @@ func (x *StudentRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentRanking.ProtoReflect.Descriptor instead.
-func (*StudentRanking) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use VolunteerTournamentStatsResponse.ProtoReflect.Descriptor instead.
+func (*VolunteerTournamentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *StudentRanking) GetStudentId() int32 {
+func (x *VolunteerTournamentStatsResponse) GetTotalRoundsJudged() int32 {
 	if x != nil {
-		return x.StudentId
+		return x.TotalRoundsJudged
 	}
 	return 0
 }
 
-func (x *StudentRanking) GetStudentName() string {
-	if x != nil {
-		return x.StudentName
-	}
-	return ""
-}
-
-func (x *StudentRanking) GetSchoolName() string {
+func (x *VolunteerTournamentStatsResponse) GetRoundsJudgedChange() string {
 	if x != nil {
-		return x.SchoolName
+		return x.RoundsJudgedChange
 	}
 	return ""
 }
 
-func (x *StudentRanking) GetTotalWins() int32 {
+func (x *VolunteerTournamentStatsResponse) GetTournamentsAttended() int32 {
 	if x != nil {
-		return x.TotalWins
+		return x.TournamentsAttended
 	}
 	return 0
 }
 
-func (x *StudentRanking) GetTotalPoints() float64 {
+func (x *VolunteerTournamentStatsResponse) GetTournamentsAttendedChange() string {
 	if x != nil {
-		return x.TotalPoints
+		return x.TournamentsAttendedChange
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentRanking) GetAverageRank() float64 {
+func (x *VolunteerTournamentStatsResponse) GetUpcomingTournaments() int32 {
 	if x != nil {
-		return x.AverageRank
+		return x.UpcomingTournaments
 	}
 	return 0
 }
 
-func (x *StudentRanking) GetPlace() int32 {
+func (x *VolunteerTournamentStatsResponse) GetUpcomingTournamentsChange() string {
 	if x != nil {
-		return x.Place
+		return x.UpcomingTournamentsChange
 	}
-	return 0
+	return ""
 }
 
-type TournamentTeamsRankingRequest struct {
+type GetStudentFeedbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentTeamsRankingRequest) Reset() {
-	*x = TournamentTeamsRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[54]
+func (x *GetStudentFeedbackRequest) Reset() {
+	*x = GetStudentFeedbackRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentTeamsRankingRequest) String() string {
+func (x *GetStudentFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentTeamsRankingRequest) ProtoMessage() {}
+func (*GetStudentFeedbackRequest) ProtoMessage() {}
 
-func (x *TournamentTeamsRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[54]
+func (x *GetStudentFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3334,69 +8746,72 @@ func (x *TournamentTeamsRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentTeamsRankingRequest.ProtoReflect.Descriptor instead.
-func (*TournamentTeamsRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetStudentFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *TournamentTeamsRankingRequest) GetTournamentId() int32 {
+func (x *GetStudentFeedbackRequest) GetTournamentId() int32 {
 	if x != nil {
 		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *TournamentTeamsRankingRequest) GetToken() string {
+func (x *GetStudentFeedbackRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *TournamentTeamsRankingRequest) GetPage() int32 {
+func (x *GetStudentFeedbackRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *TournamentTeamsRankingRequest) GetPageSize() int32 {
+func (x *GetStudentFeedbackRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *TournamentTeamsRankingRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
-}
-
-type TournamentTeamsRankingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rankings      []*TeamRanking         `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StudentFeedbackEntry struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RoundNumber        int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound bool                   `protobuf:"varint,2,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	SpeakerPoints      float64                `protobuf:"fixed64,3,opt,name=speaker_points,json=speakerPoints,proto3" json:"speaker_points,omitempty"`
+	Feedback           string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	IsRead             bool                   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	HeadJudgeName      string                 `protobuf:"bytes,6,opt,name=head_judge_name,json=headJudgeName,proto3" json:"head_judge_name,omitempty"`
+	RoomName           string                 `protobuf:"bytes,7,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	OpponentTeamName   string                 `protobuf:"bytes,8,opt,name=opponent_team_name,json=opponentTeamName,proto3" json:"opponent_team_name,omitempty"`
+	StudentTeamName    string                 `protobuf:"bytes,9,opt,name=student_team_name,json=studentTeamName,proto3" json:"student_team_name,omitempty"`
+	DebateId           int32                  `protobuf:"varint,10,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
+	Judges             []*JudgeInfo           `protobuf:"bytes,11,rep,name=judges,proto3" json:"judges,omitempty"`
+	BallotId           int32                  `protobuf:"varint,12,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *TournamentTeamsRankingResponse) Reset() {
-	*x = TournamentTeamsRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[55]
+func (x *StudentFeedbackEntry) Reset() {
+	*x = StudentFeedbackEntry{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentTeamsRankingResponse) String() string {
+func (x *StudentFeedbackEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentTeamsRankingResponse) ProtoMessage() {}
+func (*StudentFeedbackEntry) ProtoMessage() {}
 
-func (x *TournamentTeamsRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[55]
+func (x *StudentFeedbackEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3407,143 +8822,119 @@ func (x *TournamentTeamsRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentTeamsRankingResponse.ProtoReflect.Descriptor instead.
-func (*TournamentTeamsRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use StudentFeedbackEntry.ProtoReflect.Descriptor instead.
+func (*StudentFeedbackEntry) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *TournamentTeamsRankingResponse) GetRankings() []*TeamRanking {
+func (x *StudentFeedbackEntry) GetRoundNumber() int32 {
 	if x != nil {
-		return x.Rankings
+		return x.RoundNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *TournamentTeamsRankingResponse) GetTotalCount() int32 {
+func (x *StudentFeedbackEntry) GetIsEliminationRound() bool {
 	if x != nil {
-		return x.TotalCount
+		return x.IsEliminationRound
 	}
-	return 0
-}
-
-type TeamRanking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
-	SchoolNames   []string               `protobuf:"bytes,3,rep,name=school_names,json=schoolNames,proto3" json:"school_names,omitempty"`
-	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	AverageRank   float64                `protobuf:"fixed64,6,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
-	Place         int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TeamRanking) Reset() {
-	*x = TeamRanking{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return false
 }
 
-func (x *TeamRanking) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StudentFeedbackEntry) GetSpeakerPoints() float64 {
+	if x != nil {
+		return x.SpeakerPoints
+	}
+	return 0
 }
 
-func (*TeamRanking) ProtoMessage() {}
-
-func (x *TeamRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[56]
+func (x *StudentFeedbackEntry) GetFeedback() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Feedback
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TeamRanking.ProtoReflect.Descriptor instead.
-func (*TeamRanking) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{56}
+func (x *StudentFeedbackEntry) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
 }
 
-func (x *TeamRanking) GetTeamId() int32 {
+func (x *StudentFeedbackEntry) GetHeadJudgeName() string {
 	if x != nil {
-		return x.TeamId
+		return x.HeadJudgeName
 	}
-	return 0
+	return ""
 }
 
-func (x *TeamRanking) GetTeamName() string {
+func (x *StudentFeedbackEntry) GetRoomName() string {
 	if x != nil {
-		return x.TeamName
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *TeamRanking) GetSchoolNames() []string {
+func (x *StudentFeedbackEntry) GetOpponentTeamName() string {
 	if x != nil {
-		return x.SchoolNames
+		return x.OpponentTeamName
 	}
-	return nil
+	return ""
 }
 
-func (x *TeamRanking) GetWins() int32 {
+func (x *StudentFeedbackEntry) GetStudentTeamName() string {
 	if x != nil {
-		return x.Wins
+		return x.StudentTeamName
 	}
-	return 0
+	return ""
 }
 
-func (x *TeamRanking) GetTotalPoints() float64 {
+func (x *StudentFeedbackEntry) GetDebateId() int32 {
 	if x != nil {
-		return x.TotalPoints
+		return x.DebateId
 	}
 	return 0
 }
 
-func (x *TeamRanking) GetAverageRank() float64 {
+func (x *StudentFeedbackEntry) GetJudges() []*JudgeInfo {
 	if x != nil {
-		return x.AverageRank
+		return x.Judges
 	}
-	return 0
+	return nil
 }
 
-func (x *TeamRanking) GetPlace() int32 {
+func (x *StudentFeedbackEntry) GetBallotId() int32 {
 	if x != nil {
-		return x.Place
+		return x.BallotId
 	}
 	return 0
 }
 
-type TournamentSchoolRankingRequest struct {
+type JudgeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	JudgeName     string                 `protobuf:"bytes,2,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
+	IsHeadJudge   bool                   `protobuf:"varint,3,opt,name=is_head_judge,json=isHeadJudge,proto3" json:"is_head_judge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentSchoolRankingRequest) Reset() {
-	*x = TournamentSchoolRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[57]
+func (x *JudgeInfo) Reset() {
+	*x = JudgeInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentSchoolRankingRequest) String() string {
+func (x *JudgeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentSchoolRankingRequest) ProtoMessage() {}
+func (*JudgeInfo) ProtoMessage() {}
 
-func (x *TournamentSchoolRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[57]
+func (x *JudgeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3554,69 +8945,55 @@ func (x *TournamentSchoolRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentSchoolRankingRequest.ProtoReflect.Descriptor instead.
-func (*TournamentSchoolRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use JudgeInfo.ProtoReflect.Descriptor instead.
+func (*JudgeInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *TournamentSchoolRankingRequest) GetTournamentId() int32 {
+func (x *JudgeInfo) GetJudgeId() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.JudgeId
 	}
 	return 0
 }
 
-func (x *TournamentSchoolRankingRequest) GetToken() string {
+func (x *JudgeInfo) GetJudgeName() string {
 	if x != nil {
-		return x.Token
+		return x.JudgeName
 	}
 	return ""
 }
 
-func (x *TournamentSchoolRankingRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *TournamentSchoolRankingRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *TournamentSchoolRankingRequest) GetSearch() string {
+func (x *JudgeInfo) GetIsHeadJudge() bool {
 	if x != nil {
-		return x.Search
+		return x.IsHeadJudge
 	}
-	return ""
+	return false
 }
 
-type TournamentSchoolRankingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rankings      []*SchoolRanking       `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetStudentFeedbackResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	FeedbackEntries []*StudentFeedbackEntry `protobuf:"bytes,1,rep,name=feedback_entries,json=feedbackEntries,proto3" json:"feedback_entries,omitempty"`
+	TotalCount      int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *TournamentSchoolRankingResponse) Reset() {
-	*x = TournamentSchoolRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[58]
+func (x *GetStudentFeedbackResponse) Reset() {
+	*x = GetStudentFeedbackResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentSchoolRankingResponse) String() string {
+func (x *GetStudentFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentSchoolRankingResponse) ProtoMessage() {}
+func (*GetStudentFeedbackResponse) ProtoMessage() {}
 
-func (x *TournamentSchoolRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[58]
+func (x *GetStudentFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,52 +9004,55 @@ func (x *TournamentSchoolRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentSchoolRankingResponse.ProtoReflect.Descriptor instead.
-func (*TournamentSchoolRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetStudentFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *TournamentSchoolRankingResponse) GetRankings() []*SchoolRanking {
+func (x *GetStudentFeedbackResponse) GetFeedbackEntries() []*StudentFeedbackEntry {
 	if x != nil {
-		return x.Rankings
+		return x.FeedbackEntries
 	}
 	return nil
 }
 
-func (x *TournamentSchoolRankingResponse) GetTotalCount() int32 {
+func (x *GetStudentFeedbackResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-type SchoolRanking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SchoolName    string                 `protobuf:"bytes,1,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	TeamCount     int32                  `protobuf:"varint,2,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
-	TotalWins     int32                  `protobuf:"varint,3,opt,name=total_wins,json=totalWins,proto3" json:"total_wins,omitempty"`
-	AverageRank   float64                `protobuf:"fixed64,4,opt,name=average_rank,json=averageRank,proto3" json:"average_rank,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	Place         int32                  `protobuf:"varint,6,opt,name=place,proto3" json:"place,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SubmitJudgeFeedbackRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId                int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	DebateId               int32                  `protobuf:"varint,2,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
+	ClarityRating          float64                `protobuf:"fixed64,3,opt,name=clarity_rating,json=clarityRating,proto3" json:"clarity_rating,omitempty"`
+	ConstructivenessRating float64                `protobuf:"fixed64,4,opt,name=constructiveness_rating,json=constructivenessRating,proto3" json:"constructiveness_rating,omitempty"`
+	TimelinessRating       float64                `protobuf:"fixed64,5,opt,name=timeliness_rating,json=timelinessRating,proto3" json:"timeliness_rating,omitempty"`
+	FairnessRating         float64                `protobuf:"fixed64,6,opt,name=fairness_rating,json=fairnessRating,proto3" json:"fairness_rating,omitempty"`
+	EngagementRating       float64                `protobuf:"fixed64,7,opt,name=engagement_rating,json=engagementRating,proto3" json:"engagement_rating,omitempty"`
+	TextFeedback           string                 `protobuf:"bytes,8,opt,name=text_feedback,json=textFeedback,proto3" json:"text_feedback,omitempty"`
+	Token                  string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SchoolRanking) Reset() {
-	*x = SchoolRanking{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[59]
+func (x *SubmitJudgeFeedbackRequest) Reset() {
+	*x = SubmitJudgeFeedbackRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchoolRanking) String() string {
+func (x *SubmitJudgeFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchoolRanking) ProtoMessage() {}
+func (*SubmitJudgeFeedbackRequest) ProtoMessage() {}
 
-func (x *SchoolRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[59]
+func (x *SubmitJudgeFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3683,76 +9063,97 @@ func (x *SchoolRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchoolRanking.ProtoReflect.Descriptor instead.
-func (*SchoolRanking) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SubmitJudgeFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJudgeFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *SchoolRanking) GetSchoolName() string {
+func (x *SubmitJudgeFeedbackRequest) GetJudgeId() int32 {
 	if x != nil {
-		return x.SchoolName
+		return x.JudgeId
 	}
-	return ""
+	return 0
 }
 
-func (x *SchoolRanking) GetTeamCount() int32 {
+func (x *SubmitJudgeFeedbackRequest) GetDebateId() int32 {
+	if x != nil {
+		return x.DebateId
+	}
+	return 0
+}
+
+func (x *SubmitJudgeFeedbackRequest) GetClarityRating() float64 {
+	if x != nil {
+		return x.ClarityRating
+	}
+	return 0
+}
+
+func (x *SubmitJudgeFeedbackRequest) GetConstructivenessRating() float64 {
+	if x != nil {
+		return x.ConstructivenessRating
+	}
+	return 0
+}
+
+func (x *SubmitJudgeFeedbackRequest) GetTimelinessRating() float64 {
 	if x != nil {
-		return x.TeamCount
+		return x.TimelinessRating
 	}
 	return 0
 }
 
-func (x *SchoolRanking) GetTotalWins() int32 {
+func (x *SubmitJudgeFeedbackRequest) GetFairnessRating() float64 {
 	if x != nil {
-		return x.TotalWins
+		return x.FairnessRating
 	}
 	return 0
 }
 
-func (x *SchoolRanking) GetAverageRank() float64 {
+func (x *SubmitJudgeFeedbackRequest) GetEngagementRating() float64 {
 	if x != nil {
-		return x.AverageRank
+		return x.EngagementRating
 	}
 	return 0
 }
 
-func (x *SchoolRanking) GetTotalPoints() float64 {
+func (x *SubmitJudgeFeedbackRequest) GetTextFeedback() string {
 	if x != nil {
-		return x.TotalPoints
+		return x.TextFeedback
 	}
-	return 0
+	return ""
 }
 
-func (x *SchoolRanking) GetPlace() int32 {
+func (x *SubmitJudgeFeedbackRequest) GetToken() string {
 	if x != nil {
-		return x.Place
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type OverallSchoolRankingRequest struct {
+type SubmitJudgeFeedbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverallSchoolRankingRequest) Reset() {
-	*x = OverallSchoolRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[60]
+func (x *SubmitJudgeFeedbackResponse) Reset() {
+	*x = SubmitJudgeFeedbackResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverallSchoolRankingRequest) String() string {
+func (x *SubmitJudgeFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverallSchoolRankingRequest) ProtoMessage() {}
+func (*SubmitJudgeFeedbackResponse) ProtoMessage() {}
 
-func (x *OverallSchoolRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[60]
+func (x *SubmitJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,51 +9164,49 @@ func (x *OverallSchoolRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverallSchoolRankingRequest.ProtoReflect.Descriptor instead.
-func (*OverallSchoolRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use SubmitJudgeFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*SubmitJudgeFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *OverallSchoolRankingRequest) GetUserId() int32 {
+func (x *SubmitJudgeFeedbackResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *OverallSchoolRankingRequest) GetToken() string {
+func (x *SubmitJudgeFeedbackResponse) GetMessage() string {
 	if x != nil {
-		return x.Token
+		return x.Message
 	}
 	return ""
 }
 
-type OverallSchoolRankingResponse struct {
+type GetJudgeFeedbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SchoolRank    int32                  `protobuf:"varint,1,opt,name=school_rank,json=schoolRank,proto3" json:"school_rank,omitempty"`
-	TotalSchools  int32                  `protobuf:"varint,2,opt,name=total_schools,json=totalSchools,proto3" json:"total_schools,omitempty"`
-	RankChange    int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
-	TopSchools    []*TopSchool           `protobuf:"bytes,4,rep,name=top_schools,json=topSchools,proto3" json:"top_schools,omitempty"`
-	SchoolInfo    *SchoolInfo            `protobuf:"bytes,5,opt,name=school_info,json=schoolInfo,proto3" json:"school_info,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverallSchoolRankingResponse) Reset() {
-	*x = OverallSchoolRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[61]
+func (x *GetJudgeFeedbackRequest) Reset() {
+	*x = GetJudgeFeedbackRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverallSchoolRankingResponse) String() string {
+func (x *GetJudgeFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverallSchoolRankingResponse) ProtoMessage() {}
+func (*GetJudgeFeedbackRequest) ProtoMessage() {}
 
-func (x *OverallSchoolRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[61]
+func (x *GetJudgeFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,71 +9217,65 @@ func (x *OverallSchoolRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverallSchoolRankingResponse.ProtoReflect.Descriptor instead.
-func (*OverallSchoolRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetJudgeFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*GetJudgeFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *OverallSchoolRankingResponse) GetSchoolRank() int32 {
+func (x *GetJudgeFeedbackRequest) GetToken() string {
 	if x != nil {
-		return x.SchoolRank
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *OverallSchoolRankingResponse) GetTotalSchools() int32 {
+func (x *GetJudgeFeedbackRequest) GetPage() int32 {
 	if x != nil {
-		return x.TotalSchools
+		return x.Page
 	}
 	return 0
 }
 
-func (x *OverallSchoolRankingResponse) GetRankChange() int32 {
+func (x *GetJudgeFeedbackRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RankChange
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *OverallSchoolRankingResponse) GetTopSchools() []*TopSchool {
-	if x != nil {
-		return x.TopSchools
-	}
-	return nil
-}
-
-func (x *OverallSchoolRankingResponse) GetSchoolInfo() *SchoolInfo {
-	if x != nil {
-		return x.SchoolInfo
-	}
-	return nil
-}
-
-type TopSchool struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,3,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	RankChange    int32                  `protobuf:"varint,4,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type JudgeFeedbackEntry struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	StudentAlias           string                 `protobuf:"bytes,1,opt,name=student_alias,json=studentAlias,proto3" json:"student_alias,omitempty"`
+	TournamentDate         string                 `protobuf:"bytes,2,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
+	IsRead                 bool                   `protobuf:"varint,3,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	ClarityRating          float64                `protobuf:"fixed64,4,opt,name=clarity_rating,json=clarityRating,proto3" json:"clarity_rating,omitempty"`
+	ConstructivenessRating float64                `protobuf:"fixed64,5,opt,name=constructiveness_rating,json=constructivenessRating,proto3" json:"constructiveness_rating,omitempty"`
+	TimelinessRating       float64                `protobuf:"fixed64,6,opt,name=timeliness_rating,json=timelinessRating,proto3" json:"timeliness_rating,omitempty"`
+	FairnessRating         float64                `protobuf:"fixed64,7,opt,name=fairness_rating,json=fairnessRating,proto3" json:"fairness_rating,omitempty"`
+	EngagementRating       float64                `protobuf:"fixed64,8,opt,name=engagement_rating,json=engagementRating,proto3" json:"engagement_rating,omitempty"`
+	TextFeedback           string                 `protobuf:"bytes,9,opt,name=text_feedback,json=textFeedback,proto3" json:"text_feedback,omitempty"`
+	RoundNumber            int32                  `protobuf:"varint,10,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsEliminationRound     bool                   `protobuf:"varint,11,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
+	FeedbackId             int32                  `protobuf:"varint,12,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *TopSchool) Reset() {
-	*x = TopSchool{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[62]
+func (x *JudgeFeedbackEntry) Reset() {
+	*x = JudgeFeedbackEntry{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopSchool) String() string {
+func (x *JudgeFeedbackEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopSchool) ProtoMessage() {}
+func (*JudgeFeedbackEntry) ProtoMessage() {}
 
-func (x *TopSchool) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[62]
+func (x *JudgeFeedbackEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3893,116 +9286,118 @@ func (x *TopSchool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopSchool.ProtoReflect.Descriptor instead.
-func (*TopSchool) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use JudgeFeedbackEntry.ProtoReflect.Descriptor instead.
+func (*JudgeFeedbackEntry) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *TopSchool) GetRank() int32 {
+func (x *JudgeFeedbackEntry) GetStudentAlias() string {
 	if x != nil {
-		return x.Rank
+		return x.StudentAlias
 	}
-	return 0
+	return ""
 }
 
-func (x *TopSchool) GetName() string {
+func (x *JudgeFeedbackEntry) GetTournamentDate() string {
 	if x != nil {
-		return x.Name
+		return x.TournamentDate
 	}
 	return ""
 }
 
-func (x *TopSchool) GetTotalPoints() float64 {
+func (x *JudgeFeedbackEntry) GetIsRead() bool {
 	if x != nil {
-		return x.TotalPoints
+		return x.IsRead
 	}
-	return 0
+	return false
 }
 
-func (x *TopSchool) GetRankChange() int32 {
+func (x *JudgeFeedbackEntry) GetClarityRating() float64 {
 	if x != nil {
-		return x.RankChange
+		return x.ClarityRating
 	}
 	return 0
 }
 
-type SchoolInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	TotalPoints   float64                `protobuf:"fixed64,2,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JudgeFeedbackEntry) GetConstructivenessRating() float64 {
+	if x != nil {
+		return x.ConstructivenessRating
+	}
+	return 0
 }
 
-func (x *SchoolInfo) Reset() {
-	*x = SchoolInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[63]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JudgeFeedbackEntry) GetTimelinessRating() float64 {
+	if x != nil {
+		return x.TimelinessRating
+	}
+	return 0
 }
 
-func (x *SchoolInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JudgeFeedbackEntry) GetFairnessRating() float64 {
+	if x != nil {
+		return x.FairnessRating
+	}
+	return 0
 }
 
-func (*SchoolInfo) ProtoMessage() {}
+func (x *JudgeFeedbackEntry) GetEngagementRating() float64 {
+	if x != nil {
+		return x.EngagementRating
+	}
+	return 0
+}
 
-func (x *SchoolInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[63]
+func (x *JudgeFeedbackEntry) GetTextFeedback() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TextFeedback
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SchoolInfo.ProtoReflect.Descriptor instead.
-func (*SchoolInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{63}
+func (x *JudgeFeedbackEntry) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
 }
 
-func (x *SchoolInfo) GetName() string {
+func (x *JudgeFeedbackEntry) GetIsEliminationRound() bool {
 	if x != nil {
-		return x.Name
+		return x.IsEliminationRound
 	}
-	return ""
+	return false
 }
 
-func (x *SchoolInfo) GetTotalPoints() float64 {
+func (x *JudgeFeedbackEntry) GetFeedbackId() int32 {
 	if x != nil {
-		return x.TotalPoints
+		return x.FeedbackId
 	}
 	return 0
 }
 
-type SchoolPerformanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetJudgeFeedbackResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackEntries []*JudgeFeedbackEntry  `protobuf:"bytes,1,rep,name=feedback_entries,json=feedbackEntries,proto3" json:"feedback_entries,omitempty"`
+	TotalCount      int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SchoolPerformanceRequest) Reset() {
-	*x = SchoolPerformanceRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[64]
+func (x *GetJudgeFeedbackResponse) Reset() {
+	*x = GetJudgeFeedbackResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchoolPerformanceRequest) String() string {
+func (x *GetJudgeFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchoolPerformanceRequest) ProtoMessage() {}
+func (*GetJudgeFeedbackResponse) ProtoMessage() {}
 
-func (x *SchoolPerformanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[64]
+func (x *GetJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,61 +9408,47 @@ func (x *SchoolPerformanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchoolPerformanceRequest.ProtoReflect.Descriptor instead.
-func (*SchoolPerformanceRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *SchoolPerformanceRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *SchoolPerformanceRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use GetJudgeFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*GetJudgeFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *SchoolPerformanceRequest) GetEndDate() string {
+func (x *GetJudgeFeedbackResponse) GetFeedbackEntries() []*JudgeFeedbackEntry {
 	if x != nil {
-		return x.EndDate
+		return x.FeedbackEntries
 	}
-	return ""
+	return nil
 }
 
-func (x *SchoolPerformanceRequest) GetToken() string {
+func (x *GetJudgeFeedbackResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Token
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type SchoolPerformanceResponse struct {
-	state           protoimpl.MessageState   `protogen:"open.v1"`
-	PerformanceData []*SchoolPerformanceData `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetVolunteerRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchoolPerformanceResponse) Reset() {
-	*x = SchoolPerformanceResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[65]
+func (x *GetVolunteerRankingRequest) Reset() {
+	*x = GetVolunteerRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchoolPerformanceResponse) String() string {
+func (x *GetVolunteerRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchoolPerformanceResponse) ProtoMessage() {}
+func (*GetVolunteerRankingRequest) ProtoMessage() {}
 
-func (x *SchoolPerformanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[65]
+func (x *GetVolunteerRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4078,43 +9459,43 @@ func (x *SchoolPerformanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchoolPerformanceResponse.ProtoReflect.Descriptor instead.
-func (*SchoolPerformanceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetVolunteerRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *SchoolPerformanceResponse) GetPerformanceData() []*SchoolPerformanceData {
+func (x *GetVolunteerRankingRequest) GetToken() string {
 	if x != nil {
-		return x.PerformanceData
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type SchoolPerformanceData struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	TournamentDate      string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
-	SchoolTotalPoints   float64                `protobuf:"fixed64,2,opt,name=school_total_points,json=schoolTotalPoints,proto3" json:"school_total_points,omitempty"`
-	SchoolAveragePoints float64                `protobuf:"fixed64,3,opt,name=school_average_points,json=schoolAveragePoints,proto3" json:"school_average_points,omitempty"`
-	TournamentRank      int32                  `protobuf:"varint,4,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type TopVolunteer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RankChange    int32                  `protobuf:"varint,4,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchoolPerformanceData) Reset() {
-	*x = SchoolPerformanceData{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[66]
+func (x *TopVolunteer) Reset() {
+	*x = TopVolunteer{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchoolPerformanceData) String() string {
+func (x *TopVolunteer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchoolPerformanceData) ProtoMessage() {}
+func (*TopVolunteer) ProtoMessage() {}
 
-func (x *SchoolPerformanceData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[66]
+func (x *TopVolunteer) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4125,62 +9506,62 @@ func (x *SchoolPerformanceData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchoolPerformanceData.ProtoReflect.Descriptor instead.
-func (*SchoolPerformanceData) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use TopVolunteer.ProtoReflect.Descriptor instead.
+func (*TopVolunteer) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *SchoolPerformanceData) GetTournamentDate() string {
+func (x *TopVolunteer) GetRank() int32 {
 	if x != nil {
-		return x.TournamentDate
+		return x.Rank
 	}
-	return ""
+	return 0
 }
 
-func (x *SchoolPerformanceData) GetSchoolTotalPoints() float64 {
+func (x *TopVolunteer) GetName() string {
 	if x != nil {
-		return x.SchoolTotalPoints
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SchoolPerformanceData) GetSchoolAveragePoints() float64 {
+func (x *TopVolunteer) GetAverageRating() float64 {
 	if x != nil {
-		return x.SchoolAveragePoints
+		return x.AverageRating
 	}
 	return 0
 }
 
-func (x *SchoolPerformanceData) GetTournamentRank() int32 {
+func (x *TopVolunteer) GetRankChange() int32 {
 	if x != nil {
-		return x.TournamentRank
+		return x.RankChange
 	}
 	return 0
 }
 
-type StudentTournamentStatsRequest struct {
+type VolunteerInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentTournamentStatsRequest) Reset() {
-	*x = StudentTournamentStatsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[67]
+func (x *VolunteerInfo) Reset() {
+	*x = VolunteerInfo{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentTournamentStatsRequest) String() string {
+func (x *VolunteerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentTournamentStatsRequest) ProtoMessage() {}
+func (*VolunteerInfo) ProtoMessage() {}
 
-func (x *StudentTournamentStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[67]
+func (x *VolunteerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4191,52 +9572,51 @@ func (x *StudentTournamentStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentTournamentStatsRequest.ProtoReflect.Descriptor instead.
-func (*StudentTournamentStatsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use VolunteerInfo.ProtoReflect.Descriptor instead.
+func (*VolunteerInfo) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *StudentTournamentStatsRequest) GetStudentId() int32 {
+func (x *VolunteerInfo) GetName() string {
 	if x != nil {
-		return x.StudentId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentTournamentStatsRequest) GetToken() string {
+func (x *VolunteerInfo) GetAverageRating() float64 {
 	if x != nil {
-		return x.Token
+		return x.AverageRating
 	}
-	return ""
+	return 0
 }
 
-type StudentTournamentStatsResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	TotalTournaments          int32                  `protobuf:"varint,1,opt,name=total_tournaments,json=totalTournaments,proto3" json:"total_tournaments,omitempty"`
-	TotalTournamentsChange    string                 `protobuf:"bytes,2,opt,name=total_tournaments_change,json=totalTournamentsChange,proto3" json:"total_tournaments_change,omitempty"`
-	AttendedTournaments       int32                  `protobuf:"varint,3,opt,name=attended_tournaments,json=attendedTournaments,proto3" json:"attended_tournaments,omitempty"`
-	AttendedTournamentsChange string                 `protobuf:"bytes,4,opt,name=attended_tournaments_change,json=attendedTournamentsChange,proto3" json:"attended_tournaments_change,omitempty"`
-	UpcomingTournaments       int32                  `protobuf:"varint,5,opt,name=upcoming_tournaments,json=upcomingTournaments,proto3" json:"upcoming_tournaments,omitempty"`
-	UpcomingTournamentsChange string                 `protobuf:"bytes,6,opt,name=upcoming_tournaments_change,json=upcomingTournamentsChange,proto3" json:"upcoming_tournaments_change,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type GetVolunteerRankingResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VolunteerRank   int32                  `protobuf:"varint,1,opt,name=volunteer_rank,json=volunteerRank,proto3" json:"volunteer_rank,omitempty"`
+	TotalVolunteers int32                  `protobuf:"varint,2,opt,name=total_volunteers,json=totalVolunteers,proto3" json:"total_volunteers,omitempty"`
+	RankChange      int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	TopVolunteers   []*TopVolunteer        `protobuf:"bytes,4,rep,name=top_volunteers,json=topVolunteers,proto3" json:"top_volunteers,omitempty"`
+	VolunteerInfo   *VolunteerInfo         `protobuf:"bytes,5,opt,name=volunteer_info,json=volunteerInfo,proto3" json:"volunteer_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StudentTournamentStatsResponse) Reset() {
-	*x = StudentTournamentStatsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[68]
+func (x *GetVolunteerRankingResponse) Reset() {
+	*x = GetVolunteerRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentTournamentStatsResponse) String() string {
+func (x *GetVolunteerRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentTournamentStatsResponse) ProtoMessage() {}
+func (*GetVolunteerRankingResponse) ProtoMessage() {}
 
-func (x *StudentTournamentStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[68]
+func (x *GetVolunteerRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,75 +9627,70 @@ func (x *StudentTournamentStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentTournamentStatsResponse.ProtoReflect.Descriptor instead.
-func (*StudentTournamentStatsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetVolunteerRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetVolunteerRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *StudentTournamentStatsResponse) GetTotalTournaments() int32 {
+func (x *GetVolunteerRankingResponse) GetVolunteerRank() int32 {
 	if x != nil {
-		return x.TotalTournaments
+		return x.VolunteerRank
 	}
 	return 0
 }
 
-func (x *StudentTournamentStatsResponse) GetTotalTournamentsChange() string {
-	if x != nil {
-		return x.TotalTournamentsChange
-	}
-	return ""
-}
-
-func (x *StudentTournamentStatsResponse) GetAttendedTournaments() int32 {
+func (x *GetVolunteerRankingResponse) GetTotalVolunteers() int32 {
 	if x != nil {
-		return x.AttendedTournaments
+		return x.TotalVolunteers
 	}
 	return 0
 }
 
-func (x *StudentTournamentStatsResponse) GetAttendedTournamentsChange() string {
+func (x *GetVolunteerRankingResponse) GetRankChange() int32 {
 	if x != nil {
-		return x.AttendedTournamentsChange
+		return x.RankChange
 	}
-	return ""
+	return 0
 }
 
-func (x *StudentTournamentStatsResponse) GetUpcomingTournaments() int32 {
+func (x *GetVolunteerRankingResponse) GetTopVolunteers() []*TopVolunteer {
 	if x != nil {
-		return x.UpcomingTournaments
+		return x.TopVolunteers
 	}
-	return 0
+	return nil
 }
 
-func (x *StudentTournamentStatsResponse) GetUpcomingTournamentsChange() string {
+func (x *GetVolunteerRankingResponse) GetVolunteerInfo() *VolunteerInfo {
 	if x != nil {
-		return x.UpcomingTournamentsChange
+		return x.VolunteerInfo
 	}
-	return ""
+	return nil
 }
 
-type VolunteerTournamentStatsRequest struct {
+type GetVolunteerPerformanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolunteerTournamentStatsRequest) Reset() {
-	*x = VolunteerTournamentStatsRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[69]
+func (x *GetVolunteerPerformanceRequest) Reset() {
+	*x = GetVolunteerPerformanceRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolunteerTournamentStatsRequest) String() string {
+func (x *GetVolunteerPerformanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolunteerTournamentStatsRequest) ProtoMessage() {}
+func (*GetVolunteerPerformanceRequest) ProtoMessage() {}
 
-func (x *VolunteerTournamentStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[69]
+func (x *GetVolunteerPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4326,45 +9701,57 @@ func (x *VolunteerTournamentStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolunteerTournamentStatsRequest.ProtoReflect.Descriptor instead.
-func (*VolunteerTournamentStatsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetVolunteerPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetVolunteerPerformanceRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetVolunteerPerformanceRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *VolunteerTournamentStatsRequest) GetToken() string {
+func (x *GetVolunteerPerformanceRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type VolunteerTournamentStatsResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	TotalRoundsJudged         int32                  `protobuf:"varint,1,opt,name=total_rounds_judged,json=totalRoundsJudged,proto3" json:"total_rounds_judged,omitempty"`
-	RoundsJudgedChange        string                 `protobuf:"bytes,2,opt,name=rounds_judged_change,json=roundsJudgedChange,proto3" json:"rounds_judged_change,omitempty"`
-	TournamentsAttended       int32                  `protobuf:"varint,3,opt,name=tournaments_attended,json=tournamentsAttended,proto3" json:"tournaments_attended,omitempty"`
-	TournamentsAttendedChange string                 `protobuf:"bytes,4,opt,name=tournaments_attended_change,json=tournamentsAttendedChange,proto3" json:"tournaments_attended_change,omitempty"`
-	UpcomingTournaments       int32                  `protobuf:"varint,5,opt,name=upcoming_tournaments,json=upcomingTournaments,proto3" json:"upcoming_tournaments,omitempty"`
-	UpcomingTournamentsChange string                 `protobuf:"bytes,6,opt,name=upcoming_tournaments_change,json=upcomingTournamentsChange,proto3" json:"upcoming_tournaments_change,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type VolunteerPerformanceData struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TournamentDate         string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
+	VolunteerAverageRating float64                `protobuf:"fixed64,2,opt,name=volunteer_average_rating,json=volunteerAverageRating,proto3" json:"volunteer_average_rating,omitempty"`
+	OverallAverageRating   float64                `protobuf:"fixed64,3,opt,name=overall_average_rating,json=overallAverageRating,proto3" json:"overall_average_rating,omitempty"`
+	TournamentRank         int32                  `protobuf:"varint,4,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *VolunteerTournamentStatsResponse) Reset() {
-	*x = VolunteerTournamentStatsResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[70]
+func (x *VolunteerPerformanceData) Reset() {
+	*x = VolunteerPerformanceData{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolunteerTournamentStatsResponse) String() string {
+func (x *VolunteerPerformanceData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolunteerTournamentStatsResponse) ProtoMessage() {}
+func (*VolunteerPerformanceData) ProtoMessage() {}
 
-func (x *VolunteerTournamentStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[70]
+func (x *VolunteerPerformanceData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4375,78 +9762,106 @@ func (x *VolunteerTournamentStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolunteerTournamentStatsResponse.ProtoReflect.Descriptor instead.
-func (*VolunteerTournamentStatsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use VolunteerPerformanceData.ProtoReflect.Descriptor instead.
+func (*VolunteerPerformanceData) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *VolunteerTournamentStatsResponse) GetTotalRoundsJudged() int32 {
+func (x *VolunteerPerformanceData) GetTournamentDate() string {
 	if x != nil {
-		return x.TotalRoundsJudged
+		return x.TournamentDate
 	}
-	return 0
+	return ""
 }
 
-func (x *VolunteerTournamentStatsResponse) GetRoundsJudgedChange() string {
+func (x *VolunteerPerformanceData) GetVolunteerAverageRating() float64 {
 	if x != nil {
-		return x.RoundsJudgedChange
+		return x.VolunteerAverageRating
 	}
-	return ""
+	return 0
 }
 
-func (x *VolunteerTournamentStatsResponse) GetTournamentsAttended() int32 {
+func (x *VolunteerPerformanceData) GetOverallAverageRating() float64 {
 	if x != nil {
-		return x.TournamentsAttended
+		return x.OverallAverageRating
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentStatsResponse) GetTournamentsAttendedChange() string {
+func (x *VolunteerPerformanceData) GetTournamentRank() int32 {
 	if x != nil {
-		return x.TournamentsAttendedChange
+		return x.TournamentRank
 	}
-	return ""
+	return 0
 }
 
-func (x *VolunteerTournamentStatsResponse) GetUpcomingTournaments() int32 {
+type GetVolunteerPerformanceResponse struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	PerformanceData []*VolunteerPerformanceData `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetVolunteerPerformanceResponse) Reset() {
+	*x = GetVolunteerPerformanceResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerPerformanceResponse) ProtoMessage() {}
+
+func (x *GetVolunteerPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[147]
 	if x != nil {
-		return x.UpcomingTournaments
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *VolunteerTournamentStatsResponse) GetUpcomingTournamentsChange() string {
+// Deprecated: Use GetVolunteerPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetVolunteerPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetVolunteerPerformanceResponse) GetPerformanceData() []*VolunteerPerformanceData {
 	if x != nil {
-		return x.UpcomingTournamentsChange
+		return x.PerformanceData
 	}
-	return ""
+	return nil
 }
 
-type GetStudentFeedbackRequest struct {
+type MarkFeedbackAsReadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	FeedbackId    int32                  `protobuf:"varint,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentFeedbackRequest) Reset() {
-	*x = GetStudentFeedbackRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[71]
+func (x *MarkFeedbackAsReadRequest) Reset() {
+	*x = MarkFeedbackAsReadRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentFeedbackRequest) String() string {
+func (x *MarkFeedbackAsReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentFeedbackRequest) ProtoMessage() {}
+func (*MarkFeedbackAsReadRequest) ProtoMessage() {}
 
-func (x *GetStudentFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[71]
+func (x *MarkFeedbackAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4457,72 +9872,48 @@ func (x *GetStudentFeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use MarkFeedbackAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkFeedbackAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *GetStudentFeedbackRequest) GetTournamentId() int32 {
+func (x *MarkFeedbackAsReadRequest) GetFeedbackId() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.FeedbackId
 	}
 	return 0
 }
 
-func (x *GetStudentFeedbackRequest) GetToken() string {
+func (x *MarkFeedbackAsReadRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *GetStudentFeedbackRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetStudentFeedbackRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type StudentFeedbackEntry struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RoundNumber        int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsEliminationRound bool                   `protobuf:"varint,2,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
-	SpeakerPoints      float64                `protobuf:"fixed64,3,opt,name=speaker_points,json=speakerPoints,proto3" json:"speaker_points,omitempty"`
-	Feedback           string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	IsRead             bool                   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	HeadJudgeName      string                 `protobuf:"bytes,6,opt,name=head_judge_name,json=headJudgeName,proto3" json:"head_judge_name,omitempty"`
-	RoomName           string                 `protobuf:"bytes,7,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	OpponentTeamName   string                 `protobuf:"bytes,8,opt,name=opponent_team_name,json=opponentTeamName,proto3" json:"opponent_team_name,omitempty"`
-	StudentTeamName    string                 `protobuf:"bytes,9,opt,name=student_team_name,json=studentTeamName,proto3" json:"student_team_name,omitempty"`
-	DebateId           int32                  `protobuf:"varint,10,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
-	Judges             []*JudgeInfo           `protobuf:"bytes,11,rep,name=judges,proto3" json:"judges,omitempty"`
-	BallotId           int32                  `protobuf:"varint,12,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type MarkFeedbackAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentFeedbackEntry) Reset() {
-	*x = StudentFeedbackEntry{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[72]
+func (x *MarkFeedbackAsReadResponse) Reset() {
+	*x = MarkFeedbackAsReadResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentFeedbackEntry) String() string {
+func (x *MarkFeedbackAsReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentFeedbackEntry) ProtoMessage() {}
+func (*MarkFeedbackAsReadResponse) ProtoMessage() {}
 
-func (x *StudentFeedbackEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[72]
+func (x *MarkFeedbackAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4533,119 +9924,129 @@ func (x *StudentFeedbackEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentFeedbackEntry.ProtoReflect.Descriptor instead.
-func (*StudentFeedbackEntry) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use MarkFeedbackAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkFeedbackAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *StudentFeedbackEntry) GetRoundNumber() int32 {
+func (x *MarkFeedbackAsReadResponse) GetSuccess() bool {
 	if x != nil {
-		return x.RoundNumber
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *StudentFeedbackEntry) GetIsEliminationRound() bool {
+func (x *MarkFeedbackAsReadResponse) GetMessage() string {
 	if x != nil {
-		return x.IsEliminationRound
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *StudentFeedbackEntry) GetSpeakerPoints() float64 {
-	if x != nil {
-		return x.SpeakerPoints
-	}
-	return 0
+type TournamentVolunteerRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentFeedbackEntry) GetFeedback() string {
-	if x != nil {
-		return x.Feedback
-	}
-	return ""
+func (x *TournamentVolunteerRankingRequest) Reset() {
+	*x = TournamentVolunteerRankingRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentFeedbackEntry) GetIsRead() bool {
-	if x != nil {
-		return x.IsRead
-	}
-	return false
+func (x *TournamentVolunteerRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StudentFeedbackEntry) GetHeadJudgeName() string {
+func (*TournamentVolunteerRankingRequest) ProtoMessage() {}
+
+func (x *TournamentVolunteerRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[150]
 	if x != nil {
-		return x.HeadJudgeName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StudentFeedbackEntry) GetRoomName() string {
-	if x != nil {
-		return x.RoomName
-	}
-	return ""
+// Deprecated: Use TournamentVolunteerRankingRequest.ProtoReflect.Descriptor instead.
+func (*TournamentVolunteerRankingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *StudentFeedbackEntry) GetOpponentTeamName() string {
+func (x *TournamentVolunteerRankingRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.OpponentTeamName
+		return x.TournamentId
 	}
-	return ""
+	return 0
 }
 
-func (x *StudentFeedbackEntry) GetStudentTeamName() string {
+func (x *TournamentVolunteerRankingRequest) GetToken() string {
 	if x != nil {
-		return x.StudentTeamName
+		return x.Token
 	}
 	return ""
 }
 
-func (x *StudentFeedbackEntry) GetDebateId() int32 {
+func (x *TournamentVolunteerRankingRequest) GetPage() int32 {
 	if x != nil {
-		return x.DebateId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *StudentFeedbackEntry) GetJudges() []*JudgeInfo {
+func (x *TournamentVolunteerRankingRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Judges
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *StudentFeedbackEntry) GetBallotId() int32 {
+func (x *TournamentVolunteerRankingRequest) GetSearch() string {
 	if x != nil {
-		return x.BallotId
+		return x.Search
 	}
-	return 0
+	return ""
 }
 
-type JudgeInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId       int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	JudgeName     string                 `protobuf:"bytes,2,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
-	IsHeadJudge   bool                   `protobuf:"varint,3,opt,name=is_head_judge,json=isHeadJudge,proto3" json:"is_head_judge,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type VolunteerTournamentRank struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	VolunteerId       int32                  `protobuf:"varint,1,opt,name=volunteer_id,json=volunteerId,proto3" json:"volunteer_id,omitempty"`
+	VolunteerName     string                 `protobuf:"bytes,2,opt,name=volunteer_name,json=volunteerName,proto3" json:"volunteer_name,omitempty"`
+	AverageRating     float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	PreliminaryRounds int32                  `protobuf:"varint,4,opt,name=preliminary_rounds,json=preliminaryRounds,proto3" json:"preliminary_rounds,omitempty"`
+	EliminationRounds int32                  `protobuf:"varint,5,opt,name=elimination_rounds,json=eliminationRounds,proto3" json:"elimination_rounds,omitempty"`
+	Rank              int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	Place             int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *JudgeInfo) Reset() {
-	*x = JudgeInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[73]
+func (x *VolunteerTournamentRank) Reset() {
+	*x = VolunteerTournamentRank{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JudgeInfo) String() string {
+func (x *VolunteerTournamentRank) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JudgeInfo) ProtoMessage() {}
+func (*VolunteerTournamentRank) ProtoMessage() {}
 
-func (x *JudgeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[73]
+func (x *VolunteerTournamentRank) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,55 +10057,83 @@ func (x *JudgeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JudgeInfo.ProtoReflect.Descriptor instead.
-func (*JudgeInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use VolunteerTournamentRank.ProtoReflect.Descriptor instead.
+func (*VolunteerTournamentRank) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *JudgeInfo) GetJudgeId() int32 {
+func (x *VolunteerTournamentRank) GetVolunteerId() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.VolunteerId
 	}
 	return 0
 }
 
-func (x *JudgeInfo) GetJudgeName() string {
+func (x *VolunteerTournamentRank) GetVolunteerName() string {
 	if x != nil {
-		return x.JudgeName
+		return x.VolunteerName
 	}
 	return ""
 }
 
-func (x *JudgeInfo) GetIsHeadJudge() bool {
+func (x *VolunteerTournamentRank) GetAverageRating() float64 {
 	if x != nil {
-		return x.IsHeadJudge
+		return x.AverageRating
 	}
-	return false
+	return 0
 }
 
-type GetStudentFeedbackResponse struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	FeedbackEntries []*StudentFeedbackEntry `protobuf:"bytes,1,rep,name=feedback_entries,json=feedbackEntries,proto3" json:"feedback_entries,omitempty"`
-	TotalCount      int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *VolunteerTournamentRank) GetPreliminaryRounds() int32 {
+	if x != nil {
+		return x.PreliminaryRounds
+	}
+	return 0
 }
 
-func (x *GetStudentFeedbackResponse) Reset() {
-	*x = GetStudentFeedbackResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[74]
+func (x *VolunteerTournamentRank) GetEliminationRounds() int32 {
+	if x != nil {
+		return x.EliminationRounds
+	}
+	return 0
+}
+
+func (x *VolunteerTournamentRank) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *VolunteerTournamentRank) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+type TournamentVolunteerRankingResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Rankings      []*VolunteerTournamentRank `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	TotalCount    int32                      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentVolunteerRankingResponse) Reset() {
+	*x = TournamentVolunteerRankingResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentFeedbackResponse) String() string {
+func (x *TournamentVolunteerRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentFeedbackResponse) ProtoMessage() {}
+func (*TournamentVolunteerRankingResponse) ProtoMessage() {}
 
-func (x *GetStudentFeedbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[74]
+func (x *TournamentVolunteerRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4715,55 +10144,52 @@ func (x *GetStudentFeedbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentFeedbackResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentFeedbackResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use TournamentVolunteerRankingResponse.ProtoReflect.Descriptor instead.
+func (*TournamentVolunteerRankingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetStudentFeedbackResponse) GetFeedbackEntries() []*StudentFeedbackEntry {
+func (x *TournamentVolunteerRankingResponse) GetRankings() []*VolunteerTournamentRank {
 	if x != nil {
-		return x.FeedbackEntries
+		return x.Rankings
 	}
 	return nil
 }
 
-func (x *GetStudentFeedbackResponse) GetTotalCount() int32 {
+func (x *TournamentVolunteerRankingResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-type SubmitJudgeFeedbackRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	JudgeId                int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
-	DebateId               int32                  `protobuf:"varint,2,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
-	ClarityRating          float64                `protobuf:"fixed64,3,opt,name=clarity_rating,json=clarityRating,proto3" json:"clarity_rating,omitempty"`
-	ConstructivenessRating float64                `protobuf:"fixed64,4,opt,name=constructiveness_rating,json=constructivenessRating,proto3" json:"constructiveness_rating,omitempty"`
-	TimelinessRating       float64                `protobuf:"fixed64,5,opt,name=timeliness_rating,json=timelinessRating,proto3" json:"timeliness_rating,omitempty"`
-	FairnessRating         float64                `protobuf:"fixed64,6,opt,name=fairness_rating,json=fairnessRating,proto3" json:"fairness_rating,omitempty"`
-	EngagementRating       float64                `protobuf:"fixed64,7,opt,name=engagement_rating,json=engagementRating,proto3" json:"engagement_rating,omitempty"`
-	TextFeedback           string                 `protobuf:"bytes,8,opt,name=text_feedback,json=textFeedback,proto3" json:"text_feedback,omitempty"`
-	Token                  string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type JudgeCalibrationRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token        string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Standard deviations a ballot's average points may stray from the
+	// average ballot before it is flagged; 0 for 2
+	OutlierThreshold         float64 `protobuf:"fixed64,3,opt,name=outlier_threshold,json=outlierThreshold,proto3" json:"outlier_threshold,omitempty"`
+	IncludeNormalisedRanking bool    `protobuf:"varint,4,opt,name=include_normalised_ranking,json=includeNormalisedRanking,proto3" json:"include_normalised_ranking,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *SubmitJudgeFeedbackRequest) Reset() {
-	*x = SubmitJudgeFeedbackRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[75]
+func (x *JudgeCalibrationRequest) Reset() {
+	*x = JudgeCalibrationRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJudgeFeedbackRequest) String() string {
+func (x *JudgeCalibrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitJudgeFeedbackRequest) ProtoMessage() {}
+func (*JudgeCalibrationRequest) ProtoMessage() {}
 
-func (x *SubmitJudgeFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[75]
+func (x *JudgeCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4774,97 +10200,148 @@ func (x *SubmitJudgeFeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitJudgeFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*SubmitJudgeFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use JudgeCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*JudgeCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetJudgeId() int32 {
+func (x *JudgeCalibrationRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.JudgeId
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetDebateId() int32 {
+func (x *JudgeCalibrationRequest) GetToken() string {
 	if x != nil {
-		return x.DebateId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetClarityRating() float64 {
+func (x *JudgeCalibrationRequest) GetOutlierThreshold() float64 {
 	if x != nil {
-		return x.ClarityRating
+		return x.OutlierThreshold
 	}
 	return 0
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetConstructivenessRating() float64 {
+func (x *JudgeCalibrationRequest) GetIncludeNormalisedRanking() bool {
 	if x != nil {
-		return x.ConstructivenessRating
+		return x.IncludeNormalisedRanking
 	}
-	return 0
+	return false
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetTimelinessRating() float64 {
+type JudgeCalibrationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Over every speech of the tournament's recorded debates
+	MeanPoints float64             `protobuf:"fixed64,1,opt,name=mean_points,json=meanPoints,proto3" json:"mean_points,omitempty"`
+	StdDev     float64             `protobuf:"fixed64,2,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Judges     []*JudgeCalibration `protobuf:"bytes,3,rep,name=judges,proto3" json:"judges,omitempty"`
+	Outliers   []*OutlierBallot    `protobuf:"bytes,4,rep,name=outliers,proto3" json:"outliers,omitempty"`
+	// Speakers ranked on judge-normalised points, when asked for
+	NormalisedRanking []*StudentRanking `protobuf:"bytes,5,rep,name=normalised_ranking,json=normalisedRanking,proto3" json:"normalised_ranking,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *JudgeCalibrationResponse) Reset() {
+	*x = JudgeCalibrationResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JudgeCalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JudgeCalibrationResponse) ProtoMessage() {}
+
+func (x *JudgeCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[154]
 	if x != nil {
-		return x.TimelinessRating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetFairnessRating() float64 {
+// Deprecated: Use JudgeCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*JudgeCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *JudgeCalibrationResponse) GetMeanPoints() float64 {
 	if x != nil {
-		return x.FairnessRating
+		return x.MeanPoints
 	}
 	return 0
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetEngagementRating() float64 {
+func (x *JudgeCalibrationResponse) GetStdDev() float64 {
 	if x != nil {
-		return x.EngagementRating
+		return x.StdDev
 	}
 	return 0
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetTextFeedback() string {
+func (x *JudgeCalibrationResponse) GetJudges() []*JudgeCalibration {
 	if x != nil {
-		return x.TextFeedback
+		return x.Judges
 	}
-	return ""
+	return nil
 }
 
-func (x *SubmitJudgeFeedbackRequest) GetToken() string {
+func (x *JudgeCalibrationResponse) GetOutliers() []*OutlierBallot {
 	if x != nil {
-		return x.Token
+		return x.Outliers
 	}
-	return ""
+	return nil
 }
 
-type SubmitJudgeFeedbackResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *JudgeCalibrationResponse) GetNormalisedRanking() []*StudentRanking {
+	if x != nil {
+		return x.NormalisedRanking
+	}
+	return nil
+}
+
+type JudgeCalibration struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	JudgeId    int32                  `protobuf:"varint,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	JudgeName  string                 `protobuf:"bytes,2,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
+	Ballots    int32                  `protobuf:"varint,3,opt,name=ballots,proto3" json:"ballots,omitempty"`
+	Speeches   int32                  `protobuf:"varint,4,opt,name=speeches,proto3" json:"speeches,omitempty"`
+	MeanPoints float64                `protobuf:"fixed64,5,opt,name=mean_points,json=meanPoints,proto3" json:"mean_points,omitempty"`
+	StdDev     float64                `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	MinPoints  float64                `protobuf:"fixed64,7,opt,name=min_points,json=minPoints,proto3" json:"min_points,omitempty"`
+	MaxPoints  float64                `protobuf:"fixed64,8,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// How far the judge's ballots are, on average, above their rooms' average
+	RoomDeviation float64 `protobuf:"fixed64,9,opt,name=room_deviation,json=roomDeviation,proto3" json:"room_deviation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitJudgeFeedbackResponse) Reset() {
-	*x = SubmitJudgeFeedbackResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[76]
+func (x *JudgeCalibration) Reset() {
+	*x = JudgeCalibration{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJudgeFeedbackResponse) String() string {
+func (x *JudgeCalibration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitJudgeFeedbackResponse) ProtoMessage() {}
+func (*JudgeCalibration) ProtoMessage() {}
 
-func (x *SubmitJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[76]
+func (x *JudgeCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4875,118 +10352,104 @@ func (x *SubmitJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitJudgeFeedbackResponse.ProtoReflect.Descriptor instead.
-func (*SubmitJudgeFeedbackResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use JudgeCalibration.ProtoReflect.Descriptor instead.
+func (*JudgeCalibration) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *SubmitJudgeFeedbackResponse) GetSuccess() bool {
+func (x *JudgeCalibration) GetJudgeId() int32 {
 	if x != nil {
-		return x.Success
+		return x.JudgeId
 	}
-	return false
+	return 0
 }
 
-func (x *SubmitJudgeFeedbackResponse) GetMessage() string {
+func (x *JudgeCalibration) GetJudgeName() string {
 	if x != nil {
-		return x.Message
+		return x.JudgeName
 	}
 	return ""
 }
 
-type GetJudgeFeedbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetJudgeFeedbackRequest) Reset() {
-	*x = GetJudgeFeedbackRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JudgeCalibration) GetBallots() int32 {
+	if x != nil {
+		return x.Ballots
+	}
+	return 0
 }
 
-func (x *GetJudgeFeedbackRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JudgeCalibration) GetSpeeches() int32 {
+	if x != nil {
+		return x.Speeches
+	}
+	return 0
 }
 
-func (*GetJudgeFeedbackRequest) ProtoMessage() {}
-
-func (x *GetJudgeFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[77]
+func (x *JudgeCalibration) GetMeanPoints() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MeanPoints
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetJudgeFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*GetJudgeFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{77}
+func (x *JudgeCalibration) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
 }
 
-func (x *GetJudgeFeedbackRequest) GetToken() string {
+func (x *JudgeCalibration) GetMinPoints() float64 {
 	if x != nil {
-		return x.Token
+		return x.MinPoints
 	}
-	return ""
+	return 0
 }
 
-func (x *GetJudgeFeedbackRequest) GetPage() int32 {
+func (x *JudgeCalibration) GetMaxPoints() float64 {
 	if x != nil {
-		return x.Page
+		return x.MaxPoints
 	}
 	return 0
 }
 
-func (x *GetJudgeFeedbackRequest) GetPageSize() int32 {
+func (x *JudgeCalibration) GetRoomDeviation() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.RoomDeviation
 	}
 	return 0
 }
 
-type JudgeFeedbackEntry struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	StudentAlias           string                 `protobuf:"bytes,1,opt,name=student_alias,json=studentAlias,proto3" json:"student_alias,omitempty"`
-	TournamentDate         string                 `protobuf:"bytes,2,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
-	IsRead                 bool                   `protobuf:"varint,3,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	ClarityRating          float64                `protobuf:"fixed64,4,opt,name=clarity_rating,json=clarityRating,proto3" json:"clarity_rating,omitempty"`
-	ConstructivenessRating float64                `protobuf:"fixed64,5,opt,name=constructiveness_rating,json=constructivenessRating,proto3" json:"constructiveness_rating,omitempty"`
-	TimelinessRating       float64                `protobuf:"fixed64,6,opt,name=timeliness_rating,json=timelinessRating,proto3" json:"timeliness_rating,omitempty"`
-	FairnessRating         float64                `protobuf:"fixed64,7,opt,name=fairness_rating,json=fairnessRating,proto3" json:"fairness_rating,omitempty"`
-	EngagementRating       float64                `protobuf:"fixed64,8,opt,name=engagement_rating,json=engagementRating,proto3" json:"engagement_rating,omitempty"`
-	TextFeedback           string                 `protobuf:"bytes,9,opt,name=text_feedback,json=textFeedback,proto3" json:"text_feedback,omitempty"`
-	RoundNumber            int32                  `protobuf:"varint,10,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	IsEliminationRound     bool                   `protobuf:"varint,11,opt,name=is_elimination_round,json=isEliminationRound,proto3" json:"is_elimination_round,omitempty"`
-	FeedbackId             int32                  `protobuf:"varint,12,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type OutlierBallot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JudgeBallotId int32                  `protobuf:"varint,1,opt,name=judge_ballot_id,json=judgeBallotId,proto3" json:"judge_ballot_id,omitempty"`
+	JudgeId       int32                  `protobuf:"varint,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	JudgeName     string                 `protobuf:"bytes,3,opt,name=judge_name,json=judgeName,proto3" json:"judge_name,omitempty"`
+	DebateId      int32                  `protobuf:"varint,4,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,5,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,6,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	MeanPoints    float64                `protobuf:"fixed64,7,opt,name=mean_points,json=meanPoints,proto3" json:"mean_points,omitempty"`
+	RoomAverage   float64                `protobuf:"fixed64,8,opt,name=room_average,json=roomAverage,proto3" json:"room_average,omitempty"`
+	ZScore        float64                `protobuf:"fixed64,9,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JudgeFeedbackEntry) Reset() {
-	*x = JudgeFeedbackEntry{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[78]
+func (x *OutlierBallot) Reset() {
+	*x = OutlierBallot{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JudgeFeedbackEntry) String() string {
+func (x *OutlierBallot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JudgeFeedbackEntry) ProtoMessage() {}
+func (*OutlierBallot) ProtoMessage() {}
 
-func (x *JudgeFeedbackEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[78]
+func (x *OutlierBallot) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4997,118 +10460,173 @@ func (x *JudgeFeedbackEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JudgeFeedbackEntry.ProtoReflect.Descriptor instead.
-func (*JudgeFeedbackEntry) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use OutlierBallot.ProtoReflect.Descriptor instead.
+func (*OutlierBallot) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *JudgeFeedbackEntry) GetStudentAlias() string {
+func (x *OutlierBallot) GetJudgeBallotId() int32 {
 	if x != nil {
-		return x.StudentAlias
+		return x.JudgeBallotId
 	}
-	return ""
+	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetTournamentDate() string {
+func (x *OutlierBallot) GetJudgeId() int32 {
 	if x != nil {
-		return x.TournamentDate
+		return x.JudgeId
+	}
+	return 0
+}
+
+func (x *OutlierBallot) GetJudgeName() string {
+	if x != nil {
+		return x.JudgeName
 	}
 	return ""
 }
 
-func (x *JudgeFeedbackEntry) GetIsRead() bool {
+func (x *OutlierBallot) GetDebateId() int32 {
 	if x != nil {
-		return x.IsRead
+		return x.DebateId
 	}
-	return false
+	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetClarityRating() float64 {
+func (x *OutlierBallot) GetRoundNumber() int32 {
 	if x != nil {
-		return x.ClarityRating
+		return x.RoundNumber
 	}
 	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetConstructivenessRating() float64 {
+func (x *OutlierBallot) GetIsElimination() bool {
 	if x != nil {
-		return x.ConstructivenessRating
+		return x.IsElimination
+	}
+	return false
+}
+
+func (x *OutlierBallot) GetMeanPoints() float64 {
+	if x != nil {
+		return x.MeanPoints
 	}
 	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetTimelinessRating() float64 {
+func (x *OutlierBallot) GetRoomAverage() float64 {
 	if x != nil {
-		return x.TimelinessRating
+		return x.RoomAverage
 	}
 	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetFairnessRating() float64 {
+func (x *OutlierBallot) GetZScore() float64 {
 	if x != nil {
-		return x.FairnessRating
+		return x.ZScore
 	}
 	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetEngagementRating() float64 {
+type SetRankingVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	RankingType   string                 `protobuf:"bytes,2,opt,name=ranking_type,json=rankingType,proto3" json:"ranking_type,omitempty"` // "student", "team", "school", "volunteer"
+	VisibleTo     string                 `protobuf:"bytes,3,opt,name=visible_to,json=visibleTo,proto3" json:"visible_to,omitempty"`       // "volunteer", "school", "student"
+	IsVisible     bool                   `protobuf:"varint,4,opt,name=is_visible,json=isVisible,proto3" json:"is_visible,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRankingVisibilityRequest) Reset() {
+	*x = SetRankingVisibilityRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRankingVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRankingVisibilityRequest) ProtoMessage() {}
+
+func (x *SetRankingVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[157]
 	if x != nil {
-		return x.EngagementRating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRankingVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetRankingVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SetRankingVisibilityRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *JudgeFeedbackEntry) GetTextFeedback() string {
+func (x *SetRankingVisibilityRequest) GetRankingType() string {
 	if x != nil {
-		return x.TextFeedback
+		return x.RankingType
 	}
 	return ""
 }
 
-func (x *JudgeFeedbackEntry) GetRoundNumber() int32 {
+func (x *SetRankingVisibilityRequest) GetVisibleTo() string {
 	if x != nil {
-		return x.RoundNumber
+		return x.VisibleTo
 	}
-	return 0
+	return ""
 }
 
-func (x *JudgeFeedbackEntry) GetIsEliminationRound() bool {
+func (x *SetRankingVisibilityRequest) GetIsVisible() bool {
 	if x != nil {
-		return x.IsEliminationRound
+		return x.IsVisible
 	}
 	return false
 }
 
-func (x *JudgeFeedbackEntry) GetFeedbackId() int32 {
+func (x *SetRankingVisibilityRequest) GetToken() string {
 	if x != nil {
-		return x.FeedbackId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type GetJudgeFeedbackResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	FeedbackEntries []*JudgeFeedbackEntry  `protobuf:"bytes,1,rep,name=feedback_entries,json=feedbackEntries,proto3" json:"feedback_entries,omitempty"`
-	TotalCount      int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SetRankingVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJudgeFeedbackResponse) Reset() {
-	*x = GetJudgeFeedbackResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[79]
+func (x *SetRankingVisibilityResponse) Reset() {
+	*x = SetRankingVisibilityResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJudgeFeedbackResponse) String() string {
+func (x *SetRankingVisibilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJudgeFeedbackResponse) ProtoMessage() {}
+func (*SetRankingVisibilityResponse) ProtoMessage() {}
 
-func (x *GetJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[79]
+func (x *SetRankingVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5119,47 +10637,49 @@ func (x *GetJudgeFeedbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJudgeFeedbackResponse.ProtoReflect.Descriptor instead.
-func (*GetJudgeFeedbackResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use SetRankingVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*SetRankingVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *GetJudgeFeedbackResponse) GetFeedbackEntries() []*JudgeFeedbackEntry {
+func (x *SetRankingVisibilityResponse) GetSuccess() bool {
 	if x != nil {
-		return x.FeedbackEntries
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GetJudgeFeedbackResponse) GetTotalCount() int32 {
+func (x *SetRankingVisibilityResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetVolunteerRankingRequest struct {
+// Published tab messages
+type PublishTabRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVolunteerRankingRequest) Reset() {
-	*x = GetVolunteerRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[80]
+func (x *PublishTabRequest) Reset() {
+	*x = PublishTabRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolunteerRankingRequest) String() string {
+func (x *PublishTabRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolunteerRankingRequest) ProtoMessage() {}
+func (*PublishTabRequest) ProtoMessage() {}
 
-func (x *GetVolunteerRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[80]
+func (x *PublishTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5170,43 +10690,49 @@ func (x *GetVolunteerRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolunteerRankingRequest.ProtoReflect.Descriptor instead.
-func (*GetVolunteerRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use PublishTabRequest.ProtoReflect.Descriptor instead.
+func (*PublishTabRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *GetVolunteerRankingRequest) GetToken() string {
+func (x *PublishTabRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *PublishTabRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type TopVolunteer struct {
+type PublishTabResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	AverageRating float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
-	RankChange    int32                  `protobuf:"varint,4,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tab           *PublishedTab          `protobuf:"bytes,3,opt,name=tab,proto3" json:"tab,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopVolunteer) Reset() {
-	*x = TopVolunteer{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[81]
+func (x *PublishTabResponse) Reset() {
+	*x = PublishTabResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopVolunteer) String() string {
+func (x *PublishTabResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopVolunteer) ProtoMessage() {}
+func (*PublishTabResponse) ProtoMessage() {}
 
-func (x *TopVolunteer) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[81]
+func (x *PublishTabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5217,62 +10743,110 @@ func (x *TopVolunteer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopVolunteer.ProtoReflect.Descriptor instead.
-func (*TopVolunteer) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use PublishTabResponse.ProtoReflect.Descriptor instead.
+func (*PublishTabResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *TopVolunteer) GetRank() int32 {
+func (x *PublishTabResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Rank
+		return x.Success
 	}
-	return 0
+	return false
+}
+
+func (x *PublishTabResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishTabResponse) GetTab() *PublishedTab {
+	if x != nil {
+		return x.Tab
+	}
+	return nil
+}
+
+// The published tab is public, so no token is needed
+type GetPublishedTabRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 for the latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopVolunteer) GetName() string {
+func (x *GetPublishedTabRequest) Reset() {
+	*x = GetPublishedTabRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublishedTabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublishedTabRequest) ProtoMessage() {}
+
+func (x *GetPublishedTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[161]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TopVolunteer) GetAverageRating() float64 {
+// Deprecated: Use GetPublishedTabRequest.ProtoReflect.Descriptor instead.
+func (*GetPublishedTabRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetPublishedTabRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.AverageRating
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *TopVolunteer) GetRankChange() int32 {
+func (x *GetPublishedTabRequest) GetVersion() int32 {
 	if x != nil {
-		return x.RankChange
+		return x.Version
 	}
 	return 0
 }
 
-type VolunteerInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetPublishedTabResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Tab      *PublishedTab          `protobuf:"bytes,1,opt,name=tab,proto3" json:"tab,omitempty"`
+	Versions []int32                `protobuf:"varint,2,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	// Ballots of the tournament changed since this version was published
+	ChangedBallots int32 `protobuf:"varint,3,opt,name=changed_ballots,json=changedBallots,proto3" json:"changed_ballots,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *VolunteerInfo) Reset() {
-	*x = VolunteerInfo{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[82]
+func (x *GetPublishedTabResponse) Reset() {
+	*x = GetPublishedTabResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolunteerInfo) String() string {
+func (x *GetPublishedTabResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolunteerInfo) ProtoMessage() {}
+func (*GetPublishedTabResponse) ProtoMessage() {}
 
-func (x *VolunteerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[82]
+func (x *GetPublishedTabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5283,51 +10857,56 @@ func (x *VolunteerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolunteerInfo.ProtoReflect.Descriptor instead.
-func (*VolunteerInfo) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use GetPublishedTabResponse.ProtoReflect.Descriptor instead.
+func (*GetPublishedTabResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *VolunteerInfo) GetName() string {
+func (x *GetPublishedTabResponse) GetTab() *PublishedTab {
 	if x != nil {
-		return x.Name
+		return x.Tab
 	}
-	return ""
+	return nil
 }
 
-func (x *VolunteerInfo) GetAverageRating() float64 {
+func (x *GetPublishedTabResponse) GetVersions() []int32 {
 	if x != nil {
-		return x.AverageRating
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *GetPublishedTabResponse) GetChangedBallots() int32 {
+	if x != nil {
+		return x.ChangedBallots
 	}
 	return 0
 }
 
-type GetVolunteerRankingResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	VolunteerRank   int32                  `protobuf:"varint,1,opt,name=volunteer_rank,json=volunteerRank,proto3" json:"volunteer_rank,omitempty"`
-	TotalVolunteers int32                  `protobuf:"varint,2,opt,name=total_volunteers,json=totalVolunteers,proto3" json:"total_volunteers,omitempty"`
-	RankChange      int32                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`
-	TopVolunteers   []*TopVolunteer        `protobuf:"bytes,4,rep,name=top_volunteers,json=topVolunteers,proto3" json:"top_volunteers,omitempty"`
-	VolunteerInfo   *VolunteerInfo         `protobuf:"bytes,5,opt,name=volunteer_info,json=volunteerInfo,proto3" json:"volunteer_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ExportPublishedTabRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 for the latest
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`    // "csv" or "json"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVolunteerRankingResponse) Reset() {
-	*x = GetVolunteerRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[83]
+func (x *ExportPublishedTabRequest) Reset() {
+	*x = ExportPublishedTabRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolunteerRankingResponse) String() string {
+func (x *ExportPublishedTabRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolunteerRankingResponse) ProtoMessage() {}
+func (*ExportPublishedTabRequest) ProtoMessage() {}
 
-func (x *GetVolunteerRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[83]
+func (x *ExportPublishedTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5338,70 +10917,56 @@ func (x *GetVolunteerRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolunteerRankingResponse.ProtoReflect.Descriptor instead.
-func (*GetVolunteerRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *GetVolunteerRankingResponse) GetVolunteerRank() int32 {
-	if x != nil {
-		return x.VolunteerRank
-	}
-	return 0
+// Deprecated: Use ExportPublishedTabRequest.ProtoReflect.Descriptor instead.
+func (*ExportPublishedTabRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *GetVolunteerRankingResponse) GetTotalVolunteers() int32 {
+func (x *ExportPublishedTabRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.TotalVolunteers
+		return x.TournamentId
 	}
 	return 0
 }
 
-func (x *GetVolunteerRankingResponse) GetRankChange() int32 {
+func (x *ExportPublishedTabRequest) GetVersion() int32 {
 	if x != nil {
-		return x.RankChange
+		return x.Version
 	}
 	return 0
 }
 
-func (x *GetVolunteerRankingResponse) GetTopVolunteers() []*TopVolunteer {
-	if x != nil {
-		return x.TopVolunteers
-	}
-	return nil
-}
-
-func (x *GetVolunteerRankingResponse) GetVolunteerInfo() *VolunteerInfo {
+func (x *ExportPublishedTabRequest) GetFormat() string {
 	if x != nil {
-		return x.VolunteerInfo
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-type GetVolunteerPerformanceRequest struct {
+type ExportPublishedTabResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVolunteerPerformanceRequest) Reset() {
-	*x = GetVolunteerPerformanceRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[84]
+func (x *ExportPublishedTabResponse) Reset() {
+	*x = ExportPublishedTabResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolunteerPerformanceRequest) String() string {
+func (x *ExportPublishedTabResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolunteerPerformanceRequest) ProtoMessage() {}
+func (*ExportPublishedTabResponse) ProtoMessage() {}
 
-func (x *GetVolunteerPerformanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[84]
+func (x *ExportPublishedTabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,57 +10977,61 @@ func (x *GetVolunteerPerformanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolunteerPerformanceRequest.ProtoReflect.Descriptor instead.
-func (*GetVolunteerPerformanceRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use ExportPublishedTabResponse.ProtoReflect.Descriptor instead.
+func (*ExportPublishedTabResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *GetVolunteerPerformanceRequest) GetStartDate() string {
+func (x *ExportPublishedTabResponse) GetFileName() string {
 	if x != nil {
-		return x.StartDate
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *GetVolunteerPerformanceRequest) GetEndDate() string {
+func (x *ExportPublishedTabResponse) GetContentType() string {
 	if x != nil {
-		return x.EndDate
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *GetVolunteerPerformanceRequest) GetToken() string {
+func (x *ExportPublishedTabResponse) GetContent() []byte {
 	if x != nil {
-		return x.Token
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type VolunteerPerformanceData struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	TournamentDate         string                 `protobuf:"bytes,1,opt,name=tournament_date,json=tournamentDate,proto3" json:"tournament_date,omitempty"`
-	VolunteerAverageRating float64                `protobuf:"fixed64,2,opt,name=volunteer_average_rating,json=volunteerAverageRating,proto3" json:"volunteer_average_rating,omitempty"`
-	OverallAverageRating   float64                `protobuf:"fixed64,3,opt,name=overall_average_rating,json=overallAverageRating,proto3" json:"overall_average_rating,omitempty"`
-	TournamentRank         int32                  `protobuf:"varint,4,opt,name=tournament_rank,json=tournamentRank,proto3" json:"tournament_rank,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type PublishedTab struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId     int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	TournamentName   string                 `protobuf:"bytes,2,opt,name=tournament_name,json=tournamentName,proto3" json:"tournament_name,omitempty"`
+	Version          int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PublishedAt      string                 `protobuf:"bytes,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	TeamStandings    []*TeamRanking         `protobuf:"bytes,5,rep,name=team_standings,json=teamStandings,proto3" json:"team_standings,omitempty"`
+	SpeakerStandings []*StudentRanking      `protobuf:"bytes,6,rep,name=speaker_standings,json=speakerStandings,proto3" json:"speaker_standings,omitempty"`
+	Breaks           []*TabBreak            `protobuf:"bytes,7,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	Rounds           []*TabRound            `protobuf:"bytes,8,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *VolunteerPerformanceData) Reset() {
-	*x = VolunteerPerformanceData{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[85]
+func (x *PublishedTab) Reset() {
+	*x = PublishedTab{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolunteerPerformanceData) String() string {
+func (x *PublishedTab) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolunteerPerformanceData) ProtoMessage() {}
+func (*PublishedTab) ProtoMessage() {}
 
-func (x *VolunteerPerformanceData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[85]
+func (x *PublishedTab) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5473,61 +11042,90 @@ func (x *VolunteerPerformanceData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolunteerPerformanceData.ProtoReflect.Descriptor instead.
-func (*VolunteerPerformanceData) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use PublishedTab.ProtoReflect.Descriptor instead.
+func (*PublishedTab) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *VolunteerPerformanceData) GetTournamentDate() string {
+func (x *PublishedTab) GetTournamentId() int32 {
 	if x != nil {
-		return x.TournamentDate
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *PublishedTab) GetTournamentName() string {
+	if x != nil {
+		return x.TournamentName
 	}
 	return ""
 }
 
-func (x *VolunteerPerformanceData) GetVolunteerAverageRating() float64 {
+func (x *PublishedTab) GetVersion() int32 {
 	if x != nil {
-		return x.VolunteerAverageRating
+		return x.Version
 	}
 	return 0
 }
 
-func (x *VolunteerPerformanceData) GetOverallAverageRating() float64 {
+func (x *PublishedTab) GetPublishedAt() string {
 	if x != nil {
-		return x.OverallAverageRating
+		return x.PublishedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *VolunteerPerformanceData) GetTournamentRank() int32 {
+func (x *PublishedTab) GetTeamStandings() []*TeamRanking {
 	if x != nil {
-		return x.TournamentRank
+		return x.TeamStandings
 	}
-	return 0
+	return nil
 }
 
-type GetVolunteerPerformanceResponse struct {
-	state           protoimpl.MessageState      `protogen:"open.v1"`
-	PerformanceData []*VolunteerPerformanceData `protobuf:"bytes,1,rep,name=performance_data,json=performanceData,proto3" json:"performance_data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PublishedTab) GetSpeakerStandings() []*StudentRanking {
+	if x != nil {
+		return x.SpeakerStandings
+	}
+	return nil
 }
 
-func (x *GetVolunteerPerformanceResponse) Reset() {
-	*x = GetVolunteerPerformanceResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[86]
+func (x *PublishedTab) GetBreaks() []*TabBreak {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+func (x *PublishedTab) GetRounds() []*TabRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type TabBreak struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // Empty for a tournament without break categories
+	Teams         []*TabBreakingTeam     `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TabBreak) Reset() {
+	*x = TabBreak{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolunteerPerformanceResponse) String() string {
+func (x *TabBreak) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolunteerPerformanceResponse) ProtoMessage() {}
+func (*TabBreak) ProtoMessage() {}
 
-func (x *GetVolunteerPerformanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[86]
+func (x *TabBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5538,41 +11136,49 @@ func (x *GetVolunteerPerformanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolunteerPerformanceResponse.ProtoReflect.Descriptor instead.
-func (*GetVolunteerPerformanceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use TabBreak.ProtoReflect.Descriptor instead.
+func (*TabBreak) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetVolunteerPerformanceResponse) GetPerformanceData() []*VolunteerPerformanceData {
+func (x *TabBreak) GetCategoryName() string {
 	if x != nil {
-		return x.PerformanceData
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *TabBreak) GetTeams() []*TabBreakingTeam {
+	if x != nil {
+		return x.Teams
 	}
 	return nil
 }
 
-type MarkFeedbackAsReadRequest struct {
+type TabBreakingTeam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FeedbackId    int32                  `protobuf:"varint,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Seed          int32                  `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkFeedbackAsReadRequest) Reset() {
-	*x = MarkFeedbackAsReadRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[87]
+func (x *TabBreakingTeam) Reset() {
+	*x = TabBreakingTeam{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkFeedbackAsReadRequest) String() string {
+func (x *TabBreakingTeam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkFeedbackAsReadRequest) ProtoMessage() {}
+func (*TabBreakingTeam) ProtoMessage() {}
 
-func (x *MarkFeedbackAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[87]
+func (x *TabBreakingTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5583,48 +11189,59 @@ func (x *MarkFeedbackAsReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkFeedbackAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkFeedbackAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use TabBreakingTeam.ProtoReflect.Descriptor instead.
+func (*TabBreakingTeam) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *MarkFeedbackAsReadRequest) GetFeedbackId() int32 {
+func (x *TabBreakingTeam) GetSeed() int32 {
 	if x != nil {
-		return x.FeedbackId
+		return x.Seed
 	}
 	return 0
 }
 
-func (x *MarkFeedbackAsReadRequest) GetToken() string {
+func (x *TabBreakingTeam) GetTeamId() int32 {
 	if x != nil {
-		return x.Token
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TabBreakingTeam) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
 	}
 	return ""
 }
 
-type MarkFeedbackAsReadResponse struct {
+type TabRound struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RoundNumber   int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	IsElimination bool                   `protobuf:"varint,2,opt,name=is_elimination,json=isElimination,proto3" json:"is_elimination,omitempty"`
+	Motion        string                 `protobuf:"bytes,3,opt,name=motion,proto3" json:"motion,omitempty"`
+	InfoSlide     string                 `protobuf:"bytes,4,opt,name=info_slide,json=infoSlide,proto3" json:"info_slide,omitempty"`
+	Debates       []*TabDebate           `protobuf:"bytes,5,rep,name=debates,proto3" json:"debates,omitempty"`
+	Byes          []*Bye                 `protobuf:"bytes,6,rep,name=byes,proto3" json:"byes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkFeedbackAsReadResponse) Reset() {
-	*x = MarkFeedbackAsReadResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[88]
+func (x *TabRound) Reset() {
+	*x = TabRound{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkFeedbackAsReadResponse) String() string {
+func (x *TabRound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkFeedbackAsReadResponse) ProtoMessage() {}
+func (*TabRound) ProtoMessage() {}
 
-func (x *MarkFeedbackAsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[88]
+func (x *TabRound) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5635,51 +11252,77 @@ func (x *MarkFeedbackAsReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkFeedbackAsReadResponse.ProtoReflect.Descriptor instead.
-func (*MarkFeedbackAsReadResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use TabRound.ProtoReflect.Descriptor instead.
+func (*TabRound) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *MarkFeedbackAsReadResponse) GetSuccess() bool {
+func (x *TabRound) GetRoundNumber() int32 {
 	if x != nil {
-		return x.Success
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *TabRound) GetIsElimination() bool {
+	if x != nil {
+		return x.IsElimination
 	}
 	return false
 }
 
-func (x *MarkFeedbackAsReadResponse) GetMessage() string {
+func (x *TabRound) GetMotion() string {
 	if x != nil {
-		return x.Message
+		return x.Motion
 	}
 	return ""
 }
 
-type TournamentVolunteerRankingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+func (x *TabRound) GetInfoSlide() string {
+	if x != nil {
+		return x.InfoSlide
+	}
+	return ""
+}
+
+func (x *TabRound) GetDebates() []*TabDebate {
+	if x != nil {
+		return x.Debates
+	}
+	return nil
+}
+
+func (x *TabRound) GetByes() []*Bye {
+	if x != nil {
+		return x.Byes
+	}
+	return nil
+}
+
+type TabDebate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebateId      int32                  `protobuf:"varint,1,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	Teams         []*TabDebateTeam       `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentVolunteerRankingRequest) Reset() {
-	*x = TournamentVolunteerRankingRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[89]
+func (x *TabDebate) Reset() {
+	*x = TabDebate{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentVolunteerRankingRequest) String() string {
+func (x *TabDebate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentVolunteerRankingRequest) ProtoMessage() {}
+func (*TabDebate) ProtoMessage() {}
 
-func (x *TournamentVolunteerRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[89]
+func (x *TabDebate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5690,74 +11333,60 @@ func (x *TournamentVolunteerRankingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentVolunteerRankingRequest.ProtoReflect.Descriptor instead.
-func (*TournamentVolunteerRankingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use TabDebate.ProtoReflect.Descriptor instead.
+func (*TabDebate) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *TournamentVolunteerRankingRequest) GetTournamentId() int32 {
+func (x *TabDebate) GetDebateId() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.DebateId
 	}
 	return 0
 }
 
-func (x *TournamentVolunteerRankingRequest) GetToken() string {
+func (x *TabDebate) GetRoomName() string {
 	if x != nil {
-		return x.Token
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *TournamentVolunteerRankingRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *TournamentVolunteerRankingRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *TournamentVolunteerRankingRequest) GetSearch() string {
+func (x *TabDebate) GetTeams() []*TabDebateTeam {
 	if x != nil {
-		return x.Search
+		return x.Teams
 	}
-	return ""
+	return nil
 }
 
-type VolunteerTournamentRank struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	VolunteerId       int32                  `protobuf:"varint,1,opt,name=volunteer_id,json=volunteerId,proto3" json:"volunteer_id,omitempty"`
-	VolunteerName     string                 `protobuf:"bytes,2,opt,name=volunteer_name,json=volunteerName,proto3" json:"volunteer_name,omitempty"`
-	AverageRating     float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
-	PreliminaryRounds int32                  `protobuf:"varint,4,opt,name=preliminary_rounds,json=preliminaryRounds,proto3" json:"preliminary_rounds,omitempty"`
-	EliminationRounds int32                  `protobuf:"varint,5,opt,name=elimination_rounds,json=eliminationRounds,proto3" json:"elimination_rounds,omitempty"`
-	Rank              int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
-	Place             int32                  `protobuf:"varint,7,opt,name=place,proto3" json:"place,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type TabDebateTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Side          int32                  `protobuf:"varint,3,opt,name=side,proto3" json:"side,omitempty"` // The team's place on the debate, 1 to 4
+	TeamPoints    int32                  `protobuf:"varint,4,opt,name=team_points,json=teamPoints,proto3" json:"team_points,omitempty"`
+	TotalPoints   float64                `protobuf:"fixed64,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	Won           bool                   `protobuf:"varint,7,opt,name=won,proto3" json:"won,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolunteerTournamentRank) Reset() {
-	*x = VolunteerTournamentRank{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[90]
+func (x *TabDebateTeam) Reset() {
+	*x = TabDebateTeam{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolunteerTournamentRank) String() string {
+func (x *TabDebateTeam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolunteerTournamentRank) ProtoMessage() {}
+func (*TabDebateTeam) ProtoMessage() {}
 
-func (x *VolunteerTournamentRank) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[90]
+func (x *TabDebateTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5768,83 +11397,83 @@ func (x *VolunteerTournamentRank) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolunteerTournamentRank.ProtoReflect.Descriptor instead.
-func (*VolunteerTournamentRank) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use TabDebateTeam.ProtoReflect.Descriptor instead.
+func (*TabDebateTeam) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *VolunteerTournamentRank) GetVolunteerId() int32 {
+func (x *TabDebateTeam) GetTeamId() int32 {
 	if x != nil {
-		return x.VolunteerId
+		return x.TeamId
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentRank) GetVolunteerName() string {
+func (x *TabDebateTeam) GetTeamName() string {
 	if x != nil {
-		return x.VolunteerName
+		return x.TeamName
 	}
 	return ""
 }
 
-func (x *VolunteerTournamentRank) GetAverageRating() float64 {
+func (x *TabDebateTeam) GetSide() int32 {
 	if x != nil {
-		return x.AverageRating
+		return x.Side
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentRank) GetPreliminaryRounds() int32 {
+func (x *TabDebateTeam) GetTeamPoints() int32 {
 	if x != nil {
-		return x.PreliminaryRounds
+		return x.TeamPoints
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentRank) GetEliminationRounds() int32 {
+func (x *TabDebateTeam) GetTotalPoints() float64 {
 	if x != nil {
-		return x.EliminationRounds
+		return x.TotalPoints
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentRank) GetRank() int32 {
+func (x *TabDebateTeam) GetRank() int32 {
 	if x != nil {
 		return x.Rank
 	}
 	return 0
 }
 
-func (x *VolunteerTournamentRank) GetPlace() int32 {
+func (x *TabDebateTeam) GetWon() bool {
 	if x != nil {
-		return x.Place
+		return x.Won
 	}
-	return 0
+	return false
 }
 
-type TournamentVolunteerRankingResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Rankings      []*VolunteerTournamentRank `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
-	TotalCount    int32                      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+type TabIntegrityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentVolunteerRankingResponse) Reset() {
-	*x = TournamentVolunteerRankingResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[91]
+func (x *TabIntegrityRequest) Reset() {
+	*x = TabIntegrityRequest{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentVolunteerRankingResponse) String() string {
+func (x *TabIntegrityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentVolunteerRankingResponse) ProtoMessage() {}
+func (*TabIntegrityRequest) ProtoMessage() {}
 
-func (x *TournamentVolunteerRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[91]
+func (x *TabIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5855,51 +11484,51 @@ func (x *TournamentVolunteerRankingResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentVolunteerRankingResponse.ProtoReflect.Descriptor instead.
-func (*TournamentVolunteerRankingResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use TabIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*TabIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *TournamentVolunteerRankingResponse) GetRankings() []*VolunteerTournamentRank {
+func (x *TabIntegrityRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.Rankings
+		return x.TournamentId
 	}
-	return nil
+	return 0
 }
 
-func (x *TournamentVolunteerRankingResponse) GetTotalCount() int32 {
+func (x *TabIntegrityRequest) GetToken() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type SetRankingVisibilityRequest struct {
+type TabIntegrityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	RankingType   string                 `protobuf:"bytes,2,opt,name=ranking_type,json=rankingType,proto3" json:"ranking_type,omitempty"` // "student", "team", "school", "volunteer"
-	VisibleTo     string                 `protobuf:"bytes,3,opt,name=visible_to,json=visibleTo,proto3" json:"visible_to,omitempty"`       // "volunteer", "school", "student"
-	IsVisible     bool                   `protobuf:"varint,4,opt,name=is_visible,json=isVisible,proto3" json:"is_visible,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetRankingVisibilityRequest) Reset() {
-	*x = SetRankingVisibilityRequest{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[92]
+	Discrepancies []*TabDiscrepancy      `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Repaired      bool                   `protobuf:"varint,2,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	// The standings recomputed from the ballots
+	TeamStandings    []*TeamRanking    `protobuf:"bytes,3,rep,name=team_standings,json=teamStandings,proto3" json:"team_standings,omitempty"`
+	SpeakerStandings []*StudentRanking `protobuf:"bytes,4,rep,name=speaker_standings,json=speakerStandings,proto3" json:"speaker_standings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TabIntegrityResponse) Reset() {
+	*x = TabIntegrityResponse{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetRankingVisibilityRequest) String() string {
+func (x *TabIntegrityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRankingVisibilityRequest) ProtoMessage() {}
+func (*TabIntegrityResponse) ProtoMessage() {}
 
-func (x *SetRankingVisibilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[92]
+func (x *TabIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5910,69 +11539,67 @@ func (x *SetRankingVisibilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRankingVisibilityRequest.ProtoReflect.Descriptor instead.
-func (*SetRankingVisibilityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *SetRankingVisibilityRequest) GetTournamentId() int32 {
-	if x != nil {
-		return x.TournamentId
-	}
-	return 0
+// Deprecated: Use TabIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*TabIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *SetRankingVisibilityRequest) GetRankingType() string {
+func (x *TabIntegrityResponse) GetDiscrepancies() []*TabDiscrepancy {
 	if x != nil {
-		return x.RankingType
+		return x.Discrepancies
 	}
-	return ""
+	return nil
 }
 
-func (x *SetRankingVisibilityRequest) GetVisibleTo() string {
+func (x *TabIntegrityResponse) GetRepaired() bool {
 	if x != nil {
-		return x.VisibleTo
+		return x.Repaired
 	}
-	return ""
+	return false
 }
 
-func (x *SetRankingVisibilityRequest) GetIsVisible() bool {
+func (x *TabIntegrityResponse) GetTeamStandings() []*TeamRanking {
 	if x != nil {
-		return x.IsVisible
+		return x.TeamStandings
 	}
-	return false
+	return nil
 }
 
-func (x *SetRankingVisibilityRequest) GetToken() string {
+func (x *TabIntegrityResponse) GetSpeakerStandings() []*StudentRanking {
 	if x != nil {
-		return x.Token
+		return x.SpeakerStandings
 	}
-	return ""
+	return nil
 }
 
-type SetRankingVisibilityResponse struct {
+// A stored aggregate that differs from the one recomputed from the ballots
+type TabDiscrepancy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	DebateId      int32                  `protobuf:"varint,3,opt,name=debate_id,json=debateId,proto3" json:"debate_id,omitempty"` // 0 for the team's totals
+	Field         string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	Stored        string                 `protobuf:"bytes,5,opt,name=stored,proto3" json:"stored,omitempty"`
+	Recomputed    string                 `protobuf:"bytes,6,opt,name=recomputed,proto3" json:"recomputed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetRankingVisibilityResponse) Reset() {
-	*x = SetRankingVisibilityResponse{}
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[93]
+func (x *TabDiscrepancy) Reset() {
+	*x = TabDiscrepancy{}
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetRankingVisibilityResponse) String() string {
+func (x *TabDiscrepancy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRankingVisibilityResponse) ProtoMessage() {}
+func (*TabDiscrepancy) ProtoMessage() {}
 
-func (x *SetRankingVisibilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[93]
+func (x *TabDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_proto_debate_management_debate_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5983,21 +11610,49 @@ func (x *SetRankingVisibilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRankingVisibilityResponse.ProtoReflect.Descriptor instead.
-func (*SetRankingVisibilityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use TabDiscrepancy.ProtoReflect.Descriptor instead.
+func (*TabDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *SetRankingVisibilityResponse) GetSuccess() bool {
+func (x *TabDiscrepancy) GetTeamId() int32 {
 	if x != nil {
-		return x.Success
+		return x.TeamId
 	}
-	return false
+	return 0
 }
 
-func (x *SetRankingVisibilityResponse) GetMessage() string {
+func (x *TabDiscrepancy) GetTeamName() string {
 	if x != nil {
-		return x.Message
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TabDiscrepancy) GetDebateId() int32 {
+	if x != nil {
+		return x.DebateId
+	}
+	return 0
+}
+
+func (x *TabDiscrepancy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TabDiscrepancy) GetStored() string {
+	if x != nil {
+		return x.Stored
+	}
+	return ""
+}
+
+func (x *TabDiscrepancy) GetRecomputed() string {
+	if x != nil {
+		return x.Recomputed
 	}
 	return ""
 }
@@ -6009,210 +11664,494 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
 	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3b, 0x0a,
-	0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x52,
-	0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x22, 0x4e, 0x0a, 0x12, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x64,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f,
 	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
 	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
-	0x22, 0xb7, 0x01, 0x0a, 0x05, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75,
-	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75,
-	0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
-	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x6c,
-	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x44, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6c, 0x69,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73,
-	0x22, 0x64, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x7b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x42, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
 	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6a, 0x75,
-	0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x48, 0x65, 0x61,
-	0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f,
-	0x6f, 0x6d, 0x73, 0x1a, 0x55, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x67, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0xca, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x49, 0x0a,
+	0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x56,
-	0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x5b, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x7e, 0x0a, 0x08, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x66, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x51, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x66, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x51, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4e, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x05, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x08, 0x52, 0x6f, 0x6f,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x48, 0x65, 0x61, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x22,
+	0xa1, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a,
+	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x1a, 0x55, 0x0a, 0x0a,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
 	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x10, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xd8, 0x03, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69,
-	0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64,
+	0x02, 0x38, 0x01, 0x22, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbf, 0x04, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x56, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x12, 0x58, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6c,
-	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
-	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x1a, 0x5b, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x6a, 0x75, 0x64,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x4a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67,
+	0x54, 0x65, 0x61, 0x6d, 0x1a, 0x5b, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x5b, 0x0a, 0x10, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b,
-	0x0a, 0x10, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x69, 0x72, 0x69,
-	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05,
-	0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf8,
+	0x04, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
+	0x58, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x65, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x4a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x6d, 0x1a,
+	0x5b, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x10,
+	0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x0d, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x49, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x1c,
+	0x53, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xc0, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x69,
+	0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x45, 0x6c, 0x69,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x6e, 0x41, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa1, 0x02,
+	0x0a, 0x10, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x5f,
+	0x62, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x42, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x6e, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64,
+	0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x22, 0x80, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x6f,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x92, 0x02, 0x0a, 0x17, 0x4d,
+	0x61, 0x72, 0x6b, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x45, 0x0a, 0x11, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72,
+	0x6f, 0x6f, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x22,
+	0xcf, 0x04, 0x0a, 0x07, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x14, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x45,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x31, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x32, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6a, 0x75, 0x64, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65,
+	0x61, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6a,
+	0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x2d, 0x0a, 0x05, 0x74,
-	0x65, 0x61, 0x6d, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
-	0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65,
-	0x61, 0x64, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x06, 0x6a, 0x75,
-	0x64, 0x67, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a,
-	0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x70,
-	0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65,
-	0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x61, 0x6b,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65,
-	0x61, 0x67, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xd5, 0x01, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x61,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x33, 0x12, 0x2d, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x34, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xf3, 0x02, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x70,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0xe7, 0x02, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x61,
 	0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72,
 	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -6225,22 +12164,42 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61,
 	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
 	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x99, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25,
-	0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x65, 0x0a, 0x15, 0x55, 0x70,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x65, 0x22, 0x99, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9a, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x04, 0x62, 0x79, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x42, 0x79, 0x65, 0x52, 0x04, 0x62, 0x79, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x03, 0x42, 0x79,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x15, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
@@ -6252,220 +12211,611 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69,
-	0x6e, 0x67, 0x73, 0x22, 0xfc, 0x03, 0x0a, 0x06, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25,
-	0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6a,
-	0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a,
-	0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x6e, 0x67, 0x73, 0x22, 0x5b, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x9e, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73,
+	0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x53, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x22, 0x9d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x86, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x75, 0x0a, 0x11, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xfe, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x2d, 0x0a, 0x05,
-	0x74, 0x65, 0x61, 0x6d, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x83, 0x06,
+	0x0a, 0x06, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6c, 0x6c,
+	0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65,
+	0x61, 0x64, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x68, 0x65, 0x61, 0x64, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x33, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x68, 0x65, 0x61, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x33, 0x12, 0x2d, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x34, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x34, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64,
+	0x69, 0x73, 0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
+	0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61,
+	0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22, 0x91, 0x01, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
 	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52,
-	0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
-	0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xab, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61,
+	0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x81,
+	0x03, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
 	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52,
-	0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
 	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c,
-	0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22, 0x5e, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62,
-	0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06,
-	0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22, 0x5f, 0x0a, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x58, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61,
+	0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x22,
+	0x79, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
 	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x18,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50,
-	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0x9a, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x36, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x73,
-	0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73,
-	0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x1a, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc1, 0x01, 0x0a,
+	0x0b, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a,
+	0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x46, 0x0a,
-	0x15, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c,
-	0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52,
-	0x61, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61,
-	0x6e, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x74,
-	0x6f, 0x70, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x52, 0x0b, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a,
-	0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x78, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e,
-	0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x72, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x0b, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0x7d, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x64, 0x0a, 0x13, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x61, 0x6e, 0x6b, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x22, 0x5a, 0x0a, 0x19, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
+	0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6a,
+	0x75, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0xa0, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
+	0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x22, 0x82, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xe5, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xc1,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x53, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x69, 0x64, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x79, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x79, 0x65, 0x52, 0x04, 0x62, 0x79,
+	0x65, 0x73, 0x22, 0x7a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xc8,
+	0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x6f,
+	0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x65, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72, 0x6f,
+	0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x6f, 0x6f,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x03, 0x0a, 0x0b, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x31, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x0b, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0d, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x91, 0x02,
+	0x0a, 0x0d, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6f, 0x70,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x18, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x4f, 0x70, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xfc, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x08,
+	0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x65, 0x65,
+	0x64, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42,
+	0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x15,
+	0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e,
+	0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x6f,
+	0x70, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0c,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x78, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72,
+	0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x7d, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64,
+	0x0a, 0x13, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x19, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
 	0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
 	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52,
 	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0xee, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x22, 0x8d, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b,
 	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
@@ -6480,17 +12830,21 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
 	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70,
 	0x6c, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x7d, 0x0a, 0x1e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79,
+	0x22, 0xc4, 0x01, 0x0a, 0x1d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x1e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
 	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x61, 0x6e,
 	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65,
@@ -6498,7 +12852,7 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x61, 0x6e,
 	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x0b, 0x54, 0x65, 0x61, 0x6d, 0x52,
 	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
 	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -6511,7 +12865,11 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
 	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76,
 	0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x22,
 	0xa4, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
 	0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
@@ -6899,282 +13257,686 @@ var file_internal_grpc_proto_debate_management_debate_proto_rawDesc = string([]b
 	0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52,
 	0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x1b, 0x53,
-	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54,
-	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xd3, 0x1f, 0x0a, 0x0d, 0x44,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x21, 0x2e, 0x64,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x17, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3c,
+	0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x02, 0x0a,
+	0x18, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x6d, 0x65, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64,
+	0x44, 0x65, 0x76, 0x12, 0x3b, 0x0a, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73,
+	0x12, 0x3c, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x61,
+	0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x50,
+	0x0a, 0x12, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x6e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x22, 0xa1, 0x02, 0x0a, 0x10, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x02, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f,
+	0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a,
+	0x75, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x7a, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x7a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xb9, 0x01, 0x0a,
+	0x1b, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a, 0x11,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7b, 0x0a, 0x12,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x74, 0x61, 0x62, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x54, 0x61, 0x62, 0x52, 0x03, 0x74, 0x61, 0x62, 0x22, 0x57, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x03, 0x74, 0x61, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x03, 0x74, 0x61,
+	0x62, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42,
+	0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x76, 0x0a, 0x1a, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x54, 0x61, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x45,
+	0x0a, 0x0e, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x52, 0x06, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x61, 0x62, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22,
+	0x69, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x38, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
-	0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x65,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x5b, 0x0a, 0x0f, 0x54, 0x61,
+	0x62, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x73, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x73,
+	0x6c, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f,
+	0x53, 0x6c, 0x69, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x44, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x52, 0x07, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x04, 0x62, 0x79, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64,
-	0x67, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75,
-	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x64,
+	0x42, 0x79, 0x65, 0x52, 0x04, 0x62, 0x79, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x09, 0x54, 0x61, 0x62,
+	0x44, 0x65, 0x62, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x44, 0x65, 0x62, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x62,
+	0x44, 0x65, 0x62, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03,
+	0x77, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x22, 0x50,
+	0x0a, 0x13, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x92, 0x02, 0x0a, 0x14, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x45,
+	0x0a, 0x0e, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x62, 0x44, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x32, 0xea, 0x36, 0x0a, 0x0d, 0x44, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x21, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f,
-	0x74, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c,
-	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42,
-	0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
 	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x4d,
+	0x61, 0x72, 0x6b, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12, 0x29, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64,
+	0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1b, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x42, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61,
 	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x81, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x35, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2f,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x24,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x45, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x45, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x4b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
 	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
 	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61,
-	0x6d, 0x12, 0x4b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12,
-	0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x77,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
-	0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
-	0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
-	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
-	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x62,
+	0x6d, 0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x62,
 	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
 	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72,
-	0x61, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
 	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
-	0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x2e, 0x64, 0x65, 0x62,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x2e,
 	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x6f,
-	0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53,
-	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x2e, 0x64,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c,
-	0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x64,
+	0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
 	0x2e, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63,
-	0x68, 0x6f, 0x6f, 0x6c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x64, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56,
 	0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
-	0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x12, 0x34, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x74, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x75, 0x64, 0x67,
-	0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a,
-	0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x64,
-	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74,
+	0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x64, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74,
 	0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x64,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2d, 0x2e, 0x64,
 	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x65,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x65,
 	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x64, 0x65, 0x62,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x2a, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65,
+	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x64, 0x65, 0x62,
 	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
 	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78,
-	0x0a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x2e, 0x64, 0x65,
-	0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x64,
-	0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
-	0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
-	0x52, 0x61, 0x6e, 0x6b, 0x48, 0x75, 0x62, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x4d,
+	0x61, 0x72, 0x6b, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x61,
+	0x62, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x61, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61,
+	0x62, 0x12, 0x29, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x12, 0x2c,
+	0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x54, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x54, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x08, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x54, 0x61, 0x62, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x64, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x54, 0x61, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x52, 0x61, 0x6e, 0x6b, 0x48, 0x75, 0x62, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 })
 
 var (
@@ -7189,239 +13951,443 @@ func file_internal_grpc_proto_debate_management_debate_proto_rawDescGZIP() []byt
 	return file_internal_grpc_proto_debate_management_debate_proto_rawDescData
 }
 
-var file_internal_grpc_proto_debate_management_debate_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_internal_grpc_proto_debate_management_debate_proto_msgTypes = make([]protoimpl.MessageInfo, 180)
 var file_internal_grpc_proto_debate_management_debate_proto_goTypes = []any{
 	(*Room)(nil),                               // 0: debate_management.Room
-	(*RoundStatus)(nil),                        // 1: debate_management.RoundStatus
-	(*RoomStatus)(nil),                         // 2: debate_management.RoomStatus
-	(*GetRoomsRequest)(nil),                    // 3: debate_management.GetRoomsRequest
-	(*GetRoomsResponse)(nil),                   // 4: debate_management.GetRoomsResponse
-	(*GetRoomRequest)(nil),                     // 5: debate_management.GetRoomRequest
-	(*GetRoomResponse)(nil),                    // 6: debate_management.GetRoomResponse
-	(*UpdateRoomRequest)(nil),                  // 7: debate_management.UpdateRoomRequest
-	(*UpdateRoomResponse)(nil),                 // 8: debate_management.UpdateRoomResponse
-	(*Judge)(nil),                              // 9: debate_management.Judge
-	(*GetJudgesRequest)(nil),                   // 10: debate_management.GetJudgesRequest
-	(*GetJudgesResponse)(nil),                  // 11: debate_management.GetJudgesResponse
-	(*RoomInfo)(nil),                           // 12: debate_management.RoomInfo
-	(*RoundInfo)(nil),                          // 13: debate_management.RoundInfo
-	(*GetJudgeRequest)(nil),                    // 14: debate_management.GetJudgeRequest
-	(*GetJudgeResponse)(nil),                   // 15: debate_management.GetJudgeResponse
-	(*UpdateJudgeRequest)(nil),                 // 16: debate_management.UpdateJudgeRequest
-	(*UpdateJudgeResponse)(nil),                // 17: debate_management.UpdateJudgeResponse
-	(*Pairing)(nil),                            // 18: debate_management.Pairing
-	(*Team)(nil),                               // 19: debate_management.Team
-	(*Speaker)(nil),                            // 20: debate_management.Speaker
-	(*GetPairingsRequest)(nil),                 // 21: debate_management.GetPairingsRequest
-	(*GetPairingsResponse)(nil),                // 22: debate_management.GetPairingsResponse
-	(*UpdatePairingsRequest)(nil),              // 23: debate_management.UpdatePairingsRequest
-	(*UpdatePairingsResponse)(nil),             // 24: debate_management.UpdatePairingsResponse
-	(*Ballot)(nil),                             // 25: debate_management.Ballot
-	(*GetBallotsRequest)(nil),                  // 26: debate_management.GetBallotsRequest
-	(*GetBallotsResponse)(nil),                 // 27: debate_management.GetBallotsResponse
-	(*GetBallotRequest)(nil),                   // 28: debate_management.GetBallotRequest
-	(*GetBallotResponse)(nil),                  // 29: debate_management.GetBallotResponse
-	(*GetBallotByJudgeIDRequest)(nil),          // 30: debate_management.GetBallotByJudgeIDRequest
-	(*GetBallotByJudgeIDResponse)(nil),         // 31: debate_management.GetBallotByJudgeIDResponse
-	(*UpdateBallotRequest)(nil),                // 32: debate_management.UpdateBallotRequest
-	(*UpdateBallotResponse)(nil),               // 33: debate_management.UpdateBallotResponse
-	(*GeneratePreliminaryPairingsRequest)(nil), // 34: debate_management.GeneratePreliminaryPairingsRequest
-	(*GenerateEliminationPairingsRequest)(nil), // 35: debate_management.GenerateEliminationPairingsRequest
-	(*GeneratePairingsResponse)(nil),           // 36: debate_management.GeneratePairingsResponse
-	(*CreateTeamRequest)(nil),                  // 37: debate_management.CreateTeamRequest
-	(*GetTeamRequest)(nil),                     // 38: debate_management.GetTeamRequest
-	(*UpdateTeamRequest)(nil),                  // 39: debate_management.UpdateTeamRequest
-	(*DeleteTeamRequest)(nil),                  // 40: debate_management.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),                 // 41: debate_management.DeleteTeamResponse
-	(*GetTeamsByTournamentRequest)(nil),        // 42: debate_management.GetTeamsByTournamentRequest
-	(*GetTeamsByTournamentResponse)(nil),       // 43: debate_management.GetTeamsByTournamentResponse
-	(*OverallRankingRequest)(nil),              // 44: debate_management.OverallRankingRequest
-	(*OverallRankingResponse)(nil),             // 45: debate_management.OverallRankingResponse
-	(*TopStudent)(nil),                         // 46: debate_management.TopStudent
-	(*StudentInfo)(nil),                        // 47: debate_management.StudentInfo
-	(*PerformanceRequest)(nil),                 // 48: debate_management.PerformanceRequest
-	(*PerformanceResponse)(nil),                // 49: debate_management.PerformanceResponse
-	(*PerformanceData)(nil),                    // 50: debate_management.PerformanceData
-	(*TournamentRankingRequest)(nil),           // 51: debate_management.TournamentRankingRequest
-	(*TournamentRankingResponse)(nil),          // 52: debate_management.TournamentRankingResponse
-	(*StudentRanking)(nil),                     // 53: debate_management.StudentRanking
-	(*TournamentTeamsRankingRequest)(nil),      // 54: debate_management.TournamentTeamsRankingRequest
-	(*TournamentTeamsRankingResponse)(nil),     // 55: debate_management.TournamentTeamsRankingResponse
-	(*TeamRanking)(nil),                        // 56: debate_management.TeamRanking
-	(*TournamentSchoolRankingRequest)(nil),     // 57: debate_management.TournamentSchoolRankingRequest
-	(*TournamentSchoolRankingResponse)(nil),    // 58: debate_management.TournamentSchoolRankingResponse
-	(*SchoolRanking)(nil),                      // 59: debate_management.SchoolRanking
-	(*OverallSchoolRankingRequest)(nil),        // 60: debate_management.OverallSchoolRankingRequest
-	(*OverallSchoolRankingResponse)(nil),       // 61: debate_management.OverallSchoolRankingResponse
-	(*TopSchool)(nil),                          // 62: debate_management.TopSchool
-	(*SchoolInfo)(nil),                         // 63: debate_management.SchoolInfo
-	(*SchoolPerformanceRequest)(nil),           // 64: debate_management.SchoolPerformanceRequest
-	(*SchoolPerformanceResponse)(nil),          // 65: debate_management.SchoolPerformanceResponse
-	(*SchoolPerformanceData)(nil),              // 66: debate_management.SchoolPerformanceData
-	(*StudentTournamentStatsRequest)(nil),      // 67: debate_management.StudentTournamentStatsRequest
-	(*StudentTournamentStatsResponse)(nil),     // 68: debate_management.StudentTournamentStatsResponse
-	(*VolunteerTournamentStatsRequest)(nil),    // 69: debate_management.VolunteerTournamentStatsRequest
-	(*VolunteerTournamentStatsResponse)(nil),   // 70: debate_management.VolunteerTournamentStatsResponse
-	(*GetStudentFeedbackRequest)(nil),          // 71: debate_management.GetStudentFeedbackRequest
-	(*StudentFeedbackEntry)(nil),               // 72: debate_management.StudentFeedbackEntry
-	(*JudgeInfo)(nil),                          // 73: debate_management.JudgeInfo
-	(*GetStudentFeedbackResponse)(nil),         // 74: debate_management.GetStudentFeedbackResponse
-	(*SubmitJudgeFeedbackRequest)(nil),         // 75: debate_management.SubmitJudgeFeedbackRequest
-	(*SubmitJudgeFeedbackResponse)(nil),        // 76: debate_management.SubmitJudgeFeedbackResponse
-	(*GetJudgeFeedbackRequest)(nil),            // 77: debate_management.GetJudgeFeedbackRequest
-	(*JudgeFeedbackEntry)(nil),                 // 78: debate_management.JudgeFeedbackEntry
-	(*GetJudgeFeedbackResponse)(nil),           // 79: debate_management.GetJudgeFeedbackResponse
-	(*GetVolunteerRankingRequest)(nil),         // 80: debate_management.GetVolunteerRankingRequest
-	(*TopVolunteer)(nil),                       // 81: debate_management.TopVolunteer
-	(*VolunteerInfo)(nil),                      // 82: debate_management.VolunteerInfo
-	(*GetVolunteerRankingResponse)(nil),        // 83: debate_management.GetVolunteerRankingResponse
-	(*GetVolunteerPerformanceRequest)(nil),     // 84: debate_management.GetVolunteerPerformanceRequest
-	(*VolunteerPerformanceData)(nil),           // 85: debate_management.VolunteerPerformanceData
-	(*GetVolunteerPerformanceResponse)(nil),    // 86: debate_management.GetVolunteerPerformanceResponse
-	(*MarkFeedbackAsReadRequest)(nil),          // 87: debate_management.MarkFeedbackAsReadRequest
-	(*MarkFeedbackAsReadResponse)(nil),         // 88: debate_management.MarkFeedbackAsReadResponse
-	(*TournamentVolunteerRankingRequest)(nil),  // 89: debate_management.TournamentVolunteerRankingRequest
-	(*VolunteerTournamentRank)(nil),            // 90: debate_management.VolunteerTournamentRank
-	(*TournamentVolunteerRankingResponse)(nil), // 91: debate_management.TournamentVolunteerRankingResponse
-	(*SetRankingVisibilityRequest)(nil),        // 92: debate_management.SetRankingVisibilityRequest
-	(*SetRankingVisibilityResponse)(nil),       // 93: debate_management.SetRankingVisibilityResponse
-	nil,                                        // 94: debate_management.RoundInfo.RoomsEntry
-	nil,                                        // 95: debate_management.GetJudgeResponse.PreliminaryEntry
-	nil,                                        // 96: debate_management.GetJudgeResponse.EliminationEntry
-	nil,                                        // 97: debate_management.UpdateJudgeRequest.PreliminaryEntry
-	nil,                                        // 98: debate_management.UpdateJudgeRequest.EliminationEntry
+	(*AvailabilityWindow)(nil),                 // 1: debate_management.AvailabilityWindow
+	(*RoundStatus)(nil),                        // 2: debate_management.RoundStatus
+	(*RoomStatus)(nil),                         // 3: debate_management.RoomStatus
+	(*GetRoomsRequest)(nil),                    // 4: debate_management.GetRoomsRequest
+	(*GetRoomsResponse)(nil),                   // 5: debate_management.GetRoomsResponse
+	(*GetRoomRequest)(nil),                     // 6: debate_management.GetRoomRequest
+	(*GetRoomResponse)(nil),                    // 7: debate_management.GetRoomResponse
+	(*UpdateRoomRequest)(nil),                  // 8: debate_management.UpdateRoomRequest
+	(*UpdateRoomResponse)(nil),                 // 9: debate_management.UpdateRoomResponse
+	(*CreateRoomRequest)(nil),                  // 10: debate_management.CreateRoomRequest
+	(*CreateRoomResponse)(nil),                 // 11: debate_management.CreateRoomResponse
+	(*DeleteRoomRequest)(nil),                  // 12: debate_management.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),                 // 13: debate_management.DeleteRoomResponse
+	(*SetRoomAvailabilityRequest)(nil),         // 14: debate_management.SetRoomAvailabilityRequest
+	(*SetRoomAvailabilityResponse)(nil),        // 15: debate_management.SetRoomAvailabilityResponse
+	(*Building)(nil),                           // 16: debate_management.Building
+	(*CreateBuildingRequest)(nil),              // 17: debate_management.CreateBuildingRequest
+	(*CreateBuildingResponse)(nil),             // 18: debate_management.CreateBuildingResponse
+	(*GetBuildingsRequest)(nil),                // 19: debate_management.GetBuildingsRequest
+	(*GetBuildingsResponse)(nil),               // 20: debate_management.GetBuildingsResponse
+	(*UpdateBuildingRequest)(nil),              // 21: debate_management.UpdateBuildingRequest
+	(*UpdateBuildingResponse)(nil),             // 22: debate_management.UpdateBuildingResponse
+	(*DeleteBuildingRequest)(nil),              // 23: debate_management.DeleteBuildingRequest
+	(*DeleteBuildingResponse)(nil),             // 24: debate_management.DeleteBuildingResponse
+	(*Judge)(nil),                              // 25: debate_management.Judge
+	(*GetJudgesRequest)(nil),                   // 26: debate_management.GetJudgesRequest
+	(*GetJudgesResponse)(nil),                  // 27: debate_management.GetJudgesResponse
+	(*RoomInfo)(nil),                           // 28: debate_management.RoomInfo
+	(*RoundInfo)(nil),                          // 29: debate_management.RoundInfo
+	(*GetJudgeRequest)(nil),                    // 30: debate_management.GetJudgeRequest
+	(*GetJudgeResponse)(nil),                   // 31: debate_management.GetJudgeResponse
+	(*UpdateJudgeRequest)(nil),                 // 32: debate_management.UpdateJudgeRequest
+	(*JudgeConflict)(nil),                      // 33: debate_management.JudgeConflict
+	(*UpdateJudgeResponse)(nil),                // 34: debate_management.UpdateJudgeResponse
+	(*RoundAvailability)(nil),                  // 35: debate_management.RoundAvailability
+	(*SetJudgeAvailabilityRequest)(nil),        // 36: debate_management.SetJudgeAvailabilityRequest
+	(*SetJudgeAvailabilityResponse)(nil),       // 37: debate_management.SetJudgeAvailabilityResponse
+	(*CheckInJudgeRequest)(nil),                // 38: debate_management.CheckInJudgeRequest
+	(*CheckInJudgeResponse)(nil),               // 39: debate_management.CheckInJudgeResponse
+	(*GetJudgeRosterRequest)(nil),              // 40: debate_management.GetJudgeRosterRequest
+	(*JudgeRosterEntry)(nil),                   // 41: debate_management.JudgeRosterEntry
+	(*GetJudgeRosterResponse)(nil),             // 42: debate_management.GetJudgeRosterResponse
+	(*MarkJudgeAbsentRequest)(nil),             // 43: debate_management.MarkJudgeAbsentRequest
+	(*MarkJudgeAbsentResponse)(nil),            // 44: debate_management.MarkJudgeAbsentResponse
+	(*Pairing)(nil),                            // 45: debate_management.Pairing
+	(*Team)(nil),                               // 46: debate_management.Team
+	(*Speaker)(nil),                            // 47: debate_management.Speaker
+	(*GetPairingsRequest)(nil),                 // 48: debate_management.GetPairingsRequest
+	(*GetPairingsResponse)(nil),                // 49: debate_management.GetPairingsResponse
+	(*Bye)(nil),                                // 50: debate_management.Bye
+	(*UpdatePairingsRequest)(nil),              // 51: debate_management.UpdatePairingsRequest
+	(*UpdatePairingsResponse)(nil),             // 52: debate_management.UpdatePairingsResponse
+	(*PairingIssue)(nil),                       // 53: debate_management.PairingIssue
+	(*ValidatePairingsRequest)(nil),            // 54: debate_management.ValidatePairingsRequest
+	(*ValidatePairingsResponse)(nil),           // 55: debate_management.ValidatePairingsResponse
+	(*PairingChange)(nil),                      // 56: debate_management.PairingChange
+	(*GetPairingsDiffRequest)(nil),             // 57: debate_management.GetPairingsDiffRequest
+	(*GetPairingsDiffResponse)(nil),            // 58: debate_management.GetPairingsDiffResponse
+	(*ReleasePairingsRequest)(nil),             // 59: debate_management.ReleasePairingsRequest
+	(*ReleasePairingsResponse)(nil),            // 60: debate_management.ReleasePairingsResponse
+	(*ReplayPairingsRequest)(nil),              // 61: debate_management.ReplayPairingsRequest
+	(*PairingDifference)(nil),                  // 62: debate_management.PairingDifference
+	(*ReplayPairingsResponse)(nil),             // 63: debate_management.ReplayPairingsResponse
+	(*Ballot)(nil),                             // 64: debate_management.Ballot
+	(*GetBallotsRequest)(nil),                  // 65: debate_management.GetBallotsRequest
+	(*GetBallotsResponse)(nil),                 // 66: debate_management.GetBallotsResponse
+	(*GetBallotRequest)(nil),                   // 67: debate_management.GetBallotRequest
+	(*GetBallotResponse)(nil),                  // 68: debate_management.GetBallotResponse
+	(*GetBallotByJudgeIDRequest)(nil),          // 69: debate_management.GetBallotByJudgeIDRequest
+	(*GetBallotByJudgeIDResponse)(nil),         // 70: debate_management.GetBallotByJudgeIDResponse
+	(*UpdateBallotRequest)(nil),                // 71: debate_management.UpdateBallotRequest
+	(*UpdateBallotResponse)(nil),               // 72: debate_management.UpdateBallotResponse
+	(*BallotVersion)(nil),                      // 73: debate_management.BallotVersion
+	(*BallotChange)(nil),                       // 74: debate_management.BallotChange
+	(*GetBallotHistoryRequest)(nil),            // 75: debate_management.GetBallotHistoryRequest
+	(*GetBallotHistoryResponse)(nil),           // 76: debate_management.GetBallotHistoryResponse
+	(*RevertBallotRequest)(nil),                // 77: debate_management.RevertBallotRequest
+	(*RevertBallotResponse)(nil),               // 78: debate_management.RevertBallotResponse
+	(*SetBallotEntryModeRequest)(nil),          // 79: debate_management.SetBallotEntryModeRequest
+	(*SetBallotEntryModeResponse)(nil),         // 80: debate_management.SetBallotEntryModeResponse
+	(*BallotEntry)(nil),                        // 81: debate_management.BallotEntry
+	(*BallotConflict)(nil),                     // 82: debate_management.BallotConflict
+	(*GetBallotConflictsRequest)(nil),          // 83: debate_management.GetBallotConflictsRequest
+	(*GetBallotConflictsResponse)(nil),         // 84: debate_management.GetBallotConflictsResponse
+	(*GeneratePreliminaryPairingsRequest)(nil), // 85: debate_management.GeneratePreliminaryPairingsRequest
+	(*GenerateEliminationPairingsRequest)(nil), // 86: debate_management.GenerateEliminationPairingsRequest
+	(*GeneratePairingsResponse)(nil),           // 87: debate_management.GeneratePairingsResponse
+	(*GetEliminationBracketRequest)(nil),       // 88: debate_management.GetEliminationBracketRequest
+	(*GetEliminationBracketResponse)(nil),      // 89: debate_management.GetEliminationBracketResponse
+	(*BracketNode)(nil),                        // 90: debate_management.BracketNode
+	(*BracketTeam)(nil),                        // 91: debate_management.BracketTeam
+	(*BreakCategory)(nil),                      // 92: debate_management.BreakCategory
+	(*GetBreakCategoriesRequest)(nil),          // 93: debate_management.GetBreakCategoriesRequest
+	(*GetBreakCategoriesResponse)(nil),         // 94: debate_management.GetBreakCategoriesResponse
+	(*UpdateBreakCategoriesRequest)(nil),       // 95: debate_management.UpdateBreakCategoriesRequest
+	(*UpdateBreakCategoriesResponse)(nil),      // 96: debate_management.UpdateBreakCategoriesResponse
+	(*TeamSideCount)(nil),                      // 97: debate_management.TeamSideCount
+	(*CreateTeamRequest)(nil),                  // 98: debate_management.CreateTeamRequest
+	(*GetTeamRequest)(nil),                     // 99: debate_management.GetTeamRequest
+	(*UpdateTeamRequest)(nil),                  // 100: debate_management.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),                  // 101: debate_management.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                 // 102: debate_management.DeleteTeamResponse
+	(*GetTeamsByTournamentRequest)(nil),        // 103: debate_management.GetTeamsByTournamentRequest
+	(*GetTeamsByTournamentResponse)(nil),       // 104: debate_management.GetTeamsByTournamentResponse
+	(*OverallRankingRequest)(nil),              // 105: debate_management.OverallRankingRequest
+	(*OverallRankingResponse)(nil),             // 106: debate_management.OverallRankingResponse
+	(*TopStudent)(nil),                         // 107: debate_management.TopStudent
+	(*StudentInfo)(nil),                        // 108: debate_management.StudentInfo
+	(*PerformanceRequest)(nil),                 // 109: debate_management.PerformanceRequest
+	(*PerformanceResponse)(nil),                // 110: debate_management.PerformanceResponse
+	(*PerformanceData)(nil),                    // 111: debate_management.PerformanceData
+	(*TournamentRankingRequest)(nil),           // 112: debate_management.TournamentRankingRequest
+	(*TournamentRankingResponse)(nil),          // 113: debate_management.TournamentRankingResponse
+	(*StudentRanking)(nil),                     // 114: debate_management.StudentRanking
+	(*TournamentTeamsRankingRequest)(nil),      // 115: debate_management.TournamentTeamsRankingRequest
+	(*TournamentTeamsRankingResponse)(nil),     // 116: debate_management.TournamentTeamsRankingResponse
+	(*TeamRanking)(nil),                        // 117: debate_management.TeamRanking
+	(*TournamentSchoolRankingRequest)(nil),     // 118: debate_management.TournamentSchoolRankingRequest
+	(*TournamentSchoolRankingResponse)(nil),    // 119: debate_management.TournamentSchoolRankingResponse
+	(*SchoolRanking)(nil),                      // 120: debate_management.SchoolRanking
+	(*OverallSchoolRankingRequest)(nil),        // 121: debate_management.OverallSchoolRankingRequest
+	(*OverallSchoolRankingResponse)(nil),       // 122: debate_management.OverallSchoolRankingResponse
+	(*TopSchool)(nil),                          // 123: debate_management.TopSchool
+	(*SchoolInfo)(nil),                         // 124: debate_management.SchoolInfo
+	(*SchoolPerformanceRequest)(nil),           // 125: debate_management.SchoolPerformanceRequest
+	(*SchoolPerformanceResponse)(nil),          // 126: debate_management.SchoolPerformanceResponse
+	(*SchoolPerformanceData)(nil),              // 127: debate_management.SchoolPerformanceData
+	(*StudentTournamentStatsRequest)(nil),      // 128: debate_management.StudentTournamentStatsRequest
+	(*StudentTournamentStatsResponse)(nil),     // 129: debate_management.StudentTournamentStatsResponse
+	(*VolunteerTournamentStatsRequest)(nil),    // 130: debate_management.VolunteerTournamentStatsRequest
+	(*VolunteerTournamentStatsResponse)(nil),   // 131: debate_management.VolunteerTournamentStatsResponse
+	(*GetStudentFeedbackRequest)(nil),          // 132: debate_management.GetStudentFeedbackRequest
+	(*StudentFeedbackEntry)(nil),               // 133: debate_management.StudentFeedbackEntry
+	(*JudgeInfo)(nil),                          // 134: debate_management.JudgeInfo
+	(*GetStudentFeedbackResponse)(nil),         // 135: debate_management.GetStudentFeedbackResponse
+	(*SubmitJudgeFeedbackRequest)(nil),         // 136: debate_management.SubmitJudgeFeedbackRequest
+	(*SubmitJudgeFeedbackResponse)(nil),        // 137: debate_management.SubmitJudgeFeedbackResponse
+	(*GetJudgeFeedbackRequest)(nil),            // 138: debate_management.GetJudgeFeedbackRequest
+	(*JudgeFeedbackEntry)(nil),                 // 139: debate_management.JudgeFeedbackEntry
+	(*GetJudgeFeedbackResponse)(nil),           // 140: debate_management.GetJudgeFeedbackResponse
+	(*GetVolunteerRankingRequest)(nil),         // 141: debate_management.GetVolunteerRankingRequest
+	(*TopVolunteer)(nil),                       // 142: debate_management.TopVolunteer
+	(*VolunteerInfo)(nil),                      // 143: debate_management.VolunteerInfo
+	(*GetVolunteerRankingResponse)(nil),        // 144: debate_management.GetVolunteerRankingResponse
+	(*GetVolunteerPerformanceRequest)(nil),     // 145: debate_management.GetVolunteerPerformanceRequest
+	(*VolunteerPerformanceData)(nil),           // 146: debate_management.VolunteerPerformanceData
+	(*GetVolunteerPerformanceResponse)(nil),    // 147: debate_management.GetVolunteerPerformanceResponse
+	(*MarkFeedbackAsReadRequest)(nil),          // 148: debate_management.MarkFeedbackAsReadRequest
+	(*MarkFeedbackAsReadResponse)(nil),         // 149: debate_management.MarkFeedbackAsReadResponse
+	(*TournamentVolunteerRankingRequest)(nil),  // 150: debate_management.TournamentVolunteerRankingRequest
+	(*VolunteerTournamentRank)(nil),            // 151: debate_management.VolunteerTournamentRank
+	(*TournamentVolunteerRankingResponse)(nil), // 152: debate_management.TournamentVolunteerRankingResponse
+	(*JudgeCalibrationRequest)(nil),            // 153: debate_management.JudgeCalibrationRequest
+	(*JudgeCalibrationResponse)(nil),           // 154: debate_management.JudgeCalibrationResponse
+	(*JudgeCalibration)(nil),                   // 155: debate_management.JudgeCalibration
+	(*OutlierBallot)(nil),                      // 156: debate_management.OutlierBallot
+	(*SetRankingVisibilityRequest)(nil),        // 157: debate_management.SetRankingVisibilityRequest
+	(*SetRankingVisibilityResponse)(nil),       // 158: debate_management.SetRankingVisibilityResponse
+	(*PublishTabRequest)(nil),                  // 159: debate_management.PublishTabRequest
+	(*PublishTabResponse)(nil),                 // 160: debate_management.PublishTabResponse
+	(*GetPublishedTabRequest)(nil),             // 161: debate_management.GetPublishedTabRequest
+	(*GetPublishedTabResponse)(nil),            // 162: debate_management.GetPublishedTabResponse
+	(*ExportPublishedTabRequest)(nil),          // 163: debate_management.ExportPublishedTabRequest
+	(*ExportPublishedTabResponse)(nil),         // 164: debate_management.ExportPublishedTabResponse
+	(*PublishedTab)(nil),                       // 165: debate_management.PublishedTab
+	(*TabBreak)(nil),                           // 166: debate_management.TabBreak
+	(*TabBreakingTeam)(nil),                    // 167: debate_management.TabBreakingTeam
+	(*TabRound)(nil),                           // 168: debate_management.TabRound
+	(*TabDebate)(nil),                          // 169: debate_management.TabDebate
+	(*TabDebateTeam)(nil),                      // 170: debate_management.TabDebateTeam
+	(*TabIntegrityRequest)(nil),                // 171: debate_management.TabIntegrityRequest
+	(*TabIntegrityResponse)(nil),               // 172: debate_management.TabIntegrityResponse
+	(*TabDiscrepancy)(nil),                     // 173: debate_management.TabDiscrepancy
+	nil,                                        // 174: debate_management.RoundInfo.RoomsEntry
+	nil,                                        // 175: debate_management.GetJudgeResponse.PreliminaryEntry
+	nil,                                        // 176: debate_management.GetJudgeResponse.EliminationEntry
+	nil,                                        // 177: debate_management.UpdateJudgeRequest.PreliminaryEntry
+	nil,                                        // 178: debate_management.UpdateJudgeRequest.EliminationEntry
+	nil,                                        // 179: debate_management.GetEliminationBracketResponse.RootNodeIdsEntry
 }
 var file_internal_grpc_proto_debate_management_debate_proto_depIdxs = []int32{
-	2,  // 0: debate_management.GetRoomsResponse.rooms:type_name -> debate_management.RoomStatus
-	1,  // 1: debate_management.GetRoomResponse.preliminary:type_name -> debate_management.RoundStatus
-	1,  // 2: debate_management.GetRoomResponse.elimination:type_name -> debate_management.RoundStatus
-	0,  // 3: debate_management.UpdateRoomRequest.room:type_name -> debate_management.Room
-	0,  // 4: debate_management.UpdateRoomResponse.room:type_name -> debate_management.Room
-	9,  // 5: debate_management.GetJudgesResponse.judges:type_name -> debate_management.Judge
-	94, // 6: debate_management.RoundInfo.rooms:type_name -> debate_management.RoundInfo.RoomsEntry
-	95, // 7: debate_management.GetJudgeResponse.preliminary:type_name -> debate_management.GetJudgeResponse.PreliminaryEntry
-	96, // 8: debate_management.GetJudgeResponse.elimination:type_name -> debate_management.GetJudgeResponse.EliminationEntry
-	97, // 9: debate_management.UpdateJudgeRequest.preliminary:type_name -> debate_management.UpdateJudgeRequest.PreliminaryEntry
-	98, // 10: debate_management.UpdateJudgeRequest.elimination:type_name -> debate_management.UpdateJudgeRequest.EliminationEntry
-	19, // 11: debate_management.Pairing.team1:type_name -> debate_management.Team
-	19, // 12: debate_management.Pairing.team2:type_name -> debate_management.Team
-	9,  // 13: debate_management.Pairing.judges:type_name -> debate_management.Judge
-	20, // 14: debate_management.Team.speakers:type_name -> debate_management.Speaker
-	18, // 15: debate_management.GetPairingsResponse.pairings:type_name -> debate_management.Pairing
-	18, // 16: debate_management.UpdatePairingsRequest.pairings:type_name -> debate_management.Pairing
-	18, // 17: debate_management.UpdatePairingsResponse.pairings:type_name -> debate_management.Pairing
-	9,  // 18: debate_management.Ballot.judges:type_name -> debate_management.Judge
-	19, // 19: debate_management.Ballot.team1:type_name -> debate_management.Team
-	19, // 20: debate_management.Ballot.team2:type_name -> debate_management.Team
-	25, // 21: debate_management.GetBallotsResponse.ballots:type_name -> debate_management.Ballot
-	25, // 22: debate_management.GetBallotResponse.ballot:type_name -> debate_management.Ballot
-	25, // 23: debate_management.GetBallotByJudgeIDResponse.ballot:type_name -> debate_management.Ballot
-	25, // 24: debate_management.UpdateBallotRequest.ballot:type_name -> debate_management.Ballot
-	25, // 25: debate_management.UpdateBallotResponse.ballot:type_name -> debate_management.Ballot
-	18, // 26: debate_management.GeneratePairingsResponse.pairings:type_name -> debate_management.Pairing
-	20, // 27: debate_management.CreateTeamRequest.speakers:type_name -> debate_management.Speaker
-	19, // 28: debate_management.UpdateTeamRequest.team:type_name -> debate_management.Team
-	19, // 29: debate_management.GetTeamsByTournamentResponse.teams:type_name -> debate_management.Team
-	46, // 30: debate_management.OverallRankingResponse.top_students:type_name -> debate_management.TopStudent
-	47, // 31: debate_management.OverallRankingResponse.student_info:type_name -> debate_management.StudentInfo
-	50, // 32: debate_management.PerformanceResponse.performance_data:type_name -> debate_management.PerformanceData
-	53, // 33: debate_management.TournamentRankingResponse.rankings:type_name -> debate_management.StudentRanking
-	56, // 34: debate_management.TournamentTeamsRankingResponse.rankings:type_name -> debate_management.TeamRanking
-	59, // 35: debate_management.TournamentSchoolRankingResponse.rankings:type_name -> debate_management.SchoolRanking
-	62, // 36: debate_management.OverallSchoolRankingResponse.top_schools:type_name -> debate_management.TopSchool
-	63, // 37: debate_management.OverallSchoolRankingResponse.school_info:type_name -> debate_management.SchoolInfo
-	66, // 38: debate_management.SchoolPerformanceResponse.performance_data:type_name -> debate_management.SchoolPerformanceData
-	73, // 39: debate_management.StudentFeedbackEntry.judges:type_name -> debate_management.JudgeInfo
-	72, // 40: debate_management.GetStudentFeedbackResponse.feedback_entries:type_name -> debate_management.StudentFeedbackEntry
-	78, // 41: debate_management.GetJudgeFeedbackResponse.feedback_entries:type_name -> debate_management.JudgeFeedbackEntry
-	81, // 42: debate_management.GetVolunteerRankingResponse.top_volunteers:type_name -> debate_management.TopVolunteer
-	82, // 43: debate_management.GetVolunteerRankingResponse.volunteer_info:type_name -> debate_management.VolunteerInfo
-	85, // 44: debate_management.GetVolunteerPerformanceResponse.performance_data:type_name -> debate_management.VolunteerPerformanceData
-	90, // 45: debate_management.TournamentVolunteerRankingResponse.rankings:type_name -> debate_management.VolunteerTournamentRank
-	12, // 46: debate_management.RoundInfo.RoomsEntry.value:type_name -> debate_management.RoomInfo
-	12, // 47: debate_management.GetJudgeResponse.PreliminaryEntry.value:type_name -> debate_management.RoomInfo
-	12, // 48: debate_management.GetJudgeResponse.EliminationEntry.value:type_name -> debate_management.RoomInfo
-	12, // 49: debate_management.UpdateJudgeRequest.PreliminaryEntry.value:type_name -> debate_management.RoomInfo
-	12, // 50: debate_management.UpdateJudgeRequest.EliminationEntry.value:type_name -> debate_management.RoomInfo
-	3,  // 51: debate_management.DebateService.GetRooms:input_type -> debate_management.GetRoomsRequest
-	5,  // 52: debate_management.DebateService.GetRoom:input_type -> debate_management.GetRoomRequest
-	7,  // 53: debate_management.DebateService.UpdateRoom:input_type -> debate_management.UpdateRoomRequest
-	10, // 54: debate_management.DebateService.GetJudges:input_type -> debate_management.GetJudgesRequest
-	14, // 55: debate_management.DebateService.GetJudge:input_type -> debate_management.GetJudgeRequest
-	16, // 56: debate_management.DebateService.UpdateJudge:input_type -> debate_management.UpdateJudgeRequest
-	21, // 57: debate_management.DebateService.GetPairings:input_type -> debate_management.GetPairingsRequest
-	23, // 58: debate_management.DebateService.UpdatePairings:input_type -> debate_management.UpdatePairingsRequest
-	26, // 59: debate_management.DebateService.GetBallots:input_type -> debate_management.GetBallotsRequest
-	28, // 60: debate_management.DebateService.GetBallot:input_type -> debate_management.GetBallotRequest
-	32, // 61: debate_management.DebateService.UpdateBallot:input_type -> debate_management.UpdateBallotRequest
-	30, // 62: debate_management.DebateService.GetBallotByJudgeID:input_type -> debate_management.GetBallotByJudgeIDRequest
-	34, // 63: debate_management.DebateService.GeneratePreliminaryPairings:input_type -> debate_management.GeneratePreliminaryPairingsRequest
-	35, // 64: debate_management.DebateService.GenerateEliminationPairings:input_type -> debate_management.GenerateEliminationPairingsRequest
-	37, // 65: debate_management.DebateService.CreateTeam:input_type -> debate_management.CreateTeamRequest
-	38, // 66: debate_management.DebateService.GetTeam:input_type -> debate_management.GetTeamRequest
-	39, // 67: debate_management.DebateService.UpdateTeam:input_type -> debate_management.UpdateTeamRequest
-	42, // 68: debate_management.DebateService.GetTeamsByTournament:input_type -> debate_management.GetTeamsByTournamentRequest
-	40, // 69: debate_management.DebateService.DeleteTeam:input_type -> debate_management.DeleteTeamRequest
-	92, // 70: debate_management.DebateService.SetRankingVisibility:input_type -> debate_management.SetRankingVisibilityRequest
-	51, // 71: debate_management.DebateService.GetTournamentStudentRanking:input_type -> debate_management.TournamentRankingRequest
-	44, // 72: debate_management.DebateService.GetOverallStudentRanking:input_type -> debate_management.OverallRankingRequest
-	48, // 73: debate_management.DebateService.GetStudentOverallPerformance:input_type -> debate_management.PerformanceRequest
-	67, // 74: debate_management.DebateService.GetStudentTournamentStats:input_type -> debate_management.StudentTournamentStatsRequest
-	54, // 75: debate_management.DebateService.GetTournamentTeamsRanking:input_type -> debate_management.TournamentTeamsRankingRequest
-	57, // 76: debate_management.DebateService.GetTournamentSchoolRanking:input_type -> debate_management.TournamentSchoolRankingRequest
-	60, // 77: debate_management.DebateService.GetOverallSchoolRanking:input_type -> debate_management.OverallSchoolRankingRequest
-	64, // 78: debate_management.DebateService.GetSchoolOverallPerformance:input_type -> debate_management.SchoolPerformanceRequest
-	69, // 79: debate_management.DebateService.GetVolunteerTournamentStats:input_type -> debate_management.VolunteerTournamentStatsRequest
-	89, // 80: debate_management.DebateService.GetTournamentVolunteerRanking:input_type -> debate_management.TournamentVolunteerRankingRequest
-	71, // 81: debate_management.DebateService.GetStudentFeedback:input_type -> debate_management.GetStudentFeedbackRequest
-	75, // 82: debate_management.DebateService.SubmitJudgeFeedback:input_type -> debate_management.SubmitJudgeFeedbackRequest
-	77, // 83: debate_management.DebateService.GetJudgeFeedback:input_type -> debate_management.GetJudgeFeedbackRequest
-	80, // 84: debate_management.DebateService.GetVolunteerRanking:input_type -> debate_management.GetVolunteerRankingRequest
-	84, // 85: debate_management.DebateService.GetVolunteerPerformance:input_type -> debate_management.GetVolunteerPerformanceRequest
-	87, // 86: debate_management.DebateService.MarkStudentFeedbackAsRead:input_type -> debate_management.MarkFeedbackAsReadRequest
-	87, // 87: debate_management.DebateService.MarkJudgeFeedbackAsRead:input_type -> debate_management.MarkFeedbackAsReadRequest
-	4,  // 88: debate_management.DebateService.GetRooms:output_type -> debate_management.GetRoomsResponse
-	6,  // 89: debate_management.DebateService.GetRoom:output_type -> debate_management.GetRoomResponse
-	8,  // 90: debate_management.DebateService.UpdateRoom:output_type -> debate_management.UpdateRoomResponse
-	11, // 91: debate_management.DebateService.GetJudges:output_type -> debate_management.GetJudgesResponse
-	15, // 92: debate_management.DebateService.GetJudge:output_type -> debate_management.GetJudgeResponse
-	17, // 93: debate_management.DebateService.UpdateJudge:output_type -> debate_management.UpdateJudgeResponse
-	22, // 94: debate_management.DebateService.GetPairings:output_type -> debate_management.GetPairingsResponse
-	24, // 95: debate_management.DebateService.UpdatePairings:output_type -> debate_management.UpdatePairingsResponse
-	27, // 96: debate_management.DebateService.GetBallots:output_type -> debate_management.GetBallotsResponse
-	29, // 97: debate_management.DebateService.GetBallot:output_type -> debate_management.GetBallotResponse
-	33, // 98: debate_management.DebateService.UpdateBallot:output_type -> debate_management.UpdateBallotResponse
-	31, // 99: debate_management.DebateService.GetBallotByJudgeID:output_type -> debate_management.GetBallotByJudgeIDResponse
-	36, // 100: debate_management.DebateService.GeneratePreliminaryPairings:output_type -> debate_management.GeneratePairingsResponse
-	36, // 101: debate_management.DebateService.GenerateEliminationPairings:output_type -> debate_management.GeneratePairingsResponse
-	19, // 102: debate_management.DebateService.CreateTeam:output_type -> debate_management.Team
-	19, // 103: debate_management.DebateService.GetTeam:output_type -> debate_management.Team
-	19, // 104: debate_management.DebateService.UpdateTeam:output_type -> debate_management.Team
-	43, // 105: debate_management.DebateService.GetTeamsByTournament:output_type -> debate_management.GetTeamsByTournamentResponse
-	41, // 106: debate_management.DebateService.DeleteTeam:output_type -> debate_management.DeleteTeamResponse
-	93, // 107: debate_management.DebateService.SetRankingVisibility:output_type -> debate_management.SetRankingVisibilityResponse
-	52, // 108: debate_management.DebateService.GetTournamentStudentRanking:output_type -> debate_management.TournamentRankingResponse
-	45, // 109: debate_management.DebateService.GetOverallStudentRanking:output_type -> debate_management.OverallRankingResponse
-	49, // 110: debate_management.DebateService.GetStudentOverallPerformance:output_type -> debate_management.PerformanceResponse
-	68, // 111: debate_management.DebateService.GetStudentTournamentStats:output_type -> debate_management.StudentTournamentStatsResponse
-	55, // 112: debate_management.DebateService.GetTournamentTeamsRanking:output_type -> debate_management.TournamentTeamsRankingResponse
-	58, // 113: debate_management.DebateService.GetTournamentSchoolRanking:output_type -> debate_management.TournamentSchoolRankingResponse
-	61, // 114: debate_management.DebateService.GetOverallSchoolRanking:output_type -> debate_management.OverallSchoolRankingResponse
-	65, // 115: debate_management.DebateService.GetSchoolOverallPerformance:output_type -> debate_management.SchoolPerformanceResponse
-	70, // 116: debate_management.DebateService.GetVolunteerTournamentStats:output_type -> debate_management.VolunteerTournamentStatsResponse
-	91, // 117: debate_management.DebateService.GetTournamentVolunteerRanking:output_type -> debate_management.TournamentVolunteerRankingResponse
-	74, // 118: debate_management.DebateService.GetStudentFeedback:output_type -> debate_management.GetStudentFeedbackResponse
-	76, // 119: debate_management.DebateService.SubmitJudgeFeedback:output_type -> debate_management.SubmitJudgeFeedbackResponse
-	79, // 120: debate_management.DebateService.GetJudgeFeedback:output_type -> debate_management.GetJudgeFeedbackResponse
-	83, // 121: debate_management.DebateService.GetVolunteerRanking:output_type -> debate_management.GetVolunteerRankingResponse
-	86, // 122: debate_management.DebateService.GetVolunteerPerformance:output_type -> debate_management.GetVolunteerPerformanceResponse
-	88, // 123: debate_management.DebateService.MarkStudentFeedbackAsRead:output_type -> debate_management.MarkFeedbackAsReadResponse
-	88, // 124: debate_management.DebateService.MarkJudgeFeedbackAsRead:output_type -> debate_management.MarkFeedbackAsReadResponse
-	88, // [88:125] is the sub-list for method output_type
-	51, // [51:88] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	1,   // 0: debate_management.Room.availability:type_name -> debate_management.AvailabilityWindow
+	0,   // 1: debate_management.RoomStatus.room:type_name -> debate_management.Room
+	3,   // 2: debate_management.GetRoomsResponse.rooms:type_name -> debate_management.RoomStatus
+	2,   // 3: debate_management.GetRoomResponse.preliminary:type_name -> debate_management.RoundStatus
+	2,   // 4: debate_management.GetRoomResponse.elimination:type_name -> debate_management.RoundStatus
+	0,   // 5: debate_management.UpdateRoomRequest.room:type_name -> debate_management.Room
+	0,   // 6: debate_management.UpdateRoomResponse.room:type_name -> debate_management.Room
+	0,   // 7: debate_management.CreateRoomRequest.room:type_name -> debate_management.Room
+	0,   // 8: debate_management.CreateRoomResponse.room:type_name -> debate_management.Room
+	1,   // 9: debate_management.SetRoomAvailabilityRequest.availability:type_name -> debate_management.AvailabilityWindow
+	0,   // 10: debate_management.SetRoomAvailabilityResponse.room:type_name -> debate_management.Room
+	16,  // 11: debate_management.CreateBuildingRequest.building:type_name -> debate_management.Building
+	16,  // 12: debate_management.CreateBuildingResponse.building:type_name -> debate_management.Building
+	16,  // 13: debate_management.GetBuildingsResponse.buildings:type_name -> debate_management.Building
+	16,  // 14: debate_management.UpdateBuildingRequest.building:type_name -> debate_management.Building
+	16,  // 15: debate_management.UpdateBuildingResponse.building:type_name -> debate_management.Building
+	25,  // 16: debate_management.GetJudgesResponse.judges:type_name -> debate_management.Judge
+	174, // 17: debate_management.RoundInfo.rooms:type_name -> debate_management.RoundInfo.RoomsEntry
+	175, // 18: debate_management.GetJudgeResponse.preliminary:type_name -> debate_management.GetJudgeResponse.PreliminaryEntry
+	176, // 19: debate_management.GetJudgeResponse.elimination:type_name -> debate_management.GetJudgeResponse.EliminationEntry
+	33,  // 20: debate_management.GetJudgeResponse.conflicts:type_name -> debate_management.JudgeConflict
+	177, // 21: debate_management.UpdateJudgeRequest.preliminary:type_name -> debate_management.UpdateJudgeRequest.PreliminaryEntry
+	178, // 22: debate_management.UpdateJudgeRequest.elimination:type_name -> debate_management.UpdateJudgeRequest.EliminationEntry
+	33,  // 23: debate_management.UpdateJudgeRequest.conflicts:type_name -> debate_management.JudgeConflict
+	35,  // 24: debate_management.SetJudgeAvailabilityRequest.rounds:type_name -> debate_management.RoundAvailability
+	28,  // 25: debate_management.JudgeRosterEntry.room:type_name -> debate_management.RoomInfo
+	41,  // 26: debate_management.GetJudgeRosterResponse.judges:type_name -> debate_management.JudgeRosterEntry
+	28,  // 27: debate_management.MarkJudgeAbsentResponse.room:type_name -> debate_management.RoomInfo
+	25,  // 28: debate_management.MarkJudgeAbsentResponse.replacement_judge:type_name -> debate_management.Judge
+	46,  // 29: debate_management.Pairing.team1:type_name -> debate_management.Team
+	46,  // 30: debate_management.Pairing.team2:type_name -> debate_management.Team
+	25,  // 31: debate_management.Pairing.judges:type_name -> debate_management.Judge
+	46,  // 32: debate_management.Pairing.team3:type_name -> debate_management.Team
+	46,  // 33: debate_management.Pairing.team4:type_name -> debate_management.Team
+	47,  // 34: debate_management.Team.speakers:type_name -> debate_management.Speaker
+	45,  // 35: debate_management.GetPairingsResponse.pairings:type_name -> debate_management.Pairing
+	50,  // 36: debate_management.GetPairingsResponse.byes:type_name -> debate_management.Bye
+	45,  // 37: debate_management.UpdatePairingsRequest.pairings:type_name -> debate_management.Pairing
+	45,  // 38: debate_management.UpdatePairingsResponse.pairings:type_name -> debate_management.Pairing
+	53,  // 39: debate_management.ValidatePairingsResponse.issues:type_name -> debate_management.PairingIssue
+	45,  // 40: debate_management.PairingChange.released:type_name -> debate_management.Pairing
+	45,  // 41: debate_management.PairingChange.draft:type_name -> debate_management.Pairing
+	56,  // 42: debate_management.GetPairingsDiffResponse.changes:type_name -> debate_management.PairingChange
+	53,  // 43: debate_management.ReleasePairingsResponse.issues:type_name -> debate_management.PairingIssue
+	45,  // 44: debate_management.ReplayPairingsResponse.saved:type_name -> debate_management.Pairing
+	45,  // 45: debate_management.ReplayPairingsResponse.regenerated:type_name -> debate_management.Pairing
+	62,  // 46: debate_management.ReplayPairingsResponse.differences:type_name -> debate_management.PairingDifference
+	25,  // 47: debate_management.Ballot.judges:type_name -> debate_management.Judge
+	46,  // 48: debate_management.Ballot.team1:type_name -> debate_management.Team
+	46,  // 49: debate_management.Ballot.team2:type_name -> debate_management.Team
+	46,  // 50: debate_management.Ballot.team3:type_name -> debate_management.Team
+	46,  // 51: debate_management.Ballot.team4:type_name -> debate_management.Team
+	25,  // 52: debate_management.Ballot.dissenting_judges:type_name -> debate_management.Judge
+	25,  // 53: debate_management.Ballot.pending_judges:type_name -> debate_management.Judge
+	64,  // 54: debate_management.GetBallotsResponse.ballots:type_name -> debate_management.Ballot
+	64,  // 55: debate_management.GetBallotResponse.ballot:type_name -> debate_management.Ballot
+	64,  // 56: debate_management.GetBallotByJudgeIDResponse.ballot:type_name -> debate_management.Ballot
+	64,  // 57: debate_management.UpdateBallotRequest.ballot:type_name -> debate_management.Ballot
+	64,  // 58: debate_management.UpdateBallotResponse.ballot:type_name -> debate_management.Ballot
+	74,  // 59: debate_management.UpdateBallotResponse.conflicts:type_name -> debate_management.BallotChange
+	64,  // 60: debate_management.BallotVersion.ballot:type_name -> debate_management.Ballot
+	74,  // 61: debate_management.BallotVersion.changes:type_name -> debate_management.BallotChange
+	73,  // 62: debate_management.GetBallotHistoryResponse.versions:type_name -> debate_management.BallotVersion
+	64,  // 63: debate_management.RevertBallotResponse.ballot:type_name -> debate_management.Ballot
+	64,  // 64: debate_management.BallotEntry.ballot:type_name -> debate_management.Ballot
+	81,  // 65: debate_management.BallotConflict.entries:type_name -> debate_management.BallotEntry
+	74,  // 66: debate_management.BallotConflict.changes:type_name -> debate_management.BallotChange
+	82,  // 67: debate_management.GetBallotConflictsResponse.conflicts:type_name -> debate_management.BallotConflict
+	45,  // 68: debate_management.GeneratePairingsResponse.pairings:type_name -> debate_management.Pairing
+	97,  // 69: debate_management.GeneratePairingsResponse.side_counts:type_name -> debate_management.TeamSideCount
+	50,  // 70: debate_management.GeneratePairingsResponse.byes:type_name -> debate_management.Bye
+	90,  // 71: debate_management.GetEliminationBracketResponse.nodes:type_name -> debate_management.BracketNode
+	179, // 72: debate_management.GetEliminationBracketResponse.root_node_ids:type_name -> debate_management.GetEliminationBracketResponse.RootNodeIdsEntry
+	91,  // 73: debate_management.BracketNode.team1:type_name -> debate_management.BracketTeam
+	91,  // 74: debate_management.BracketNode.team2:type_name -> debate_management.BracketTeam
+	92,  // 75: debate_management.GetBreakCategoriesResponse.categories:type_name -> debate_management.BreakCategory
+	92,  // 76: debate_management.UpdateBreakCategoriesRequest.categories:type_name -> debate_management.BreakCategory
+	92,  // 77: debate_management.UpdateBreakCategoriesResponse.categories:type_name -> debate_management.BreakCategory
+	47,  // 78: debate_management.CreateTeamRequest.speakers:type_name -> debate_management.Speaker
+	46,  // 79: debate_management.UpdateTeamRequest.team:type_name -> debate_management.Team
+	46,  // 80: debate_management.GetTeamsByTournamentResponse.teams:type_name -> debate_management.Team
+	107, // 81: debate_management.OverallRankingResponse.top_students:type_name -> debate_management.TopStudent
+	108, // 82: debate_management.OverallRankingResponse.student_info:type_name -> debate_management.StudentInfo
+	111, // 83: debate_management.PerformanceResponse.performance_data:type_name -> debate_management.PerformanceData
+	114, // 84: debate_management.TournamentRankingResponse.rankings:type_name -> debate_management.StudentRanking
+	117, // 85: debate_management.TournamentTeamsRankingResponse.rankings:type_name -> debate_management.TeamRanking
+	120, // 86: debate_management.TournamentSchoolRankingResponse.rankings:type_name -> debate_management.SchoolRanking
+	123, // 87: debate_management.OverallSchoolRankingResponse.top_schools:type_name -> debate_management.TopSchool
+	124, // 88: debate_management.OverallSchoolRankingResponse.school_info:type_name -> debate_management.SchoolInfo
+	127, // 89: debate_management.SchoolPerformanceResponse.performance_data:type_name -> debate_management.SchoolPerformanceData
+	134, // 90: debate_management.StudentFeedbackEntry.judges:type_name -> debate_management.JudgeInfo
+	133, // 91: debate_management.GetStudentFeedbackResponse.feedback_entries:type_name -> debate_management.StudentFeedbackEntry
+	139, // 92: debate_management.GetJudgeFeedbackResponse.feedback_entries:type_name -> debate_management.JudgeFeedbackEntry
+	142, // 93: debate_management.GetVolunteerRankingResponse.top_volunteers:type_name -> debate_management.TopVolunteer
+	143, // 94: debate_management.GetVolunteerRankingResponse.volunteer_info:type_name -> debate_management.VolunteerInfo
+	146, // 95: debate_management.GetVolunteerPerformanceResponse.performance_data:type_name -> debate_management.VolunteerPerformanceData
+	151, // 96: debate_management.TournamentVolunteerRankingResponse.rankings:type_name -> debate_management.VolunteerTournamentRank
+	155, // 97: debate_management.JudgeCalibrationResponse.judges:type_name -> debate_management.JudgeCalibration
+	156, // 98: debate_management.JudgeCalibrationResponse.outliers:type_name -> debate_management.OutlierBallot
+	114, // 99: debate_management.JudgeCalibrationResponse.normalised_ranking:type_name -> debate_management.StudentRanking
+	165, // 100: debate_management.PublishTabResponse.tab:type_name -> debate_management.PublishedTab
+	165, // 101: debate_management.GetPublishedTabResponse.tab:type_name -> debate_management.PublishedTab
+	117, // 102: debate_management.PublishedTab.team_standings:type_name -> debate_management.TeamRanking
+	114, // 103: debate_management.PublishedTab.speaker_standings:type_name -> debate_management.StudentRanking
+	166, // 104: debate_management.PublishedTab.breaks:type_name -> debate_management.TabBreak
+	168, // 105: debate_management.PublishedTab.rounds:type_name -> debate_management.TabRound
+	167, // 106: debate_management.TabBreak.teams:type_name -> debate_management.TabBreakingTeam
+	169, // 107: debate_management.TabRound.debates:type_name -> debate_management.TabDebate
+	50,  // 108: debate_management.TabRound.byes:type_name -> debate_management.Bye
+	170, // 109: debate_management.TabDebate.teams:type_name -> debate_management.TabDebateTeam
+	173, // 110: debate_management.TabIntegrityResponse.discrepancies:type_name -> debate_management.TabDiscrepancy
+	117, // 111: debate_management.TabIntegrityResponse.team_standings:type_name -> debate_management.TeamRanking
+	114, // 112: debate_management.TabIntegrityResponse.speaker_standings:type_name -> debate_management.StudentRanking
+	28,  // 113: debate_management.RoundInfo.RoomsEntry.value:type_name -> debate_management.RoomInfo
+	28,  // 114: debate_management.GetJudgeResponse.PreliminaryEntry.value:type_name -> debate_management.RoomInfo
+	28,  // 115: debate_management.GetJudgeResponse.EliminationEntry.value:type_name -> debate_management.RoomInfo
+	28,  // 116: debate_management.UpdateJudgeRequest.PreliminaryEntry.value:type_name -> debate_management.RoomInfo
+	28,  // 117: debate_management.UpdateJudgeRequest.EliminationEntry.value:type_name -> debate_management.RoomInfo
+	4,   // 118: debate_management.DebateService.GetRooms:input_type -> debate_management.GetRoomsRequest
+	6,   // 119: debate_management.DebateService.GetRoom:input_type -> debate_management.GetRoomRequest
+	8,   // 120: debate_management.DebateService.UpdateRoom:input_type -> debate_management.UpdateRoomRequest
+	10,  // 121: debate_management.DebateService.CreateRoom:input_type -> debate_management.CreateRoomRequest
+	12,  // 122: debate_management.DebateService.DeleteRoom:input_type -> debate_management.DeleteRoomRequest
+	14,  // 123: debate_management.DebateService.SetRoomAvailability:input_type -> debate_management.SetRoomAvailabilityRequest
+	17,  // 124: debate_management.DebateService.CreateBuilding:input_type -> debate_management.CreateBuildingRequest
+	19,  // 125: debate_management.DebateService.GetBuildings:input_type -> debate_management.GetBuildingsRequest
+	21,  // 126: debate_management.DebateService.UpdateBuilding:input_type -> debate_management.UpdateBuildingRequest
+	23,  // 127: debate_management.DebateService.DeleteBuilding:input_type -> debate_management.DeleteBuildingRequest
+	26,  // 128: debate_management.DebateService.GetJudges:input_type -> debate_management.GetJudgesRequest
+	30,  // 129: debate_management.DebateService.GetJudge:input_type -> debate_management.GetJudgeRequest
+	32,  // 130: debate_management.DebateService.UpdateJudge:input_type -> debate_management.UpdateJudgeRequest
+	36,  // 131: debate_management.DebateService.SetJudgeAvailability:input_type -> debate_management.SetJudgeAvailabilityRequest
+	38,  // 132: debate_management.DebateService.CheckInJudge:input_type -> debate_management.CheckInJudgeRequest
+	40,  // 133: debate_management.DebateService.GetJudgeRoster:input_type -> debate_management.GetJudgeRosterRequest
+	43,  // 134: debate_management.DebateService.MarkJudgeAbsent:input_type -> debate_management.MarkJudgeAbsentRequest
+	48,  // 135: debate_management.DebateService.GetPairings:input_type -> debate_management.GetPairingsRequest
+	51,  // 136: debate_management.DebateService.UpdatePairings:input_type -> debate_management.UpdatePairingsRequest
+	54,  // 137: debate_management.DebateService.ValidatePairings:input_type -> debate_management.ValidatePairingsRequest
+	57,  // 138: debate_management.DebateService.GetPairingsDiff:input_type -> debate_management.GetPairingsDiffRequest
+	59,  // 139: debate_management.DebateService.ReleasePairings:input_type -> debate_management.ReleasePairingsRequest
+	61,  // 140: debate_management.DebateService.ReplayPairings:input_type -> debate_management.ReplayPairingsRequest
+	65,  // 141: debate_management.DebateService.GetBallots:input_type -> debate_management.GetBallotsRequest
+	67,  // 142: debate_management.DebateService.GetBallot:input_type -> debate_management.GetBallotRequest
+	71,  // 143: debate_management.DebateService.UpdateBallot:input_type -> debate_management.UpdateBallotRequest
+	69,  // 144: debate_management.DebateService.GetBallotByJudgeID:input_type -> debate_management.GetBallotByJudgeIDRequest
+	75,  // 145: debate_management.DebateService.GetBallotHistory:input_type -> debate_management.GetBallotHistoryRequest
+	77,  // 146: debate_management.DebateService.RevertBallot:input_type -> debate_management.RevertBallotRequest
+	79,  // 147: debate_management.DebateService.SetBallotEntryMode:input_type -> debate_management.SetBallotEntryModeRequest
+	83,  // 148: debate_management.DebateService.GetBallotConflicts:input_type -> debate_management.GetBallotConflictsRequest
+	85,  // 149: debate_management.DebateService.GeneratePreliminaryPairings:input_type -> debate_management.GeneratePreliminaryPairingsRequest
+	86,  // 150: debate_management.DebateService.GenerateEliminationPairings:input_type -> debate_management.GenerateEliminationPairingsRequest
+	88,  // 151: debate_management.DebateService.GetEliminationBracket:input_type -> debate_management.GetEliminationBracketRequest
+	93,  // 152: debate_management.DebateService.GetBreakCategories:input_type -> debate_management.GetBreakCategoriesRequest
+	95,  // 153: debate_management.DebateService.UpdateBreakCategories:input_type -> debate_management.UpdateBreakCategoriesRequest
+	98,  // 154: debate_management.DebateService.CreateTeam:input_type -> debate_management.CreateTeamRequest
+	99,  // 155: debate_management.DebateService.GetTeam:input_type -> debate_management.GetTeamRequest
+	100, // 156: debate_management.DebateService.UpdateTeam:input_type -> debate_management.UpdateTeamRequest
+	103, // 157: debate_management.DebateService.GetTeamsByTournament:input_type -> debate_management.GetTeamsByTournamentRequest
+	101, // 158: debate_management.DebateService.DeleteTeam:input_type -> debate_management.DeleteTeamRequest
+	157, // 159: debate_management.DebateService.SetRankingVisibility:input_type -> debate_management.SetRankingVisibilityRequest
+	112, // 160: debate_management.DebateService.GetTournamentStudentRanking:input_type -> debate_management.TournamentRankingRequest
+	105, // 161: debate_management.DebateService.GetOverallStudentRanking:input_type -> debate_management.OverallRankingRequest
+	109, // 162: debate_management.DebateService.GetStudentOverallPerformance:input_type -> debate_management.PerformanceRequest
+	128, // 163: debate_management.DebateService.GetStudentTournamentStats:input_type -> debate_management.StudentTournamentStatsRequest
+	115, // 164: debate_management.DebateService.GetTournamentTeamsRanking:input_type -> debate_management.TournamentTeamsRankingRequest
+	118, // 165: debate_management.DebateService.GetTournamentSchoolRanking:input_type -> debate_management.TournamentSchoolRankingRequest
+	121, // 166: debate_management.DebateService.GetOverallSchoolRanking:input_type -> debate_management.OverallSchoolRankingRequest
+	125, // 167: debate_management.DebateService.GetSchoolOverallPerformance:input_type -> debate_management.SchoolPerformanceRequest
+	130, // 168: debate_management.DebateService.GetVolunteerTournamentStats:input_type -> debate_management.VolunteerTournamentStatsRequest
+	150, // 169: debate_management.DebateService.GetTournamentVolunteerRanking:input_type -> debate_management.TournamentVolunteerRankingRequest
+	153, // 170: debate_management.DebateService.GetJudgeCalibration:input_type -> debate_management.JudgeCalibrationRequest
+	132, // 171: debate_management.DebateService.GetStudentFeedback:input_type -> debate_management.GetStudentFeedbackRequest
+	136, // 172: debate_management.DebateService.SubmitJudgeFeedback:input_type -> debate_management.SubmitJudgeFeedbackRequest
+	138, // 173: debate_management.DebateService.GetJudgeFeedback:input_type -> debate_management.GetJudgeFeedbackRequest
+	141, // 174: debate_management.DebateService.GetVolunteerRanking:input_type -> debate_management.GetVolunteerRankingRequest
+	145, // 175: debate_management.DebateService.GetVolunteerPerformance:input_type -> debate_management.GetVolunteerPerformanceRequest
+	148, // 176: debate_management.DebateService.MarkStudentFeedbackAsRead:input_type -> debate_management.MarkFeedbackAsReadRequest
+	148, // 177: debate_management.DebateService.MarkJudgeFeedbackAsRead:input_type -> debate_management.MarkFeedbackAsReadRequest
+	159, // 178: debate_management.DebateService.PublishTab:input_type -> debate_management.PublishTabRequest
+	161, // 179: debate_management.DebateService.GetPublishedTab:input_type -> debate_management.GetPublishedTabRequest
+	163, // 180: debate_management.DebateService.ExportPublishedTab:input_type -> debate_management.ExportPublishedTabRequest
+	171, // 181: debate_management.DebateService.CheckTab:input_type -> debate_management.TabIntegrityRequest
+	171, // 182: debate_management.DebateService.RepairTab:input_type -> debate_management.TabIntegrityRequest
+	5,   // 183: debate_management.DebateService.GetRooms:output_type -> debate_management.GetRoomsResponse
+	7,   // 184: debate_management.DebateService.GetRoom:output_type -> debate_management.GetRoomResponse
+	9,   // 185: debate_management.DebateService.UpdateRoom:output_type -> debate_management.UpdateRoomResponse
+	11,  // 186: debate_management.DebateService.CreateRoom:output_type -> debate_management.CreateRoomResponse
+	13,  // 187: debate_management.DebateService.DeleteRoom:output_type -> debate_management.DeleteRoomResponse
+	15,  // 188: debate_management.DebateService.SetRoomAvailability:output_type -> debate_management.SetRoomAvailabilityResponse
+	18,  // 189: debate_management.DebateService.CreateBuilding:output_type -> debate_management.CreateBuildingResponse
+	20,  // 190: debate_management.DebateService.GetBuildings:output_type -> debate_management.GetBuildingsResponse
+	22,  // 191: debate_management.DebateService.UpdateBuilding:output_type -> debate_management.UpdateBuildingResponse
+	24,  // 192: debate_management.DebateService.DeleteBuilding:output_type -> debate_management.DeleteBuildingResponse
+	27,  // 193: debate_management.DebateService.GetJudges:output_type -> debate_management.GetJudgesResponse
+	31,  // 194: debate_management.DebateService.GetJudge:output_type -> debate_management.GetJudgeResponse
+	34,  // 195: debate_management.DebateService.UpdateJudge:output_type -> debate_management.UpdateJudgeResponse
+	37,  // 196: debate_management.DebateService.SetJudgeAvailability:output_type -> debate_management.SetJudgeAvailabilityResponse
+	39,  // 197: debate_management.DebateService.CheckInJudge:output_type -> debate_management.CheckInJudgeResponse
+	42,  // 198: debate_management.DebateService.GetJudgeRoster:output_type -> debate_management.GetJudgeRosterResponse
+	44,  // 199: debate_management.DebateService.MarkJudgeAbsent:output_type -> debate_management.MarkJudgeAbsentResponse
+	49,  // 200: debate_management.DebateService.GetPairings:output_type -> debate_management.GetPairingsResponse
+	52,  // 201: debate_management.DebateService.UpdatePairings:output_type -> debate_management.UpdatePairingsResponse
+	55,  // 202: debate_management.DebateService.ValidatePairings:output_type -> debate_management.ValidatePairingsResponse
+	58,  // 203: debate_management.DebateService.GetPairingsDiff:output_type -> debate_management.GetPairingsDiffResponse
+	60,  // 204: debate_management.DebateService.ReleasePairings:output_type -> debate_management.ReleasePairingsResponse
+	63,  // 205: debate_management.DebateService.ReplayPairings:output_type -> debate_management.ReplayPairingsResponse
+	66,  // 206: debate_management.DebateService.GetBallots:output_type -> debate_management.GetBallotsResponse
+	68,  // 207: debate_management.DebateService.GetBallot:output_type -> debate_management.GetBallotResponse
+	72,  // 208: debate_management.DebateService.UpdateBallot:output_type -> debate_management.UpdateBallotResponse
+	70,  // 209: debate_management.DebateService.GetBallotByJudgeID:output_type -> debate_management.GetBallotByJudgeIDResponse
+	76,  // 210: debate_management.DebateService.GetBallotHistory:output_type -> debate_management.GetBallotHistoryResponse
+	78,  // 211: debate_management.DebateService.RevertBallot:output_type -> debate_management.RevertBallotResponse
+	80,  // 212: debate_management.DebateService.SetBallotEntryMode:output_type -> debate_management.SetBallotEntryModeResponse
+	84,  // 213: debate_management.DebateService.GetBallotConflicts:output_type -> debate_management.GetBallotConflictsResponse
+	87,  // 214: debate_management.DebateService.GeneratePreliminaryPairings:output_type -> debate_management.GeneratePairingsResponse
+	87,  // 215: debate_management.DebateService.GenerateEliminationPairings:output_type -> debate_management.GeneratePairingsResponse
+	89,  // 216: debate_management.DebateService.GetEliminationBracket:output_type -> debate_management.GetEliminationBracketResponse
+	94,  // 217: debate_management.DebateService.GetBreakCategories:output_type -> debate_management.GetBreakCategoriesResponse
+	96,  // 218: debate_management.DebateService.UpdateBreakCategories:output_type -> debate_management.UpdateBreakCategoriesResponse
+	46,  // 219: debate_management.DebateService.CreateTeam:output_type -> debate_management.Team
+	46,  // 220: debate_management.DebateService.GetTeam:output_type -> debate_management.Team
+	46,  // 221: debate_management.DebateService.UpdateTeam:output_type -> debate_management.Team
+	104, // 222: debate_management.DebateService.GetTeamsByTournament:output_type -> debate_management.GetTeamsByTournamentResponse
+	102, // 223: debate_management.DebateService.DeleteTeam:output_type -> debate_management.DeleteTeamResponse
+	158, // 224: debate_management.DebateService.SetRankingVisibility:output_type -> debate_management.SetRankingVisibilityResponse
+	113, // 225: debate_management.DebateService.GetTournamentStudentRanking:output_type -> debate_management.TournamentRankingResponse
+	106, // 226: debate_management.DebateService.GetOverallStudentRanking:output_type -> debate_management.OverallRankingResponse
+	110, // 227: debate_management.DebateService.GetStudentOverallPerformance:output_type -> debate_management.PerformanceResponse
+	129, // 228: debate_management.DebateService.GetStudentTournamentStats:output_type -> debate_management.StudentTournamentStatsResponse
+	116, // 229: debate_management.DebateService.GetTournamentTeamsRanking:output_type -> debate_management.TournamentTeamsRankingResponse
+	119, // 230: debate_management.DebateService.GetTournamentSchoolRanking:output_type -> debate_management.TournamentSchoolRankingResponse
+	122, // 231: debate_management.DebateService.GetOverallSchoolRanking:output_type -> debate_management.OverallSchoolRankingResponse
+	126, // 232: debate_management.DebateService.GetSchoolOverallPerformance:output_type -> debate_management.SchoolPerformanceResponse
+	131, // 233: debate_management.DebateService.GetVolunteerTournamentStats:output_type -> debate_management.VolunteerTournamentStatsResponse
+	152, // 234: debate_management.DebateService.GetTournamentVolunteerRanking:output_type -> debate_management.TournamentVolunteerRankingResponse
+	154, // 235: debate_management.DebateService.GetJudgeCalibration:output_type -> debate_management.JudgeCalibrationResponse
+	135, // 236: debate_management.DebateService.GetStudentFeedback:output_type -> debate_management.GetStudentFeedbackResponse
+	137, // 237: debate_management.DebateService.SubmitJudgeFeedback:output_type -> debate_management.SubmitJudgeFeedbackResponse
+	140, // 238: debate_management.DebateService.GetJudgeFeedback:output_type -> debate_management.GetJudgeFeedbackResponse
+	144, // 239: debate_management.DebateService.GetVolunteerRanking:output_type -> debate_management.GetVolunteerRankingResponse
+	147, // 240: debate_management.DebateService.GetVolunteerPerformance:output_type -> debate_management.GetVolunteerPerformanceResponse
+	149, // 241: debate_management.DebateService.MarkStudentFeedbackAsRead:output_type -> debate_management.MarkFeedbackAsReadResponse
+	149, // 242: debate_management.DebateService.MarkJudgeFeedbackAsRead:output_type -> debate_management.MarkFeedbackAsReadResponse
+	160, // 243: debate_management.DebateService.PublishTab:output_type -> debate_management.PublishTabResponse
+	162, // 244: debate_management.DebateService.GetPublishedTab:output_type -> debate_management.GetPublishedTabResponse
+	164, // 245: debate_management.DebateService.ExportPublishedTab:output_type -> debate_management.ExportPublishedTabResponse
+	172, // 246: debate_management.DebateService.CheckTab:output_type -> debate_management.TabIntegrityResponse
+	172, // 247: debate_management.DebateService.RepairTab:output_type -> debate_management.TabIntegrityResponse
+	183, // [183:248] is the sub-list for method output_type
+	118, // [118:183] is the sub-list for method input_type
+	118, // [118:118] is the sub-list for extension type_name
+	118, // [118:118] is the sub-list for extension extendee
+	0,   // [0:118] is the sub-list for field type_name
 }
 
 func init() { file_internal_grpc_proto_debate_management_debate_proto_init() }
@@ -7435,7 +14401,7 @@ func file_internal_grpc_proto_debate_management_debate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_grpc_proto_debate_management_debate_proto_rawDesc), len(file_internal_grpc_proto_debate_management_debate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   180,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
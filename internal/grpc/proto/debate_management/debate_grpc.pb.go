@@ -22,17 +22,39 @@ const (
 	DebateService_GetRooms_FullMethodName                      = "/debate_management.DebateService/GetRooms"
 	DebateService_GetRoom_FullMethodName                       = "/debate_management.DebateService/GetRoom"
 	DebateService_UpdateRoom_FullMethodName                    = "/debate_management.DebateService/UpdateRoom"
+	DebateService_CreateRoom_FullMethodName                    = "/debate_management.DebateService/CreateRoom"
+	DebateService_DeleteRoom_FullMethodName                    = "/debate_management.DebateService/DeleteRoom"
+	DebateService_SetRoomAvailability_FullMethodName           = "/debate_management.DebateService/SetRoomAvailability"
+	DebateService_CreateBuilding_FullMethodName                = "/debate_management.DebateService/CreateBuilding"
+	DebateService_GetBuildings_FullMethodName                  = "/debate_management.DebateService/GetBuildings"
+	DebateService_UpdateBuilding_FullMethodName                = "/debate_management.DebateService/UpdateBuilding"
+	DebateService_DeleteBuilding_FullMethodName                = "/debate_management.DebateService/DeleteBuilding"
 	DebateService_GetJudges_FullMethodName                     = "/debate_management.DebateService/GetJudges"
 	DebateService_GetJudge_FullMethodName                      = "/debate_management.DebateService/GetJudge"
 	DebateService_UpdateJudge_FullMethodName                   = "/debate_management.DebateService/UpdateJudge"
+	DebateService_SetJudgeAvailability_FullMethodName          = "/debate_management.DebateService/SetJudgeAvailability"
+	DebateService_CheckInJudge_FullMethodName                  = "/debate_management.DebateService/CheckInJudge"
+	DebateService_GetJudgeRoster_FullMethodName                = "/debate_management.DebateService/GetJudgeRoster"
+	DebateService_MarkJudgeAbsent_FullMethodName               = "/debate_management.DebateService/MarkJudgeAbsent"
 	DebateService_GetPairings_FullMethodName                   = "/debate_management.DebateService/GetPairings"
 	DebateService_UpdatePairings_FullMethodName                = "/debate_management.DebateService/UpdatePairings"
+	DebateService_ValidatePairings_FullMethodName              = "/debate_management.DebateService/ValidatePairings"
+	DebateService_GetPairingsDiff_FullMethodName               = "/debate_management.DebateService/GetPairingsDiff"
+	DebateService_ReleasePairings_FullMethodName               = "/debate_management.DebateService/ReleasePairings"
+	DebateService_ReplayPairings_FullMethodName                = "/debate_management.DebateService/ReplayPairings"
 	DebateService_GetBallots_FullMethodName                    = "/debate_management.DebateService/GetBallots"
 	DebateService_GetBallot_FullMethodName                     = "/debate_management.DebateService/GetBallot"
 	DebateService_UpdateBallot_FullMethodName                  = "/debate_management.DebateService/UpdateBallot"
 	DebateService_GetBallotByJudgeID_FullMethodName            = "/debate_management.DebateService/GetBallotByJudgeID"
+	DebateService_GetBallotHistory_FullMethodName              = "/debate_management.DebateService/GetBallotHistory"
+	DebateService_RevertBallot_FullMethodName                  = "/debate_management.DebateService/RevertBallot"
+	DebateService_SetBallotEntryMode_FullMethodName            = "/debate_management.DebateService/SetBallotEntryMode"
+	DebateService_GetBallotConflicts_FullMethodName            = "/debate_management.DebateService/GetBallotConflicts"
 	DebateService_GeneratePreliminaryPairings_FullMethodName   = "/debate_management.DebateService/GeneratePreliminaryPairings"
 	DebateService_GenerateEliminationPairings_FullMethodName   = "/debate_management.DebateService/GenerateEliminationPairings"
+	DebateService_GetEliminationBracket_FullMethodName         = "/debate_management.DebateService/GetEliminationBracket"
+	DebateService_GetBreakCategories_FullMethodName            = "/debate_management.DebateService/GetBreakCategories"
+	DebateService_UpdateBreakCategories_FullMethodName         = "/debate_management.DebateService/UpdateBreakCategories"
 	DebateService_CreateTeam_FullMethodName                    = "/debate_management.DebateService/CreateTeam"
 	DebateService_GetTeam_FullMethodName                       = "/debate_management.DebateService/GetTeam"
 	DebateService_UpdateTeam_FullMethodName                    = "/debate_management.DebateService/UpdateTeam"
@@ -49,6 +71,7 @@ const (
 	DebateService_GetSchoolOverallPerformance_FullMethodName   = "/debate_management.DebateService/GetSchoolOverallPerformance"
 	DebateService_GetVolunteerTournamentStats_FullMethodName   = "/debate_management.DebateService/GetVolunteerTournamentStats"
 	DebateService_GetTournamentVolunteerRanking_FullMethodName = "/debate_management.DebateService/GetTournamentVolunteerRanking"
+	DebateService_GetJudgeCalibration_FullMethodName           = "/debate_management.DebateService/GetJudgeCalibration"
 	DebateService_GetStudentFeedback_FullMethodName            = "/debate_management.DebateService/GetStudentFeedback"
 	DebateService_SubmitJudgeFeedback_FullMethodName           = "/debate_management.DebateService/SubmitJudgeFeedback"
 	DebateService_GetJudgeFeedback_FullMethodName              = "/debate_management.DebateService/GetJudgeFeedback"
@@ -56,6 +79,11 @@ const (
 	DebateService_GetVolunteerPerformance_FullMethodName       = "/debate_management.DebateService/GetVolunteerPerformance"
 	DebateService_MarkStudentFeedbackAsRead_FullMethodName     = "/debate_management.DebateService/MarkStudentFeedbackAsRead"
 	DebateService_MarkJudgeFeedbackAsRead_FullMethodName       = "/debate_management.DebateService/MarkJudgeFeedbackAsRead"
+	DebateService_PublishTab_FullMethodName                    = "/debate_management.DebateService/PublishTab"
+	DebateService_GetPublishedTab_FullMethodName               = "/debate_management.DebateService/GetPublishedTab"
+	DebateService_ExportPublishedTab_FullMethodName            = "/debate_management.DebateService/ExportPublishedTab"
+	DebateService_CheckTab_FullMethodName                      = "/debate_management.DebateService/CheckTab"
+	DebateService_RepairTab_FullMethodName                     = "/debate_management.DebateService/RepairTab"
 )
 
 // DebateServiceClient is the client API for DebateService service.
@@ -66,21 +94,44 @@ type DebateServiceClient interface {
 	GetRooms(ctx context.Context, in *GetRoomsRequest, opts ...grpc.CallOption) (*GetRoomsResponse, error)
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
 	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	SetRoomAvailability(ctx context.Context, in *SetRoomAvailabilityRequest, opts ...grpc.CallOption) (*SetRoomAvailabilityResponse, error)
+	// Building operations
+	CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*CreateBuildingResponse, error)
+	GetBuildings(ctx context.Context, in *GetBuildingsRequest, opts ...grpc.CallOption) (*GetBuildingsResponse, error)
+	UpdateBuilding(ctx context.Context, in *UpdateBuildingRequest, opts ...grpc.CallOption) (*UpdateBuildingResponse, error)
+	DeleteBuilding(ctx context.Context, in *DeleteBuildingRequest, opts ...grpc.CallOption) (*DeleteBuildingResponse, error)
 	// Judge operations
 	GetJudges(ctx context.Context, in *GetJudgesRequest, opts ...grpc.CallOption) (*GetJudgesResponse, error)
 	GetJudge(ctx context.Context, in *GetJudgeRequest, opts ...grpc.CallOption) (*GetJudgeResponse, error)
 	UpdateJudge(ctx context.Context, in *UpdateJudgeRequest, opts ...grpc.CallOption) (*UpdateJudgeResponse, error)
+	SetJudgeAvailability(ctx context.Context, in *SetJudgeAvailabilityRequest, opts ...grpc.CallOption) (*SetJudgeAvailabilityResponse, error)
+	CheckInJudge(ctx context.Context, in *CheckInJudgeRequest, opts ...grpc.CallOption) (*CheckInJudgeResponse, error)
+	GetJudgeRoster(ctx context.Context, in *GetJudgeRosterRequest, opts ...grpc.CallOption) (*GetJudgeRosterResponse, error)
+	MarkJudgeAbsent(ctx context.Context, in *MarkJudgeAbsentRequest, opts ...grpc.CallOption) (*MarkJudgeAbsentResponse, error)
 	// Pairing operations
 	GetPairings(ctx context.Context, in *GetPairingsRequest, opts ...grpc.CallOption) (*GetPairingsResponse, error)
 	UpdatePairings(ctx context.Context, in *UpdatePairingsRequest, opts ...grpc.CallOption) (*UpdatePairingsResponse, error)
+	ValidatePairings(ctx context.Context, in *ValidatePairingsRequest, opts ...grpc.CallOption) (*ValidatePairingsResponse, error)
+	GetPairingsDiff(ctx context.Context, in *GetPairingsDiffRequest, opts ...grpc.CallOption) (*GetPairingsDiffResponse, error)
+	ReleasePairings(ctx context.Context, in *ReleasePairingsRequest, opts ...grpc.CallOption) (*ReleasePairingsResponse, error)
+	ReplayPairings(ctx context.Context, in *ReplayPairingsRequest, opts ...grpc.CallOption) (*ReplayPairingsResponse, error)
 	// Ballot operations
 	GetBallots(ctx context.Context, in *GetBallotsRequest, opts ...grpc.CallOption) (*GetBallotsResponse, error)
 	GetBallot(ctx context.Context, in *GetBallotRequest, opts ...grpc.CallOption) (*GetBallotResponse, error)
 	UpdateBallot(ctx context.Context, in *UpdateBallotRequest, opts ...grpc.CallOption) (*UpdateBallotResponse, error)
 	GetBallotByJudgeID(ctx context.Context, in *GetBallotByJudgeIDRequest, opts ...grpc.CallOption) (*GetBallotByJudgeIDResponse, error)
+	GetBallotHistory(ctx context.Context, in *GetBallotHistoryRequest, opts ...grpc.CallOption) (*GetBallotHistoryResponse, error)
+	RevertBallot(ctx context.Context, in *RevertBallotRequest, opts ...grpc.CallOption) (*RevertBallotResponse, error)
+	SetBallotEntryMode(ctx context.Context, in *SetBallotEntryModeRequest, opts ...grpc.CallOption) (*SetBallotEntryModeResponse, error)
+	GetBallotConflicts(ctx context.Context, in *GetBallotConflictsRequest, opts ...grpc.CallOption) (*GetBallotConflictsResponse, error)
 	// Algorithm integration
 	GeneratePreliminaryPairings(ctx context.Context, in *GeneratePreliminaryPairingsRequest, opts ...grpc.CallOption) (*GeneratePairingsResponse, error)
 	GenerateEliminationPairings(ctx context.Context, in *GenerateEliminationPairingsRequest, opts ...grpc.CallOption) (*GeneratePairingsResponse, error)
+	GetEliminationBracket(ctx context.Context, in *GetEliminationBracketRequest, opts ...grpc.CallOption) (*GetEliminationBracketResponse, error)
+	GetBreakCategories(ctx context.Context, in *GetBreakCategoriesRequest, opts ...grpc.CallOption) (*GetBreakCategoriesResponse, error)
+	UpdateBreakCategories(ctx context.Context, in *UpdateBreakCategoriesRequest, opts ...grpc.CallOption) (*UpdateBreakCategoriesResponse, error)
 	// Team operations
 	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error)
 	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
@@ -99,6 +150,7 @@ type DebateServiceClient interface {
 	GetSchoolOverallPerformance(ctx context.Context, in *SchoolPerformanceRequest, opts ...grpc.CallOption) (*SchoolPerformanceResponse, error)
 	GetVolunteerTournamentStats(ctx context.Context, in *VolunteerTournamentStatsRequest, opts ...grpc.CallOption) (*VolunteerTournamentStatsResponse, error)
 	GetTournamentVolunteerRanking(ctx context.Context, in *TournamentVolunteerRankingRequest, opts ...grpc.CallOption) (*TournamentVolunteerRankingResponse, error)
+	GetJudgeCalibration(ctx context.Context, in *JudgeCalibrationRequest, opts ...grpc.CallOption) (*JudgeCalibrationResponse, error)
 	GetStudentFeedback(ctx context.Context, in *GetStudentFeedbackRequest, opts ...grpc.CallOption) (*GetStudentFeedbackResponse, error)
 	SubmitJudgeFeedback(ctx context.Context, in *SubmitJudgeFeedbackRequest, opts ...grpc.CallOption) (*SubmitJudgeFeedbackResponse, error)
 	GetJudgeFeedback(ctx context.Context, in *GetJudgeFeedbackRequest, opts ...grpc.CallOption) (*GetJudgeFeedbackResponse, error)
@@ -106,6 +158,12 @@ type DebateServiceClient interface {
 	GetVolunteerPerformance(ctx context.Context, in *GetVolunteerPerformanceRequest, opts ...grpc.CallOption) (*GetVolunteerPerformanceResponse, error)
 	MarkStudentFeedbackAsRead(ctx context.Context, in *MarkFeedbackAsReadRequest, opts ...grpc.CallOption) (*MarkFeedbackAsReadResponse, error)
 	MarkJudgeFeedbackAsRead(ctx context.Context, in *MarkFeedbackAsReadRequest, opts ...grpc.CallOption) (*MarkFeedbackAsReadResponse, error)
+	// Published tab operations
+	PublishTab(ctx context.Context, in *PublishTabRequest, opts ...grpc.CallOption) (*PublishTabResponse, error)
+	GetPublishedTab(ctx context.Context, in *GetPublishedTabRequest, opts ...grpc.CallOption) (*GetPublishedTabResponse, error)
+	ExportPublishedTab(ctx context.Context, in *ExportPublishedTabRequest, opts ...grpc.CallOption) (*ExportPublishedTabResponse, error)
+	CheckTab(ctx context.Context, in *TabIntegrityRequest, opts ...grpc.CallOption) (*TabIntegrityResponse, error)
+	RepairTab(ctx context.Context, in *TabIntegrityRequest, opts ...grpc.CallOption) (*TabIntegrityResponse, error)
 }
 
 type debateServiceClient struct {
@@ -146,6 +204,76 @@ func (c *debateServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequ
 	return out, nil
 }
 
+func (c *debateServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, DebateService_CreateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoomResponse)
+	err := c.cc.Invoke(ctx, DebateService_DeleteRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) SetRoomAvailability(ctx context.Context, in *SetRoomAvailabilityRequest, opts ...grpc.CallOption) (*SetRoomAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRoomAvailabilityResponse)
+	err := c.cc.Invoke(ctx, DebateService_SetRoomAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) CreateBuilding(ctx context.Context, in *CreateBuildingRequest, opts ...grpc.CallOption) (*CreateBuildingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBuildingResponse)
+	err := c.cc.Invoke(ctx, DebateService_CreateBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetBuildings(ctx context.Context, in *GetBuildingsRequest, opts ...grpc.CallOption) (*GetBuildingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBuildingsResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetBuildings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) UpdateBuilding(ctx context.Context, in *UpdateBuildingRequest, opts ...grpc.CallOption) (*UpdateBuildingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBuildingResponse)
+	err := c.cc.Invoke(ctx, DebateService_UpdateBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) DeleteBuilding(ctx context.Context, in *DeleteBuildingRequest, opts ...grpc.CallOption) (*DeleteBuildingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBuildingResponse)
+	err := c.cc.Invoke(ctx, DebateService_DeleteBuilding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) GetJudges(ctx context.Context, in *GetJudgesRequest, opts ...grpc.CallOption) (*GetJudgesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetJudgesResponse)
@@ -176,6 +304,46 @@ func (c *debateServiceClient) UpdateJudge(ctx context.Context, in *UpdateJudgeRe
 	return out, nil
 }
 
+func (c *debateServiceClient) SetJudgeAvailability(ctx context.Context, in *SetJudgeAvailabilityRequest, opts ...grpc.CallOption) (*SetJudgeAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetJudgeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, DebateService_SetJudgeAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) CheckInJudge(ctx context.Context, in *CheckInJudgeRequest, opts ...grpc.CallOption) (*CheckInJudgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInJudgeResponse)
+	err := c.cc.Invoke(ctx, DebateService_CheckInJudge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetJudgeRoster(ctx context.Context, in *GetJudgeRosterRequest, opts ...grpc.CallOption) (*GetJudgeRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJudgeRosterResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetJudgeRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) MarkJudgeAbsent(ctx context.Context, in *MarkJudgeAbsentRequest, opts ...grpc.CallOption) (*MarkJudgeAbsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkJudgeAbsentResponse)
+	err := c.cc.Invoke(ctx, DebateService_MarkJudgeAbsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) GetPairings(ctx context.Context, in *GetPairingsRequest, opts ...grpc.CallOption) (*GetPairingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPairingsResponse)
@@ -196,6 +364,46 @@ func (c *debateServiceClient) UpdatePairings(ctx context.Context, in *UpdatePair
 	return out, nil
 }
 
+func (c *debateServiceClient) ValidatePairings(ctx context.Context, in *ValidatePairingsRequest, opts ...grpc.CallOption) (*ValidatePairingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePairingsResponse)
+	err := c.cc.Invoke(ctx, DebateService_ValidatePairings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetPairingsDiff(ctx context.Context, in *GetPairingsDiffRequest, opts ...grpc.CallOption) (*GetPairingsDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPairingsDiffResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetPairingsDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) ReleasePairings(ctx context.Context, in *ReleasePairingsRequest, opts ...grpc.CallOption) (*ReleasePairingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleasePairingsResponse)
+	err := c.cc.Invoke(ctx, DebateService_ReleasePairings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) ReplayPairings(ctx context.Context, in *ReplayPairingsRequest, opts ...grpc.CallOption) (*ReplayPairingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayPairingsResponse)
+	err := c.cc.Invoke(ctx, DebateService_ReplayPairings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) GetBallots(ctx context.Context, in *GetBallotsRequest, opts ...grpc.CallOption) (*GetBallotsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBallotsResponse)
@@ -236,6 +444,46 @@ func (c *debateServiceClient) GetBallotByJudgeID(ctx context.Context, in *GetBal
 	return out, nil
 }
 
+func (c *debateServiceClient) GetBallotHistory(ctx context.Context, in *GetBallotHistoryRequest, opts ...grpc.CallOption) (*GetBallotHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBallotHistoryResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetBallotHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) RevertBallot(ctx context.Context, in *RevertBallotRequest, opts ...grpc.CallOption) (*RevertBallotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertBallotResponse)
+	err := c.cc.Invoke(ctx, DebateService_RevertBallot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) SetBallotEntryMode(ctx context.Context, in *SetBallotEntryModeRequest, opts ...grpc.CallOption) (*SetBallotEntryModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBallotEntryModeResponse)
+	err := c.cc.Invoke(ctx, DebateService_SetBallotEntryMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetBallotConflicts(ctx context.Context, in *GetBallotConflictsRequest, opts ...grpc.CallOption) (*GetBallotConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBallotConflictsResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetBallotConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) GeneratePreliminaryPairings(ctx context.Context, in *GeneratePreliminaryPairingsRequest, opts ...grpc.CallOption) (*GeneratePairingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GeneratePairingsResponse)
@@ -256,6 +504,36 @@ func (c *debateServiceClient) GenerateEliminationPairings(ctx context.Context, i
 	return out, nil
 }
 
+func (c *debateServiceClient) GetEliminationBracket(ctx context.Context, in *GetEliminationBracketRequest, opts ...grpc.CallOption) (*GetEliminationBracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEliminationBracketResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetEliminationBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetBreakCategories(ctx context.Context, in *GetBreakCategoriesRequest, opts ...grpc.CallOption) (*GetBreakCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBreakCategoriesResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetBreakCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) UpdateBreakCategories(ctx context.Context, in *UpdateBreakCategoriesRequest, opts ...grpc.CallOption) (*UpdateBreakCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBreakCategoriesResponse)
+	err := c.cc.Invoke(ctx, DebateService_UpdateBreakCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Team)
@@ -416,6 +694,16 @@ func (c *debateServiceClient) GetTournamentVolunteerRanking(ctx context.Context,
 	return out, nil
 }
 
+func (c *debateServiceClient) GetJudgeCalibration(ctx context.Context, in *JudgeCalibrationRequest, opts ...grpc.CallOption) (*JudgeCalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JudgeCalibrationResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetJudgeCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debateServiceClient) GetStudentFeedback(ctx context.Context, in *GetStudentFeedbackRequest, opts ...grpc.CallOption) (*GetStudentFeedbackResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStudentFeedbackResponse)
@@ -486,6 +774,56 @@ func (c *debateServiceClient) MarkJudgeFeedbackAsRead(ctx context.Context, in *M
 	return out, nil
 }
 
+func (c *debateServiceClient) PublishTab(ctx context.Context, in *PublishTabRequest, opts ...grpc.CallOption) (*PublishTabResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishTabResponse)
+	err := c.cc.Invoke(ctx, DebateService_PublishTab_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) GetPublishedTab(ctx context.Context, in *GetPublishedTabRequest, opts ...grpc.CallOption) (*GetPublishedTabResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublishedTabResponse)
+	err := c.cc.Invoke(ctx, DebateService_GetPublishedTab_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) ExportPublishedTab(ctx context.Context, in *ExportPublishedTabRequest, opts ...grpc.CallOption) (*ExportPublishedTabResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPublishedTabResponse)
+	err := c.cc.Invoke(ctx, DebateService_ExportPublishedTab_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) CheckTab(ctx context.Context, in *TabIntegrityRequest, opts ...grpc.CallOption) (*TabIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TabIntegrityResponse)
+	err := c.cc.Invoke(ctx, DebateService_CheckTab_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debateServiceClient) RepairTab(ctx context.Context, in *TabIntegrityRequest, opts ...grpc.CallOption) (*TabIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TabIntegrityResponse)
+	err := c.cc.Invoke(ctx, DebateService_RepairTab_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebateServiceServer is the server API for DebateService service.
 // All implementations must embed UnimplementedDebateServiceServer
 // for forward compatibility.
@@ -494,21 +832,44 @@ type DebateServiceServer interface {
 	GetRooms(context.Context, *GetRoomsRequest) (*GetRoomsResponse, error)
 	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
 	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	SetRoomAvailability(context.Context, *SetRoomAvailabilityRequest) (*SetRoomAvailabilityResponse, error)
+	// Building operations
+	CreateBuilding(context.Context, *CreateBuildingRequest) (*CreateBuildingResponse, error)
+	GetBuildings(context.Context, *GetBuildingsRequest) (*GetBuildingsResponse, error)
+	UpdateBuilding(context.Context, *UpdateBuildingRequest) (*UpdateBuildingResponse, error)
+	DeleteBuilding(context.Context, *DeleteBuildingRequest) (*DeleteBuildingResponse, error)
 	// Judge operations
 	GetJudges(context.Context, *GetJudgesRequest) (*GetJudgesResponse, error)
 	GetJudge(context.Context, *GetJudgeRequest) (*GetJudgeResponse, error)
 	UpdateJudge(context.Context, *UpdateJudgeRequest) (*UpdateJudgeResponse, error)
+	SetJudgeAvailability(context.Context, *SetJudgeAvailabilityRequest) (*SetJudgeAvailabilityResponse, error)
+	CheckInJudge(context.Context, *CheckInJudgeRequest) (*CheckInJudgeResponse, error)
+	GetJudgeRoster(context.Context, *GetJudgeRosterRequest) (*GetJudgeRosterResponse, error)
+	MarkJudgeAbsent(context.Context, *MarkJudgeAbsentRequest) (*MarkJudgeAbsentResponse, error)
 	// Pairing operations
 	GetPairings(context.Context, *GetPairingsRequest) (*GetPairingsResponse, error)
 	UpdatePairings(context.Context, *UpdatePairingsRequest) (*UpdatePairingsResponse, error)
+	ValidatePairings(context.Context, *ValidatePairingsRequest) (*ValidatePairingsResponse, error)
+	GetPairingsDiff(context.Context, *GetPairingsDiffRequest) (*GetPairingsDiffResponse, error)
+	ReleasePairings(context.Context, *ReleasePairingsRequest) (*ReleasePairingsResponse, error)
+	ReplayPairings(context.Context, *ReplayPairingsRequest) (*ReplayPairingsResponse, error)
 	// Ballot operations
 	GetBallots(context.Context, *GetBallotsRequest) (*GetBallotsResponse, error)
 	GetBallot(context.Context, *GetBallotRequest) (*GetBallotResponse, error)
 	UpdateBallot(context.Context, *UpdateBallotRequest) (*UpdateBallotResponse, error)
 	GetBallotByJudgeID(context.Context, *GetBallotByJudgeIDRequest) (*GetBallotByJudgeIDResponse, error)
+	GetBallotHistory(context.Context, *GetBallotHistoryRequest) (*GetBallotHistoryResponse, error)
+	RevertBallot(context.Context, *RevertBallotRequest) (*RevertBallotResponse, error)
+	SetBallotEntryMode(context.Context, *SetBallotEntryModeRequest) (*SetBallotEntryModeResponse, error)
+	GetBallotConflicts(context.Context, *GetBallotConflictsRequest) (*GetBallotConflictsResponse, error)
 	// Algorithm integration
 	GeneratePreliminaryPairings(context.Context, *GeneratePreliminaryPairingsRequest) (*GeneratePairingsResponse, error)
 	GenerateEliminationPairings(context.Context, *GenerateEliminationPairingsRequest) (*GeneratePairingsResponse, error)
+	GetEliminationBracket(context.Context, *GetEliminationBracketRequest) (*GetEliminationBracketResponse, error)
+	GetBreakCategories(context.Context, *GetBreakCategoriesRequest) (*GetBreakCategoriesResponse, error)
+	UpdateBreakCategories(context.Context, *UpdateBreakCategoriesRequest) (*UpdateBreakCategoriesResponse, error)
 	// Team operations
 	CreateTeam(context.Context, *CreateTeamRequest) (*Team, error)
 	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
@@ -527,6 +888,7 @@ type DebateServiceServer interface {
 	GetSchoolOverallPerformance(context.Context, *SchoolPerformanceRequest) (*SchoolPerformanceResponse, error)
 	GetVolunteerTournamentStats(context.Context, *VolunteerTournamentStatsRequest) (*VolunteerTournamentStatsResponse, error)
 	GetTournamentVolunteerRanking(context.Context, *TournamentVolunteerRankingRequest) (*TournamentVolunteerRankingResponse, error)
+	GetJudgeCalibration(context.Context, *JudgeCalibrationRequest) (*JudgeCalibrationResponse, error)
 	GetStudentFeedback(context.Context, *GetStudentFeedbackRequest) (*GetStudentFeedbackResponse, error)
 	SubmitJudgeFeedback(context.Context, *SubmitJudgeFeedbackRequest) (*SubmitJudgeFeedbackResponse, error)
 	GetJudgeFeedback(context.Context, *GetJudgeFeedbackRequest) (*GetJudgeFeedbackResponse, error)
@@ -534,6 +896,12 @@ type DebateServiceServer interface {
 	GetVolunteerPerformance(context.Context, *GetVolunteerPerformanceRequest) (*GetVolunteerPerformanceResponse, error)
 	MarkStudentFeedbackAsRead(context.Context, *MarkFeedbackAsReadRequest) (*MarkFeedbackAsReadResponse, error)
 	MarkJudgeFeedbackAsRead(context.Context, *MarkFeedbackAsReadRequest) (*MarkFeedbackAsReadResponse, error)
+	// Published tab operations
+	PublishTab(context.Context, *PublishTabRequest) (*PublishTabResponse, error)
+	GetPublishedTab(context.Context, *GetPublishedTabRequest) (*GetPublishedTabResponse, error)
+	ExportPublishedTab(context.Context, *ExportPublishedTabRequest) (*ExportPublishedTabResponse, error)
+	CheckTab(context.Context, *TabIntegrityRequest) (*TabIntegrityResponse, error)
+	RepairTab(context.Context, *TabIntegrityRequest) (*TabIntegrityResponse, error)
 	mustEmbedUnimplementedDebateServiceServer()
 }
 
@@ -553,6 +921,27 @@ func (UnimplementedDebateServiceServer) GetRoom(context.Context, *GetRoomRequest
 func (UnimplementedDebateServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
 }
+func (UnimplementedDebateServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedDebateServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (UnimplementedDebateServiceServer) SetRoomAvailability(context.Context, *SetRoomAvailabilityRequest) (*SetRoomAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoomAvailability not implemented")
+}
+func (UnimplementedDebateServiceServer) CreateBuilding(context.Context, *CreateBuildingRequest) (*CreateBuildingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBuilding not implemented")
+}
+func (UnimplementedDebateServiceServer) GetBuildings(context.Context, *GetBuildingsRequest) (*GetBuildingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildings not implemented")
+}
+func (UnimplementedDebateServiceServer) UpdateBuilding(context.Context, *UpdateBuildingRequest) (*UpdateBuildingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuilding not implemented")
+}
+func (UnimplementedDebateServiceServer) DeleteBuilding(context.Context, *DeleteBuildingRequest) (*DeleteBuildingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBuilding not implemented")
+}
 func (UnimplementedDebateServiceServer) GetJudges(context.Context, *GetJudgesRequest) (*GetJudgesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJudges not implemented")
 }
@@ -562,12 +951,36 @@ func (UnimplementedDebateServiceServer) GetJudge(context.Context, *GetJudgeReque
 func (UnimplementedDebateServiceServer) UpdateJudge(context.Context, *UpdateJudgeRequest) (*UpdateJudgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateJudge not implemented")
 }
+func (UnimplementedDebateServiceServer) SetJudgeAvailability(context.Context, *SetJudgeAvailabilityRequest) (*SetJudgeAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJudgeAvailability not implemented")
+}
+func (UnimplementedDebateServiceServer) CheckInJudge(context.Context, *CheckInJudgeRequest) (*CheckInJudgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInJudge not implemented")
+}
+func (UnimplementedDebateServiceServer) GetJudgeRoster(context.Context, *GetJudgeRosterRequest) (*GetJudgeRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJudgeRoster not implemented")
+}
+func (UnimplementedDebateServiceServer) MarkJudgeAbsent(context.Context, *MarkJudgeAbsentRequest) (*MarkJudgeAbsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkJudgeAbsent not implemented")
+}
 func (UnimplementedDebateServiceServer) GetPairings(context.Context, *GetPairingsRequest) (*GetPairingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPairings not implemented")
 }
 func (UnimplementedDebateServiceServer) UpdatePairings(context.Context, *UpdatePairingsRequest) (*UpdatePairingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePairings not implemented")
 }
+func (UnimplementedDebateServiceServer) ValidatePairings(context.Context, *ValidatePairingsRequest) (*ValidatePairingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePairings not implemented")
+}
+func (UnimplementedDebateServiceServer) GetPairingsDiff(context.Context, *GetPairingsDiffRequest) (*GetPairingsDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPairingsDiff not implemented")
+}
+func (UnimplementedDebateServiceServer) ReleasePairings(context.Context, *ReleasePairingsRequest) (*ReleasePairingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePairings not implemented")
+}
+func (UnimplementedDebateServiceServer) ReplayPairings(context.Context, *ReplayPairingsRequest) (*ReplayPairingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayPairings not implemented")
+}
 func (UnimplementedDebateServiceServer) GetBallots(context.Context, *GetBallotsRequest) (*GetBallotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBallots not implemented")
 }
@@ -580,12 +993,33 @@ func (UnimplementedDebateServiceServer) UpdateBallot(context.Context, *UpdateBal
 func (UnimplementedDebateServiceServer) GetBallotByJudgeID(context.Context, *GetBallotByJudgeIDRequest) (*GetBallotByJudgeIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBallotByJudgeID not implemented")
 }
+func (UnimplementedDebateServiceServer) GetBallotHistory(context.Context, *GetBallotHistoryRequest) (*GetBallotHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBallotHistory not implemented")
+}
+func (UnimplementedDebateServiceServer) RevertBallot(context.Context, *RevertBallotRequest) (*RevertBallotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertBallot not implemented")
+}
+func (UnimplementedDebateServiceServer) SetBallotEntryMode(context.Context, *SetBallotEntryModeRequest) (*SetBallotEntryModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBallotEntryMode not implemented")
+}
+func (UnimplementedDebateServiceServer) GetBallotConflicts(context.Context, *GetBallotConflictsRequest) (*GetBallotConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBallotConflicts not implemented")
+}
 func (UnimplementedDebateServiceServer) GeneratePreliminaryPairings(context.Context, *GeneratePreliminaryPairingsRequest) (*GeneratePairingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratePreliminaryPairings not implemented")
 }
 func (UnimplementedDebateServiceServer) GenerateEliminationPairings(context.Context, *GenerateEliminationPairingsRequest) (*GeneratePairingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateEliminationPairings not implemented")
 }
+func (UnimplementedDebateServiceServer) GetEliminationBracket(context.Context, *GetEliminationBracketRequest) (*GetEliminationBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEliminationBracket not implemented")
+}
+func (UnimplementedDebateServiceServer) GetBreakCategories(context.Context, *GetBreakCategoriesRequest) (*GetBreakCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBreakCategories not implemented")
+}
+func (UnimplementedDebateServiceServer) UpdateBreakCategories(context.Context, *UpdateBreakCategoriesRequest) (*UpdateBreakCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBreakCategories not implemented")
+}
 func (UnimplementedDebateServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*Team, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
 }
@@ -634,6 +1068,9 @@ func (UnimplementedDebateServiceServer) GetVolunteerTournamentStats(context.Cont
 func (UnimplementedDebateServiceServer) GetTournamentVolunteerRanking(context.Context, *TournamentVolunteerRankingRequest) (*TournamentVolunteerRankingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentVolunteerRanking not implemented")
 }
+func (UnimplementedDebateServiceServer) GetJudgeCalibration(context.Context, *JudgeCalibrationRequest) (*JudgeCalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJudgeCalibration not implemented")
+}
 func (UnimplementedDebateServiceServer) GetStudentFeedback(context.Context, *GetStudentFeedbackRequest) (*GetStudentFeedbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentFeedback not implemented")
 }
@@ -655,6 +1092,21 @@ func (UnimplementedDebateServiceServer) MarkStudentFeedbackAsRead(context.Contex
 func (UnimplementedDebateServiceServer) MarkJudgeFeedbackAsRead(context.Context, *MarkFeedbackAsReadRequest) (*MarkFeedbackAsReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkJudgeFeedbackAsRead not implemented")
 }
+func (UnimplementedDebateServiceServer) PublishTab(context.Context, *PublishTabRequest) (*PublishTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTab not implemented")
+}
+func (UnimplementedDebateServiceServer) GetPublishedTab(context.Context, *GetPublishedTabRequest) (*GetPublishedTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublishedTab not implemented")
+}
+func (UnimplementedDebateServiceServer) ExportPublishedTab(context.Context, *ExportPublishedTabRequest) (*ExportPublishedTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPublishedTab not implemented")
+}
+func (UnimplementedDebateServiceServer) CheckTab(context.Context, *TabIntegrityRequest) (*TabIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTab not implemented")
+}
+func (UnimplementedDebateServiceServer) RepairTab(context.Context, *TabIntegrityRequest) (*TabIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairTab not implemented")
+}
 func (UnimplementedDebateServiceServer) mustEmbedUnimplementedDebateServiceServer() {}
 func (UnimplementedDebateServiceServer) testEmbeddedByValue()                       {}
 
@@ -673,221 +1125,563 @@ func RegisterDebateServiceServer(s grpc.ServiceRegistrar, srv DebateServiceServe
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&DebateService_ServiceDesc, srv)
+	s.RegisterService(&DebateService_ServiceDesc, srv)
+}
+
+func _DebateService_GetRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetRooms(ctx, req.(*GetRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_DeleteRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_SetRoomAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoomAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).SetRoomAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_SetRoomAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).SetRoomAvailability(ctx, req.(*SetRoomAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_CreateBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).CreateBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_CreateBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).CreateBuilding(ctx, req.(*CreateBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetBuildings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetBuildings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetBuildings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetBuildings(ctx, req.(*GetBuildingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_UpdateBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).UpdateBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_UpdateBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).UpdateBuilding(ctx, req.(*UpdateBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_DeleteBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).DeleteBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_DeleteBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).DeleteBuilding(ctx, req.(*DeleteBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetJudges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJudgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetJudges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetJudges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetJudges(ctx, req.(*GetJudgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetJudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetJudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetJudge(ctx, req.(*GetJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_UpdateJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).UpdateJudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_UpdateJudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).UpdateJudge(ctx, req.(*UpdateJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_SetJudgeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJudgeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).SetJudgeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_SetJudgeAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).SetJudgeAvailability(ctx, req.(*SetJudgeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_CheckInJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).CheckInJudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_CheckInJudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).CheckInJudge(ctx, req.(*CheckInJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetJudgeRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJudgeRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetJudgeRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetJudgeRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetJudgeRoster(ctx, req.(*GetJudgeRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_MarkJudgeAbsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkJudgeAbsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).MarkJudgeAbsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_MarkJudgeAbsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).MarkJudgeAbsent(ctx, req.(*MarkJudgeAbsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetPairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPairingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetPairings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetPairings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetPairings(ctx, req.(*GetPairingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_UpdatePairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePairingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).UpdatePairings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_UpdatePairings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).UpdatePairings(ctx, req.(*UpdatePairingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoomsRequest)
+func _DebateService_ValidatePairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePairingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetRooms(ctx, in)
+		return srv.(DebateServiceServer).ValidatePairings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetRooms_FullMethodName,
+		FullMethod: DebateService_ValidatePairings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetRooms(ctx, req.(*GetRoomsRequest))
+		return srv.(DebateServiceServer).ValidatePairings(ctx, req.(*ValidatePairingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoomRequest)
+func _DebateService_GetPairingsDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPairingsDiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetRoom(ctx, in)
+		return srv.(DebateServiceServer).GetPairingsDiff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetRoom_FullMethodName,
+		FullMethod: DebateService_GetPairingsDiff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+		return srv.(DebateServiceServer).GetPairingsDiff(ctx, req.(*GetPairingsDiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoomRequest)
+func _DebateService_ReleasePairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePairingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).UpdateRoom(ctx, in)
+		return srv.(DebateServiceServer).ReleasePairings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_UpdateRoom_FullMethodName,
+		FullMethod: DebateService_ReleasePairings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+		return srv.(DebateServiceServer).ReleasePairings(ctx, req.(*ReleasePairingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetJudges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJudgesRequest)
+func _DebateService_ReplayPairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayPairingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetJudges(ctx, in)
+		return srv.(DebateServiceServer).ReplayPairings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetJudges_FullMethodName,
+		FullMethod: DebateService_ReplayPairings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetJudges(ctx, req.(*GetJudgesRequest))
+		return srv.(DebateServiceServer).ReplayPairings(ctx, req.(*ReplayPairingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJudgeRequest)
+func _DebateService_GetBallots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetJudge(ctx, in)
+		return srv.(DebateServiceServer).GetBallots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetJudge_FullMethodName,
+		FullMethod: DebateService_GetBallots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetJudge(ctx, req.(*GetJudgeRequest))
+		return srv.(DebateServiceServer).GetBallots(ctx, req.(*GetBallotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_UpdateJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJudgeRequest)
+func _DebateService_GetBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).UpdateJudge(ctx, in)
+		return srv.(DebateServiceServer).GetBallot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_UpdateJudge_FullMethodName,
+		FullMethod: DebateService_GetBallot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).UpdateJudge(ctx, req.(*UpdateJudgeRequest))
+		return srv.(DebateServiceServer).GetBallot(ctx, req.(*GetBallotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetPairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPairingsRequest)
+func _DebateService_UpdateBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBallotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetPairings(ctx, in)
+		return srv.(DebateServiceServer).UpdateBallot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetPairings_FullMethodName,
+		FullMethod: DebateService_UpdateBallot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetPairings(ctx, req.(*GetPairingsRequest))
+		return srv.(DebateServiceServer).UpdateBallot(ctx, req.(*UpdateBallotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_UpdatePairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePairingsRequest)
+func _DebateService_GetBallotByJudgeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotByJudgeIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).UpdatePairings(ctx, in)
+		return srv.(DebateServiceServer).GetBallotByJudgeID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_UpdatePairings_FullMethodName,
+		FullMethod: DebateService_GetBallotByJudgeID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).UpdatePairings(ctx, req.(*UpdatePairingsRequest))
+		return srv.(DebateServiceServer).GetBallotByJudgeID(ctx, req.(*GetBallotByJudgeIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetBallots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBallotsRequest)
+func _DebateService_GetBallotHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetBallots(ctx, in)
+		return srv.(DebateServiceServer).GetBallotHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetBallots_FullMethodName,
+		FullMethod: DebateService_GetBallotHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetBallots(ctx, req.(*GetBallotsRequest))
+		return srv.(DebateServiceServer).GetBallotHistory(ctx, req.(*GetBallotHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBallotRequest)
+func _DebateService_RevertBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertBallotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetBallot(ctx, in)
+		return srv.(DebateServiceServer).RevertBallot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetBallot_FullMethodName,
+		FullMethod: DebateService_RevertBallot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetBallot(ctx, req.(*GetBallotRequest))
+		return srv.(DebateServiceServer).RevertBallot(ctx, req.(*RevertBallotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_UpdateBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBallotRequest)
+func _DebateService_SetBallotEntryMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBallotEntryModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).UpdateBallot(ctx, in)
+		return srv.(DebateServiceServer).SetBallotEntryMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_UpdateBallot_FullMethodName,
+		FullMethod: DebateService_SetBallotEntryMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).UpdateBallot(ctx, req.(*UpdateBallotRequest))
+		return srv.(DebateServiceServer).SetBallotEntryMode(ctx, req.(*SetBallotEntryModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DebateService_GetBallotByJudgeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBallotByJudgeIDRequest)
+func _DebateService_GetBallotConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBallotConflictsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DebateServiceServer).GetBallotByJudgeID(ctx, in)
+		return srv.(DebateServiceServer).GetBallotConflicts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DebateService_GetBallotByJudgeID_FullMethodName,
+		FullMethod: DebateService_GetBallotConflicts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebateServiceServer).GetBallotByJudgeID(ctx, req.(*GetBallotByJudgeIDRequest))
+		return srv.(DebateServiceServer).GetBallotConflicts(ctx, req.(*GetBallotConflictsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -928,6 +1722,60 @@ func _DebateService_GenerateEliminationPairings_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebateService_GetEliminationBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEliminationBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetEliminationBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetEliminationBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetEliminationBracket(ctx, req.(*GetEliminationBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetBreakCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBreakCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetBreakCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetBreakCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetBreakCategories(ctx, req.(*GetBreakCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_UpdateBreakCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBreakCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).UpdateBreakCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_UpdateBreakCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).UpdateBreakCategories(ctx, req.(*UpdateBreakCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DebateService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTeamRequest)
 	if err := dec(in); err != nil {
@@ -1216,6 +2064,24 @@ func _DebateService_GetTournamentVolunteerRanking_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebateService_GetJudgeCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JudgeCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetJudgeCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetJudgeCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetJudgeCalibration(ctx, req.(*JudgeCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DebateService_GetStudentFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStudentFeedbackRequest)
 	if err := dec(in); err != nil {
@@ -1342,6 +2208,96 @@ func _DebateService_MarkJudgeFeedbackAsRead_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebateService_PublishTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).PublishTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_PublishTab_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).PublishTab(ctx, req.(*PublishTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_GetPublishedTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublishedTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).GetPublishedTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_GetPublishedTab_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).GetPublishedTab(ctx, req.(*GetPublishedTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_ExportPublishedTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPublishedTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).ExportPublishedTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_ExportPublishedTab_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).ExportPublishedTab(ctx, req.(*ExportPublishedTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_CheckTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).CheckTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_CheckTab_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).CheckTab(ctx, req.(*TabIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebateService_RepairTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebateServiceServer).RepairTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebateService_RepairTab_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebateServiceServer).RepairTab(ctx, req.(*TabIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DebateService_ServiceDesc is the grpc.ServiceDesc for DebateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1361,6 +2317,34 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRoom",
 			Handler:    _DebateService_UpdateRoom_Handler,
 		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _DebateService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _DebateService_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "SetRoomAvailability",
+			Handler:    _DebateService_SetRoomAvailability_Handler,
+		},
+		{
+			MethodName: "CreateBuilding",
+			Handler:    _DebateService_CreateBuilding_Handler,
+		},
+		{
+			MethodName: "GetBuildings",
+			Handler:    _DebateService_GetBuildings_Handler,
+		},
+		{
+			MethodName: "UpdateBuilding",
+			Handler:    _DebateService_UpdateBuilding_Handler,
+		},
+		{
+			MethodName: "DeleteBuilding",
+			Handler:    _DebateService_DeleteBuilding_Handler,
+		},
 		{
 			MethodName: "GetJudges",
 			Handler:    _DebateService_GetJudges_Handler,
@@ -1373,6 +2357,22 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateJudge",
 			Handler:    _DebateService_UpdateJudge_Handler,
 		},
+		{
+			MethodName: "SetJudgeAvailability",
+			Handler:    _DebateService_SetJudgeAvailability_Handler,
+		},
+		{
+			MethodName: "CheckInJudge",
+			Handler:    _DebateService_CheckInJudge_Handler,
+		},
+		{
+			MethodName: "GetJudgeRoster",
+			Handler:    _DebateService_GetJudgeRoster_Handler,
+		},
+		{
+			MethodName: "MarkJudgeAbsent",
+			Handler:    _DebateService_MarkJudgeAbsent_Handler,
+		},
 		{
 			MethodName: "GetPairings",
 			Handler:    _DebateService_GetPairings_Handler,
@@ -1381,6 +2381,22 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePairings",
 			Handler:    _DebateService_UpdatePairings_Handler,
 		},
+		{
+			MethodName: "ValidatePairings",
+			Handler:    _DebateService_ValidatePairings_Handler,
+		},
+		{
+			MethodName: "GetPairingsDiff",
+			Handler:    _DebateService_GetPairingsDiff_Handler,
+		},
+		{
+			MethodName: "ReleasePairings",
+			Handler:    _DebateService_ReleasePairings_Handler,
+		},
+		{
+			MethodName: "ReplayPairings",
+			Handler:    _DebateService_ReplayPairings_Handler,
+		},
 		{
 			MethodName: "GetBallots",
 			Handler:    _DebateService_GetBallots_Handler,
@@ -1397,6 +2413,22 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBallotByJudgeID",
 			Handler:    _DebateService_GetBallotByJudgeID_Handler,
 		},
+		{
+			MethodName: "GetBallotHistory",
+			Handler:    _DebateService_GetBallotHistory_Handler,
+		},
+		{
+			MethodName: "RevertBallot",
+			Handler:    _DebateService_RevertBallot_Handler,
+		},
+		{
+			MethodName: "SetBallotEntryMode",
+			Handler:    _DebateService_SetBallotEntryMode_Handler,
+		},
+		{
+			MethodName: "GetBallotConflicts",
+			Handler:    _DebateService_GetBallotConflicts_Handler,
+		},
 		{
 			MethodName: "GeneratePreliminaryPairings",
 			Handler:    _DebateService_GeneratePreliminaryPairings_Handler,
@@ -1405,6 +2437,18 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateEliminationPairings",
 			Handler:    _DebateService_GenerateEliminationPairings_Handler,
 		},
+		{
+			MethodName: "GetEliminationBracket",
+			Handler:    _DebateService_GetEliminationBracket_Handler,
+		},
+		{
+			MethodName: "GetBreakCategories",
+			Handler:    _DebateService_GetBreakCategories_Handler,
+		},
+		{
+			MethodName: "UpdateBreakCategories",
+			Handler:    _DebateService_UpdateBreakCategories_Handler,
+		},
 		{
 			MethodName: "CreateTeam",
 			Handler:    _DebateService_CreateTeam_Handler,
@@ -1469,6 +2513,10 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTournamentVolunteerRanking",
 			Handler:    _DebateService_GetTournamentVolunteerRanking_Handler,
 		},
+		{
+			MethodName: "GetJudgeCalibration",
+			Handler:    _DebateService_GetJudgeCalibration_Handler,
+		},
 		{
 			MethodName: "GetStudentFeedback",
 			Handler:    _DebateService_GetStudentFeedback_Handler,
@@ -1497,6 +2545,26 @@ var DebateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkJudgeFeedbackAsRead",
 			Handler:    _DebateService_MarkJudgeFeedbackAsRead_Handler,
 		},
+		{
+			MethodName: "PublishTab",
+			Handler:    _DebateService_PublishTab_Handler,
+		},
+		{
+			MethodName: "GetPublishedTab",
+			Handler:    _DebateService_GetPublishedTab_Handler,
+		},
+		{
+			MethodName: "ExportPublishedTab",
+			Handler:    _DebateService_ExportPublishedTab_Handler,
+		},
+		{
+			MethodName: "CheckTab",
+			Handler:    _DebateService_CheckTab_Handler,
+		},
+		{
+			MethodName: "RepairTab",
+			Handler:    _DebateService_RepairTab_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/grpc/proto/debate_management/debate.proto",
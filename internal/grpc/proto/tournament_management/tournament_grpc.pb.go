@@ -51,6 +51,16 @@ const (
 	TournamentService_GetSchoolRegistration_FullMethodName       = "/tournament_management.TournamentService/GetSchoolRegistration"
 	TournamentService_ListTournamentRegistrations_FullMethodName = "/tournament_management.TournamentService/ListTournamentRegistrations"
 	TournamentService_SearchTournaments_FullMethodName           = "/tournament_management.TournamentService/SearchTournaments"
+	TournamentService_GetTournamentSchedule_FullMethodName       = "/tournament_management.TournamentService/GetTournamentSchedule"
+	TournamentService_UpdateTournamentSchedule_FullMethodName    = "/tournament_management.TournamentService/UpdateTournamentSchedule"
+	TournamentService_UpdateRoundSchedule_FullMethodName         = "/tournament_management.TournamentService/UpdateRoundSchedule"
+	TournamentService_ReleaseMotion_FullMethodName               = "/tournament_management.TournamentService/ReleaseMotion"
+	TournamentService_SearchMotionArchive_FullMethodName         = "/tournament_management.TournamentService/SearchMotionArchive"
+	TournamentService_CreateBankMotion_FullMethodName            = "/tournament_management.TournamentService/CreateBankMotion"
+	TournamentService_UpdateBankMotion_FullMethodName            = "/tournament_management.TournamentService/UpdateBankMotion"
+	TournamentService_DeleteBankMotion_FullMethodName            = "/tournament_management.TournamentService/DeleteBankMotion"
+	TournamentService_ListBankMotions_FullMethodName             = "/tournament_management.TournamentService/ListBankMotions"
+	TournamentService_AttachBankMotion_FullMethodName            = "/tournament_management.TournamentService/AttachBankMotion"
 )
 
 // TournamentServiceClient is the client API for TournamentService service.
@@ -96,6 +106,19 @@ type TournamentServiceClient interface {
 	ListTournamentRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error)
 	// Real-time tournament search
 	SearchTournaments(ctx context.Context, in *SearchTournamentsRequest, opts ...grpc.CallOption) (*SearchTournamentsResponse, error)
+	// Timetable operations
+	GetTournamentSchedule(ctx context.Context, in *GetTournamentScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error)
+	UpdateTournamentSchedule(ctx context.Context, in *UpdateTournamentScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error)
+	UpdateRoundSchedule(ctx context.Context, in *UpdateRoundScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error)
+	// Motion operations
+	ReleaseMotion(ctx context.Context, in *ReleaseMotionRequest, opts ...grpc.CallOption) (*ReleaseMotionResponse, error)
+	SearchMotionArchive(ctx context.Context, in *SearchMotionArchiveRequest, opts ...grpc.CallOption) (*SearchMotionArchiveResponse, error)
+	// Motion bank operations
+	CreateBankMotion(ctx context.Context, in *CreateBankMotionRequest, opts ...grpc.CallOption) (*BankMotionResponse, error)
+	UpdateBankMotion(ctx context.Context, in *UpdateBankMotionRequest, opts ...grpc.CallOption) (*BankMotionResponse, error)
+	DeleteBankMotion(ctx context.Context, in *DeleteBankMotionRequest, opts ...grpc.CallOption) (*DeleteBankMotionResponse, error)
+	ListBankMotions(ctx context.Context, in *ListBankMotionsRequest, opts ...grpc.CallOption) (*ListBankMotionsResponse, error)
+	AttachBankMotion(ctx context.Context, in *AttachBankMotionRequest, opts ...grpc.CallOption) (*AttachBankMotionResponse, error)
 }
 
 type tournamentServiceClient struct {
@@ -426,6 +449,106 @@ func (c *tournamentServiceClient) SearchTournaments(ctx context.Context, in *Sea
 	return out, nil
 }
 
+func (c *tournamentServiceClient) GetTournamentSchedule(ctx context.Context, in *GetTournamentScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentScheduleResponse)
+	err := c.cc.Invoke(ctx, TournamentService_GetTournamentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) UpdateTournamentSchedule(ctx context.Context, in *UpdateTournamentScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentScheduleResponse)
+	err := c.cc.Invoke(ctx, TournamentService_UpdateTournamentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) UpdateRoundSchedule(ctx context.Context, in *UpdateRoundScheduleRequest, opts ...grpc.CallOption) (*TournamentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentScheduleResponse)
+	err := c.cc.Invoke(ctx, TournamentService_UpdateRoundSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ReleaseMotion(ctx context.Context, in *ReleaseMotionRequest, opts ...grpc.CallOption) (*ReleaseMotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseMotionResponse)
+	err := c.cc.Invoke(ctx, TournamentService_ReleaseMotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SearchMotionArchive(ctx context.Context, in *SearchMotionArchiveRequest, opts ...grpc.CallOption) (*SearchMotionArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMotionArchiveResponse)
+	err := c.cc.Invoke(ctx, TournamentService_SearchMotionArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CreateBankMotion(ctx context.Context, in *CreateBankMotionRequest, opts ...grpc.CallOption) (*BankMotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankMotionResponse)
+	err := c.cc.Invoke(ctx, TournamentService_CreateBankMotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) UpdateBankMotion(ctx context.Context, in *UpdateBankMotionRequest, opts ...grpc.CallOption) (*BankMotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankMotionResponse)
+	err := c.cc.Invoke(ctx, TournamentService_UpdateBankMotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteBankMotion(ctx context.Context, in *DeleteBankMotionRequest, opts ...grpc.CallOption) (*DeleteBankMotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBankMotionResponse)
+	err := c.cc.Invoke(ctx, TournamentService_DeleteBankMotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ListBankMotions(ctx context.Context, in *ListBankMotionsRequest, opts ...grpc.CallOption) (*ListBankMotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankMotionsResponse)
+	err := c.cc.Invoke(ctx, TournamentService_ListBankMotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) AttachBankMotion(ctx context.Context, in *AttachBankMotionRequest, opts ...grpc.CallOption) (*AttachBankMotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachBankMotionResponse)
+	err := c.cc.Invoke(ctx, TournamentService_AttachBankMotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TournamentServiceServer is the server API for TournamentService service.
 // All implementations must embed UnimplementedTournamentServiceServer
 // for forward compatibility.
@@ -469,6 +592,19 @@ type TournamentServiceServer interface {
 	ListTournamentRegistrations(context.Context, *ListRegistrationsRequest) (*ListRegistrationsResponse, error)
 	// Real-time tournament search
 	SearchTournaments(context.Context, *SearchTournamentsRequest) (*SearchTournamentsResponse, error)
+	// Timetable operations
+	GetTournamentSchedule(context.Context, *GetTournamentScheduleRequest) (*TournamentScheduleResponse, error)
+	UpdateTournamentSchedule(context.Context, *UpdateTournamentScheduleRequest) (*TournamentScheduleResponse, error)
+	UpdateRoundSchedule(context.Context, *UpdateRoundScheduleRequest) (*TournamentScheduleResponse, error)
+	// Motion operations
+	ReleaseMotion(context.Context, *ReleaseMotionRequest) (*ReleaseMotionResponse, error)
+	SearchMotionArchive(context.Context, *SearchMotionArchiveRequest) (*SearchMotionArchiveResponse, error)
+	// Motion bank operations
+	CreateBankMotion(context.Context, *CreateBankMotionRequest) (*BankMotionResponse, error)
+	UpdateBankMotion(context.Context, *UpdateBankMotionRequest) (*BankMotionResponse, error)
+	DeleteBankMotion(context.Context, *DeleteBankMotionRequest) (*DeleteBankMotionResponse, error)
+	ListBankMotions(context.Context, *ListBankMotionsRequest) (*ListBankMotionsResponse, error)
+	AttachBankMotion(context.Context, *AttachBankMotionRequest) (*AttachBankMotionResponse, error)
 	mustEmbedUnimplementedTournamentServiceServer()
 }
 
@@ -575,6 +711,36 @@ func (UnimplementedTournamentServiceServer) ListTournamentRegistrations(context.
 func (UnimplementedTournamentServiceServer) SearchTournaments(context.Context, *SearchTournamentsRequest) (*SearchTournamentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchTournaments not implemented")
 }
+func (UnimplementedTournamentServiceServer) GetTournamentSchedule(context.Context, *GetTournamentScheduleRequest) (*TournamentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentSchedule not implemented")
+}
+func (UnimplementedTournamentServiceServer) UpdateTournamentSchedule(context.Context, *UpdateTournamentScheduleRequest) (*TournamentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTournamentSchedule not implemented")
+}
+func (UnimplementedTournamentServiceServer) UpdateRoundSchedule(context.Context, *UpdateRoundScheduleRequest) (*TournamentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoundSchedule not implemented")
+}
+func (UnimplementedTournamentServiceServer) ReleaseMotion(context.Context, *ReleaseMotionRequest) (*ReleaseMotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseMotion not implemented")
+}
+func (UnimplementedTournamentServiceServer) SearchMotionArchive(context.Context, *SearchMotionArchiveRequest) (*SearchMotionArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMotionArchive not implemented")
+}
+func (UnimplementedTournamentServiceServer) CreateBankMotion(context.Context, *CreateBankMotionRequest) (*BankMotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankMotion not implemented")
+}
+func (UnimplementedTournamentServiceServer) UpdateBankMotion(context.Context, *UpdateBankMotionRequest) (*BankMotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankMotion not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteBankMotion(context.Context, *DeleteBankMotionRequest) (*DeleteBankMotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankMotion not implemented")
+}
+func (UnimplementedTournamentServiceServer) ListBankMotions(context.Context, *ListBankMotionsRequest) (*ListBankMotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankMotions not implemented")
+}
+func (UnimplementedTournamentServiceServer) AttachBankMotion(context.Context, *AttachBankMotionRequest) (*AttachBankMotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachBankMotion not implemented")
+}
 func (UnimplementedTournamentServiceServer) mustEmbedUnimplementedTournamentServiceServer() {}
 func (UnimplementedTournamentServiceServer) testEmbeddedByValue()                           {}
 
@@ -1172,6 +1338,186 @@ func _TournamentService_SearchTournaments_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TournamentService_GetTournamentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_GetTournamentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentSchedule(ctx, req.(*GetTournamentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_UpdateTournamentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTournamentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).UpdateTournamentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_UpdateTournamentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).UpdateTournamentSchedule(ctx, req.(*UpdateTournamentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_UpdateRoundSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoundScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).UpdateRoundSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_UpdateRoundSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).UpdateRoundSchedule(ctx, req.(*UpdateRoundScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ReleaseMotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseMotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ReleaseMotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_ReleaseMotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ReleaseMotion(ctx, req.(*ReleaseMotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SearchMotionArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMotionArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SearchMotionArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_SearchMotionArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SearchMotionArchive(ctx, req.(*SearchMotionArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateBankMotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankMotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateBankMotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_CreateBankMotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateBankMotion(ctx, req.(*CreateBankMotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_UpdateBankMotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankMotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).UpdateBankMotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_UpdateBankMotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).UpdateBankMotion(ctx, req.(*UpdateBankMotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteBankMotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankMotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteBankMotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_DeleteBankMotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteBankMotion(ctx, req.(*DeleteBankMotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ListBankMotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankMotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ListBankMotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_ListBankMotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ListBankMotions(ctx, req.(*ListBankMotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_AttachBankMotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachBankMotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).AttachBankMotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_AttachBankMotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).AttachBankMotion(ctx, req.(*AttachBankMotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1307,6 +1653,46 @@ var TournamentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchTournaments",
 			Handler:    _TournamentService_SearchTournaments_Handler,
 		},
+		{
+			MethodName: "GetTournamentSchedule",
+			Handler:    _TournamentService_GetTournamentSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateTournamentSchedule",
+			Handler:    _TournamentService_UpdateTournamentSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateRoundSchedule",
+			Handler:    _TournamentService_UpdateRoundSchedule_Handler,
+		},
+		{
+			MethodName: "ReleaseMotion",
+			Handler:    _TournamentService_ReleaseMotion_Handler,
+		},
+		{
+			MethodName: "SearchMotionArchive",
+			Handler:    _TournamentService_SearchMotionArchive_Handler,
+		},
+		{
+			MethodName: "CreateBankMotion",
+			Handler:    _TournamentService_CreateBankMotion_Handler,
+		},
+		{
+			MethodName: "UpdateBankMotion",
+			Handler:    _TournamentService_UpdateBankMotion_Handler,
+		},
+		{
+			MethodName: "DeleteBankMotion",
+			Handler:    _TournamentService_DeleteBankMotion_Handler,
+		},
+		{
+			MethodName: "ListBankMotions",
+			Handler:    _TournamentService_ListBankMotions_Handler,
+		},
+		{
+			MethodName: "AttachBankMotion",
+			Handler:    _TournamentService_AttachBankMotion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/grpc/proto/tournament_management/tournament.proto",
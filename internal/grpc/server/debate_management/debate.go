@@ -3,8 +3,10 @@ package server
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,6 +23,8 @@ type debateServer struct {
 	teamService     *services.TeamService
 	rankingService  *services.RankingService
 	feedbackService *services.FeedbackService
+	breakService    *services.BreakCategoryService
+	tabService      *services.TabService
 }
 
 func NewDebateServer(db *sql.DB) (debate_management.DebateServiceServer, error) {
@@ -32,6 +36,8 @@ func NewDebateServer(db *sql.DB) (debate_management.DebateServiceServer, error)
 		teamService:     services.NewTeamService(db),
 		rankingService:  services.NewRankingService(db),
 		feedbackService: services.NewFeedbackService(db),
+		breakService:    services.NewBreakCategoryService(db),
+		tabService:      services.NewTabService(db),
 	}, nil
 }
 
@@ -60,6 +66,62 @@ func (s *debateServer) UpdateRoom(ctx context.Context, req *debate_management.Up
 	return &debate_management.UpdateRoomResponse{Room: room}, nil
 }
 
+func (s *debateServer) CreateRoom(ctx context.Context, req *debate_management.CreateRoomRequest) (*debate_management.CreateRoomResponse, error) {
+	room, err := s.roomService.CreateRoom(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create room: %v", err)
+	}
+	return &debate_management.CreateRoomResponse{Room: room}, nil
+}
+
+func (s *debateServer) DeleteRoom(ctx context.Context, req *debate_management.DeleteRoomRequest) (*debate_management.DeleteRoomResponse, error) {
+	success, message, err := s.roomService.DeleteRoom(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete room: %v", err)
+	}
+	return &debate_management.DeleteRoomResponse{Success: success, Message: message}, nil
+}
+
+func (s *debateServer) SetRoomAvailability(ctx context.Context, req *debate_management.SetRoomAvailabilityRequest) (*debate_management.SetRoomAvailabilityResponse, error) {
+	room, err := s.roomService.SetRoomAvailability(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set room availability: %v", err)
+	}
+	return &debate_management.SetRoomAvailabilityResponse{Room: room}, nil
+}
+
+// Building operations
+func (s *debateServer) CreateBuilding(ctx context.Context, req *debate_management.CreateBuildingRequest) (*debate_management.CreateBuildingResponse, error) {
+	building, err := s.roomService.CreateBuilding(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create building: %v", err)
+	}
+	return &debate_management.CreateBuildingResponse{Building: building}, nil
+}
+
+func (s *debateServer) GetBuildings(ctx context.Context, req *debate_management.GetBuildingsRequest) (*debate_management.GetBuildingsResponse, error) {
+	buildings, err := s.roomService.GetBuildings(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get buildings: %v", err)
+	}
+	return &debate_management.GetBuildingsResponse{Buildings: buildings}, nil
+}
+
+func (s *debateServer) UpdateBuilding(ctx context.Context, req *debate_management.UpdateBuildingRequest) (*debate_management.UpdateBuildingResponse, error) {
+	building, err := s.roomService.UpdateBuilding(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update building: %v", err)
+	}
+	return &debate_management.UpdateBuildingResponse{Building: building}, nil
+}
+
+func (s *debateServer) DeleteBuilding(ctx context.Context, req *debate_management.DeleteBuildingRequest) (*debate_management.DeleteBuildingResponse, error) {
+	if err := s.roomService.DeleteBuilding(ctx, req); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete building: %v", err)
+	}
+	return &debate_management.DeleteBuildingResponse{Success: true}, nil
+}
+
 // Judge operations
 func (s *debateServer) GetJudges(ctx context.Context, req *debate_management.GetJudgesRequest) (*debate_management.GetJudgesResponse, error) {
 	judges, err := s.judgeService.GetJudges(ctx, req)
@@ -85,6 +147,38 @@ func (s *debateServer) UpdateJudge(ctx context.Context, req *debate_management.U
 	return response, nil
 }
 
+func (s *debateServer) SetJudgeAvailability(ctx context.Context, req *debate_management.SetJudgeAvailabilityRequest) (*debate_management.SetJudgeAvailabilityResponse, error) {
+	response, err := s.judgeService.SetJudgeAvailability(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set judge availability: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) CheckInJudge(ctx context.Context, req *debate_management.CheckInJudgeRequest) (*debate_management.CheckInJudgeResponse, error) {
+	response, err := s.judgeService.CheckInJudge(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to check in judge: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) GetJudgeRoster(ctx context.Context, req *debate_management.GetJudgeRosterRequest) (*debate_management.GetJudgeRosterResponse, error) {
+	response, err := s.judgeService.GetJudgeRoster(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get judge roster: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) MarkJudgeAbsent(ctx context.Context, req *debate_management.MarkJudgeAbsentRequest) (*debate_management.MarkJudgeAbsentResponse, error) {
+	response, err := s.judgeService.MarkJudgeAbsent(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to mark judge absent: %v", err)
+	}
+	return response, nil
+}
+
 // Pairing operations
 func (s *debateServer) GetPairings(ctx context.Context, req *debate_management.GetPairingsRequest) (*debate_management.GetPairingsResponse, error) {
 	response, err := s.pairingService.GetPairings(ctx, req)
@@ -102,6 +196,38 @@ func (s *debateServer) UpdatePairings(ctx context.Context, req *debate_managemen
 	return response, nil
 }
 
+func (s *debateServer) ValidatePairings(ctx context.Context, req *debate_management.ValidatePairingsRequest) (*debate_management.ValidatePairingsResponse, error) {
+	response, err := s.pairingService.ValidatePairings(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to validate pairings: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) GetPairingsDiff(ctx context.Context, req *debate_management.GetPairingsDiffRequest) (*debate_management.GetPairingsDiffResponse, error) {
+	response, err := s.pairingService.GetPairingsDiff(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get pairings diff: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) ReleasePairings(ctx context.Context, req *debate_management.ReleasePairingsRequest) (*debate_management.ReleasePairingsResponse, error) {
+	response, err := s.pairingService.ReleasePairings(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to release pairings: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) ReplayPairings(ctx context.Context, req *debate_management.ReplayPairingsRequest) (*debate_management.ReplayPairingsResponse, error) {
+	response, err := s.pairingService.ReplayPairings(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay pairings: %v", err)
+	}
+	return response, nil
+}
+
 // Ballot operations
 func (s *debateServer) GetBallots(ctx context.Context, req *debate_management.GetBallotsRequest) (*debate_management.GetBallotsResponse, error) {
 	ballots, err := s.ballotService.GetBallots(ctx, req)
@@ -128,11 +254,64 @@ func (s *debateServer) GetBallotByJudgeID(ctx context.Context, req *debate_manag
 }
 
 func (s *debateServer) UpdateBallot(ctx context.Context, req *debate_management.UpdateBallotRequest) (*debate_management.UpdateBallotResponse, error) {
-	ballot, err := s.ballotService.UpdateBallot(ctx, req)
+	response, err := s.ballotService.UpdateBallot(ctx, req)
 	if err != nil {
+		var invalid *services.BallotValidationError
+		if errors.As(err, &invalid) {
+			return nil, ballotValidationStatus(invalid)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to update ballot: %v", err)
 	}
-	return &debate_management.UpdateBallotResponse{Ballot: ballot}, nil
+	return response, nil
+}
+
+func (s *debateServer) GetBallotHistory(ctx context.Context, req *debate_management.GetBallotHistoryRequest) (*debate_management.GetBallotHistoryResponse, error) {
+	versions, err := s.ballotService.GetBallotHistory(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get ballot history: %v", err)
+	}
+	return &debate_management.GetBallotHistoryResponse{Versions: versions}, nil
+}
+
+func (s *debateServer) RevertBallot(ctx context.Context, req *debate_management.RevertBallotRequest) (*debate_management.RevertBallotResponse, error) {
+	ballot, err := s.ballotService.RevertBallot(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to revert ballot: %v", err)
+	}
+	return &debate_management.RevertBallotResponse{Ballot: ballot}, nil
+}
+
+func (s *debateServer) SetBallotEntryMode(ctx context.Context, req *debate_management.SetBallotEntryModeRequest) (*debate_management.SetBallotEntryModeResponse, error) {
+	response, err := s.ballotService.SetBallotEntryMode(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set ballot entry mode: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) GetBallotConflicts(ctx context.Context, req *debate_management.GetBallotConflictsRequest) (*debate_management.GetBallotConflictsResponse, error) {
+	conflicts, err := s.ballotService.GetBallotConflicts(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get ballot conflicts: %v", err)
+	}
+	return &debate_management.GetBallotConflictsResponse{Conflicts: conflicts}, nil
+}
+
+// ballotValidationStatus reports each rule a ballot breaks as a field violation.
+func ballotValidationStatus(invalid *services.BallotValidationError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range invalid.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "ballot." + violation.Field,
+			Description: violation.Message,
+		})
+	}
+
+	st, err := status.New(codes.InvalidArgument, invalid.Error()).WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, invalid.Error())
+	}
+	return st.Err()
 }
 
 // Team operations
@@ -205,6 +384,30 @@ func (s *debateServer) GenerateEliminationPairings(ctx context.Context, req *deb
 	return response, nil
 }
 
+func (s *debateServer) GetEliminationBracket(ctx context.Context, req *debate_management.GetEliminationBracketRequest) (*debate_management.GetEliminationBracketResponse, error) {
+	response, err := s.pairingService.GetEliminationBracket(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get elimination bracket: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) GetBreakCategories(ctx context.Context, req *debate_management.GetBreakCategoriesRequest) (*debate_management.GetBreakCategoriesResponse, error) {
+	categories, err := s.breakService.GetBreakCategories(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get break categories: %v", err)
+	}
+	return &debate_management.GetBreakCategoriesResponse{Categories: categories}, nil
+}
+
+func (s *debateServer) UpdateBreakCategories(ctx context.Context, req *debate_management.UpdateBreakCategoriesRequest) (*debate_management.UpdateBreakCategoriesResponse, error) {
+	categories, err := s.breakService.UpdateBreakCategories(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update break categories: %v", err)
+	}
+	return &debate_management.UpdateBreakCategoriesResponse{Categories: categories}, nil
+}
+
 func (s *debateServer) GetTournamentStudentRanking(ctx context.Context, req *debate_management.TournamentRankingRequest) (*debate_management.TournamentRankingResponse, error) {
 	response, err := s.rankingService.GetTournamentStudentRanking(ctx, req)
 	if err != nil {
@@ -356,3 +559,52 @@ func (s *debateServer) SetRankingVisibility(ctx context.Context, req *debate_man
 	}
 	return response, nil
 }
+
+func (s *debateServer) GetJudgeCalibration(ctx context.Context, req *debate_management.JudgeCalibrationRequest) (*debate_management.JudgeCalibrationResponse, error) {
+	response, err := s.rankingService.GetJudgeCalibration(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get judge calibration: %v", err)
+	}
+	return response, nil
+}
+
+// Published tab operations
+func (s *debateServer) PublishTab(ctx context.Context, req *debate_management.PublishTabRequest) (*debate_management.PublishTabResponse, error) {
+	response, err := s.tabService.PublishTab(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to publish tab: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) GetPublishedTab(ctx context.Context, req *debate_management.GetPublishedTabRequest) (*debate_management.GetPublishedTabResponse, error) {
+	response, err := s.tabService.GetPublishedTab(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get published tab: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) ExportPublishedTab(ctx context.Context, req *debate_management.ExportPublishedTabRequest) (*debate_management.ExportPublishedTabResponse, error) {
+	response, err := s.tabService.ExportPublishedTab(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to export published tab: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) CheckTab(ctx context.Context, req *debate_management.TabIntegrityRequest) (*debate_management.TabIntegrityResponse, error) {
+	response, err := s.tabService.CheckTab(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to check tab: %v", err)
+	}
+	return response, nil
+}
+
+func (s *debateServer) RepairTab(ctx context.Context, req *debate_management.TabIntegrityRequest) (*debate_management.TabIntegrityResponse, error) {
+	response, err := s.tabService.RepairTab(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to repair tab: %v", err)
+	}
+	return response, nil
+}
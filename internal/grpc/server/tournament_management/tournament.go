@@ -20,6 +20,8 @@ type tournamentServer struct {
 	tournamentService             *services.TournamentService
 	invitationService             *services.InvitationService
 	billingService                *services.BillingService // Add this line
+	scheduleService               *services.ScheduleService
+	motionService                 *services.MotionService
 	reminderService               *cronservices.ReminderService
 	tournamentCountsUpdateService *cronservices.TournamentCountsUpdateService
 }
@@ -30,6 +32,8 @@ func NewTournamentServer(db *sql.DB) (tournament_management.TournamentServiceSer
 	tournamentService := services.NewTournamentService(db)
 	invitationService := services.NewInvitationService(db)
 	billingService := services.NewBillingService(db) // Add this line
+	scheduleService := services.NewScheduleService(db)
+	motionService := services.NewMotionService(db)
 
 	reminderService, err := cronservices.NewReminderService(db)
 	if err != nil {
@@ -47,6 +51,8 @@ func NewTournamentServer(db *sql.DB) (tournament_management.TournamentServiceSer
 		tournamentService:             tournamentService,
 		invitationService:             invitationService,
 		billingService:                billingService, // Add this line
+		scheduleService:               scheduleService,
+		motionService:                 motionService,
 		reminderService:               reminderService,
 		tournamentCountsUpdateService: tournamentCountsUpdateService,
 	}
@@ -351,3 +357,83 @@ func (s *tournamentServer) SearchTournaments(ctx context.Context, req *tournamen
 	}
 	return response, nil
 }
+
+func (s *tournamentServer) GetTournamentSchedule(ctx context.Context, req *tournament_management.GetTournamentScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	response, err := s.scheduleService.GetTournamentSchedule(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get tournament schedule: %v", err)
+	}
+	return response, nil
+}
+
+func (s *tournamentServer) UpdateTournamentSchedule(ctx context.Context, req *tournament_management.UpdateTournamentScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	response, err := s.scheduleService.UpdateTournamentSchedule(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update tournament schedule: %v", err)
+	}
+	return response, nil
+}
+
+func (s *tournamentServer) UpdateRoundSchedule(ctx context.Context, req *tournament_management.UpdateRoundScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	response, err := s.scheduleService.UpdateRoundSchedule(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update round schedule: %v", err)
+	}
+	return response, nil
+}
+
+func (s *tournamentServer) ReleaseMotion(ctx context.Context, req *tournament_management.ReleaseMotionRequest) (*tournament_management.ReleaseMotionResponse, error) {
+	motion, err := s.motionService.ReleaseMotion(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to release motion: %v", err)
+	}
+	return &tournament_management.ReleaseMotionResponse{Motion: motion}, nil
+}
+
+func (s *tournamentServer) SearchMotionArchive(ctx context.Context, req *tournament_management.SearchMotionArchiveRequest) (*tournament_management.SearchMotionArchiveResponse, error) {
+	response, err := s.motionService.SearchMotionArchive(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to search motion archive: %v", err)
+	}
+	return response, nil
+}
+
+func (s *tournamentServer) CreateBankMotion(ctx context.Context, req *tournament_management.CreateBankMotionRequest) (*tournament_management.BankMotionResponse, error) {
+	motion, err := s.motionService.CreateBankMotion(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create bank motion: %v", err)
+	}
+	return &tournament_management.BankMotionResponse{Motion: motion}, nil
+}
+
+func (s *tournamentServer) UpdateBankMotion(ctx context.Context, req *tournament_management.UpdateBankMotionRequest) (*tournament_management.BankMotionResponse, error) {
+	motion, err := s.motionService.UpdateBankMotion(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update bank motion: %v", err)
+	}
+	return &tournament_management.BankMotionResponse{Motion: motion}, nil
+}
+
+func (s *tournamentServer) DeleteBankMotion(ctx context.Context, req *tournament_management.DeleteBankMotionRequest) (*tournament_management.DeleteBankMotionResponse, error) {
+	success, message, err := s.motionService.DeleteBankMotion(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete bank motion: %v", err)
+	}
+	return &tournament_management.DeleteBankMotionResponse{Success: success, Message: message}, nil
+}
+
+func (s *tournamentServer) ListBankMotions(ctx context.Context, req *tournament_management.ListBankMotionsRequest) (*tournament_management.ListBankMotionsResponse, error) {
+	response, err := s.motionService.ListBankMotions(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list bank motions: %v", err)
+	}
+	return response, nil
+}
+
+func (s *tournamentServer) AttachBankMotion(ctx context.Context, req *tournament_management.AttachBankMotionRequest) (*tournament_management.AttachBankMotionResponse, error) {
+	motion, err := s.motionService.AttachBankMotion(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to attach bank motion: %v", err)
+	}
+	return &tournament_management.AttachBankMotionResponse{Motion: motion}, nil
+}
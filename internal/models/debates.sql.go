@@ -8,9 +8,45 @@ package models
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
+
+	"github.com/lib/pq"
+	"github.com/sqlc-dev/pqtype"
 )
 
+const addStudentBreakCategory = `-- name: AddStudentBreakCategory :exec
+INSERT INTO StudentBreakCategories (StudentID, CategoryID)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddStudentBreakCategoryParams struct {
+	Studentid  int32 `json:"studentid"`
+	Categoryid int32 `json:"categoryid"`
+}
+
+func (q *Queries) AddStudentBreakCategory(ctx context.Context, arg AddStudentBreakCategoryParams) error {
+	_, err := q.db.ExecContext(ctx, addStudentBreakCategory, arg.Studentid, arg.Categoryid)
+	return err
+}
+
+const addTeamBreakCategory = `-- name: AddTeamBreakCategory :exec
+INSERT INTO TeamBreakCategories (TeamID, CategoryID)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddTeamBreakCategoryParams struct {
+	Teamid     int32 `json:"teamid"`
+	Categoryid int32 `json:"categoryid"`
+}
+
+func (q *Queries) AddTeamBreakCategory(ctx context.Context, arg AddTeamBreakCategoryParams) error {
+	_, err := q.db.ExecContext(ctx, addTeamBreakCategory, arg.Teamid, arg.Categoryid)
+	return err
+}
+
 const addTeamMember = `-- name: AddTeamMember :one
 INSERT INTO TeamMembers (TeamID, StudentID)
 VALUES ($1, $2)
@@ -71,6 +107,19 @@ func (q *Queries) AssignRoomToDebate(ctx context.Context, arg AssignRoomToDebate
 	return err
 }
 
+const breakCategoryHasBracket = `-- name: BreakCategoryHasBracket :one
+SELECT EXISTS (
+    SELECT 1 FROM EliminationBracketNodes WHERE CategoryID = $1
+) AS has_bracket
+`
+
+func (q *Queries) BreakCategoryHasBracket(ctx context.Context, categoryid sql.NullInt32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, breakCategoryHasBracket, categoryid)
+	var has_bracket bool
+	err := row.Scan(&has_bracket)
+	return has_bracket, err
+}
+
 const checkExistingTeamMembership = `-- name: CheckExistingTeamMembership :one
 SELECT COUNT(*) > 0 AS has_team
 FROM TeamMembers tm
@@ -90,6 +139,54 @@ func (q *Queries) CheckExistingTeamMembership(ctx context.Context, arg CheckExis
 	return has_team, err
 }
 
+const confirmBallotEntries = `-- name: ConfirmBallotEntries :exec
+UPDATE BallotEntries
+SET ConfirmedAt = CURRENT_TIMESTAMP
+WHERE BallotID = $1 AND JudgeID = $2 AND ConfirmedAt IS NULL
+`
+
+type ConfirmBallotEntriesParams struct {
+	Ballotid int32 `json:"ballotid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+func (q *Queries) ConfirmBallotEntries(ctx context.Context, arg ConfirmBallotEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, confirmBallotEntries, arg.Ballotid, arg.Judgeid)
+	return err
+}
+
+const countBallotsChangedSince = `-- name: CountBallotsChangedSince :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1
+  AND b.last_updated_at > $2
+`
+
+type CountBallotsChangedSinceParams struct {
+	Tournamentid  int32        `json:"tournamentid"`
+	LastUpdatedAt sql.NullTime `json:"last_updated_at"`
+}
+
+func (q *Queries) CountBallotsChangedSince(ctx context.Context, arg CountBallotsChangedSinceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBallotsChangedSince, arg.Tournamentid, arg.LastUpdatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countBuildingsByTournament = `-- name: CountBuildingsByTournament :one
+SELECT COUNT(*) FROM Buildings
+WHERE TournamentID = $1
+`
+
+func (q *Queries) CountBuildingsByTournament(ctx context.Context, tournamentid int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBuildingsByTournament, tournamentid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countJudgeDebates = `-- name: CountJudgeDebates :one
 SELECT
     COUNT(DISTINCT d.DebateID) as DebateCount
@@ -116,6 +213,35 @@ func (q *Queries) CountJudgeDebates(ctx context.Context, arg CountJudgeDebatesPa
 	return debatecount, err
 }
 
+const countUnrecordedBallots = `-- name: CountUnrecordedBallots :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus <> 'Recorded'
+`
+
+func (q *Queries) CountUnrecordedBallots(ctx context.Context, tournamentid int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnrecordedBallots, tournamentid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnrecordedPreliminaryBallots = `-- name: CountUnrecordedPreliminaryBallots :one
+SELECT COUNT(*)
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+WHERE d.TournamentID = $1 AND d.IsEliminationRound = false AND b.RecordingStatus != 'Recorded'
+`
+
+func (q *Queries) CountUnrecordedPreliminaryBallots(ctx context.Context, tournamentid int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnrecordedPreliminaryBallots, tournamentid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createBallot = `-- name: CreateBallot :one
 INSERT INTO ballots (
     debateid,
@@ -125,7 +251,7 @@ INSERT INTO ballots (
 ) VALUES (
     $1, $2, $3, $4
 )
-RETURNING ballotid, debateid, judgeid, team1totalscore, team1feedback, team2totalscore, team2feedback, recordingstatus, verdict, last_updated_by, last_updated_at, head_judge_submitted
+RETURNING ballotid, debateid, judgeid, team1totalscore, team1feedback, team2totalscore, team2feedback, recordingstatus, verdict, last_updated_by, last_updated_at, head_judge_submitted, team3totalscore, team3feedback, team4totalscore, team4feedback
 `
 
 type CreateBallotParams struct {
@@ -156,25 +282,186 @@ func (q *Queries) CreateBallot(ctx context.Context, arg CreateBallotParams) (Bal
 		&i.LastUpdatedBy,
 		&i.LastUpdatedAt,
 		&i.HeadJudgeSubmitted,
+		&i.Team3totalscore,
+		&i.Team3feedback,
+		&i.Team4totalscore,
+		&i.Team4feedback,
+	)
+	return i, err
+}
+
+const createBallotEntry = `-- name: CreateBallotEntry :one
+INSERT INTO BallotEntries (BallotID, JudgeID, EnteredBy, Snapshot)
+VALUES ($1, $2, $3, $4)
+RETURNING entryid, ballotid, judgeid, enteredby, snapshot, enteredat, confirmedat
+`
+
+type CreateBallotEntryParams struct {
+	Ballotid  int32           `json:"ballotid"`
+	Judgeid   int32           `json:"judgeid"`
+	Enteredby int32           `json:"enteredby"`
+	Snapshot  json.RawMessage `json:"snapshot"`
+}
+
+func (q *Queries) CreateBallotEntry(ctx context.Context, arg CreateBallotEntryParams) (Ballotentry, error) {
+	row := q.db.QueryRowContext(ctx, createBallotEntry,
+		arg.Ballotid,
+		arg.Judgeid,
+		arg.Enteredby,
+		arg.Snapshot,
+	)
+	var i Ballotentry
+	err := row.Scan(
+		&i.Entryid,
+		&i.Ballotid,
+		&i.Judgeid,
+		&i.Enteredby,
+		&i.Snapshot,
+		&i.Enteredat,
+		&i.Confirmedat,
+	)
+	return i, err
+}
+
+const createBallotVersion = `-- name: CreateBallotVersion :one
+INSERT INTO BallotVersions (BallotID, VersionNumber, JudgeID, Snapshot, CreatedBy, Reason, RevertedFrom)
+VALUES ($1, (SELECT COALESCE(MAX(bv.VersionNumber), 0) + 1 FROM BallotVersions bv WHERE bv.BallotID = $1),
+        $2, $3, $4, $5, $6)
+RETURNING versionid, ballotid, versionnumber, judgeid, snapshot, createdby, createdat, reason, revertedfrom
+`
+
+type CreateBallotVersionParams struct {
+	Ballotid     int32           `json:"ballotid"`
+	Judgeid      int32           `json:"judgeid"`
+	Snapshot     json.RawMessage `json:"snapshot"`
+	Createdby    int32           `json:"createdby"`
+	Reason       sql.NullString  `json:"reason"`
+	Revertedfrom sql.NullInt32   `json:"revertedfrom"`
+}
+
+func (q *Queries) CreateBallotVersion(ctx context.Context, arg CreateBallotVersionParams) (Ballotversion, error) {
+	row := q.db.QueryRowContext(ctx, createBallotVersion,
+		arg.Ballotid,
+		arg.Judgeid,
+		arg.Snapshot,
+		arg.Createdby,
+		arg.Reason,
+		arg.Revertedfrom,
+	)
+	var i Ballotversion
+	err := row.Scan(
+		&i.Versionid,
+		&i.Ballotid,
+		&i.Versionnumber,
+		&i.Judgeid,
+		&i.Snapshot,
+		&i.Createdby,
+		&i.Createdat,
+		&i.Reason,
+		&i.Revertedfrom,
+	)
+	return i, err
+}
+
+const createBreakCategory = `-- name: CreateBreakCategory :one
+INSERT INTO BreakCategories (TournamentID, Name, BreakSize, Priority, IsGeneral)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING categoryid, tournamentid, name, breaksize, priority, isgeneral
+`
+
+type CreateBreakCategoryParams struct {
+	Tournamentid int32  `json:"tournamentid"`
+	Name         string `json:"name"`
+	Breaksize    int32  `json:"breaksize"`
+	Priority     int32  `json:"priority"`
+	Isgeneral    bool   `json:"isgeneral"`
+}
+
+func (q *Queries) CreateBreakCategory(ctx context.Context, arg CreateBreakCategoryParams) (Breakcategory, error) {
+	row := q.db.QueryRowContext(ctx, createBreakCategory,
+		arg.Tournamentid,
+		arg.Name,
+		arg.Breaksize,
+		arg.Priority,
+		arg.Isgeneral,
+	)
+	var i Breakcategory
+	err := row.Scan(
+		&i.Categoryid,
+		&i.Tournamentid,
+		&i.Name,
+		&i.Breaksize,
+		&i.Priority,
+		&i.Isgeneral,
+	)
+	return i, err
+}
+
+const createBuilding = `-- name: CreateBuilding :one
+INSERT INTO Buildings (TournamentID, Name, Address)
+VALUES ($1, $2, $3)
+RETURNING buildingid, tournamentid, name, address
+`
+
+type CreateBuildingParams struct {
+	Tournamentid int32  `json:"tournamentid"`
+	Name         string `json:"name"`
+	Address      string `json:"address"`
+}
+
+func (q *Queries) CreateBuilding(ctx context.Context, arg CreateBuildingParams) (Building, error) {
+	row := q.db.QueryRowContext(ctx, createBuilding, arg.Tournamentid, arg.Name, arg.Address)
+	var i Building
+	err := row.Scan(
+		&i.Buildingid,
+		&i.Tournamentid,
+		&i.Name,
+		&i.Address,
 	)
 	return i, err
 }
 
+const createBye = `-- name: CreateBye :exec
+INSERT INTO Byes (TournamentID, RoundID, RoundNumber, TeamID)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateByeParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Roundid      int32 `json:"roundid"`
+	Roundnumber  int32 `json:"roundnumber"`
+	Teamid       int32 `json:"teamid"`
+}
+
+func (q *Queries) CreateBye(ctx context.Context, arg CreateByeParams) error {
+	_, err := q.db.ExecContext(ctx, createBye,
+		arg.Tournamentid,
+		arg.Roundid,
+		arg.Roundnumber,
+		arg.Teamid,
+	)
+	return err
+}
+
 const createDebate = `-- name: CreateDebate :one
-INSERT INTO Debates (TournamentID, RoundID, RoundNumber, IsEliminationRound, Team1ID, Team2ID, RoomID, StartTime)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO Debates (TournamentID, RoundID, RoundNumber, IsEliminationRound, Team1ID, Team2ID, RoomID, StartTime, Team3ID, Team4ID, PanelQuality, EndTime)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING DebateID
 `
 
 type CreateDebateParams struct {
-	Tournamentid       int32     `json:"tournamentid"`
-	Roundid            int32     `json:"roundid"`
-	Roundnumber        int32     `json:"roundnumber"`
-	Iseliminationround bool      `json:"iseliminationround"`
-	Team1id            int32     `json:"team1id"`
-	Team2id            int32     `json:"team2id"`
-	Roomid             int32     `json:"roomid"`
-	Starttime          time.Time `json:"starttime"`
+	Tournamentid       int32          `json:"tournamentid"`
+	Roundid            int32          `json:"roundid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Team1id            int32          `json:"team1id"`
+	Team2id            int32          `json:"team2id"`
+	Roomid             int32          `json:"roomid"`
+	Starttime          time.Time      `json:"starttime"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Panelquality       sql.NullString `json:"panelquality"`
+	Endtime            sql.NullTime   `json:"endtime"`
 }
 
 func (q *Queries) CreateDebate(ctx context.Context, arg CreateDebateParams) (int32, error) {
@@ -187,15 +474,56 @@ func (q *Queries) CreateDebate(ctx context.Context, arg CreateDebateParams) (int
 		arg.Team2id,
 		arg.Roomid,
 		arg.Starttime,
+		arg.Team3id,
+		arg.Team4id,
+		arg.Panelquality,
+		arg.Endtime,
 	)
 	var debateid int32
 	err := row.Scan(&debateid)
 	return debateid, err
 }
 
+const createEliminationBracketNode = `-- name: CreateEliminationBracketNode :one
+INSERT INTO EliminationBracketNodes (TournamentID, RoundNumber, Position, ParentNodeID, ParentSlot, Team1ID, Team1Seed, Team2ID, Team2Seed, CategoryID)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING NodeID
+`
+
+type CreateEliminationBracketNodeParams struct {
+	Tournamentid int32         `json:"tournamentid"`
+	Roundnumber  int32         `json:"roundnumber"`
+	Position     int32         `json:"position"`
+	Parentnodeid sql.NullInt32 `json:"parentnodeid"`
+	Parentslot   sql.NullInt32 `json:"parentslot"`
+	Team1id      sql.NullInt32 `json:"team1id"`
+	Team1seed    sql.NullInt32 `json:"team1seed"`
+	Team2id      sql.NullInt32 `json:"team2id"`
+	Team2seed    sql.NullInt32 `json:"team2seed"`
+	Categoryid   sql.NullInt32 `json:"categoryid"`
+}
+
+func (q *Queries) CreateEliminationBracketNode(ctx context.Context, arg CreateEliminationBracketNodeParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, createEliminationBracketNode,
+		arg.Tournamentid,
+		arg.Roundnumber,
+		arg.Position,
+		arg.Parentnodeid,
+		arg.Parentslot,
+		arg.Team1id,
+		arg.Team1seed,
+		arg.Team2id,
+		arg.Team2seed,
+		arg.Categoryid,
+	)
+	var nodeid int32
+	err := row.Scan(&nodeid)
+	return nodeid, err
+}
+
 const createInitialSpeakerScores = `-- name: CreateInitialSpeakerScores :exec
 WITH ballot_info AS (
-    SELECT b.BallotID, d.Team1ID, d.Team2ID
+    SELECT b.BallotID, d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
     WHERE d.DebateID = $1
@@ -206,18 +534,23 @@ team_speakers AS (
            CASE
                WHEN t.TeamID = bi.Team1ID THEN 1
                WHEN t.TeamID = bi.Team2ID THEN 2
+               WHEN t.TeamID = bi.Team3ID THEN 3
+               WHEN t.TeamID = bi.Team4ID THEN 4
            END as TeamNumber
     FROM TeamMembers tm
     JOIN Teams t ON tm.TeamID = t.TeamID
-    JOIN ballot_info bi ON t.TeamID IN (bi.Team1ID, bi.Team2ID)
+    JOIN ballot_info bi ON t.TeamID IN (bi.Team1ID, bi.Team2ID, bi.Team3ID, bi.Team4ID)
 )
-INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints)
+INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, TeamID, Position)
 SELECT bi.BallotID, ts.SpeakerID,
        ROW_NUMBER() OVER (PARTITION BY bi.BallotID, ts.TeamNumber ORDER BY ts.SpeakerID) as SpeakerRank,
-       0 as SpeakerPoints
+       0 as SpeakerPoints, ts.TeamID,
+       ROW_NUMBER() OVER (PARTITION BY bi.BallotID, ts.TeamNumber ORDER BY ts.SpeakerID) as Position
 FROM ballot_info bi
 JOIN team_speakers ts ON (ts.TeamNumber = 1 AND bi.Team1ID = ts.TeamID)
                       OR (ts.TeamNumber = 2 AND bi.Team2ID = ts.TeamID)
+                      OR (ts.TeamNumber = 3 AND bi.Team3ID = ts.TeamID)
+                      OR (ts.TeamNumber = 4 AND bi.Team4ID = ts.TeamID)
 `
 
 func (q *Queries) CreateInitialSpeakerScores(ctx context.Context, debateid int32) error {
@@ -225,6 +558,44 @@ func (q *Queries) CreateInitialSpeakerScores(ctx context.Context, debateid int32
 	return err
 }
 
+const createJudgeConflict = `-- name: CreateJudgeConflict :one
+INSERT INTO JudgeConflicts (JudgeID, ConflictType, SchoolID, TeamID, StudentID, Reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING conflictid, judgeid, conflicttype, schoolid, teamid, studentid, reason, createdat
+`
+
+type CreateJudgeConflictParams struct {
+	Judgeid      int32          `json:"judgeid"`
+	Conflicttype string         `json:"conflicttype"`
+	Schoolid     sql.NullInt32  `json:"schoolid"`
+	Teamid       sql.NullInt32  `json:"teamid"`
+	Studentid    sql.NullInt32  `json:"studentid"`
+	Reason       sql.NullString `json:"reason"`
+}
+
+func (q *Queries) CreateJudgeConflict(ctx context.Context, arg CreateJudgeConflictParams) (Judgeconflict, error) {
+	row := q.db.QueryRowContext(ctx, createJudgeConflict,
+		arg.Judgeid,
+		arg.Conflicttype,
+		arg.Schoolid,
+		arg.Teamid,
+		arg.Studentid,
+		arg.Reason,
+	)
+	var i Judgeconflict
+	err := row.Scan(
+		&i.Conflictid,
+		&i.Judgeid,
+		&i.Conflicttype,
+		&i.Schoolid,
+		&i.Teamid,
+		&i.Studentid,
+		&i.Reason,
+		&i.Createdat,
+	)
+	return i, err
+}
+
 const createJudgeFeedback = `-- name: CreateJudgeFeedback :one
 INSERT INTO JudgeFeedback (
     JudgeID, StudentID, DebateID,
@@ -278,6 +649,36 @@ func (q *Queries) CreateJudgeFeedback(ctx context.Context, arg CreateJudgeFeedba
 	return i, err
 }
 
+const createJudgeSpeakerScore = `-- name: CreateJudgeSpeakerScore :exec
+INSERT INTO JudgeSpeakerScores (JudgeBallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, ReplyPoints, TeamID, Position)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateJudgeSpeakerScoreParams struct {
+	Judgeballotid int32          `json:"judgeballotid"`
+	Speakerid     int32          `json:"speakerid"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Replypoints   string         `json:"replypoints"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
+}
+
+func (q *Queries) CreateJudgeSpeakerScore(ctx context.Context, arg CreateJudgeSpeakerScoreParams) error {
+	_, err := q.db.ExecContext(ctx, createJudgeSpeakerScore,
+		arg.Judgeballotid,
+		arg.Speakerid,
+		arg.Speakerrank,
+		arg.Speakerpoints,
+		arg.Feedback,
+		arg.Replypoints,
+		arg.Teamid,
+		arg.Position,
+	)
+	return err
+}
+
 const createPairingHistory = `-- name: CreatePairingHistory :exec
 INSERT INTO PairingHistory (TournamentID, Team1ID, Team2ID, RoundNumber, IsElimination)
 VALUES ($1, $2, $3, $4, $5)
@@ -302,10 +703,38 @@ func (q *Queries) CreatePairingHistory(ctx context.Context, arg CreatePairingHis
 	return err
 }
 
+const createPublishedTab = `-- name: CreatePublishedTab :one
+INSERT INTO PublishedTabs (TournamentID, Version, Snapshot, PublishedBy)
+SELECT $1, COALESCE(MAX(Version), 0) + 1, $2, $3
+FROM PublishedTabs
+WHERE TournamentID = $1
+RETURNING tabid, tournamentid, version, snapshot, publishedby, publishedat
+`
+
+type CreatePublishedTabParams struct {
+	Tournamentid int32           `json:"tournamentid"`
+	Snapshot     json.RawMessage `json:"snapshot"`
+	Publishedby  int32           `json:"publishedby"`
+}
+
+func (q *Queries) CreatePublishedTab(ctx context.Context, arg CreatePublishedTabParams) (Publishedtab, error) {
+	row := q.db.QueryRowContext(ctx, createPublishedTab, arg.Tournamentid, arg.Snapshot, arg.Publishedby)
+	var i Publishedtab
+	err := row.Scan(
+		&i.Tabid,
+		&i.Tournamentid,
+		&i.Version,
+		&i.Snapshot,
+		&i.Publishedby,
+		&i.Publishedat,
+	)
+	return i, err
+}
+
 const createRoom = `-- name: CreateRoom :one
-INSERT INTO Rooms (RoomName, Location, Capacity, TournamentID)
-VALUES ($1, $2, $3, $4)
-RETURNING roomid, roomname, location, capacity, tournamentid
+INSERT INTO Rooms (RoomName, Location, Capacity, TournamentID, BuildingID, IsAccessible)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING roomid, roomname, location, capacity, tournamentid, buildingid, isaccessible
 `
 
 type CreateRoomParams struct {
@@ -313,6 +742,8 @@ type CreateRoomParams struct {
 	Location     string        `json:"location"`
 	Capacity     int32         `json:"capacity"`
 	Tournamentid sql.NullInt32 `json:"tournamentid"`
+	Buildingid   sql.NullInt32 `json:"buildingid"`
+	Isaccessible bool          `json:"isaccessible"`
 }
 
 func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) (Room, error) {
@@ -321,6 +752,8 @@ func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) (Room, e
 		arg.Location,
 		arg.Capacity,
 		arg.Tournamentid,
+		arg.Buildingid,
+		arg.Isaccessible,
 	)
 	var i Room
 	err := row.Scan(
@@ -329,13 +762,31 @@ func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) (Room, e
 		&i.Location,
 		&i.Capacity,
 		&i.Tournamentid,
+		&i.Buildingid,
+		&i.Isaccessible,
 	)
 	return i, err
 }
 
+const createRoomAvailability = `-- name: CreateRoomAvailability :exec
+INSERT INTO RoomAvailability (RoomID, StartTime, EndTime)
+VALUES ($1, $2, $3)
+`
+
+type CreateRoomAvailabilityParams struct {
+	Roomid    int32     `json:"roomid"`
+	Starttime time.Time `json:"starttime"`
+	Endtime   time.Time `json:"endtime"`
+}
+
+func (q *Queries) CreateRoomAvailability(ctx context.Context, arg CreateRoomAvailabilityParams) error {
+	_, err := q.db.ExecContext(ctx, createRoomAvailability, arg.Roomid, arg.Starttime, arg.Endtime)
+	return err
+}
+
 const createSpeakerScore = `-- name: CreateSpeakerScore :exec
-INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO SpeakerScores (BallotID, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, TeamID, Position)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateSpeakerScoreParams struct {
@@ -344,6 +795,8 @@ type CreateSpeakerScoreParams struct {
 	Speakerrank   int32          `json:"speakerrank"`
 	Speakerpoints string         `json:"speakerpoints"`
 	Feedback      sql.NullString `json:"feedback"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
 }
 
 func (q *Queries) CreateSpeakerScore(ctx context.Context, arg CreateSpeakerScoreParams) error {
@@ -353,34 +806,73 @@ func (q *Queries) CreateSpeakerScore(ctx context.Context, arg CreateSpeakerScore
 		arg.Speakerrank,
 		arg.Speakerpoints,
 		arg.Feedback,
+		arg.Teamid,
+		arg.Position,
 	)
 	return err
 }
 
 const createTeam = `-- name: CreateTeam :one
-INSERT INTO Teams (Name, TournamentID)
-VALUES ($1, $2)
-RETURNING TeamID, Name, TournamentID
+INSERT INTO Teams (Name, TournamentID, NeedsAccessibleRoom)
+VALUES ($1, $2, $3)
+RETURNING TeamID, Name, TournamentID, NeedsAccessibleRoom
 `
 
 type CreateTeamParams struct {
-	Name         string `json:"name"`
-	Tournamentid int32  `json:"tournamentid"`
+	Name                string `json:"name"`
+	Tournamentid        int32  `json:"tournamentid"`
+	Needsaccessibleroom bool   `json:"needsaccessibleroom"`
 }
 
 type CreateTeamRow struct {
-	Teamid       int32  `json:"teamid"`
-	Name         string `json:"name"`
-	Tournamentid int32  `json:"tournamentid"`
+	Teamid              int32  `json:"teamid"`
+	Name                string `json:"name"`
+	Tournamentid        int32  `json:"tournamentid"`
+	Needsaccessibleroom bool   `json:"needsaccessibleroom"`
 }
 
 func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (CreateTeamRow, error) {
-	row := q.db.QueryRowContext(ctx, createTeam, arg.Name, arg.Tournamentid)
+	row := q.db.QueryRowContext(ctx, createTeam, arg.Name, arg.Tournamentid, arg.Needsaccessibleroom)
 	var i CreateTeamRow
-	err := row.Scan(&i.Teamid, &i.Name, &i.Tournamentid)
+	err := row.Scan(
+		&i.Teamid,
+		&i.Name,
+		&i.Tournamentid,
+		&i.Needsaccessibleroom,
+	)
 	return i, err
 }
 
+const deleteBallotEntries = `-- name: DeleteBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1
+`
+
+func (q *Queries) DeleteBallotEntries(ctx context.Context, ballotid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteBallotEntries, ballotid)
+	return err
+}
+
+const deleteBreakCategory = `-- name: DeleteBreakCategory :exec
+DELETE FROM BreakCategories
+WHERE CategoryID = $1
+`
+
+func (q *Queries) DeleteBreakCategory(ctx context.Context, categoryid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteBreakCategory, categoryid)
+	return err
+}
+
+const deleteBuilding = `-- name: DeleteBuilding :exec
+DELETE FROM Buildings
+WHERE BuildingID = $1
+`
+
+func (q *Queries) DeleteBuilding(ctx context.Context, buildingid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteBuilding, buildingid)
+	return err
+}
+
 const deleteDebatesForTournament = `-- name: DeleteDebatesForTournament :exec
 DELETE FROM Debates
 WHERE TournamentID = $1
@@ -401,17 +893,47 @@ func (q *Queries) DeleteJudgeAssignmentsForTournament(ctx context.Context, tourn
 	return err
 }
 
-const deletePairingHistoryForTournament = `-- name: DeletePairingHistoryForTournament :exec
-DELETE FROM PairingHistory
-WHERE TournamentID = $1
+const deleteJudgeBallots = `-- name: DeleteJudgeBallots :exec
+DELETE FROM JudgeBallots
+WHERE BallotID = $1
 `
 
-func (q *Queries) DeletePairingHistoryForTournament(ctx context.Context, tournamentid int32) error {
-	_, err := q.db.ExecContext(ctx, deletePairingHistoryForTournament, tournamentid)
+func (q *Queries) DeleteJudgeBallots(ctx context.Context, ballotid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteJudgeBallots, ballotid)
 	return err
 }
 
-const deletePairingsForTournament = `-- name: DeletePairingsForTournament :exec
+const deleteJudgeConflicts = `-- name: DeleteJudgeConflicts :exec
+DELETE FROM JudgeConflicts
+WHERE JudgeID = $1
+`
+
+func (q *Queries) DeleteJudgeConflicts(ctx context.Context, judgeid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteJudgeConflicts, judgeid)
+	return err
+}
+
+const deleteJudgeSpeakerScores = `-- name: DeleteJudgeSpeakerScores :exec
+DELETE FROM JudgeSpeakerScores
+WHERE JudgeBallotID = $1
+`
+
+func (q *Queries) DeleteJudgeSpeakerScores(ctx context.Context, judgeballotid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteJudgeSpeakerScores, judgeballotid)
+	return err
+}
+
+const deletePairingHistoryForTournament = `-- name: DeletePairingHistoryForTournament :exec
+DELETE FROM PairingHistory
+WHERE TournamentID = $1
+`
+
+func (q *Queries) DeletePairingHistoryForTournament(ctx context.Context, tournamentid int32) error {
+	_, err := q.db.ExecContext(ctx, deletePairingHistoryForTournament, tournamentid)
+	return err
+}
+
+const deletePairingsForTournament = `-- name: DeletePairingsForTournament :exec
 DELETE FROM Debates
 WHERE TournamentID = $1
 `
@@ -421,6 +943,55 @@ func (q *Queries) DeletePairingsForTournament(ctx context.Context, tournamentid
 	return err
 }
 
+const deletePendingBallotEntries = `-- name: DeletePendingBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1 AND JudgeID = $2 AND ConfirmedAt IS NULL
+`
+
+type DeletePendingBallotEntriesParams struct {
+	Ballotid int32 `json:"ballotid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+func (q *Queries) DeletePendingBallotEntries(ctx context.Context, arg DeletePendingBallotEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, deletePendingBallotEntries, arg.Ballotid, arg.Judgeid)
+	return err
+}
+
+const deleteReleasedDraw = `-- name: DeleteReleasedDraw :exec
+DELETE FROM ReleasedDraws
+WHERE RoundID = $1
+`
+
+func (q *Queries) DeleteReleasedDraw(ctx context.Context, roundid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteReleasedDraw, roundid)
+	return err
+}
+
+const deleteRoom = `-- name: DeleteRoom :execrows
+DELETE FROM Rooms r
+WHERE r.RoomID = $1
+  AND NOT EXISTS (SELECT 1 FROM Debates d WHERE d.RoomID = r.RoomID)
+`
+
+func (q *Queries) DeleteRoom(ctx context.Context, roomid int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRoom, roomid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRoomAvailability = `-- name: DeleteRoomAvailability :exec
+DELETE FROM RoomAvailability
+WHERE RoomID = $1
+`
+
+func (q *Queries) DeleteRoomAvailability(ctx context.Context, roomid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRoomAvailability, roomid)
+	return err
+}
+
 const deleteRoomsForTournament = `-- name: DeleteRoomsForTournament :exec
 DELETE FROM Rooms
 WHERE TournamentID = $1
@@ -453,12 +1024,7 @@ func (q *Queries) DeleteSpeakerScoresByBallot(ctx context.Context, ballotid int3
 
 const deleteSpeakerScoresByBallotAndTeam = `-- name: DeleteSpeakerScoresByBallotAndTeam :exec
 DELETE FROM SpeakerScores
-WHERE BallotID = $1
-  AND SpeakerID IN (
-    SELECT tm.StudentID
-    FROM TeamMembers tm
-    WHERE tm.TeamID = $2
-)
+WHERE BallotID = $1 AND TeamID = $2
 `
 
 type DeleteSpeakerScoresByBallotAndTeamParams struct {
@@ -471,6 +1037,22 @@ func (q *Queries) DeleteSpeakerScoresByBallotAndTeam(ctx context.Context, arg De
 	return err
 }
 
+const deleteStudentBreakCategories = `-- name: DeleteStudentBreakCategories :exec
+DELETE FROM StudentBreakCategories
+WHERE StudentID = $1
+  AND CategoryID IN (SELECT CategoryID FROM BreakCategories WHERE TournamentID = $2)
+`
+
+type DeleteStudentBreakCategoriesParams struct {
+	Studentid    int32 `json:"studentid"`
+	Tournamentid int32 `json:"tournamentid"`
+}
+
+func (q *Queries) DeleteStudentBreakCategories(ctx context.Context, arg DeleteStudentBreakCategoriesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStudentBreakCategories, arg.Studentid, arg.Tournamentid)
+	return err
+}
+
 const deleteTeam = `-- name: DeleteTeam :exec
 WITH debate_check AS (
     SELECT 1
@@ -487,6 +1069,16 @@ func (q *Queries) DeleteTeam(ctx context.Context, teamid int32) error {
 	return err
 }
 
+const deleteTeamBreakCategories = `-- name: DeleteTeamBreakCategories :exec
+DELETE FROM TeamBreakCategories
+WHERE TeamID = $1
+`
+
+func (q *Queries) DeleteTeamBreakCategories(ctx context.Context, teamid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamBreakCategories, teamid)
+	return err
+}
+
 const deleteTeamMembers = `-- name: DeleteTeamMembers :exec
 DELETE FROM TeamMembers
 WHERE TeamID = $1
@@ -497,8 +1089,39 @@ func (q *Queries) DeleteTeamMembers(ctx context.Context, teamid int32) error {
 	return err
 }
 
+const deleteTeamScore = `-- name: DeleteTeamScore :exec
+DELETE FROM TeamScores
+WHERE TeamID = $1 AND DebateID = $2
+`
+
+type DeleteTeamScoreParams struct {
+	Teamid   sql.NullInt32 `json:"teamid"`
+	Debateid sql.NullInt32 `json:"debateid"`
+}
+
+func (q *Queries) DeleteTeamScore(ctx context.Context, arg DeleteTeamScoreParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamScore, arg.Teamid, arg.Debateid)
+	return err
+}
+
+const deleteUserPendingBallotEntries = `-- name: DeleteUserPendingBallotEntries :exec
+DELETE FROM BallotEntries
+WHERE BallotID = $1 AND JudgeID = $2 AND EnteredBy = $3 AND ConfirmedAt IS NULL
+`
+
+type DeleteUserPendingBallotEntriesParams struct {
+	Ballotid  int32 `json:"ballotid"`
+	Judgeid   int32 `json:"judgeid"`
+	Enteredby int32 `json:"enteredby"`
+}
+
+func (q *Queries) DeleteUserPendingBallotEntries(ctx context.Context, arg DeleteUserPendingBallotEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUserPendingBallotEntries, arg.Ballotid, arg.Judgeid, arg.Enteredby)
+	return err
+}
+
 const getAvailableJudges = `-- name: GetAvailableJudges :many
-SELECT DISTINCT u.UserID, u.Name, u.Email, v.iDebateVolunteerID
+SELECT DISTINCT u.UserID, u.Name, u.Email, v.iDebateVolunteerID, v.MaxTimesJudgingTeam
 FROM Users u
          JOIN Volunteers v ON u.UserID = v.UserID
          JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
@@ -508,10 +1131,11 @@ WHERE ti.TournamentID = $1
 `
 
 type GetAvailableJudgesRow struct {
-	Userid             int32          `json:"userid"`
-	Name               string         `json:"name"`
-	Email              string         `json:"email"`
-	Idebatevolunteerid sql.NullString `json:"idebatevolunteerid"`
+	Userid              int32          `json:"userid"`
+	Name                string         `json:"name"`
+	Email               string         `json:"email"`
+	Idebatevolunteerid  sql.NullString `json:"idebatevolunteerid"`
+	Maxtimesjudgingteam sql.NullInt32  `json:"maxtimesjudgingteam"`
 }
 
 func (q *Queries) GetAvailableJudges(ctx context.Context, tournamentid int32) ([]GetAvailableJudgesRow, error) {
@@ -528,6 +1152,57 @@ func (q *Queries) GetAvailableJudges(ctx context.Context, tournamentid int32) ([
 			&i.Name,
 			&i.Email,
 			&i.Idebatevolunteerid,
+			&i.Maxtimesjudgingteam,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAvailableRooms = `-- name: GetAvailableRooms :many
+SELECT r.roomid, r.roomname, r.location, r.capacity, r.tournamentid, r.buildingid, r.isaccessible
+FROM Rooms r
+WHERE r.TournamentID = $1
+  AND r.RoomName <> 'Unassigned'
+  AND (NOT EXISTS (SELECT 1 FROM RoomAvailability ra WHERE ra.RoomID = r.RoomID)
+       OR EXISTS (SELECT 1 FROM RoomAvailability ra
+                  WHERE ra.RoomID = r.RoomID AND ra.StartTime <= $2 AND ra.EndTime >= $3))
+ORDER BY r.RoomID
+`
+
+type GetAvailableRoomsParams struct {
+	Tournamentid sql.NullInt32 `json:"tournamentid"`
+	Starttime    time.Time     `json:"starttime"`
+	Endtime      time.Time     `json:"endtime"`
+}
+
+// Rooms without availability windows are always available; the others only
+// when one of their windows covers the whole slot.
+func (q *Queries) GetAvailableRooms(ctx context.Context, arg GetAvailableRoomsParams) ([]Room, error) {
+	rows, err := q.db.QueryContext(ctx, getAvailableRooms, arg.Tournamentid, arg.Starttime, arg.Endtime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Room{}
+	for rows.Next() {
+		var i Room
+		if err := rows.Scan(
+			&i.Roomid,
+			&i.Roomname,
+			&i.Location,
+			&i.Capacity,
+			&i.Tournamentid,
+			&i.Buildingid,
+			&i.Isaccessible,
 		); err != nil {
 			return nil, err
 		}
@@ -548,13 +1223,17 @@ SELECT b.BallotID, d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
        b.Team1TotalScore, b.Team2TotalScore, b.RecordingStatus, b.Verdict,
        b.Team1Feedback, b.Team2Feedback, b.last_updated_by, b.last_updated_at,
-       b.head_judge_submitted
+       b.head_judge_submitted,
+       d.Team3ID, t3.Name AS Team3Name, d.Team4ID, t4.Name AS Team4Name,
+       b.Team3TotalScore, b.Team4TotalScore, b.Team3Feedback, b.Team4Feedback
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
 LEFT JOIN Rooms r ON d.RoomID = r.RoomID
 JOIN Users u ON b.JudgeID = u.UserID
 JOIN Teams t1 ON d.Team1ID = t1.TeamID
 JOIN Teams t2 ON d.Team2ID = t2.TeamID
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
 WHERE b.BallotID = $1
 `
 
@@ -580,6 +1259,14 @@ type GetBallotByIDRow struct {
 	LastUpdatedBy      sql.NullInt32  `json:"last_updated_by"`
 	LastUpdatedAt      sql.NullTime   `json:"last_updated_at"`
 	HeadJudgeSubmitted sql.NullBool   `json:"head_judge_submitted"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+	Team3totalscore    sql.NullString `json:"team3totalscore"`
+	Team4totalscore    sql.NullString `json:"team4totalscore"`
+	Team3feedback      sql.NullString `json:"team3feedback"`
+	Team4feedback      sql.NullString `json:"team4feedback"`
 }
 
 func (q *Queries) GetBallotByID(ctx context.Context, ballotid int32) (GetBallotByIDRow, error) {
@@ -607,6 +1294,14 @@ func (q *Queries) GetBallotByID(ctx context.Context, ballotid int32) (GetBallotB
 		&i.LastUpdatedBy,
 		&i.LastUpdatedAt,
 		&i.HeadJudgeSubmitted,
+		&i.Team3id,
+		&i.Team3name,
+		&i.Team4id,
+		&i.Team4name,
+		&i.Team3totalscore,
+		&i.Team4totalscore,
+		&i.Team3feedback,
+		&i.Team4feedback,
 	)
 	return i, err
 }
@@ -617,14 +1312,20 @@ SELECT b.BallotID, d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
        b.Team1TotalScore, b.Team2TotalScore, b.RecordingStatus, b.Verdict,
        b.Team1Feedback, b.Team2Feedback, b.last_updated_by, b.last_updated_at,
-       b.head_judge_submitted
+       b.head_judge_submitted,
+       d.Team3ID, t3.Name AS Team3Name, d.Team4ID, t4.Name AS Team4Name,
+       b.Team3TotalScore, b.Team4TotalScore, b.Team3Feedback, b.Team4Feedback
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
 LEFT JOIN Rooms r ON d.RoomID = r.RoomID
 JOIN Users u ON b.JudgeID = u.UserID
 JOIN Teams t1 ON d.Team1ID = t1.TeamID
 JOIN Teams t2 ON d.Team2ID = t2.TeamID
-WHERE b.JudgeID = $1 AND d.TournamentID = $2
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
+JOIN JudgeAssignments ja ON ja.DebateID = d.DebateID
+WHERE ja.JudgeID = $1 AND d.TournamentID = $2
+  AND EXISTS (SELECT 1 FROM ReleasedDraws rd WHERE rd.DebateID = d.DebateID)
 ORDER BY d.RoundNumber DESC
 LIMIT 1
 `
@@ -656,6 +1357,14 @@ type GetBallotByJudgeIDRow struct {
 	LastUpdatedBy      sql.NullInt32  `json:"last_updated_by"`
 	LastUpdatedAt      sql.NullTime   `json:"last_updated_at"`
 	HeadJudgeSubmitted sql.NullBool   `json:"head_judge_submitted"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+	Team3totalscore    sql.NullString `json:"team3totalscore"`
+	Team4totalscore    sql.NullString `json:"team4totalscore"`
+	Team3feedback      sql.NullString `json:"team3feedback"`
+	Team4feedback      sql.NullString `json:"team4feedback"`
 }
 
 func (q *Queries) GetBallotByJudgeID(ctx context.Context, arg GetBallotByJudgeIDParams) (GetBallotByJudgeIDRow, error) {
@@ -683,37 +1392,64 @@ func (q *Queries) GetBallotByJudgeID(ctx context.Context, arg GetBallotByJudgeID
 		&i.LastUpdatedBy,
 		&i.LastUpdatedAt,
 		&i.HeadJudgeSubmitted,
+		&i.Team3id,
+		&i.Team3name,
+		&i.Team4id,
+		&i.Team4name,
+		&i.Team3totalscore,
+		&i.Team4totalscore,
+		&i.Team3feedback,
+		&i.Team4feedback,
 	)
 	return i, err
 }
 
-const getBallotsByDebateID = `-- name: GetBallotsByDebateID :many
-SELECT ballotid, debateid, judgeid, team1totalscore, team1feedback, team2totalscore, team2feedback, recordingstatus, verdict, last_updated_by, last_updated_at, head_judge_submitted FROM Ballots
-WHERE DebateID = $1
+const getBallotEntryMode = `-- name: GetBallotEntryMode :one
+SELECT t.DoubleEntryBallots
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Tournaments t ON d.TournamentID = t.TournamentID
+WHERE b.BallotID = $1
 `
 
-func (q *Queries) GetBallotsByDebateID(ctx context.Context, debateid int32) ([]Ballot, error) {
-	rows, err := q.db.QueryContext(ctx, getBallotsByDebateID, debateid)
+func (q *Queries) GetBallotEntryMode(ctx context.Context, ballotid int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getBallotEntryMode, ballotid)
+	var doubleentryballots bool
+	err := row.Scan(&doubleentryballots)
+	return doubleentryballots, err
+}
+
+const getBallotPanel = `-- name: GetBallotPanel :many
+SELECT ja.JudgeID, u.Name AS JudgeName, ja.IsHeadJudge, jb.Verdict
+FROM Ballots b
+JOIN JudgeAssignments ja ON ja.DebateID = b.DebateID
+JOIN Users u ON ja.JudgeID = u.UserID
+LEFT JOIN JudgeBallots jb ON jb.BallotID = b.BallotID AND jb.JudgeID = ja.JudgeID
+WHERE b.BallotID = $1
+ORDER BY ja.IsHeadJudge DESC, u.Name
+`
+
+type GetBallotPanelRow struct {
+	Judgeid     int32          `json:"judgeid"`
+	Judgename   string         `json:"judgename"`
+	Isheadjudge bool           `json:"isheadjudge"`
+	Verdict     sql.NullString `json:"verdict"`
+}
+
+func (q *Queries) GetBallotPanel(ctx context.Context, ballotid int32) ([]GetBallotPanelRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotPanel, ballotid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Ballot{}
+	items := []GetBallotPanelRow{}
 	for rows.Next() {
-		var i Ballot
+		var i GetBallotPanelRow
 		if err := rows.Scan(
-			&i.Ballotid,
-			&i.Debateid,
 			&i.Judgeid,
-			&i.Team1totalscore,
-			&i.Team1feedback,
-			&i.Team2totalscore,
-			&i.Team2feedback,
-			&i.Recordingstatus,
+			&i.Judgename,
+			&i.Isheadjudge,
 			&i.Verdict,
-			&i.LastUpdatedBy,
-			&i.LastUpdatedAt,
-			&i.HeadJudgeSubmitted,
 		); err != nil {
 			return nil, err
 		}
@@ -728,61 +1464,46 @@ func (q *Queries) GetBallotsByDebateID(ctx context.Context, debateid int32) ([]B
 	return items, nil
 }
 
-const getBallotsByTournamentAndRound = `-- name: GetBallotsByTournamentAndRound :many
-SELECT b.BallotID, d.RoundNumber, d.IsEliminationRound, r.RoomName,
-       u.Name AS HeadJudgeName, b.RecordingStatus, b.Verdict,
-       t1.TeamID as Team1ID, t1.Name as Team1Name,
-       t2.TeamID as Team2ID, t2.Name as Team2Name
+const getBallotPanelsByTournamentAndRound = `-- name: GetBallotPanelsByTournamentAndRound :many
+SELECT b.BallotID, ja.JudgeID, u.Name AS JudgeName, ja.IsHeadJudge, jb.Verdict
 FROM Ballots b
 JOIN Debates d ON b.DebateID = d.DebateID
-JOIN Rooms r ON d.RoomID = r.RoomID
-JOIN Users u ON b.JudgeID = u.UserID
-JOIN Teams t1 ON d.Team1ID = t1.TeamID
-JOIN Teams t2 ON d.Team2ID = t2.TeamID
+JOIN JudgeAssignments ja ON ja.DebateID = b.DebateID
+JOIN Users u ON ja.JudgeID = u.UserID
+LEFT JOIN JudgeBallots jb ON jb.BallotID = b.BallotID AND jb.JudgeID = ja.JudgeID
 WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+ORDER BY b.BallotID, ja.IsHeadJudge DESC, u.Name
 `
 
-type GetBallotsByTournamentAndRoundParams struct {
+type GetBallotPanelsByTournamentAndRoundParams struct {
 	Tournamentid       int32 `json:"tournamentid"`
 	Roundnumber        int32 `json:"roundnumber"`
 	Iseliminationround bool  `json:"iseliminationround"`
 }
 
-type GetBallotsByTournamentAndRoundRow struct {
-	Ballotid           int32  `json:"ballotid"`
-	Roundnumber        int32  `json:"roundnumber"`
-	Iseliminationround bool   `json:"iseliminationround"`
-	Roomname           string `json:"roomname"`
-	Headjudgename      string `json:"headjudgename"`
-	Recordingstatus    string `json:"recordingstatus"`
-	Verdict            string `json:"verdict"`
-	Team1id            int32  `json:"team1id"`
-	Team1name          string `json:"team1name"`
-	Team2id            int32  `json:"team2id"`
-	Team2name          string `json:"team2name"`
+type GetBallotPanelsByTournamentAndRoundRow struct {
+	Ballotid    int32          `json:"ballotid"`
+	Judgeid     int32          `json:"judgeid"`
+	Judgename   string         `json:"judgename"`
+	Isheadjudge bool           `json:"isheadjudge"`
+	Verdict     sql.NullString `json:"verdict"`
 }
 
-func (q *Queries) GetBallotsByTournamentAndRound(ctx context.Context, arg GetBallotsByTournamentAndRoundParams) ([]GetBallotsByTournamentAndRoundRow, error) {
-	rows, err := q.db.QueryContext(ctx, getBallotsByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+func (q *Queries) GetBallotPanelsByTournamentAndRound(ctx context.Context, arg GetBallotPanelsByTournamentAndRoundParams) ([]GetBallotPanelsByTournamentAndRoundRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotPanelsByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetBallotsByTournamentAndRoundRow{}
+	items := []GetBallotPanelsByTournamentAndRoundRow{}
 	for rows.Next() {
-		var i GetBallotsByTournamentAndRoundRow
+		var i GetBallotPanelsByTournamentAndRoundRow
 		if err := rows.Scan(
 			&i.Ballotid,
-			&i.Roundnumber,
-			&i.Iseliminationround,
-			&i.Roomname,
-			&i.Headjudgename,
-			&i.Recordingstatus,
+			&i.Judgeid,
+			&i.Judgename,
+			&i.Isheadjudge,
 			&i.Verdict,
-			&i.Team1id,
-			&i.Team1name,
-			&i.Team2id,
-			&i.Team2name,
 		); err != nil {
 			return nil, err
 		}
@@ -797,129 +1518,281 @@ func (q *Queries) GetBallotsByTournamentAndRound(ctx context.Context, arg GetBal
 	return items, nil
 }
 
-const getDebateByBallotID = `-- name: GetDebateByBallotID :one
-SELECT d.DebateID, d.Team1ID, d.Team2ID, d.IsEliminationRound, d.TournamentID
-FROM Debates d
-JOIN Ballots b ON d.DebateID = b.DebateID
+const getBallotScoringRules = `-- name: GetBallotScoringRules :one
+SELECT tf.SpeakersPerTeam, tf.MinSpeakerPoints, tf.MaxSpeakerPoints, tf.SpeakerPointStep,
+       tf.AllowLowPointWins, tf.ReplySpeeches, tf.MinReplyPoints, tf.MaxReplyPoints, tf.TieHandling
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Tournaments t ON d.TournamentID = t.TournamentID
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
 WHERE b.BallotID = $1
 `
 
-type GetDebateByBallotIDRow struct {
-	Debateid           int32 `json:"debateid"`
-	Team1id            int32 `json:"team1id"`
-	Team2id            int32 `json:"team2id"`
-	Iseliminationround bool  `json:"iseliminationround"`
-	Tournamentid       int32 `json:"tournamentid"`
-}
-
-func (q *Queries) GetDebateByBallotID(ctx context.Context, ballotid int32) (GetDebateByBallotIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getDebateByBallotID, ballotid)
-	var i GetDebateByBallotIDRow
+type GetBallotScoringRulesRow struct {
+	Speakersperteam   int32  `json:"speakersperteam"`
+	Minspeakerpoints  string `json:"minspeakerpoints"`
+	Maxspeakerpoints  string `json:"maxspeakerpoints"`
+	Speakerpointstep  string `json:"speakerpointstep"`
+	Allowlowpointwins bool   `json:"allowlowpointwins"`
+	Replyspeeches     bool   `json:"replyspeeches"`
+	Minreplypoints    string `json:"minreplypoints"`
+	Maxreplypoints    string `json:"maxreplypoints"`
+	Tiehandling       string `json:"tiehandling"`
+}
+
+func (q *Queries) GetBallotScoringRules(ctx context.Context, ballotid int32) (GetBallotScoringRulesRow, error) {
+	row := q.db.QueryRowContext(ctx, getBallotScoringRules, ballotid)
+	var i GetBallotScoringRulesRow
 	err := row.Scan(
-		&i.Debateid,
-		&i.Team1id,
-		&i.Team2id,
-		&i.Iseliminationround,
-		&i.Tournamentid,
+		&i.Speakersperteam,
+		&i.Minspeakerpoints,
+		&i.Maxspeakerpoints,
+		&i.Speakerpointstep,
+		&i.Allowlowpointwins,
+		&i.Replyspeeches,
+		&i.Minreplypoints,
+		&i.Maxreplypoints,
+		&i.Tiehandling,
 	)
 	return i, err
 }
 
-const getDebateByID = `-- name: GetDebateByID :one
-SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status FROM Debates
-WHERE DebateID = $1
+const getBallotTeamMembers = `-- name: GetBallotTeamMembers :many
+SELECT tm.TeamID, tm.StudentID
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN TeamMembers tm ON tm.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+WHERE b.BallotID = $1
 `
 
-func (q *Queries) GetDebateByID(ctx context.Context, debateid int32) (Debate, error) {
-	row := q.db.QueryRowContext(ctx, getDebateByID, debateid)
-	var i Debate
-	err := row.Scan(
-		&i.Debateid,
-		&i.Roundid,
-		&i.Roundnumber,
-		&i.Iseliminationround,
-		&i.Tournamentid,
-		&i.Team1id,
-		&i.Team2id,
-		&i.Starttime,
-		&i.Endtime,
-		&i.Roomid,
-		&i.Status,
-	)
-	return i, err
+func (q *Queries) GetBallotTeamMembers(ctx context.Context, ballotid int32) ([]Teammember, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotTeamMembers, ballotid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Teammember{}
+	for rows.Next() {
+		var i Teammember
+		if err := rows.Scan(&i.Teamid, &i.Studentid); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getDebateByRoomAndRound = `-- name: GetDebateByRoomAndRound :one
-SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status
-FROM Debates
-WHERE TournamentID = $1 AND RoomID = $2 AND RoundNumber = $3 AND IsEliminationRound = $4
-LIMIT 1
+const getBallotVersion = `-- name: GetBallotVersion :one
+SELECT versionid, ballotid, versionnumber, judgeid, snapshot, createdby, createdat, reason, revertedfrom FROM BallotVersions
+WHERE BallotID = $1 AND VersionNumber = $2
 `
 
-type GetDebateByRoomAndRoundParams struct {
-	Tournamentid       int32 `json:"tournamentid"`
-	Roomid             int32 `json:"roomid"`
-	Roundnumber        int32 `json:"roundnumber"`
-	Iseliminationround bool  `json:"iseliminationround"`
+type GetBallotVersionParams struct {
+	Ballotid      int32 `json:"ballotid"`
+	Versionnumber int32 `json:"versionnumber"`
 }
 
-func (q *Queries) GetDebateByRoomAndRound(ctx context.Context, arg GetDebateByRoomAndRoundParams) (Debate, error) {
-	row := q.db.QueryRowContext(ctx, getDebateByRoomAndRound,
-		arg.Tournamentid,
-		arg.Roomid,
-		arg.Roundnumber,
-		arg.Iseliminationround,
-	)
-	var i Debate
+func (q *Queries) GetBallotVersion(ctx context.Context, arg GetBallotVersionParams) (Ballotversion, error) {
+	row := q.db.QueryRowContext(ctx, getBallotVersion, arg.Ballotid, arg.Versionnumber)
+	var i Ballotversion
 	err := row.Scan(
-		&i.Debateid,
-		&i.Roundid,
-		&i.Roundnumber,
-		&i.Iseliminationround,
-		&i.Tournamentid,
-		&i.Team1id,
-		&i.Team2id,
-		&i.Starttime,
-		&i.Endtime,
-		&i.Roomid,
-		&i.Status,
+		&i.Versionid,
+		&i.Ballotid,
+		&i.Versionnumber,
+		&i.Judgeid,
+		&i.Snapshot,
+		&i.Createdby,
+		&i.Createdat,
+		&i.Reason,
+		&i.Revertedfrom,
 	)
 	return i, err
 }
 
-const getDebatesByRoomAndTournament = `-- name: GetDebatesByRoomAndTournament :many
-SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status
-FROM Debates
-WHERE TournamentID = $1 AND RoomID = $2 AND IsEliminationRound = $3
+const getBallotVersions = `-- name: GetBallotVersions :many
+SELECT bv.VersionID, bv.BallotID, bv.VersionNumber, bv.JudgeID, j.Name AS JudgeName,
+       bv.Snapshot, bv.CreatedBy, c.Name AS CreatedByName, bv.CreatedAt, bv.Reason, bv.RevertedFrom
+FROM BallotVersions bv
+JOIN Users j ON bv.JudgeID = j.UserID
+JOIN Users c ON bv.CreatedBy = c.UserID
+WHERE bv.BallotID = $1
+ORDER BY bv.VersionNumber
 `
 
-type GetDebatesByRoomAndTournamentParams struct {
+type GetBallotVersionsRow struct {
+	Versionid     int32           `json:"versionid"`
+	Ballotid      int32           `json:"ballotid"`
+	Versionnumber int32           `json:"versionnumber"`
+	Judgeid       int32           `json:"judgeid"`
+	Judgename     string          `json:"judgename"`
+	Snapshot      json.RawMessage `json:"snapshot"`
+	Createdby     int32           `json:"createdby"`
+	Createdbyname string          `json:"createdbyname"`
+	Createdat     time.Time       `json:"createdat"`
+	Reason        sql.NullString  `json:"reason"`
+	Revertedfrom  sql.NullInt32   `json:"revertedfrom"`
+}
+
+func (q *Queries) GetBallotVersions(ctx context.Context, ballotid int32) ([]GetBallotVersionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotVersions, ballotid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetBallotVersionsRow{}
+	for rows.Next() {
+		var i GetBallotVersionsRow
+		if err := rows.Scan(
+			&i.Versionid,
+			&i.Ballotid,
+			&i.Versionnumber,
+			&i.Judgeid,
+			&i.Judgename,
+			&i.Snapshot,
+			&i.Createdby,
+			&i.Createdbyname,
+			&i.Createdat,
+			&i.Reason,
+			&i.Revertedfrom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBallotsByDebateID = `-- name: GetBallotsByDebateID :many
+SELECT ballotid, debateid, judgeid, team1totalscore, team1feedback, team2totalscore, team2feedback, recordingstatus, verdict, last_updated_by, last_updated_at, head_judge_submitted, team3totalscore, team3feedback, team4totalscore, team4feedback FROM Ballots
+WHERE DebateID = $1
+`
+
+func (q *Queries) GetBallotsByDebateID(ctx context.Context, debateid int32) ([]Ballot, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotsByDebateID, debateid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ballot{}
+	for rows.Next() {
+		var i Ballot
+		if err := rows.Scan(
+			&i.Ballotid,
+			&i.Debateid,
+			&i.Judgeid,
+			&i.Team1totalscore,
+			&i.Team1feedback,
+			&i.Team2totalscore,
+			&i.Team2feedback,
+			&i.Recordingstatus,
+			&i.Verdict,
+			&i.LastUpdatedBy,
+			&i.LastUpdatedAt,
+			&i.HeadJudgeSubmitted,
+			&i.Team3totalscore,
+			&i.Team3feedback,
+			&i.Team4totalscore,
+			&i.Team4feedback,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBallotsByTournamentAndRound = `-- name: GetBallotsByTournamentAndRound :many
+SELECT b.BallotID, d.RoundNumber, d.IsEliminationRound, r.RoomName,
+       u.Name AS HeadJudgeName, b.RecordingStatus, b.Verdict,
+       t1.TeamID as Team1ID, t1.Name as Team1Name,
+       t2.TeamID as Team2ID, t2.Name as Team2Name,
+       d.Team3ID, t3.Name as Team3Name,
+       d.Team4ID, t4.Name as Team4Name
+FROM Ballots b
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Rooms r ON d.RoomID = r.RoomID
+JOIN Users u ON b.JudgeID = u.UserID
+JOIN Teams t1 ON d.Team1ID = t1.TeamID
+JOIN Teams t2 ON d.Team2ID = t2.TeamID
+LEFT JOIN Teams t3 ON d.Team3ID = t3.TeamID
+LEFT JOIN Teams t4 ON d.Team4ID = t4.TeamID
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+  AND (NOT $4::bool OR EXISTS (SELECT 1 FROM ReleasedDraws rd WHERE rd.DebateID = d.DebateID))
+`
+
+type GetBallotsByTournamentAndRoundParams struct {
 	Tournamentid       int32 `json:"tournamentid"`
-	Roomid             int32 `json:"roomid"`
+	Roundnumber        int32 `json:"roundnumber"`
 	Iseliminationround bool  `json:"iseliminationround"`
+	Column4            bool  `json:"column_4"`
 }
 
-func (q *Queries) GetDebatesByRoomAndTournament(ctx context.Context, arg GetDebatesByRoomAndTournamentParams) ([]Debate, error) {
-	rows, err := q.db.QueryContext(ctx, getDebatesByRoomAndTournament, arg.Tournamentid, arg.Roomid, arg.Iseliminationround)
+type GetBallotsByTournamentAndRoundRow struct {
+	Ballotid           int32          `json:"ballotid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Roomname           string         `json:"roomname"`
+	Headjudgename      string         `json:"headjudgename"`
+	Recordingstatus    string         `json:"recordingstatus"`
+	Verdict            string         `json:"verdict"`
+	Team1id            int32          `json:"team1id"`
+	Team1name          string         `json:"team1name"`
+	Team2id            int32          `json:"team2id"`
+	Team2name          string         `json:"team2name"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+}
+
+func (q *Queries) GetBallotsByTournamentAndRound(ctx context.Context, arg GetBallotsByTournamentAndRoundParams) ([]GetBallotsByTournamentAndRoundRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBallotsByTournamentAndRound,
+		arg.Tournamentid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Debate{}
+	items := []GetBallotsByTournamentAndRoundRow{}
 	for rows.Next() {
-		var i Debate
+		var i GetBallotsByTournamentAndRoundRow
 		if err := rows.Scan(
-			&i.Debateid,
-			&i.Roundid,
+			&i.Ballotid,
 			&i.Roundnumber,
 			&i.Iseliminationround,
-			&i.Tournamentid,
+			&i.Roomname,
+			&i.Headjudgename,
+			&i.Recordingstatus,
+			&i.Verdict,
 			&i.Team1id,
+			&i.Team1name,
 			&i.Team2id,
-			&i.Starttime,
-			&i.Endtime,
-			&i.Roomid,
-			&i.Status,
+			&i.Team2name,
+			&i.Team3id,
+			&i.Team3name,
+			&i.Team4id,
+			&i.Team4name,
 		); err != nil {
 			return nil, err
 		}
@@ -934,32 +1807,29 @@ func (q *Queries) GetDebatesByRoomAndTournament(ctx context.Context, arg GetDeba
 	return items, nil
 }
 
-const getDebatesByTeam = `-- name: GetDebatesByTeam :many
-SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status FROM Debates
-WHERE Team1ID = $1 OR Team2ID = $1
+const getBreakCategories = `-- name: GetBreakCategories :many
+SELECT CategoryID, TournamentID, Name, BreakSize, Priority, IsGeneral
+FROM BreakCategories
+WHERE TournamentID = $1
+ORDER BY Priority, CategoryID
 `
 
-func (q *Queries) GetDebatesByTeam(ctx context.Context, team1id int32) ([]Debate, error) {
-	rows, err := q.db.QueryContext(ctx, getDebatesByTeam, team1id)
+func (q *Queries) GetBreakCategories(ctx context.Context, tournamentid int32) ([]Breakcategory, error) {
+	rows, err := q.db.QueryContext(ctx, getBreakCategories, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Debate{}
+	items := []Breakcategory{}
 	for rows.Next() {
-		var i Debate
+		var i Breakcategory
 		if err := rows.Scan(
-			&i.Debateid,
-			&i.Roundid,
-			&i.Roundnumber,
-			&i.Iseliminationround,
+			&i.Categoryid,
 			&i.Tournamentid,
-			&i.Team1id,
-			&i.Team2id,
-			&i.Starttime,
-			&i.Endtime,
-			&i.Roomid,
-			&i.Status,
+			&i.Name,
+			&i.Breaksize,
+			&i.Priority,
+			&i.Isgeneral,
 		); err != nil {
 			return nil, err
 		}
@@ -974,70 +1844,103 @@ func (q *Queries) GetDebatesByTeam(ctx context.Context, team1id int32) ([]Debate
 	return items, nil
 }
 
-const getEliminationRoundTeams = `-- name: GetEliminationRoundTeams :many
-SELECT
-    CASE
-        WHEN b.Verdict = t1.Name THEN d.Team1ID
-        WHEN b.Verdict = t2.Name THEN d.Team2ID
-    END AS TeamID,
-    CASE
-        WHEN b.Verdict = t1.Name THEN t1.Name
-        WHEN b.Verdict = t2.Name THEN t2.Name
-    END AS TeamName,
-    d.TournamentID,
-    CASE
-        WHEN b.Verdict = t1.Name THEN b.Team1TotalScore
-        WHEN b.Verdict = t2.Name THEN b.Team2TotalScore
-    END AS TotalScore
-FROM
-    Debates d
-JOIN
-    Ballots b ON d.DebateID = b.DebateID
-JOIN
-    Teams t1 ON d.Team1ID = t1.TeamID
-JOIN
-    Teams t2 ON d.Team2ID = t2.TeamID
-WHERE
-    d.TournamentID = $1
-    AND d.RoundNumber = $2
-    AND d.IsEliminationRound = true
-    AND b.RecordingStatus = 'Recorded'
-    AND (b.Verdict = t1.Name OR b.Verdict = t2.Name)
-ORDER BY
-    CASE
-        WHEN b.Verdict = t1.Name THEN b.Team1TotalScore
-        WHEN b.Verdict = t2.Name THEN b.Team2TotalScore
-    END DESC
-LIMIT $3
+const getBreakingTeams = `-- name: GetBreakingTeams :many
+SELECT t.TeamID, t.Name AS TeamName,
+       COALESCE(bc.Name, '') AS CategoryName,
+       COALESCE(bc.Priority, 0)::integer AS CategoryPriority,
+       COALESCE(CASE WHEN n.Team1ID = t.TeamID THEN n.Team1Seed ELSE n.Team2Seed END, 0)::integer AS Seed
+FROM Debates d
+JOIN Teams t ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+LEFT JOIN EliminationBracketNodes n ON n.DebateID = d.DebateID
+LEFT JOIN BreakCategories bc ON n.CategoryID = bc.CategoryID
+WHERE d.TournamentID = $1
+  AND d.IsEliminationRound = true
+  AND d.RoundNumber = 1
+ORDER BY CategoryPriority, CategoryName, Seed, t.TeamID
+`
+
+type GetBreakingTeamsRow struct {
+	Teamid           int32  `json:"teamid"`
+	Teamname         string `json:"teamname"`
+	Categoryname     string `json:"categoryname"`
+	Categorypriority int32  `json:"categorypriority"`
+	Seed             int32  `json:"seed"`
+}
+
+// The teams of the first elimination round, with the break category and seed
+// of their bracket slot. Rounds without a bracket, as in BP, leave both empty.
+func (q *Queries) GetBreakingTeams(ctx context.Context, tournamentid int32) ([]GetBreakingTeamsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBreakingTeams, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetBreakingTeamsRow{}
+	for rows.Next() {
+		var i GetBreakingTeamsRow
+		if err := rows.Scan(
+			&i.Teamid,
+			&i.Teamname,
+			&i.Categoryname,
+			&i.Categorypriority,
+			&i.Seed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBritishParliamentaryAdvancingTeams = `-- name: GetBritishParliamentaryAdvancingTeams :many
+SELECT ts.TeamID, t.Name AS TeamName, d.TournamentID, ts.TotalScore, ts.Rank
+FROM TeamScores ts
+JOIN Debates d ON ts.DebateID = d.DebateID
+JOIN Teams t ON ts.TeamID = t.TeamID
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE d.TournamentID = $1
+  AND d.RoundNumber = $2
+  AND d.IsEliminationRound = true
+  AND b.RecordingStatus = 'Recorded'
+  AND ts.Rank IN (1, 2)
+ORDER BY d.DebateID, ts.Rank
 `
 
-type GetEliminationRoundTeamsParams struct {
+type GetBritishParliamentaryAdvancingTeamsParams struct {
 	Tournamentid int32 `json:"tournamentid"`
 	Roundnumber  int32 `json:"roundnumber"`
-	Limit        int32 `json:"limit"`
 }
 
-type GetEliminationRoundTeamsRow struct {
-	Teamid       interface{} `json:"teamid"`
-	Teamname     interface{} `json:"teamname"`
-	Tournamentid int32       `json:"tournamentid"`
-	Totalscore   interface{} `json:"totalscore"`
+type GetBritishParliamentaryAdvancingTeamsRow struct {
+	Teamid       sql.NullInt32  `json:"teamid"`
+	Teamname     string         `json:"teamname"`
+	Tournamentid int32          `json:"tournamentid"`
+	Totalscore   sql.NullString `json:"totalscore"`
+	Rank         sql.NullInt32  `json:"rank"`
 }
 
-func (q *Queries) GetEliminationRoundTeams(ctx context.Context, arg GetEliminationRoundTeamsParams) ([]GetEliminationRoundTeamsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getEliminationRoundTeams, arg.Tournamentid, arg.Roundnumber, arg.Limit)
+// The top two teams of each room of a BP elimination round advance.
+func (q *Queries) GetBritishParliamentaryAdvancingTeams(ctx context.Context, arg GetBritishParliamentaryAdvancingTeamsParams) ([]GetBritishParliamentaryAdvancingTeamsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBritishParliamentaryAdvancingTeams, arg.Tournamentid, arg.Roundnumber)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetEliminationRoundTeamsRow{}
+	items := []GetBritishParliamentaryAdvancingTeamsRow{}
 	for rows.Next() {
-		var i GetEliminationRoundTeamsRow
+		var i GetBritishParliamentaryAdvancingTeamsRow
 		if err := rows.Scan(
 			&i.Teamid,
 			&i.Teamname,
 			&i.Tournamentid,
 			&i.Totalscore,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -1052,133 +1955,186 @@ func (q *Queries) GetEliminationRoundTeams(ctx context.Context, arg GetEliminati
 	return items, nil
 }
 
-const getJudgeByID = `-- name: GetJudgeByID :one
-SELECT u.UserID, u.Name, u.Email
-FROM Users u
-WHERE u.UserID = $1
+const getBuildingsByTournament = `-- name: GetBuildingsByTournament :many
+SELECT buildingid, tournamentid, name, address FROM Buildings
+WHERE TournamentID = $1
+ORDER BY Name
 `
 
-type GetJudgeByIDRow struct {
-	Userid int32  `json:"userid"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
+func (q *Queries) GetBuildingsByTournament(ctx context.Context, tournamentid int32) ([]Building, error) {
+	rows, err := q.db.QueryContext(ctx, getBuildingsByTournament, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Building{}
+	for rows.Next() {
+		var i Building
+		if err := rows.Scan(
+			&i.Buildingid,
+			&i.Tournamentid,
+			&i.Name,
+			&i.Address,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetJudgeByID(ctx context.Context, userid int32) (GetJudgeByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getJudgeByID, userid)
-	var i GetJudgeByIDRow
-	err := row.Scan(&i.Userid, &i.Name, &i.Email)
-	return i, err
+const getByeResult = `-- name: GetByeResult :one
+SELECT tf.ByeResult
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1
+`
+
+func (q *Queries) GetByeResult(ctx context.Context, tournamentid int32) (string, error) {
+	row := q.db.QueryRowContext(ctx, getByeResult, tournamentid)
+	var byeresult string
+	err := row.Scan(&byeresult)
+	return byeresult, err
 }
 
-const getJudgeDetails = `-- name: GetJudgeDetails :one
-SELECT
-    u.UserID as JudgeID,
-    u.Name,
-    v.iDebateVolunteerID
-FROM
-    Users u
-JOIN
-    Volunteers v ON u.UserID = v.UserID
-WHERE
-    u.UserID = $1
+const getDebateByBallotID = `-- name: GetDebateByBallotID :one
+SELECT d.DebateID, d.Team1ID, d.Team2ID, d.IsEliminationRound, d.TournamentID
+FROM Debates d
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE b.BallotID = $1
 `
 
-type GetJudgeDetailsRow struct {
-	Judgeid            int32          `json:"judgeid"`
-	Name               string         `json:"name"`
-	Idebatevolunteerid sql.NullString `json:"idebatevolunteerid"`
+type GetDebateByBallotIDRow struct {
+	Debateid           int32 `json:"debateid"`
+	Team1id            int32 `json:"team1id"`
+	Team2id            int32 `json:"team2id"`
+	Iseliminationround bool  `json:"iseliminationround"`
+	Tournamentid       int32 `json:"tournamentid"`
 }
 
-func (q *Queries) GetJudgeDetails(ctx context.Context, userid int32) (GetJudgeDetailsRow, error) {
-	row := q.db.QueryRowContext(ctx, getJudgeDetails, userid)
-	var i GetJudgeDetailsRow
-	err := row.Scan(&i.Judgeid, &i.Name, &i.Idebatevolunteerid)
+func (q *Queries) GetDebateByBallotID(ctx context.Context, ballotid int32) (GetDebateByBallotIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getDebateByBallotID, ballotid)
+	var i GetDebateByBallotIDRow
+	err := row.Scan(
+		&i.Debateid,
+		&i.Team1id,
+		&i.Team2id,
+		&i.Iseliminationround,
+		&i.Tournamentid,
+	)
 	return i, err
 }
 
-const getJudgeFeedbackCount = `-- name: GetJudgeFeedbackCount :one
-SELECT COUNT(*)
-FROM JudgeFeedback
-WHERE JudgeID = $1
+const getDebateByID = `-- name: GetDebateByID :one
+SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status, team3id, team4id, panelquality FROM Debates
+WHERE DebateID = $1
 `
 
-func (q *Queries) GetJudgeFeedbackCount(ctx context.Context, judgeid sql.NullInt32) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getJudgeFeedbackCount, judgeid)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+func (q *Queries) GetDebateByID(ctx context.Context, debateid int32) (Debate, error) {
+	row := q.db.QueryRowContext(ctx, getDebateByID, debateid)
+	var i Debate
+	err := row.Scan(
+		&i.Debateid,
+		&i.Roundid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Tournamentid,
+		&i.Team1id,
+		&i.Team2id,
+		&i.Starttime,
+		&i.Endtime,
+		&i.Roomid,
+		&i.Status,
+		&i.Team3id,
+		&i.Team4id,
+		&i.Panelquality,
+	)
+	return i, err
 }
 
-const getJudgeFeedbackList = `-- name: GetJudgeFeedbackList :many
-SELECT
-    f.feedbackid, f.judgeid, f.studentid, f.debateid, f.clarityrating, f.constructivenessrating, f.timelinessrating, f.fairnessrating, f.engagementrating, f.averagerating, f.textfeedback, f.isread, f.createdat,
-    d.RoundNumber,
-    d.IsEliminationRound,
-    t.StartDate as TournamentDate,
-    b.BallotID as BallotID
-FROM JudgeFeedback f
-JOIN Debates d ON f.DebateID = d.DebateID
-JOIN Tournaments t ON d.TournamentID = t.TournamentID
-JOIN Ballots b ON d.DebateID = b.DebateID
-WHERE f.JudgeID = $1
-ORDER BY f.CreatedAt DESC
-LIMIT $2 OFFSET $3
+const getDebateByRoomAndRound = `-- name: GetDebateByRoomAndRound :one
+SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status, team3id, team4id, panelquality
+FROM Debates
+WHERE TournamentID = $1 AND RoomID = $2 AND RoundNumber = $3 AND IsEliminationRound = $4
+LIMIT 1
 `
 
-type GetJudgeFeedbackListParams struct {
-	Judgeid sql.NullInt32 `json:"judgeid"`
-	Limit   int32         `json:"limit"`
-	Offset  int32         `json:"offset"`
+type GetDebateByRoomAndRoundParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roomid             int32 `json:"roomid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
 }
 
-type GetJudgeFeedbackListRow struct {
-	Feedbackid             int32           `json:"feedbackid"`
-	Judgeid                sql.NullInt32   `json:"judgeid"`
-	Studentid              sql.NullInt32   `json:"studentid"`
-	Debateid               sql.NullInt32   `json:"debateid"`
-	Clarityrating          sql.NullFloat64 `json:"clarityrating"`
-	Constructivenessrating sql.NullFloat64 `json:"constructivenessrating"`
-	Timelinessrating       sql.NullFloat64 `json:"timelinessrating"`
-	Fairnessrating         sql.NullFloat64 `json:"fairnessrating"`
-	Engagementrating       sql.NullFloat64 `json:"engagementrating"`
-	Averagerating          sql.NullFloat64 `json:"averagerating"`
-	Textfeedback           sql.NullString  `json:"textfeedback"`
-	Isread                 sql.NullBool    `json:"isread"`
-	Createdat              sql.NullTime    `json:"createdat"`
-	Roundnumber            int32           `json:"roundnumber"`
-	Iseliminationround     bool            `json:"iseliminationround"`
-	Tournamentdate         time.Time       `json:"tournamentdate"`
-	Ballotid               int32           `json:"ballotid"`
+func (q *Queries) GetDebateByRoomAndRound(ctx context.Context, arg GetDebateByRoomAndRoundParams) (Debate, error) {
+	row := q.db.QueryRowContext(ctx, getDebateByRoomAndRound,
+		arg.Tournamentid,
+		arg.Roomid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+	)
+	var i Debate
+	err := row.Scan(
+		&i.Debateid,
+		&i.Roundid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Tournamentid,
+		&i.Team1id,
+		&i.Team2id,
+		&i.Starttime,
+		&i.Endtime,
+		&i.Roomid,
+		&i.Status,
+		&i.Team3id,
+		&i.Team4id,
+		&i.Panelquality,
+	)
+	return i, err
 }
 
-func (q *Queries) GetJudgeFeedbackList(ctx context.Context, arg GetJudgeFeedbackListParams) ([]GetJudgeFeedbackListRow, error) {
-	rows, err := q.db.QueryContext(ctx, getJudgeFeedbackList, arg.Judgeid, arg.Limit, arg.Offset)
+const getDebatesByRoomAndTournament = `-- name: GetDebatesByRoomAndTournament :many
+SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status, team3id, team4id, panelquality
+FROM Debates
+WHERE TournamentID = $1 AND RoomID = $2 AND IsEliminationRound = $3
+`
+
+type GetDebatesByRoomAndTournamentParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roomid             int32 `json:"roomid"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+func (q *Queries) GetDebatesByRoomAndTournament(ctx context.Context, arg GetDebatesByRoomAndTournamentParams) ([]Debate, error) {
+	rows, err := q.db.QueryContext(ctx, getDebatesByRoomAndTournament, arg.Tournamentid, arg.Roomid, arg.Iseliminationround)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetJudgeFeedbackListRow{}
+	items := []Debate{}
 	for rows.Next() {
-		var i GetJudgeFeedbackListRow
+		var i Debate
 		if err := rows.Scan(
-			&i.Feedbackid,
-			&i.Judgeid,
-			&i.Studentid,
 			&i.Debateid,
-			&i.Clarityrating,
-			&i.Constructivenessrating,
-			&i.Timelinessrating,
-			&i.Fairnessrating,
-			&i.Engagementrating,
-			&i.Averagerating,
-			&i.Textfeedback,
-			&i.Isread,
-			&i.Createdat,
+			&i.Roundid,
 			&i.Roundnumber,
 			&i.Iseliminationround,
-			&i.Tournamentdate,
-			&i.Ballotid,
+			&i.Tournamentid,
+			&i.Team1id,
+			&i.Team2id,
+			&i.Starttime,
+			&i.Endtime,
+			&i.Roomid,
+			&i.Status,
+			&i.Team3id,
+			&i.Team4id,
+			&i.Panelquality,
 		); err != nil {
 			return nil, err
 		}
@@ -1193,53 +2149,35 @@ func (q *Queries) GetJudgeFeedbackList(ctx context.Context, arg GetJudgeFeedback
 	return items, nil
 }
 
-const getJudgeRooms = `-- name: GetJudgeRooms :many
-SELECT
-    d.RoundNumber,
-    d.RoomID,
-    r.RoomName,
-    ja.IsHeadJudge
-FROM
-    JudgeAssignments ja
-        JOIN
-    Debates d ON ja.DebateID = d.DebateID
-        JOIN
-    Rooms r ON d.RoomID = r.RoomID
-WHERE
-    ja.JudgeID = $1 AND
-    d.TournamentID = $2 AND
-    d.IsEliminationRound = $3
-ORDER BY
-    d.RoundNumber
+const getDebatesByTeam = `-- name: GetDebatesByTeam :many
+SELECT debateid, roundid, roundnumber, iseliminationround, tournamentid, team1id, team2id, starttime, endtime, roomid, status, team3id, team4id, panelquality FROM Debates
+WHERE Team1ID = $1 OR Team2ID = $1
 `
 
-type GetJudgeRoomsParams struct {
-	Judgeid            int32 `json:"judgeid"`
-	Tournamentid       int32 `json:"tournamentid"`
-	Iseliminationround bool  `json:"iseliminationround"`
-}
-
-type GetJudgeRoomsRow struct {
-	Roundnumber int32  `json:"roundnumber"`
-	Roomid      int32  `json:"roomid"`
-	Roomname    string `json:"roomname"`
-	Isheadjudge bool   `json:"isheadjudge"`
-}
-
-func (q *Queries) GetJudgeRooms(ctx context.Context, arg GetJudgeRoomsParams) ([]GetJudgeRoomsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getJudgeRooms, arg.Judgeid, arg.Tournamentid, arg.Iseliminationround)
+func (q *Queries) GetDebatesByTeam(ctx context.Context, team1id int32) ([]Debate, error) {
+	rows, err := q.db.QueryContext(ctx, getDebatesByTeam, team1id)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetJudgeRoomsRow{}
+	items := []Debate{}
 	for rows.Next() {
-		var i GetJudgeRoomsRow
+		var i Debate
 		if err := rows.Scan(
+			&i.Debateid,
+			&i.Roundid,
 			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Tournamentid,
+			&i.Team1id,
+			&i.Team2id,
+			&i.Starttime,
+			&i.Endtime,
 			&i.Roomid,
-			&i.Roomname,
-			&i.Isheadjudge,
+			&i.Status,
+			&i.Team3id,
+			&i.Team4id,
+			&i.Panelquality,
 		); err != nil {
 			return nil, err
 		}
@@ -1254,40 +2192,74 @@ func (q *Queries) GetJudgeRooms(ctx context.Context, arg GetJudgeRoomsParams) ([
 	return items, nil
 }
 
-const getJudgesByTournamentAndRound = `-- name: GetJudgesByTournamentAndRound :many
-SELECT u.UserID, u.Name, u.Email, ja.IsHeadJudge
-FROM Users u
-JOIN JudgeAssignments ja ON u.UserID = ja.JudgeID
-WHERE ja.TournamentID = $1 AND ja.RoundNumber = $2 AND ja.IsElimination = $3
+const getEliminationBracket = `-- name: GetEliminationBracket :many
+SELECT n.NodeID, n.RoundNumber, n.Position, n.ParentNodeID, n.ParentSlot,
+       n.Team1ID, t1.Name AS Team1Name, n.Team1Seed,
+       n.Team2ID, t2.Name AS Team2Name, n.Team2Seed,
+       n.DebateID, n.WinnerTeamID, d.RoomID, r.RoomName,
+       COALESCE(n.CategoryID, 0)::int AS CategoryID
+FROM EliminationBracketNodes n
+         LEFT JOIN Teams t1 ON n.Team1ID = t1.TeamID
+         LEFT JOIN Teams t2 ON n.Team2ID = t2.TeamID
+         LEFT JOIN Debates d ON n.DebateID = d.DebateID
+         LEFT JOIN Rooms r ON d.RoomID = r.RoomID
+WHERE n.TournamentID = $1
+  AND ($2::int = 0 OR n.CategoryID = $2::int)
+ORDER BY COALESCE(n.CategoryID, 0), n.RoundNumber, n.Position
 `
 
-type GetJudgesByTournamentAndRoundParams struct {
-	Tournamentid  int32 `json:"tournamentid"`
-	Roundnumber   int32 `json:"roundnumber"`
-	Iselimination bool  `json:"iselimination"`
-}
-
-type GetJudgesByTournamentAndRoundRow struct {
-	Userid      int32  `json:"userid"`
-	Name        string `json:"name"`
-	Email       string `json:"email"`
-	Isheadjudge bool   `json:"isheadjudge"`
-}
-
-func (q *Queries) GetJudgesByTournamentAndRound(ctx context.Context, arg GetJudgesByTournamentAndRoundParams) ([]GetJudgesByTournamentAndRoundRow, error) {
-	rows, err := q.db.QueryContext(ctx, getJudgesByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iselimination)
+type GetEliminationBracketParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Column2      int32 `json:"column_2"`
+}
+
+type GetEliminationBracketRow struct {
+	Nodeid       int32          `json:"nodeid"`
+	Roundnumber  int32          `json:"roundnumber"`
+	Position     int32          `json:"position"`
+	Parentnodeid sql.NullInt32  `json:"parentnodeid"`
+	Parentslot   sql.NullInt32  `json:"parentslot"`
+	Team1id      sql.NullInt32  `json:"team1id"`
+	Team1name    sql.NullString `json:"team1name"`
+	Team1seed    sql.NullInt32  `json:"team1seed"`
+	Team2id      sql.NullInt32  `json:"team2id"`
+	Team2name    sql.NullString `json:"team2name"`
+	Team2seed    sql.NullInt32  `json:"team2seed"`
+	Debateid     sql.NullInt32  `json:"debateid"`
+	Winnerteamid sql.NullInt32  `json:"winnerteamid"`
+	Roomid       sql.NullInt32  `json:"roomid"`
+	Roomname     sql.NullString `json:"roomname"`
+	Categoryid   int32          `json:"categoryid"`
+}
+
+// A zero category returns every bracket of the tournament: the one bracket of
+// a tournament without break categories, or one per category.
+func (q *Queries) GetEliminationBracket(ctx context.Context, arg GetEliminationBracketParams) ([]GetEliminationBracketRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEliminationBracket, arg.Tournamentid, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetJudgesByTournamentAndRoundRow{}
+	items := []GetEliminationBracketRow{}
 	for rows.Next() {
-		var i GetJudgesByTournamentAndRoundRow
+		var i GetEliminationBracketRow
 		if err := rows.Scan(
-			&i.Userid,
-			&i.Name,
-			&i.Email,
-			&i.Isheadjudge,
+			&i.Nodeid,
+			&i.Roundnumber,
+			&i.Position,
+			&i.Parentnodeid,
+			&i.Parentslot,
+			&i.Team1id,
+			&i.Team1name,
+			&i.Team1seed,
+			&i.Team2id,
+			&i.Team2name,
+			&i.Team2seed,
+			&i.Debateid,
+			&i.Winnerteamid,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Categoryid,
 		); err != nil {
 			return nil, err
 		}
@@ -1302,95 +2274,82 @@ func (q *Queries) GetJudgesByTournamentAndRound(ctx context.Context, arg GetJudg
 	return items, nil
 }
 
-const getJudgesForDebate = `-- name: GetJudgesForDebate :many
-SELECT ja.JudgeID, u.Name
-FROM JudgeAssignments ja
-JOIN Users u ON ja.JudgeID = u.UserID
-WHERE ja.DebateID = $1
+const getEliminationBracketNodeByDebate = `-- name: GetEliminationBracketNodeByDebate :one
+SELECT n.NodeID, n.ParentNodeID, n.ParentSlot,
+       n.Team1ID, n.Team1Seed, n.Team2ID, n.Team2Seed,
+       n.WinnerTeamID, p.DebateID AS ParentDebateID
+FROM EliminationBracketNodes n
+         LEFT JOIN EliminationBracketNodes p ON n.ParentNodeID = p.NodeID
+WHERE n.DebateID = $1
 `
 
-type GetJudgesForDebateRow struct {
-	Judgeid int32  `json:"judgeid"`
-	Name    string `json:"name"`
+type GetEliminationBracketNodeByDebateRow struct {
+	Nodeid         int32         `json:"nodeid"`
+	Parentnodeid   sql.NullInt32 `json:"parentnodeid"`
+	Parentslot     sql.NullInt32 `json:"parentslot"`
+	Team1id        sql.NullInt32 `json:"team1id"`
+	Team1seed      sql.NullInt32 `json:"team1seed"`
+	Team2id        sql.NullInt32 `json:"team2id"`
+	Team2seed      sql.NullInt32 `json:"team2seed"`
+	Winnerteamid   sql.NullInt32 `json:"winnerteamid"`
+	Parentdebateid sql.NullInt32 `json:"parentdebateid"`
+}
+
+func (q *Queries) GetEliminationBracketNodeByDebate(ctx context.Context, debateid sql.NullInt32) (GetEliminationBracketNodeByDebateRow, error) {
+	row := q.db.QueryRowContext(ctx, getEliminationBracketNodeByDebate, debateid)
+	var i GetEliminationBracketNodeByDebateRow
+	err := row.Scan(
+		&i.Nodeid,
+		&i.Parentnodeid,
+		&i.Parentslot,
+		&i.Team1id,
+		&i.Team1seed,
+		&i.Team2id,
+		&i.Team2seed,
+		&i.Winnerteamid,
+		&i.Parentdebateid,
+	)
+	return i, err
 }
 
-func (q *Queries) GetJudgesForDebate(ctx context.Context, debateid int32) ([]GetJudgesForDebateRow, error) {
-	rows, err := q.db.QueryContext(ctx, getJudgesForDebate, debateid)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []GetJudgesForDebateRow{}
-	for rows.Next() {
-		var i GetJudgesForDebateRow
-		if err := rows.Scan(&i.Judgeid, &i.Name); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getJudgesForTournament = `-- name: GetJudgesForTournament :many
-SELECT
-    u.UserID as JudgeID,
-    u.Name,
-    v.iDebateVolunteerID,
-    COALESCE(r.RoomName, 'Unassigned') AS RoomName,
-    COALESCE(ja.IsHeadJudge, false) AS IsHeadJudge,
-    CASE WHEN ja.JudgeID IS NULL THEN false ELSE true END AS IsAssigned
-FROM
-    Users u
-        JOIN
-    Volunteers v ON u.UserID = v.UserID
-        JOIN
-    TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
-        LEFT JOIN
-    JudgeAssignments ja ON u.UserID = ja.JudgeID AND ja.TournamentID = $1
-        LEFT JOIN
-    Debates d ON ja.DebateID = d.DebateID
-        LEFT JOIN
-    Rooms r ON d.RoomID = r.RoomID
-WHERE
-    ti.TournamentID = $1
-  AND ti.Status = 'accepted'
-  AND ti.InviteeRole = 'volunteer'
-ORDER BY
-    IsAssigned DESC, u.Name
+const getEliminationBracketResults = `-- name: GetEliminationBracketResults :many
+SELECT n.NodeID,
+       CASE
+           WHEN b.Verdict = t1.Name THEN d.Team1ID
+           WHEN b.Verdict = t2.Name THEN d.Team2ID
+       END::int AS WinnerTeamID
+FROM EliminationBracketNodes n
+         JOIN Debates d ON n.DebateID = d.DebateID
+         JOIN Ballots b ON d.DebateID = b.DebateID
+         JOIN Teams t1 ON d.Team1ID = t1.TeamID
+         JOIN Teams t2 ON d.Team2ID = t2.TeamID
+WHERE n.TournamentID = $1
+  AND COALESCE(n.CategoryID, 0) = $2::int
+  AND n.WinnerTeamID IS NULL
+  AND b.RecordingStatus = 'Recorded'
+  AND (b.Verdict = t1.Name OR b.Verdict = t2.Name)
 `
 
-type GetJudgesForTournamentRow struct {
-	Judgeid            int32          `json:"judgeid"`
-	Name               string         `json:"name"`
-	Idebatevolunteerid sql.NullString `json:"idebatevolunteerid"`
-	Roomname           string         `json:"roomname"`
-	Isheadjudge        bool           `json:"isheadjudge"`
-	Isassigned         bool           `json:"isassigned"`
+type GetEliminationBracketResultsParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Column2      int32 `json:"column_2"`
 }
 
-func (q *Queries) GetJudgesForTournament(ctx context.Context, tournamentid int32) ([]GetJudgesForTournamentRow, error) {
-	rows, err := q.db.QueryContext(ctx, getJudgesForTournament, tournamentid)
+type GetEliminationBracketResultsRow struct {
+	Nodeid       int32 `json:"nodeid"`
+	Winnerteamid int32 `json:"winnerteamid"`
+}
+
+func (q *Queries) GetEliminationBracketResults(ctx context.Context, arg GetEliminationBracketResultsParams) ([]GetEliminationBracketResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEliminationBracketResults, arg.Tournamentid, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetJudgesForTournamentRow{}
+	items := []GetEliminationBracketResultsRow{}
 	for rows.Next() {
-		var i GetJudgesForTournamentRow
-		if err := rows.Scan(
-			&i.Judgeid,
-			&i.Name,
-			&i.Idebatevolunteerid,
-			&i.Roomname,
-			&i.Isheadjudge,
-			&i.Isassigned,
-		); err != nil {
+		var i GetEliminationBracketResultsRow
+		if err := rows.Scan(&i.Nodeid, &i.Winnerteamid); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1404,76 +2363,34 @@ func (q *Queries) GetJudgesForTournament(ctx context.Context, tournamentid int32
 	return items, nil
 }
 
-const getOverallSchoolRanking = `-- name: GetOverallSchoolRanking :many
-WITH school_ranking AS (
-    SELECT
-        s.SchoolID,
-        s.SchoolName,
-        CAST(COALESCE(SUM(ts.TotalScore), 0) AS DECIMAL(10,2)) AS TotalPoints,
-        COALESCE(AVG(ts.Rank), 0) AS AverageRank,
-        COUNT(DISTINCT tour.TournamentID) as TournamentsParticipated,
-        RANK() OVER (ORDER BY COALESCE(SUM(ts.TotalScore), 0) DESC, COALESCE(AVG(ts.Rank), 0) ASC) as CurrentRank,
-        COUNT(*) OVER () as TotalSchools,
-        MAX(tour.StartDate) as LastTournamentDate
-    FROM
-        Schools s
-        LEFT JOIN Students stu ON s.SchoolID = stu.SchoolID
-        LEFT JOIN TeamMembers tm ON stu.StudentID = tm.StudentID
-        LEFT JOIN Teams te ON tm.TeamID = te.TeamID
-        LEFT JOIN Debates d ON (te.TeamID = d.Team1ID OR te.TeamID = d.Team2ID)
-        LEFT JOIN TeamScores ts ON te.TeamID = ts.TeamID AND d.DebateID = ts.DebateID
-        LEFT JOIN Tournaments tour ON d.TournamentID = tour.TournamentID
-        LEFT JOIN Leagues l ON tour.LeagueID = l.LeagueID
-    WHERE
-        s.SchoolID = $1 OR s.SchoolID IN (
-            SELECT s2.SchoolID
-            FROM Schools s2
-            LEFT JOIN Students stu2 ON s2.SchoolID = stu2.SchoolID
-            LEFT JOIN TeamMembers tm2 ON stu2.StudentID = tm2.StudentID
-            LEFT JOIN Teams te2 ON tm2.TeamID = te2.TeamID
-            LEFT JOIN Debates d2 ON (te2.TeamID = d2.Team1ID OR te2.TeamID = d2.Team2ID)
-            LEFT JOIN TeamScores ts2 ON te2.TeamID = ts2.TeamID AND d2.DebateID = ts2.DebateID
-            GROUP BY s2.SchoolID
-            ORDER BY COALESCE(SUM(ts2.TotalScore), 0) DESC
-            LIMIT 3
-        )
-    GROUP BY
-        s.SchoolID, s.SchoolName
-)
-SELECT schoolid, schoolname, totalpoints, averagerank, tournamentsparticipated, currentrank, totalschools, lasttournamentdate
-FROM school_ranking
-ORDER BY CurrentRank
+const getJudgeBallots = `-- name: GetJudgeBallots :many
+SELECT judgeballotid, ballotid, judgeid, team1totalscore, team1feedback, team2totalscore, team2feedback, team3totalscore, team3feedback, team4totalscore, team4feedback, verdict, submittedat FROM JudgeBallots
+WHERE BallotID = $1
 `
 
-type GetOverallSchoolRankingRow struct {
-	Schoolid                int32       `json:"schoolid"`
-	Schoolname              string      `json:"schoolname"`
-	Totalpoints             string      `json:"totalpoints"`
-	Averagerank             interface{} `json:"averagerank"`
-	Tournamentsparticipated int64       `json:"tournamentsparticipated"`
-	Currentrank             int64       `json:"currentrank"`
-	Totalschools            int64       `json:"totalschools"`
-	Lasttournamentdate      interface{} `json:"lasttournamentdate"`
-}
-
-func (q *Queries) GetOverallSchoolRanking(ctx context.Context, schoolid int32) ([]GetOverallSchoolRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getOverallSchoolRanking, schoolid)
+func (q *Queries) GetJudgeBallots(ctx context.Context, ballotid int32) ([]Judgeballot, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeBallots, ballotid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetOverallSchoolRankingRow{}
+	items := []Judgeballot{}
 	for rows.Next() {
-		var i GetOverallSchoolRankingRow
+		var i Judgeballot
 		if err := rows.Scan(
-			&i.Schoolid,
-			&i.Schoolname,
-			&i.Totalpoints,
-			&i.Averagerank,
-			&i.Tournamentsparticipated,
-			&i.Currentrank,
-			&i.Totalschools,
-			&i.Lasttournamentdate,
+			&i.Judgeballotid,
+			&i.Ballotid,
+			&i.Judgeid,
+			&i.Team1totalscore,
+			&i.Team1feedback,
+			&i.Team2totalscore,
+			&i.Team2feedback,
+			&i.Team3totalscore,
+			&i.Team3feedback,
+			&i.Team4totalscore,
+			&i.Team4feedback,
+			&i.Verdict,
+			&i.Submittedat,
 		); err != nil {
 			return nil, err
 		}
@@ -1488,62 +2405,81 @@ func (q *Queries) GetOverallSchoolRanking(ctx context.Context, schoolid int32) (
 	return items, nil
 }
 
-const getOverallStudentRanking = `-- name: GetOverallStudentRanking :many
-WITH student_ranking AS (
-    SELECT
-        s.StudentID,
-        s.FirstName || ' ' || s.LastName AS StudentName,
-        CAST(SUM(ss.SpeakerPoints) AS DECIMAL(10,2)) AS TotalPoints,
-        AVG(ss.SpeakerRank) AS AverageRank,
-        COUNT(DISTINCT d.TournamentID) AS TournamentsParticipated,
-        RANK() OVER (ORDER BY SUM(ss.SpeakerPoints) DESC, AVG(ss.SpeakerRank) ASC) AS CurrentRank,
-        COUNT(*) OVER () AS TotalStudents,
-        MAX(t.StartDate) AS LastTournamentDate
-    FROM
-        Students s
-    JOIN TeamMembers tm ON s.StudentID = tm.StudentID
-    JOIN Teams te ON tm.TeamID = te.TeamID
-    JOIN Debates d ON (te.TeamID = d.Team1ID OR te.TeamID = d.Team2ID)
-    JOIN Ballots b ON d.DebateID = b.DebateID
-    JOIN SpeakerScores ss ON s.StudentID = ss.SpeakerID AND b.BallotID = ss.BallotID
-    JOIN Tournaments t ON d.TournamentID = t.TournamentID
-    GROUP BY
-        s.StudentID, s.FirstName, s.LastName
-)
-SELECT studentid, studentname, totalpoints, averagerank, tournamentsparticipated, currentrank, totalstudents, lasttournamentdate
-FROM student_ranking
-ORDER BY CurrentRank
+const getJudgeByID = `-- name: GetJudgeByID :one
+SELECT u.UserID, u.Name, u.Email
+FROM Users u
+WHERE u.UserID = $1
 `
 
-type GetOverallStudentRankingRow struct {
-	Studentid               int32       `json:"studentid"`
-	Studentname             interface{} `json:"studentname"`
-	Totalpoints             string      `json:"totalpoints"`
-	Averagerank             float64     `json:"averagerank"`
-	Tournamentsparticipated int64       `json:"tournamentsparticipated"`
-	Currentrank             int64       `json:"currentrank"`
-	Totalstudents           int64       `json:"totalstudents"`
-	Lasttournamentdate      interface{} `json:"lasttournamentdate"`
+type GetJudgeByIDRow struct {
+	Userid int32  `json:"userid"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
 }
 
-func (q *Queries) GetOverallStudentRanking(ctx context.Context) ([]GetOverallStudentRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getOverallStudentRanking)
+func (q *Queries) GetJudgeByID(ctx context.Context, userid int32) (GetJudgeByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getJudgeByID, userid)
+	var i GetJudgeByIDRow
+	err := row.Scan(&i.Userid, &i.Name, &i.Email)
+	return i, err
+}
+
+const getJudgeConflicts = `-- name: GetJudgeConflicts :many
+SELECT
+    jc.ConflictID,
+    jc.ConflictType,
+    jc.SchoolID,
+    s.SchoolName,
+    jc.TeamID,
+    t.Name AS TeamName,
+    jc.StudentID,
+    COALESCE(st.FirstName || ' ' || st.LastName, '')::text AS StudentName,
+    jc.Reason
+FROM
+    JudgeConflicts jc
+LEFT JOIN
+    Schools s ON jc.SchoolID = s.SchoolID
+LEFT JOIN
+    Teams t ON jc.TeamID = t.TeamID
+LEFT JOIN
+    Students st ON jc.StudentID = st.StudentID
+WHERE
+    jc.JudgeID = $1
+ORDER BY
+    jc.ConflictID
+`
+
+type GetJudgeConflictsRow struct {
+	Conflictid   int32          `json:"conflictid"`
+	Conflicttype string         `json:"conflicttype"`
+	Schoolid     sql.NullInt32  `json:"schoolid"`
+	Schoolname   sql.NullString `json:"schoolname"`
+	Teamid       sql.NullInt32  `json:"teamid"`
+	Teamname     sql.NullString `json:"teamname"`
+	Studentid    sql.NullInt32  `json:"studentid"`
+	Studentname  string         `json:"studentname"`
+	Reason       sql.NullString `json:"reason"`
+}
+
+func (q *Queries) GetJudgeConflicts(ctx context.Context, judgeid int32) ([]GetJudgeConflictsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeConflicts, judgeid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetOverallStudentRankingRow{}
+	items := []GetJudgeConflictsRow{}
 	for rows.Next() {
-		var i GetOverallStudentRankingRow
+		var i GetJudgeConflictsRow
 		if err := rows.Scan(
+			&i.Conflictid,
+			&i.Conflicttype,
+			&i.Schoolid,
+			&i.Schoolname,
+			&i.Teamid,
+			&i.Teamname,
 			&i.Studentid,
 			&i.Studentname,
-			&i.Totalpoints,
-			&i.Averagerank,
-			&i.Tournamentsparticipated,
-			&i.Currentrank,
-			&i.Totalstudents,
-			&i.Lasttournamentdate,
+			&i.Reason,
 		); err != nil {
 			return nil, err
 		}
@@ -1558,57 +2494,41 @@ func (q *Queries) GetOverallStudentRanking(ctx context.Context) ([]GetOverallStu
 	return items, nil
 }
 
-const getOverallVolunteerRanking = `-- name: GetOverallVolunteerRanking :many
-WITH volunteer_ranking AS (
-    SELECT
-        v.VolunteerID,
-        v.FirstName || ' ' || v.LastName AS VolunteerName,
-        AVG(jf.AverageRating) as AverageRating,
-        COUNT(DISTINCT d.TournamentID) as TournamentsJudged,
-        RANK() OVER (ORDER BY AVG(jf.AverageRating) DESC) as CurrentRank,
-        COUNT(*) OVER () as TotalVolunteers,
-        MAX(t.StartDate) as LastTournamentDate
-    FROM
-        Volunteers v
-        LEFT JOIN JudgeAssignments ja ON v.UserID = ja.JudgeID
-        LEFT JOIN Debates d ON ja.DebateID = d.DebateID
-        LEFT JOIN JudgeFeedback jf ON v.UserID = jf.JudgeID
-        LEFT JOIN Tournaments t ON d.TournamentID = t.TournamentID
-    GROUP BY
-        v.VolunteerID, v.FirstName, v.LastName
-)
-SELECT volunteerid, volunteername, averagerating, tournamentsjudged, currentrank, totalvolunteers, lasttournamentdate
-FROM volunteer_ranking
-ORDER BY CurrentRank
+const getJudgeConflictsByTournament = `-- name: GetJudgeConflictsByTournament :many
+SELECT DISTINCT jc.ConflictID, jc.JudgeID, jc.ConflictType, jc.SchoolID, jc.TeamID, jc.StudentID
+FROM JudgeConflicts jc
+         JOIN Volunteers v ON jc.JudgeID = v.UserID
+         JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
 `
 
-type GetOverallVolunteerRankingRow struct {
-	Volunteerid        int32       `json:"volunteerid"`
-	Volunteername      interface{} `json:"volunteername"`
-	Averagerating      float64     `json:"averagerating"`
-	Tournamentsjudged  int64       `json:"tournamentsjudged"`
-	Currentrank        int64       `json:"currentrank"`
-	Totalvolunteers    int64       `json:"totalvolunteers"`
-	Lasttournamentdate interface{} `json:"lasttournamentdate"`
+type GetJudgeConflictsByTournamentRow struct {
+	Conflictid   int32         `json:"conflictid"`
+	Judgeid      int32         `json:"judgeid"`
+	Conflicttype string        `json:"conflicttype"`
+	Schoolid     sql.NullInt32 `json:"schoolid"`
+	Teamid       sql.NullInt32 `json:"teamid"`
+	Studentid    sql.NullInt32 `json:"studentid"`
 }
 
-func (q *Queries) GetOverallVolunteerRanking(ctx context.Context) ([]GetOverallVolunteerRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getOverallVolunteerRanking)
+func (q *Queries) GetJudgeConflictsByTournament(ctx context.Context, tournamentid int32) ([]GetJudgeConflictsByTournamentRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeConflictsByTournament, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetOverallVolunteerRankingRow{}
+	items := []GetJudgeConflictsByTournamentRow{}
 	for rows.Next() {
-		var i GetOverallVolunteerRankingRow
+		var i GetJudgeConflictsByTournamentRow
 		if err := rows.Scan(
-			&i.Volunteerid,
-			&i.Volunteername,
-			&i.Averagerating,
-			&i.Tournamentsjudged,
-			&i.Currentrank,
-			&i.Totalvolunteers,
-			&i.Lasttournamentdate,
+			&i.Conflictid,
+			&i.Judgeid,
+			&i.Conflicttype,
+			&i.Schoolid,
+			&i.Teamid,
+			&i.Studentid,
 		); err != nil {
 			return nil, err
 		}
@@ -1623,8 +2543,1054 @@ func (q *Queries) GetOverallVolunteerRanking(ctx context.Context) ([]GetOverallV
 	return items, nil
 }
 
-const getPairingByID = `-- name: GetPairingByID :one
-SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
+const getJudgeDetails = `-- name: GetJudgeDetails :one
+SELECT
+    u.UserID as JudgeID,
+    u.Name,
+    v.iDebateVolunteerID,
+    v.MaxTimesJudgingTeam
+FROM
+    Users u
+JOIN
+    Volunteers v ON u.UserID = v.UserID
+WHERE
+    u.UserID = $1
+`
+
+type GetJudgeDetailsRow struct {
+	Judgeid             int32          `json:"judgeid"`
+	Name                string         `json:"name"`
+	Idebatevolunteerid  sql.NullString `json:"idebatevolunteerid"`
+	Maxtimesjudgingteam sql.NullInt32  `json:"maxtimesjudgingteam"`
+}
+
+func (q *Queries) GetJudgeDetails(ctx context.Context, userid int32) (GetJudgeDetailsRow, error) {
+	row := q.db.QueryRowContext(ctx, getJudgeDetails, userid)
+	var i GetJudgeDetailsRow
+	err := row.Scan(
+		&i.Judgeid,
+		&i.Name,
+		&i.Idebatevolunteerid,
+		&i.Maxtimesjudgingteam,
+	)
+	return i, err
+}
+
+const getJudgeFeedbackCount = `-- name: GetJudgeFeedbackCount :one
+SELECT COUNT(*)
+FROM JudgeFeedback
+WHERE JudgeID = $1
+`
+
+func (q *Queries) GetJudgeFeedbackCount(ctx context.Context, judgeid sql.NullInt32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getJudgeFeedbackCount, judgeid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getJudgeFeedbackList = `-- name: GetJudgeFeedbackList :many
+SELECT
+    f.feedbackid, f.judgeid, f.studentid, f.debateid, f.clarityrating, f.constructivenessrating, f.timelinessrating, f.fairnessrating, f.engagementrating, f.averagerating, f.textfeedback, f.isread, f.createdat,
+    d.RoundNumber,
+    d.IsEliminationRound,
+    t.StartDate as TournamentDate,
+    b.BallotID as BallotID
+FROM JudgeFeedback f
+JOIN Debates d ON f.DebateID = d.DebateID
+JOIN Tournaments t ON d.TournamentID = t.TournamentID
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE f.JudgeID = $1
+ORDER BY f.CreatedAt DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetJudgeFeedbackListParams struct {
+	Judgeid sql.NullInt32 `json:"judgeid"`
+	Limit   int32         `json:"limit"`
+	Offset  int32         `json:"offset"`
+}
+
+type GetJudgeFeedbackListRow struct {
+	Feedbackid             int32           `json:"feedbackid"`
+	Judgeid                sql.NullInt32   `json:"judgeid"`
+	Studentid              sql.NullInt32   `json:"studentid"`
+	Debateid               sql.NullInt32   `json:"debateid"`
+	Clarityrating          sql.NullFloat64 `json:"clarityrating"`
+	Constructivenessrating sql.NullFloat64 `json:"constructivenessrating"`
+	Timelinessrating       sql.NullFloat64 `json:"timelinessrating"`
+	Fairnessrating         sql.NullFloat64 `json:"fairnessrating"`
+	Engagementrating       sql.NullFloat64 `json:"engagementrating"`
+	Averagerating          sql.NullFloat64 `json:"averagerating"`
+	Textfeedback           sql.NullString  `json:"textfeedback"`
+	Isread                 sql.NullBool    `json:"isread"`
+	Createdat              sql.NullTime    `json:"createdat"`
+	Roundnumber            int32           `json:"roundnumber"`
+	Iseliminationround     bool            `json:"iseliminationround"`
+	Tournamentdate         time.Time       `json:"tournamentdate"`
+	Ballotid               int32           `json:"ballotid"`
+}
+
+func (q *Queries) GetJudgeFeedbackList(ctx context.Context, arg GetJudgeFeedbackListParams) ([]GetJudgeFeedbackListRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeFeedbackList, arg.Judgeid, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeFeedbackListRow{}
+	for rows.Next() {
+		var i GetJudgeFeedbackListRow
+		if err := rows.Scan(
+			&i.Feedbackid,
+			&i.Judgeid,
+			&i.Studentid,
+			&i.Debateid,
+			&i.Clarityrating,
+			&i.Constructivenessrating,
+			&i.Timelinessrating,
+			&i.Fairnessrating,
+			&i.Engagementrating,
+			&i.Averagerating,
+			&i.Textfeedback,
+			&i.Isread,
+			&i.Createdat,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Tournamentdate,
+			&i.Ballotid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgeRooms = `-- name: GetJudgeRooms :many
+SELECT
+    d.RoundNumber,
+    d.RoomID,
+    r.RoomName,
+    ja.IsHeadJudge
+FROM
+    JudgeAssignments ja
+        JOIN
+    Debates d ON ja.DebateID = d.DebateID
+        JOIN
+    Rooms r ON d.RoomID = r.RoomID
+WHERE
+    ja.JudgeID = $1 AND
+    d.TournamentID = $2 AND
+    d.IsEliminationRound = $3
+ORDER BY
+    d.RoundNumber
+`
+
+type GetJudgeRoomsParams struct {
+	Judgeid            int32 `json:"judgeid"`
+	Tournamentid       int32 `json:"tournamentid"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+type GetJudgeRoomsRow struct {
+	Roundnumber int32  `json:"roundnumber"`
+	Roomid      int32  `json:"roomid"`
+	Roomname    string `json:"roomname"`
+	Isheadjudge bool   `json:"isheadjudge"`
+}
+
+func (q *Queries) GetJudgeRooms(ctx context.Context, arg GetJudgeRoomsParams) ([]GetJudgeRoomsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeRooms, arg.Judgeid, arg.Tournamentid, arg.Iseliminationround)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeRoomsRow{}
+	for rows.Next() {
+		var i GetJudgeRoomsRow
+		if err := rows.Scan(
+			&i.Roundnumber,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Isheadjudge,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgeSpeakerScores = `-- name: GetJudgeSpeakerScores :many
+SELECT jss.ScoreID, jss.SpeakerID, s.FirstName, s.LastName,
+       jss.SpeakerRank, jss.SpeakerPoints, jss.Feedback,
+       t.TeamID, t.Name AS TeamName, jss.ReplyPoints, jss.Position,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = jss.TeamID AND tm.StudentID = jss.SpeakerID) AS IsSubstitute
+FROM JudgeSpeakerScores jss
+         JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+         JOIN Students s ON jss.SpeakerID = s.StudentID
+         JOIN Teams t ON jss.TeamID = t.TeamID
+WHERE jb.BallotID = $1 AND jb.JudgeID = $2
+ORDER BY t.TeamID, jss.SpeakerRank
+`
+
+type GetJudgeSpeakerScoresParams struct {
+	Ballotid int32 `json:"ballotid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+type GetJudgeSpeakerScoresRow struct {
+	Scoreid       int32          `json:"scoreid"`
+	Speakerid     int32          `json:"speakerid"`
+	Firstname     string         `json:"firstname"`
+	Lastname      string         `json:"lastname"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Teamid        int32          `json:"teamid"`
+	Teamname      string         `json:"teamname"`
+	Replypoints   string         `json:"replypoints"`
+	Position      int32          `json:"position"`
+	Issubstitute  bool           `json:"issubstitute"`
+}
+
+func (q *Queries) GetJudgeSpeakerScores(ctx context.Context, arg GetJudgeSpeakerScoresParams) ([]GetJudgeSpeakerScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeSpeakerScores, arg.Ballotid, arg.Judgeid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeSpeakerScoresRow{}
+	for rows.Next() {
+		var i GetJudgeSpeakerScoresRow
+		if err := rows.Scan(
+			&i.Scoreid,
+			&i.Speakerid,
+			&i.Firstname,
+			&i.Lastname,
+			&i.Speakerrank,
+			&i.Speakerpoints,
+			&i.Feedback,
+			&i.Teamid,
+			&i.Teamname,
+			&i.Replypoints,
+			&i.Position,
+			&i.Issubstitute,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgeSpeechesByTournament = `-- name: GetJudgeSpeechesByTournament :many
+SELECT jb.JudgeBallotID, jb.JudgeID, u.Name AS JudgeName,
+       d.DebateID, d.RoundNumber, d.IsEliminationRound,
+       jss.TeamID, jss.Position,
+       CAST(jss.SpeakerPoints AS TEXT) AS SpeakerPoints
+FROM JudgeSpeakerScores jss
+JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+JOIN Ballots b ON jb.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Users u ON jb.JudgeID = u.UserID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY d.DebateID, jb.JudgeBallotID, jss.TeamID, jss.Position
+`
+
+type GetJudgeSpeechesByTournamentRow struct {
+	Judgeballotid      int32  `json:"judgeballotid"`
+	Judgeid            int32  `json:"judgeid"`
+	Judgename          string `json:"judgename"`
+	Debateid           int32  `json:"debateid"`
+	Roundnumber        int32  `json:"roundnumber"`
+	Iseliminationround bool   `json:"iseliminationround"`
+	Teamid             int32  `json:"teamid"`
+	Position           int32  `json:"position"`
+	Speakerpoints      string `json:"speakerpoints"`
+}
+
+// The points each judge gave each speech of the tournament's recorded debates.
+func (q *Queries) GetJudgeSpeechesByTournament(ctx context.Context, tournamentid int32) ([]GetJudgeSpeechesByTournamentRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeSpeechesByTournament, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeSpeechesByTournamentRow{}
+	for rows.Next() {
+		var i GetJudgeSpeechesByTournamentRow
+		if err := rows.Scan(
+			&i.Judgeballotid,
+			&i.Judgeid,
+			&i.Judgename,
+			&i.Debateid,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Teamid,
+			&i.Position,
+			&i.Speakerpoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgeStrengths = `-- name: GetJudgeStrengths :many
+SELECT DISTINCT
+    u.UserID AS JudgeID,
+    COALESCE((SELECT AVG(jf.AverageRating) FROM JudgeFeedback jf WHERE jf.JudgeID = u.UserID), 0)::float8 AS AverageRating,
+    (SELECT COUNT(jf.AverageRating) FROM JudgeFeedback jf WHERE jf.JudgeID = u.UserID) AS RatingCount,
+    (SELECT COUNT(*) FROM JudgeAssignments ja WHERE ja.JudgeID = u.UserID AND ja.IsElimination = true) AS EliminationDebates
+FROM Users u
+         JOIN Volunteers v ON u.UserID = v.UserID
+         JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
+`
+
+type GetJudgeStrengthsRow struct {
+	Judgeid            int32   `json:"judgeid"`
+	Averagerating      float64 `json:"averagerating"`
+	Ratingcount        int64   `json:"ratingcount"`
+	Eliminationdebates int64   `json:"eliminationdebates"`
+}
+
+func (q *Queries) GetJudgeStrengths(ctx context.Context, tournamentid int32) ([]GetJudgeStrengthsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeStrengths, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeStrengthsRow{}
+	for rows.Next() {
+		var i GetJudgeStrengthsRow
+		if err := rows.Scan(
+			&i.Judgeid,
+			&i.Averagerating,
+			&i.Ratingcount,
+			&i.Eliminationdebates,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgedTeamCounts = `-- name: GetJudgedTeamCounts :many
+SELECT judged.JudgeID, judged.TeamID, COUNT(*) AS TimesJudged
+FROM (
+    SELECT ja.JudgeID, d.Team1ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1
+    UNION ALL
+    SELECT ja.JudgeID, d.Team2ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1
+    UNION ALL
+    SELECT ja.JudgeID, d.Team3ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1 AND d.Team3ID IS NOT NULL
+    UNION ALL
+    SELECT ja.JudgeID, d.Team4ID AS TeamID
+    FROM JudgeAssignments ja JOIN Debates d ON ja.DebateID = d.DebateID
+    WHERE d.TournamentID = $1 AND d.Team4ID IS NOT NULL
+) judged
+GROUP BY judged.JudgeID, judged.TeamID
+`
+
+type GetJudgedTeamCountsRow struct {
+	Judgeid     int32 `json:"judgeid"`
+	Teamid      int32 `json:"teamid"`
+	Timesjudged int64 `json:"timesjudged"`
+}
+
+func (q *Queries) GetJudgedTeamCounts(ctx context.Context, tournamentid int32) ([]GetJudgedTeamCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgedTeamCounts, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgedTeamCountsRow{}
+	for rows.Next() {
+		var i GetJudgedTeamCountsRow
+		if err := rows.Scan(&i.Judgeid, &i.Teamid, &i.Timesjudged); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgesByTournamentAndRound = `-- name: GetJudgesByTournamentAndRound :many
+SELECT u.UserID, u.Name, u.Email, ja.IsHeadJudge, ja.DebateID
+FROM Users u
+JOIN JudgeAssignments ja ON u.UserID = ja.JudgeID
+WHERE ja.TournamentID = $1 AND ja.RoundNumber = $2 AND ja.IsElimination = $3
+`
+
+type GetJudgesByTournamentAndRoundParams struct {
+	Tournamentid  int32 `json:"tournamentid"`
+	Roundnumber   int32 `json:"roundnumber"`
+	Iselimination bool  `json:"iselimination"`
+}
+
+type GetJudgesByTournamentAndRoundRow struct {
+	Userid      int32  `json:"userid"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	Isheadjudge bool   `json:"isheadjudge"`
+	Debateid    int32  `json:"debateid"`
+}
+
+func (q *Queries) GetJudgesByTournamentAndRound(ctx context.Context, arg GetJudgesByTournamentAndRoundParams) ([]GetJudgesByTournamentAndRoundRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgesByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iselimination)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgesByTournamentAndRoundRow{}
+	for rows.Next() {
+		var i GetJudgesByTournamentAndRoundRow
+		if err := rows.Scan(
+			&i.Userid,
+			&i.Name,
+			&i.Email,
+			&i.Isheadjudge,
+			&i.Debateid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgesForDebate = `-- name: GetJudgesForDebate :many
+SELECT ja.JudgeID, u.Name
+FROM JudgeAssignments ja
+JOIN Users u ON ja.JudgeID = u.UserID
+WHERE ja.DebateID = $1
+`
+
+type GetJudgesForDebateRow struct {
+	Judgeid int32  `json:"judgeid"`
+	Name    string `json:"name"`
+}
+
+func (q *Queries) GetJudgesForDebate(ctx context.Context, debateid int32) ([]GetJudgesForDebateRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgesForDebate, debateid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgesForDebateRow{}
+	for rows.Next() {
+		var i GetJudgesForDebateRow
+		if err := rows.Scan(&i.Judgeid, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJudgesForTournament = `-- name: GetJudgesForTournament :many
+SELECT
+    u.UserID as JudgeID,
+    u.Name,
+    v.iDebateVolunteerID,
+    COALESCE(r.RoomName, 'Unassigned') AS RoomName,
+    COALESCE(ja.IsHeadJudge, false) AS IsHeadJudge,
+    CASE WHEN ja.JudgeID IS NULL THEN false ELSE true END AS IsAssigned
+FROM
+    Users u
+        JOIN
+    Volunteers v ON u.UserID = v.UserID
+        JOIN
+    TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+        LEFT JOIN
+    JudgeAssignments ja ON u.UserID = ja.JudgeID AND ja.TournamentID = $1
+        LEFT JOIN
+    Debates d ON ja.DebateID = d.DebateID
+        LEFT JOIN
+    Rooms r ON d.RoomID = r.RoomID
+WHERE
+    ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
+ORDER BY
+    IsAssigned DESC, u.Name
+`
+
+type GetJudgesForTournamentRow struct {
+	Judgeid            int32          `json:"judgeid"`
+	Name               string         `json:"name"`
+	Idebatevolunteerid sql.NullString `json:"idebatevolunteerid"`
+	Roomname           string         `json:"roomname"`
+	Isheadjudge        bool           `json:"isheadjudge"`
+	Isassigned         bool           `json:"isassigned"`
+}
+
+func (q *Queries) GetJudgesForTournament(ctx context.Context, tournamentid int32) ([]GetJudgesForTournamentRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgesForTournament, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgesForTournamentRow{}
+	for rows.Next() {
+		var i GetJudgesForTournamentRow
+		if err := rows.Scan(
+			&i.Judgeid,
+			&i.Name,
+			&i.Idebatevolunteerid,
+			&i.Roomname,
+			&i.Isheadjudge,
+			&i.Isassigned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOtherPreliminaryDebates = `-- name: GetOtherPreliminaryDebates :many
+SELECT Team1ID, Team2ID, Team3ID, Team4ID
+FROM Debates
+WHERE TournamentID = $1 AND IsEliminationRound = false AND RoundNumber <> $2
+`
+
+type GetOtherPreliminaryDebatesParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Roundnumber  int32 `json:"roundnumber"`
+}
+
+type GetOtherPreliminaryDebatesRow struct {
+	Team1id int32         `json:"team1id"`
+	Team2id int32         `json:"team2id"`
+	Team3id sql.NullInt32 `json:"team3id"`
+	Team4id sql.NullInt32 `json:"team4id"`
+}
+
+func (q *Queries) GetOtherPreliminaryDebates(ctx context.Context, arg GetOtherPreliminaryDebatesParams) ([]GetOtherPreliminaryDebatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOtherPreliminaryDebates, arg.Tournamentid, arg.Roundnumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOtherPreliminaryDebatesRow{}
+	for rows.Next() {
+		var i GetOtherPreliminaryDebatesRow
+		if err := rows.Scan(
+			&i.Team1id,
+			&i.Team2id,
+			&i.Team3id,
+			&i.Team4id,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverallSchoolRanking = `-- name: GetOverallSchoolRanking :many
+WITH school_ranking AS (
+    SELECT
+        s.SchoolID,
+        s.SchoolName,
+        CAST(COALESCE(SUM(ts.TotalScore), 0) AS DECIMAL(10,2)) AS TotalPoints,
+        COALESCE(AVG(ts.Rank), 0) AS AverageRank,
+        COUNT(DISTINCT tour.TournamentID) as TournamentsParticipated,
+        RANK() OVER (ORDER BY COALESCE(SUM(ts.TotalScore), 0) DESC, COALESCE(AVG(ts.Rank), 0) ASC) as CurrentRank,
+        COUNT(*) OVER () as TotalSchools,
+        MAX(tour.StartDate) as LastTournamentDate
+    FROM
+        Schools s
+        LEFT JOIN Students stu ON s.SchoolID = stu.SchoolID
+        LEFT JOIN TeamMembers tm ON stu.StudentID = tm.StudentID
+        LEFT JOIN Teams te ON tm.TeamID = te.TeamID
+        LEFT JOIN Debates d ON (te.TeamID = d.Team1ID OR te.TeamID = d.Team2ID)
+        LEFT JOIN TeamScores ts ON te.TeamID = ts.TeamID AND d.DebateID = ts.DebateID
+        LEFT JOIN Tournaments tour ON d.TournamentID = tour.TournamentID
+        LEFT JOIN Leagues l ON tour.LeagueID = l.LeagueID
+    WHERE
+        s.SchoolID = $1 OR s.SchoolID IN (
+            SELECT s2.SchoolID
+            FROM Schools s2
+            LEFT JOIN Students stu2 ON s2.SchoolID = stu2.SchoolID
+            LEFT JOIN TeamMembers tm2 ON stu2.StudentID = tm2.StudentID
+            LEFT JOIN Teams te2 ON tm2.TeamID = te2.TeamID
+            LEFT JOIN Debates d2 ON (te2.TeamID = d2.Team1ID OR te2.TeamID = d2.Team2ID)
+            LEFT JOIN TeamScores ts2 ON te2.TeamID = ts2.TeamID AND d2.DebateID = ts2.DebateID
+            GROUP BY s2.SchoolID
+            ORDER BY COALESCE(SUM(ts2.TotalScore), 0) DESC
+            LIMIT 3
+        )
+    GROUP BY
+        s.SchoolID, s.SchoolName
+)
+SELECT schoolid, schoolname, totalpoints, averagerank, tournamentsparticipated, currentrank, totalschools, lasttournamentdate
+FROM school_ranking
+ORDER BY CurrentRank
+`
+
+type GetOverallSchoolRankingRow struct {
+	Schoolid                int32       `json:"schoolid"`
+	Schoolname              string      `json:"schoolname"`
+	Totalpoints             string      `json:"totalpoints"`
+	Averagerank             interface{} `json:"averagerank"`
+	Tournamentsparticipated int64       `json:"tournamentsparticipated"`
+	Currentrank             int64       `json:"currentrank"`
+	Totalschools            int64       `json:"totalschools"`
+	Lasttournamentdate      interface{} `json:"lasttournamentdate"`
+}
+
+func (q *Queries) GetOverallSchoolRanking(ctx context.Context, schoolid int32) ([]GetOverallSchoolRankingRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOverallSchoolRanking, schoolid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOverallSchoolRankingRow{}
+	for rows.Next() {
+		var i GetOverallSchoolRankingRow
+		if err := rows.Scan(
+			&i.Schoolid,
+			&i.Schoolname,
+			&i.Totalpoints,
+			&i.Averagerank,
+			&i.Tournamentsparticipated,
+			&i.Currentrank,
+			&i.Totalschools,
+			&i.Lasttournamentdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverallStudentRanking = `-- name: GetOverallStudentRanking :many
+WITH student_ranking AS (
+    SELECT
+        s.StudentID,
+        s.FirstName || ' ' || s.LastName AS StudentName,
+        CAST(SUM(ss.SpeakerPoints) AS DECIMAL(10,2)) AS TotalPoints,
+        AVG(ss.SpeakerRank) AS AverageRank,
+        COUNT(DISTINCT d.TournamentID) AS TournamentsParticipated,
+        RANK() OVER (ORDER BY SUM(ss.SpeakerPoints) DESC, AVG(ss.SpeakerRank) ASC) AS CurrentRank,
+        COUNT(*) OVER () AS TotalStudents,
+        MAX(t.StartDate) AS LastTournamentDate
+    FROM
+        Students s
+    JOIN TeamMembers tm ON s.StudentID = tm.StudentID
+    JOIN Teams te ON tm.TeamID = te.TeamID
+    JOIN Debates d ON (te.TeamID = d.Team1ID OR te.TeamID = d.Team2ID)
+    JOIN Ballots b ON d.DebateID = b.DebateID
+    JOIN SpeakerScores ss ON s.StudentID = ss.SpeakerID AND b.BallotID = ss.BallotID
+    JOIN Tournaments t ON d.TournamentID = t.TournamentID
+    GROUP BY
+        s.StudentID, s.FirstName, s.LastName
+)
+SELECT studentid, studentname, totalpoints, averagerank, tournamentsparticipated, currentrank, totalstudents, lasttournamentdate
+FROM student_ranking
+ORDER BY CurrentRank
+`
+
+type GetOverallStudentRankingRow struct {
+	Studentid               int32       `json:"studentid"`
+	Studentname             interface{} `json:"studentname"`
+	Totalpoints             string      `json:"totalpoints"`
+	Averagerank             float64     `json:"averagerank"`
+	Tournamentsparticipated int64       `json:"tournamentsparticipated"`
+	Currentrank             int64       `json:"currentrank"`
+	Totalstudents           int64       `json:"totalstudents"`
+	Lasttournamentdate      interface{} `json:"lasttournamentdate"`
+}
+
+func (q *Queries) GetOverallStudentRanking(ctx context.Context) ([]GetOverallStudentRankingRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOverallStudentRanking)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOverallStudentRankingRow{}
+	for rows.Next() {
+		var i GetOverallStudentRankingRow
+		if err := rows.Scan(
+			&i.Studentid,
+			&i.Studentname,
+			&i.Totalpoints,
+			&i.Averagerank,
+			&i.Tournamentsparticipated,
+			&i.Currentrank,
+			&i.Totalstudents,
+			&i.Lasttournamentdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverallVolunteerRanking = `-- name: GetOverallVolunteerRanking :many
+WITH volunteer_ranking AS (
+    SELECT
+        v.VolunteerID,
+        v.FirstName || ' ' || v.LastName AS VolunteerName,
+        AVG(jf.AverageRating) as AverageRating,
+        COUNT(DISTINCT d.TournamentID) as TournamentsJudged,
+        RANK() OVER (ORDER BY AVG(jf.AverageRating) DESC) as CurrentRank,
+        COUNT(*) OVER () as TotalVolunteers,
+        MAX(t.StartDate) as LastTournamentDate
+    FROM
+        Volunteers v
+        LEFT JOIN JudgeAssignments ja ON v.UserID = ja.JudgeID
+        LEFT JOIN Debates d ON ja.DebateID = d.DebateID
+        LEFT JOIN JudgeFeedback jf ON v.UserID = jf.JudgeID
+        LEFT JOIN Tournaments t ON d.TournamentID = t.TournamentID
+    GROUP BY
+        v.VolunteerID, v.FirstName, v.LastName
+)
+SELECT volunteerid, volunteername, averagerating, tournamentsjudged, currentrank, totalvolunteers, lasttournamentdate
+FROM volunteer_ranking
+ORDER BY CurrentRank
+`
+
+type GetOverallVolunteerRankingRow struct {
+	Volunteerid        int32       `json:"volunteerid"`
+	Volunteername      interface{} `json:"volunteername"`
+	Averagerating      float64     `json:"averagerating"`
+	Tournamentsjudged  int64       `json:"tournamentsjudged"`
+	Currentrank        int64       `json:"currentrank"`
+	Totalvolunteers    int64       `json:"totalvolunteers"`
+	Lasttournamentdate interface{} `json:"lasttournamentdate"`
+}
+
+func (q *Queries) GetOverallVolunteerRanking(ctx context.Context) ([]GetOverallVolunteerRankingRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOverallVolunteerRanking)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOverallVolunteerRankingRow{}
+	for rows.Next() {
+		var i GetOverallVolunteerRankingRow
+		if err := rows.Scan(
+			&i.Volunteerid,
+			&i.Volunteername,
+			&i.Averagerating,
+			&i.Tournamentsjudged,
+			&i.Currentrank,
+			&i.Totalvolunteers,
+			&i.Lasttournamentdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPairingByID = `-- name: GetPairingByID :one
+SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
+       d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
+       d.RoomID, r.roomname AS RoomName,
+       array_agg(DISTINCT s1.FirstName || ' ' || s1.LastName) AS Team1SpeakerNames,
+       array_agg(DISTINCT s2.FirstName || ' ' || s2.LastName) AS Team2SpeakerNames,
+       l1.Name AS Team1LeagueName, l2.Name AS Team2LeagueName,
+       COALESCE(t1_points.TotalPoints, 0) AS Team1TotalPoints,
+       COALESCE(t2_points.TotalPoints, 0) AS Team2TotalPoints,
+       (SELECT u.Name FROM JudgeAssignments ja
+        JOIN Users u ON ja.JudgeID = u.UserID
+        WHERE ja.DebateID = d.DebateID AND ja.IsHeadJudge = true
+        LIMIT 1) AS HeadJudgeName
+FROM Debates d
+JOIN Teams t1 ON d.Team1ID = t1.TeamID
+JOIN Teams t2 ON d.Team2ID = t2.TeamID
+LEFT JOIN Rooms r ON d.RoomID = r.RoomID
+LEFT JOIN TeamMembers tm1 ON t1.TeamID = tm1.TeamID
+LEFT JOIN TeamMembers tm2 ON t2.TeamID = tm2.TeamID
+LEFT JOIN Students s1 ON tm1.StudentID = s1.StudentID
+LEFT JOIN Students s2 ON tm2.StudentID = s2.StudentID
+LEFT JOIN Tournaments tour ON d.TournamentID = tour.TournamentID
+LEFT JOIN Leagues l1 ON tour.LeagueID = l1.LeagueID
+LEFT JOIN Leagues l2 ON tour.LeagueID = l2.LeagueID
+LEFT JOIN (
+    SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
+    FROM Ballots b
+    JOIN Debates d ON b.DebateID = d.DebateID
+    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    GROUP BY Team1ID
+    UNION ALL
+    SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
+    FROM Ballots b
+    JOIN Debates d ON b.DebateID = d.DebateID
+    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    GROUP BY Team2ID
+) t1_points ON t1.TeamID = t1_points.TeamID
+LEFT JOIN (
+    SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
+    FROM Ballots b
+    JOIN Debates d ON b.DebateID = d.DebateID
+    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    GROUP BY Team1ID
+    UNION ALL
+    SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
+    FROM Ballots b
+    JOIN Debates d ON b.DebateID = d.DebateID
+    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    GROUP BY Team2ID
+) t2_points ON t2.TeamID = t2_points.TeamID
+WHERE d.DebateID = $1
+GROUP BY d.DebateID, d.RoundNumber, d.IsEliminationRound, d.Team1ID, t1.Name, d.Team2ID, t2.Name, d.RoomID, r.RoomName,
+         l1.Name, l2.Name, t1_points.TotalPoints, t2_points.TotalPoints
+`
+
+type GetPairingByIDRow struct {
+	Debateid           int32          `json:"debateid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Team1id            int32          `json:"team1id"`
+	Team1name          string         `json:"team1name"`
+	Team2id            int32          `json:"team2id"`
+	Team2name          string         `json:"team2name"`
+	Roomid             int32          `json:"roomid"`
+	Roomname           sql.NullString `json:"roomname"`
+	Team1speakernames  interface{}    `json:"team1speakernames"`
+	Team2speakernames  interface{}    `json:"team2speakernames"`
+	Team1leaguename    sql.NullString `json:"team1leaguename"`
+	Team2leaguename    sql.NullString `json:"team2leaguename"`
+	Team1totalpoints   int64          `json:"team1totalpoints"`
+	Team2totalpoints   int64          `json:"team2totalpoints"`
+	Headjudgename      string         `json:"headjudgename"`
+}
+
+func (q *Queries) GetPairingByID(ctx context.Context, debateid int32) (GetPairingByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getPairingByID, debateid)
+	var i GetPairingByIDRow
+	err := row.Scan(
+		&i.Debateid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Team1id,
+		&i.Team1name,
+		&i.Team2id,
+		&i.Team2name,
+		&i.Roomid,
+		&i.Roomname,
+		&i.Team1speakernames,
+		&i.Team2speakernames,
+		&i.Team1leaguename,
+		&i.Team2leaguename,
+		&i.Team1totalpoints,
+		&i.Team2totalpoints,
+		&i.Headjudgename,
+	)
+	return i, err
+}
+
+const getPairings = `-- name: GetPairings :many
+SELECT
+    d.debateid,
+    d.roundnumber,
+    d.iseliminationround,
+    d.roomid,
+    r.roomname,
+    t1.teamid AS team1id,
+    t1.name AS team1name,
+    t2.teamid AS team2id,
+    t2.name AS team2name,
+    j.name AS headjudgename,
+    d.team3id,
+    t3.name AS team3name,
+    d.team4id,
+    t4.name AS team4name,
+    d.panelquality,
+    bc.name AS breakcategoryname,
+    ARRAY(SELECT aj.judgeid FROM JudgeAssignments aj WHERE aj.debateid = d.debateid ORDER BY aj.judgeid)::int[] AS judgeids,
+    d.starttime,
+    d.endtime
+FROM
+    Debates d
+    JOIN Rooms r ON d.roomid = r.roomid
+    JOIN Teams t1 ON d.team1id = t1.teamid
+    JOIN Teams t2 ON d.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON d.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON d.team4id = t4.teamid
+    LEFT JOIN JudgeAssignments ja ON d.debateid = ja.debateid AND ja.isheadjudge = true
+    LEFT JOIN Users j ON ja.judgeid = j.userid
+    LEFT JOIN EliminationBracketNodes n ON d.debateid = n.debateid
+    LEFT JOIN BreakCategories bc ON n.categoryid = bc.categoryid
+WHERE
+    d.tournamentid = $1
+    AND d.roundnumber = $2
+    AND d.iseliminationround = $3
+ORDER BY
+    d.debateid
+`
+
+type GetPairingsParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+type GetPairingsRow struct {
+	Debateid           int32          `json:"debateid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Roomid             int32          `json:"roomid"`
+	Roomname           string         `json:"roomname"`
+	Team1id            int32          `json:"team1id"`
+	Team1name          string         `json:"team1name"`
+	Team2id            int32          `json:"team2id"`
+	Team2name          string         `json:"team2name"`
+	Headjudgename      sql.NullString `json:"headjudgename"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+	Panelquality       sql.NullString `json:"panelquality"`
+	Breakcategoryname  sql.NullString `json:"breakcategoryname"`
+	Judgeids           []int32        `json:"judgeids"`
+	Starttime          time.Time      `json:"starttime"`
+	Endtime            sql.NullTime   `json:"endtime"`
+}
+
+func (q *Queries) GetPairings(ctx context.Context, arg GetPairingsParams) ([]GetPairingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPairings, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPairingsRow{}
+	for rows.Next() {
+		var i GetPairingsRow
+		if err := rows.Scan(
+			&i.Debateid,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Team1id,
+			&i.Team1name,
+			&i.Team2id,
+			&i.Team2name,
+			&i.Headjudgename,
+			&i.Team3id,
+			&i.Team3name,
+			&i.Team4id,
+			&i.Team4name,
+			&i.Panelquality,
+			&i.Breakcategoryname,
+			pq.Array(&i.Judgeids),
+			&i.Starttime,
+			&i.Endtime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPairingsByTournamentAndRound = `-- name: GetPairingsByTournamentAndRound :many
+SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
        d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
        d.RoomID, r.roomname AS RoomName,
        array_agg(DISTINCT s1.FirstName || ' ' || s1.LastName) AS Team1SpeakerNames,
@@ -1651,146 +3617,438 @@ LEFT JOIN (
     SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    WHERE d.TournamentID = $1
     GROUP BY Team1ID
     UNION ALL
     SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    WHERE d.TournamentID = $1
     GROUP BY Team2ID
 ) t1_points ON t1.TeamID = t1_points.TeamID
 LEFT JOIN (
     SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    WHERE d.TournamentID = $1
     GROUP BY Team1ID
     UNION ALL
     SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
     FROM Ballots b
     JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = (SELECT TournamentID FROM Debates WHERE d.DebateID = $1)
+    WHERE d.TournamentID = $1
     GROUP BY Team2ID
 ) t2_points ON t2.TeamID = t2_points.TeamID
-WHERE d.DebateID = $1
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
 GROUP BY d.DebateID, d.RoundNumber, d.IsEliminationRound, d.Team1ID, t1.Name, d.Team2ID, t2.Name, d.RoomID, r.RoomName,
          l1.Name, l2.Name, t1_points.TotalPoints, t2_points.TotalPoints
 `
 
-type GetPairingByIDRow struct {
-	Debateid           int32          `json:"debateid"`
-	Roundnumber        int32          `json:"roundnumber"`
-	Iseliminationround bool           `json:"iseliminationround"`
-	Team1id            int32          `json:"team1id"`
-	Team1name          string         `json:"team1name"`
-	Team2id            int32          `json:"team2id"`
-	Team2name          string         `json:"team2name"`
-	Roomid             int32          `json:"roomid"`
-	Roomname           sql.NullString `json:"roomname"`
-	Team1speakernames  interface{}    `json:"team1speakernames"`
-	Team2speakernames  interface{}    `json:"team2speakernames"`
-	Team1leaguename    sql.NullString `json:"team1leaguename"`
-	Team2leaguename    sql.NullString `json:"team2leaguename"`
-	Team1totalpoints   int64          `json:"team1totalpoints"`
-	Team2totalpoints   int64          `json:"team2totalpoints"`
-	Headjudgename      string         `json:"headjudgename"`
+type GetPairingsByTournamentAndRoundParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+type GetPairingsByTournamentAndRoundRow struct {
+	Debateid           int32          `json:"debateid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Team1id            int32          `json:"team1id"`
+	Team1name          string         `json:"team1name"`
+	Team2id            int32          `json:"team2id"`
+	Team2name          string         `json:"team2name"`
+	Roomid             int32          `json:"roomid"`
+	Roomname           sql.NullString `json:"roomname"`
+	Team1speakernames  interface{}    `json:"team1speakernames"`
+	Team2speakernames  interface{}    `json:"team2speakernames"`
+	Team1leaguename    sql.NullString `json:"team1leaguename"`
+	Team2leaguename    sql.NullString `json:"team2leaguename"`
+	Team1totalpoints   int64          `json:"team1totalpoints"`
+	Team2totalpoints   int64          `json:"team2totalpoints"`
+	Headjudgename      string         `json:"headjudgename"`
+}
+
+func (q *Queries) GetPairingsByTournamentAndRound(ctx context.Context, arg GetPairingsByTournamentAndRoundParams) ([]GetPairingsByTournamentAndRoundRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPairingsByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPairingsByTournamentAndRoundRow{}
+	for rows.Next() {
+		var i GetPairingsByTournamentAndRoundRow
+		if err := rows.Scan(
+			&i.Debateid,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Team1id,
+			&i.Team1name,
+			&i.Team2id,
+			&i.Team2name,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Team1speakernames,
+			&i.Team2speakernames,
+			&i.Team1leaguename,
+			&i.Team2leaguename,
+			&i.Team1totalpoints,
+			&i.Team2totalpoints,
+			&i.Headjudgename,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPanelSpeakerScores = `-- name: GetPanelSpeakerScores :many
+SELECT jb.JudgeID, jss.TeamID, jss.Position, jss.SpeakerID, jss.SpeakerRank, jss.SpeakerPoints, jss.Feedback, jss.ReplyPoints
+FROM JudgeSpeakerScores jss
+JOIN JudgeBallots jb ON jss.JudgeBallotID = jb.JudgeBallotID
+WHERE jb.BallotID = $1
+ORDER BY jss.TeamID, jss.Position
+`
+
+type GetPanelSpeakerScoresRow struct {
+	Judgeid       int32          `json:"judgeid"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
+	Speakerid     int32          `json:"speakerid"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Replypoints   string         `json:"replypoints"`
+}
+
+func (q *Queries) GetPanelSpeakerScores(ctx context.Context, ballotid int32) ([]GetPanelSpeakerScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPanelSpeakerScores, ballotid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPanelSpeakerScoresRow{}
+	for rows.Next() {
+		var i GetPanelSpeakerScoresRow
+		if err := rows.Scan(
+			&i.Judgeid,
+			&i.Teamid,
+			&i.Position,
+			&i.Speakerid,
+			&i.Speakerrank,
+			&i.Speakerpoints,
+			&i.Feedback,
+			&i.Replypoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingBallotEntries = `-- name: GetPendingBallotEntries :many
+SELECT be.EntryID, be.BallotID, be.JudgeID, be.EnteredBy, u.Name AS EnteredByName, be.Snapshot, be.EnteredAt
+FROM BallotEntries be
+JOIN Users u ON be.EnteredBy = u.UserID
+WHERE be.BallotID = $1 AND be.JudgeID = $2 AND be.ConfirmedAt IS NULL
+ORDER BY be.EnteredAt, be.EntryID
+`
+
+type GetPendingBallotEntriesParams struct {
+	Ballotid int32 `json:"ballotid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+type GetPendingBallotEntriesRow struct {
+	Entryid       int32           `json:"entryid"`
+	Ballotid      int32           `json:"ballotid"`
+	Judgeid       int32           `json:"judgeid"`
+	Enteredby     int32           `json:"enteredby"`
+	Enteredbyname string          `json:"enteredbyname"`
+	Snapshot      json.RawMessage `json:"snapshot"`
+	Enteredat     time.Time       `json:"enteredat"`
+}
+
+func (q *Queries) GetPendingBallotEntries(ctx context.Context, arg GetPendingBallotEntriesParams) ([]GetPendingBallotEntriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingBallotEntries, arg.Ballotid, arg.Judgeid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPendingBallotEntriesRow{}
+	for rows.Next() {
+		var i GetPendingBallotEntriesRow
+		if err := rows.Scan(
+			&i.Entryid,
+			&i.Ballotid,
+			&i.Judgeid,
+			&i.Enteredby,
+			&i.Enteredbyname,
+			&i.Snapshot,
+			&i.Enteredat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingBallotEntriesByTournamentAndRound = `-- name: GetPendingBallotEntriesByTournamentAndRound :many
+SELECT be.EntryID, be.BallotID, be.JudgeID, j.Name AS JudgeName, be.EnteredBy, u.Name AS EnteredByName,
+       be.Snapshot, be.EnteredAt
+FROM BallotEntries be
+JOIN Ballots b ON be.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Users j ON be.JudgeID = j.UserID
+JOIN Users u ON be.EnteredBy = u.UserID
+WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
+  AND be.ConfirmedAt IS NULL
+ORDER BY be.BallotID, be.JudgeID, be.EnteredAt, be.EntryID
+`
+
+type GetPendingBallotEntriesByTournamentAndRoundParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+type GetPendingBallotEntriesByTournamentAndRoundRow struct {
+	Entryid       int32           `json:"entryid"`
+	Ballotid      int32           `json:"ballotid"`
+	Judgeid       int32           `json:"judgeid"`
+	Judgename     string          `json:"judgename"`
+	Enteredby     int32           `json:"enteredby"`
+	Enteredbyname string          `json:"enteredbyname"`
+	Snapshot      json.RawMessage `json:"snapshot"`
+	Enteredat     time.Time       `json:"enteredat"`
+}
+
+func (q *Queries) GetPendingBallotEntriesByTournamentAndRound(ctx context.Context, arg GetPendingBallotEntriesByTournamentAndRoundParams) ([]GetPendingBallotEntriesByTournamentAndRoundRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingBallotEntriesByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPendingBallotEntriesByTournamentAndRoundRow{}
+	for rows.Next() {
+		var i GetPendingBallotEntriesByTournamentAndRoundRow
+		if err := rows.Scan(
+			&i.Entryid,
+			&i.Ballotid,
+			&i.Judgeid,
+			&i.Judgename,
+			&i.Enteredby,
+			&i.Enteredbyname,
+			&i.Snapshot,
+			&i.Enteredat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPreliminaryPairingHistory = `-- name: GetPreliminaryPairingHistory :many
+SELECT Team1ID, Team2ID, RoundNumber
+FROM PairingHistory
+WHERE TournamentID = $1 AND IsElimination = false
+ORDER BY RoundNumber
+`
+
+type GetPreliminaryPairingHistoryRow struct {
+	Team1id     int32 `json:"team1id"`
+	Team2id     int32 `json:"team2id"`
+	Roundnumber int32 `json:"roundnumber"`
+}
+
+func (q *Queries) GetPreliminaryPairingHistory(ctx context.Context, tournamentid int32) ([]GetPreliminaryPairingHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPreliminaryPairingHistory, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPreliminaryPairingHistoryRow{}
+	for rows.Next() {
+		var i GetPreliminaryPairingHistoryRow
+		if err := rows.Scan(&i.Team1id, &i.Team2id, &i.Roundnumber); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPreviousPairings = `-- name: GetPreviousPairings :many
+SELECT Team1ID, Team2ID
+FROM Debates
+WHERE TournamentID = $1 AND RoundNumber < $2
+`
+
+type GetPreviousPairingsParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Roundnumber  int32 `json:"roundnumber"`
+}
+
+type GetPreviousPairingsRow struct {
+	Team1id int32 `json:"team1id"`
+	Team2id int32 `json:"team2id"`
+}
+
+func (q *Queries) GetPreviousPairings(ctx context.Context, arg GetPreviousPairingsParams) ([]GetPreviousPairingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPreviousPairings, arg.Tournamentid, arg.Roundnumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPreviousPairingsRow{}
+	for rows.Next() {
+		var i GetPreviousPairingsRow
+		if err := rows.Scan(&i.Team1id, &i.Team2id); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPublishedTab = `-- name: GetPublishedTab :one
+SELECT tabid, tournamentid, version, snapshot, publishedby, publishedat
+FROM PublishedTabs
+WHERE TournamentID = $1
+  AND ($2::int = 0 OR Version = $2)
+ORDER BY Version DESC
+LIMIT 1
+`
+
+type GetPublishedTabParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Column2      int32 `json:"column_2"`
 }
 
-func (q *Queries) GetPairingByID(ctx context.Context, debateid int32) (GetPairingByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getPairingByID, debateid)
-	var i GetPairingByIDRow
+// The given version of the tournament's tab, or the latest for version 0.
+func (q *Queries) GetPublishedTab(ctx context.Context, arg GetPublishedTabParams) (Publishedtab, error) {
+	row := q.db.QueryRowContext(ctx, getPublishedTab, arg.Tournamentid, arg.Column2)
+	var i Publishedtab
 	err := row.Scan(
-		&i.Debateid,
-		&i.Roundnumber,
-		&i.Iseliminationround,
-		&i.Team1id,
-		&i.Team1name,
-		&i.Team2id,
-		&i.Team2name,
-		&i.Roomid,
-		&i.Roomname,
-		&i.Team1speakernames,
-		&i.Team2speakernames,
-		&i.Team1leaguename,
-		&i.Team2leaguename,
-		&i.Team1totalpoints,
-		&i.Team2totalpoints,
-		&i.Headjudgename,
+		&i.Tabid,
+		&i.Tournamentid,
+		&i.Version,
+		&i.Snapshot,
+		&i.Publishedby,
+		&i.Publishedat,
 	)
 	return i, err
 }
 
-const getPairings = `-- name: GetPairings :many
-SELECT
-    d.debateid,
-    d.roundnumber,
-    d.iseliminationround,
-    d.roomid,
-    r.roomname,
-    t1.teamid AS team1id,
-    t1.name AS team1name,
-    t2.teamid AS team2id,
-    t2.name AS team2name,
-    j.name AS headjudgename
-FROM
-    Debates d
-    JOIN Rooms r ON d.roomid = r.roomid
-    JOIN Teams t1 ON d.team1id = t1.teamid
-    JOIN Teams t2 ON d.team2id = t2.teamid
-    LEFT JOIN JudgeAssignments ja ON d.debateid = ja.debateid AND ja.isheadjudge = true
-    LEFT JOIN Users j ON ja.judgeid = j.userid
-WHERE
-    d.tournamentid = $1
-    AND d.roundnumber = $2
-    AND d.iseliminationround = $3
-ORDER BY
-    d.debateid
+const getPublishedTabVersions = `-- name: GetPublishedTabVersions :many
+SELECT Version
+FROM PublishedTabs
+WHERE TournamentID = $1
+ORDER BY Version
 `
 
-type GetPairingsParams struct {
-	Tournamentid       int32 `json:"tournamentid"`
-	Roundnumber        int32 `json:"roundnumber"`
-	Iseliminationround bool  `json:"iseliminationround"`
+func (q *Queries) GetPublishedTabVersions(ctx context.Context, tournamentid int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getPublishedTabVersions, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var version int32
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		items = append(items, version)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type GetPairingsRow struct {
-	Debateid           int32          `json:"debateid"`
-	Roundnumber        int32          `json:"roundnumber"`
-	Iseliminationround bool           `json:"iseliminationround"`
-	Roomid             int32          `json:"roomid"`
-	Roomname           string         `json:"roomname"`
-	Team1id            int32          `json:"team1id"`
-	Team1name          string         `json:"team1name"`
-	Team2id            int32          `json:"team2id"`
-	Team2name          string         `json:"team2name"`
-	Headjudgename      sql.NullString `json:"headjudgename"`
+const getRankingVisibility = `-- name: GetRankingVisibility :one
+SELECT IsVisible
+FROM RankingVisibility
+WHERE TournamentID = $1 AND RankingType = $2 AND VisibleTo = $3
+`
+
+type GetRankingVisibilityParams struct {
+	Tournamentid int32  `json:"tournamentid"`
+	Rankingtype  string `json:"rankingtype"`
+	Visibleto    string `json:"visibleto"`
 }
 
-func (q *Queries) GetPairings(ctx context.Context, arg GetPairingsParams) ([]GetPairingsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPairings, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+func (q *Queries) GetRankingVisibility(ctx context.Context, arg GetRankingVisibilityParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getRankingVisibility, arg.Tournamentid, arg.Rankingtype, arg.Visibleto)
+	var isvisible bool
+	err := row.Scan(&isvisible)
+	return isvisible, err
+}
+
+const getReleasedDebateIDs = `-- name: GetReleasedDebateIDs :many
+SELECT rd.DebateID
+FROM ReleasedDraws rd
+JOIN Rounds r ON rd.RoundID = r.RoundID
+WHERE r.TournamentID = $1
+`
+
+func (q *Queries) GetReleasedDebateIDs(ctx context.Context, tournamentid int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getReleasedDebateIDs, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetPairingsRow{}
+	items := []int32{}
 	for rows.Next() {
-		var i GetPairingsRow
-		if err := rows.Scan(
-			&i.Debateid,
-			&i.Roundnumber,
-			&i.Iseliminationround,
-			&i.Roomid,
-			&i.Roomname,
-			&i.Team1id,
-			&i.Team1name,
-			&i.Team2id,
-			&i.Team2name,
-			&i.Headjudgename,
-		); err != nil {
+		var debateid int32
+		if err := rows.Scan(&debateid); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, debateid)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -1801,112 +4059,104 @@ func (q *Queries) GetPairings(ctx context.Context, arg GetPairingsParams) ([]Get
 	return items, nil
 }
 
-const getPairingsByTournamentAndRound = `-- name: GetPairingsByTournamentAndRound :many
-SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
-       d.Team1ID, t1.Name AS Team1Name, d.Team2ID, t2.Name AS Team2Name,
-       d.RoomID, r.roomname AS RoomName,
-       array_agg(DISTINCT s1.FirstName || ' ' || s1.LastName) AS Team1SpeakerNames,
-       array_agg(DISTINCT s2.FirstName || ' ' || s2.LastName) AS Team2SpeakerNames,
-       l1.Name AS Team1LeagueName, l2.Name AS Team2LeagueName,
-       COALESCE(t1_points.TotalPoints, 0) AS Team1TotalPoints,
-       COALESCE(t2_points.TotalPoints, 0) AS Team2TotalPoints,
-       (SELECT u.Name FROM JudgeAssignments ja
-        JOIN Users u ON ja.JudgeID = u.UserID
-        WHERE ja.DebateID = d.DebateID AND ja.IsHeadJudge = true
-        LIMIT 1) AS HeadJudgeName
-FROM Debates d
-JOIN Teams t1 ON d.Team1ID = t1.TeamID
-JOIN Teams t2 ON d.Team2ID = t2.TeamID
-LEFT JOIN Rooms r ON d.RoomID = r.RoomID
-LEFT JOIN TeamMembers tm1 ON t1.TeamID = tm1.TeamID
-LEFT JOIN TeamMembers tm2 ON t2.TeamID = tm2.TeamID
-LEFT JOIN Students s1 ON tm1.StudentID = s1.StudentID
-LEFT JOIN Students s2 ON tm2.StudentID = s2.StudentID
-LEFT JOIN Tournaments tour ON d.TournamentID = tour.TournamentID
-LEFT JOIN Leagues l1 ON tour.LeagueID = l1.LeagueID
-LEFT JOIN Leagues l2 ON tour.LeagueID = l2.LeagueID
-LEFT JOIN (
-    SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
-    FROM Ballots b
-    JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1
-    GROUP BY Team1ID
-    UNION ALL
-    SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
-    FROM Ballots b
-    JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1
-    GROUP BY Team2ID
-) t1_points ON t1.TeamID = t1_points.TeamID
-LEFT JOIN (
-    SELECT Team1ID AS TeamID, SUM(Team1TotalScore) AS TotalPoints
-    FROM Ballots b
-    JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1
-    GROUP BY Team1ID
-    UNION ALL
-    SELECT Team2ID AS TeamID, SUM(Team2TotalScore) AS TotalPoints
-    FROM Ballots b
-    JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1
-    GROUP BY Team2ID
-) t2_points ON t2.TeamID = t2_points.TeamID
-WHERE d.TournamentID = $1 AND d.RoundNumber = $2 AND d.IsEliminationRound = $3
-GROUP BY d.DebateID, d.RoundNumber, d.IsEliminationRound, d.Team1ID, t1.Name, d.Team2ID, t2.Name, d.RoomID, r.RoomName,
-         l1.Name, l2.Name, t1_points.TotalPoints, t2_points.TotalPoints
+const getReleasedPairings = `-- name: GetReleasedPairings :many
+SELECT
+    d.debateid,
+    d.roundnumber,
+    d.iseliminationround,
+    rd.roomid,
+    r.roomname,
+    t1.teamid AS team1id,
+    t1.name AS team1name,
+    t2.teamid AS team2id,
+    t2.name AS team2name,
+    j.name AS headjudgename,
+    rd.team3id,
+    t3.name AS team3name,
+    rd.team4id,
+    t4.name AS team4name,
+    d.panelquality,
+    bc.name AS breakcategoryname,
+    rd.judgeids,
+    d.starttime,
+    d.endtime
+FROM
+    ReleasedDraws rd
+    JOIN Rounds ro ON rd.roundid = ro.roundid
+    JOIN Debates d ON rd.debateid = d.debateid
+    JOIN Rooms r ON rd.roomid = r.roomid
+    JOIN Teams t1 ON rd.team1id = t1.teamid
+    JOIN Teams t2 ON rd.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON rd.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON rd.team4id = t4.teamid
+    LEFT JOIN Users j ON rd.headjudgeid = j.userid
+    LEFT JOIN EliminationBracketNodes n ON d.debateid = n.debateid
+    LEFT JOIN BreakCategories bc ON n.categoryid = bc.categoryid
+WHERE
+    ro.tournamentid = $1
+    AND ro.roundnumber = $2
+    AND ro.iseliminationround = $3
+ORDER BY
+    d.debateid
 `
 
-type GetPairingsByTournamentAndRoundParams struct {
+type GetReleasedPairingsParams struct {
 	Tournamentid       int32 `json:"tournamentid"`
 	Roundnumber        int32 `json:"roundnumber"`
 	Iseliminationround bool  `json:"iseliminationround"`
 }
 
-type GetPairingsByTournamentAndRoundRow struct {
+type GetReleasedPairingsRow struct {
 	Debateid           int32          `json:"debateid"`
 	Roundnumber        int32          `json:"roundnumber"`
 	Iseliminationround bool           `json:"iseliminationround"`
+	Roomid             int32          `json:"roomid"`
+	Roomname           string         `json:"roomname"`
 	Team1id            int32          `json:"team1id"`
 	Team1name          string         `json:"team1name"`
 	Team2id            int32          `json:"team2id"`
 	Team2name          string         `json:"team2name"`
-	Roomid             int32          `json:"roomid"`
-	Roomname           sql.NullString `json:"roomname"`
-	Team1speakernames  interface{}    `json:"team1speakernames"`
-	Team2speakernames  interface{}    `json:"team2speakernames"`
-	Team1leaguename    sql.NullString `json:"team1leaguename"`
-	Team2leaguename    sql.NullString `json:"team2leaguename"`
-	Team1totalpoints   int64          `json:"team1totalpoints"`
-	Team2totalpoints   int64          `json:"team2totalpoints"`
-	Headjudgename      string         `json:"headjudgename"`
-}
-
-func (q *Queries) GetPairingsByTournamentAndRound(ctx context.Context, arg GetPairingsByTournamentAndRoundParams) ([]GetPairingsByTournamentAndRoundRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPairingsByTournamentAndRound, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+	Headjudgename      sql.NullString `json:"headjudgename"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+	Panelquality       sql.NullString `json:"panelquality"`
+	Breakcategoryname  sql.NullString `json:"breakcategoryname"`
+	Judgeids           []int32        `json:"judgeids"`
+	Starttime          time.Time      `json:"starttime"`
+	Endtime            sql.NullTime   `json:"endtime"`
+}
+
+func (q *Queries) GetReleasedPairings(ctx context.Context, arg GetReleasedPairingsParams) ([]GetReleasedPairingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReleasedPairings, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetPairingsByTournamentAndRoundRow{}
+	items := []GetReleasedPairingsRow{}
 	for rows.Next() {
-		var i GetPairingsByTournamentAndRoundRow
+		var i GetReleasedPairingsRow
 		if err := rows.Scan(
 			&i.Debateid,
 			&i.Roundnumber,
 			&i.Iseliminationround,
-			&i.Team1id,
-			&i.Team1name,
-			&i.Team2id,
-			&i.Team2name,
-			&i.Roomid,
-			&i.Roomname,
-			&i.Team1speakernames,
-			&i.Team2speakernames,
-			&i.Team1leaguename,
-			&i.Team2leaguename,
-			&i.Team1totalpoints,
-			&i.Team2totalpoints,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Team1id,
+			&i.Team1name,
+			&i.Team2id,
+			&i.Team2name,
 			&i.Headjudgename,
+			&i.Team3id,
+			&i.Team3name,
+			&i.Team4id,
+			&i.Team4name,
+			&i.Panelquality,
+			&i.Breakcategoryname,
+			pq.Array(&i.Judgeids),
+			&i.Starttime,
+			&i.Endtime,
 		); err != nil {
 			return nil, err
 		}
@@ -1921,32 +4171,29 @@ func (q *Queries) GetPairingsByTournamentAndRound(ctx context.Context, arg GetPa
 	return items, nil
 }
 
-const getPreviousPairings = `-- name: GetPreviousPairings :many
-SELECT Team1ID, Team2ID
-FROM Debates
-WHERE TournamentID = $1 AND RoundNumber < $2
+const getRoomAvailability = `-- name: GetRoomAvailability :many
+SELECT ra.AvailabilityID, ra.RoomID, ra.StartTime, ra.EndTime
+FROM RoomAvailability ra
+JOIN Rooms r ON ra.RoomID = r.RoomID
+WHERE r.TournamentID = $1
+ORDER BY ra.RoomID, ra.StartTime
 `
 
-type GetPreviousPairingsParams struct {
-	Tournamentid int32 `json:"tournamentid"`
-	Roundnumber  int32 `json:"roundnumber"`
-}
-
-type GetPreviousPairingsRow struct {
-	Team1id int32 `json:"team1id"`
-	Team2id int32 `json:"team2id"`
-}
-
-func (q *Queries) GetPreviousPairings(ctx context.Context, arg GetPreviousPairingsParams) ([]GetPreviousPairingsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPreviousPairings, arg.Tournamentid, arg.Roundnumber)
+func (q *Queries) GetRoomAvailability(ctx context.Context, tournamentid sql.NullInt32) ([]Roomavailability, error) {
+	rows, err := q.db.QueryContext(ctx, getRoomAvailability, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetPreviousPairingsRow{}
+	items := []Roomavailability{}
 	for rows.Next() {
-		var i GetPreviousPairingsRow
-		if err := rows.Scan(&i.Team1id, &i.Team2id); err != nil {
+		var i Roomavailability
+		if err := rows.Scan(
+			&i.Availabilityid,
+			&i.Roomid,
+			&i.Starttime,
+			&i.Endtime,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1960,54 +4207,28 @@ func (q *Queries) GetPreviousPairings(ctx context.Context, arg GetPreviousPairin
 	return items, nil
 }
 
-const getRankingVisibility = `-- name: GetRankingVisibility :one
-SELECT IsVisible
-FROM RankingVisibility
-WHERE TournamentID = $1 AND RankingType = $2 AND VisibleTo = $3
-`
-
-type GetRankingVisibilityParams struct {
-	Tournamentid int32  `json:"tournamentid"`
-	Rankingtype  string `json:"rankingtype"`
-	Visibleto    string `json:"visibleto"`
-}
-
-func (q *Queries) GetRankingVisibility(ctx context.Context, arg GetRankingVisibilityParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, getRankingVisibility, arg.Tournamentid, arg.Rankingtype, arg.Visibleto)
-	var isvisible bool
-	err := row.Scan(&isvisible)
-	return isvisible, err
-}
-
 const getRoomByID = `-- name: GetRoomByID :one
-SELECT RoomID, RoomName, TournamentID, Location, Capacity
-FROM Rooms
+SELECT roomid, roomname, location, capacity, tournamentid, buildingid, isaccessible FROM Rooms
 WHERE RoomID = $1
 `
 
-type GetRoomByIDRow struct {
-	Roomid       int32         `json:"roomid"`
-	Roomname     string        `json:"roomname"`
-	Tournamentid sql.NullInt32 `json:"tournamentid"`
-	Location     string        `json:"location"`
-	Capacity     int32         `json:"capacity"`
-}
-
-func (q *Queries) GetRoomByID(ctx context.Context, roomid int32) (GetRoomByIDRow, error) {
+func (q *Queries) GetRoomByID(ctx context.Context, roomid int32) (Room, error) {
 	row := q.db.QueryRowContext(ctx, getRoomByID, roomid)
-	var i GetRoomByIDRow
+	var i Room
 	err := row.Scan(
 		&i.Roomid,
 		&i.Roomname,
-		&i.Tournamentid,
 		&i.Location,
 		&i.Capacity,
+		&i.Tournamentid,
+		&i.Buildingid,
+		&i.Isaccessible,
 	)
 	return i, err
 }
 
 const getRoomsByTournament = `-- name: GetRoomsByTournament :many
-SELECT roomid, roomname, location, capacity, tournamentid FROM Rooms
+SELECT roomid, roomname, location, capacity, tournamentid, buildingid, isaccessible FROM Rooms
 WHERE TournamentID = $1
 `
 
@@ -2026,6 +4247,8 @@ func (q *Queries) GetRoomsByTournament(ctx context.Context, tournamentid sql.Nul
 			&i.Location,
 			&i.Capacity,
 			&i.Tournamentid,
+			&i.Buildingid,
+			&i.Isaccessible,
 		); err != nil {
 			return nil, err
 		}
@@ -2041,7 +4264,7 @@ func (q *Queries) GetRoomsByTournament(ctx context.Context, tournamentid sql.Nul
 }
 
 const getRoundByTournamentAndNumber = `-- name: GetRoundByTournamentAndNumber :one
-SELECT roundid, tournamentid, roundnumber, iseliminationround FROM Rounds
+SELECT roundid, tournamentid, roundnumber, iseliminationround, releasedat, drawseed, drawinput FROM Rounds
 WHERE TournamentID = $1 AND RoundNumber = $2 AND IsEliminationRound = $3
 LIMIT 1
 `
@@ -2060,10 +4283,54 @@ func (q *Queries) GetRoundByTournamentAndNumber(ctx context.Context, arg GetRoun
 		&i.Tournamentid,
 		&i.Roundnumber,
 		&i.Iseliminationround,
+		&i.Releasedat,
+		&i.Drawseed,
+		&i.Drawinput,
 	)
 	return i, err
 }
 
+const getRoundByes = `-- name: GetRoundByes :many
+SELECT b.TeamID, t.Name AS TeamName
+FROM Byes b
+JOIN Teams t ON b.TeamID = t.TeamID
+WHERE b.TournamentID = $1 AND b.RoundNumber = $2
+ORDER BY t.Name
+`
+
+type GetRoundByesParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Roundnumber  int32 `json:"roundnumber"`
+}
+
+type GetRoundByesRow struct {
+	Teamid   int32  `json:"teamid"`
+	Teamname string `json:"teamname"`
+}
+
+func (q *Queries) GetRoundByes(ctx context.Context, arg GetRoundByesParams) ([]GetRoundByesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRoundByes, arg.Tournamentid, arg.Roundnumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetRoundByesRow{}
+	for rows.Next() {
+		var i GetRoundByesRow
+		if err := rows.Scan(&i.Teamid, &i.Teamname); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSchoolOverallPerformance = `-- name: GetSchoolOverallPerformance :many
 WITH tournament_performance AS (
   SELECT
@@ -2158,12 +4425,19 @@ SELECT
     t1.name AS team1name,
     t2.teamid AS team2id,
     t2.name AS team2name,
-    j.name AS headjudgename
+    j.name AS headjudgename,
+    d.team3id,
+    t3.name AS team3name,
+    d.team4id,
+    t4.name AS team4name,
+    d.panelquality
 FROM
     Debates d
     JOIN Rooms r ON d.roomid = r.roomid
     JOIN Teams t1 ON d.team1id = t1.teamid
     JOIN Teams t2 ON d.team2id = t2.teamid
+    LEFT JOIN Teams t3 ON d.team3id = t3.teamid
+    LEFT JOIN Teams t4 ON d.team4id = t4.teamid
     LEFT JOIN JudgeAssignments ja ON d.debateid = ja.debateid AND ja.isheadjudge = true
     LEFT JOIN Users j ON ja.judgeid = j.userid
 WHERE
@@ -2181,6 +4455,11 @@ type GetSinglePairingRow struct {
 	Team2id            int32          `json:"team2id"`
 	Team2name          string         `json:"team2name"`
 	Headjudgename      sql.NullString `json:"headjudgename"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team3name          sql.NullString `json:"team3name"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Team4name          sql.NullString `json:"team4name"`
+	Panelquality       sql.NullString `json:"panelquality"`
 }
 
 func (q *Queries) GetSinglePairing(ctx context.Context, debateid int32) (GetSinglePairingRow, error) {
@@ -2197,56 +4476,319 @@ func (q *Queries) GetSinglePairing(ctx context.Context, debateid int32) (GetSing
 		&i.Team2id,
 		&i.Team2name,
 		&i.Headjudgename,
+		&i.Team3id,
+		&i.Team3name,
+		&i.Team4id,
+		&i.Team4name,
+		&i.Panelquality,
 	)
 	return i, err
 }
 
+const getSpeakerPreliminaryResults = `-- name: GetSpeakerPreliminaryResults :many
+SELECT s.StudentID, s.FirstName || ' ' || s.LastName AS StudentName, sch.SchoolName, d.DebateID,
+       ss.TeamID, ss.Position,
+       CAST(ss.SpeakerPoints AS TEXT) AS SpeakerPoints, ss.SpeakerRank,
+       b.Verdict = t.Name AS Won,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = ss.TeamID AND tm.StudentID = ss.SpeakerID) AS IsSubstitute,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM StudentBreakCategories sbc WHERE sbc.StudentID = s.StudentID AND sbc.CategoryID = $2)) AS Eligible
+FROM SpeakerScores ss
+JOIN Ballots b ON ss.BallotID = b.BallotID
+JOIN Debates d ON b.DebateID = d.DebateID
+JOIN Teams t ON ss.TeamID = t.TeamID
+JOIN Students s ON ss.SpeakerID = s.StudentID
+JOIN Schools sch ON s.SchoolID = sch.SchoolID
+WHERE d.TournamentID = $1
+  AND d.IsEliminationRound = false
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY s.StudentID, d.DebateID, ss.Position
+`
+
+type GetSpeakerPreliminaryResultsParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Column2      int32 `json:"column_2"`
+}
+
+type GetSpeakerPreliminaryResultsRow struct {
+	Studentid     int32        `json:"studentid"`
+	Studentname   interface{}  `json:"studentname"`
+	Schoolname    string       `json:"schoolname"`
+	Debateid      int32        `json:"debateid"`
+	Teamid        int32        `json:"teamid"`
+	Position      int32        `json:"position"`
+	Speakerpoints string       `json:"speakerpoints"`
+	Speakerrank   int32        `json:"speakerrank"`
+	Won           bool         `json:"won"`
+	Issubstitute  bool         `json:"issubstitute"`
+	Eligible      sql.NullBool `json:"eligible"`
+}
+
+// One row per speech in a recorded preliminary debate. Eligible is whether
+// the speaker may break in category $2, always true for 0. IsSubstitute marks
+// speeches given for a team the student is not on.
+func (q *Queries) GetSpeakerPreliminaryResults(ctx context.Context, arg GetSpeakerPreliminaryResultsParams) ([]GetSpeakerPreliminaryResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpeakerPreliminaryResults, arg.Tournamentid, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpeakerPreliminaryResultsRow{}
+	for rows.Next() {
+		var i GetSpeakerPreliminaryResultsRow
+		if err := rows.Scan(
+			&i.Studentid,
+			&i.Studentname,
+			&i.Schoolname,
+			&i.Debateid,
+			&i.Teamid,
+			&i.Position,
+			&i.Speakerpoints,
+			&i.Speakerrank,
+			&i.Won,
+			&i.Issubstitute,
+			&i.Eligible,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSpeakerScoresByBallot = `-- name: GetSpeakerScoresByBallot :many
 SELECT ss.ScoreID, ss.SpeakerID, s.FirstName, s.LastName,
        ss.SpeakerRank, ss.SpeakerPoints, ss.Feedback,
-       t.TeamID, t.Name AS TeamName
+       t.TeamID, t.Name AS TeamName, ss.ReplyPoints, ss.Position,
+       NOT EXISTS (SELECT 1 FROM TeamMembers tm WHERE tm.TeamID = ss.TeamID AND tm.StudentID = ss.SpeakerID) AS IsSubstitute
 FROM SpeakerScores ss
          JOIN Students s ON ss.SpeakerID = s.StudentID
-         JOIN Ballots b ON ss.BallotID = b.BallotID
-         JOIN Debates d ON b.DebateID = d.DebateID
-         JOIN TeamMembers tm ON s.StudentID = tm.StudentID
-         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN Teams t ON ss.TeamID = t.TeamID
 WHERE ss.BallotID = $1
-  AND (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-ORDER BY t.TeamID, ss.SpeakerRank
+ORDER BY t.TeamID, ss.Position
+`
+
+type GetSpeakerScoresByBallotRow struct {
+	Scoreid       int32          `json:"scoreid"`
+	Speakerid     int32          `json:"speakerid"`
+	Firstname     string         `json:"firstname"`
+	Lastname      string         `json:"lastname"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Teamid        int32          `json:"teamid"`
+	Teamname      string         `json:"teamname"`
+	Replypoints   string         `json:"replypoints"`
+	Position      int32          `json:"position"`
+	Issubstitute  bool           `json:"issubstitute"`
+}
+
+// IsSubstitute marks speeches given by a student who is not on the team.
+func (q *Queries) GetSpeakerScoresByBallot(ctx context.Context, ballotid int32) ([]GetSpeakerScoresByBallotRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpeakerScoresByBallot, ballotid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpeakerScoresByBallotRow{}
+	for rows.Next() {
+		var i GetSpeakerScoresByBallotRow
+		if err := rows.Scan(
+			&i.Scoreid,
+			&i.Speakerid,
+			&i.Firstname,
+			&i.Lastname,
+			&i.Speakerrank,
+			&i.Speakerpoints,
+			&i.Feedback,
+			&i.Teamid,
+			&i.Teamname,
+			&i.Replypoints,
+			&i.Position,
+			&i.Issubstitute,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpeakerScoresByBallotAndTeam = `-- name: GetSpeakerScoresByBallotAndTeam :many
+SELECT ss.ScoreID, ss.SpeakerID, ss.BallotID, ss.SpeakerRank,
+       ss.SpeakerPoints, ss.Feedback, ss.TeamID, ss.Position
+FROM SpeakerScores ss
+WHERE ss.BallotID = $1 AND ss.TeamID = $2
+ORDER BY ss.Position
+`
+
+type GetSpeakerScoresByBallotAndTeamParams struct {
+	Ballotid int32 `json:"ballotid"`
+	Teamid   int32 `json:"teamid"`
+}
+
+type GetSpeakerScoresByBallotAndTeamRow struct {
+	Scoreid       int32          `json:"scoreid"`
+	Speakerid     int32          `json:"speakerid"`
+	Ballotid      int32          `json:"ballotid"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
+}
+
+func (q *Queries) GetSpeakerScoresByBallotAndTeam(ctx context.Context, arg GetSpeakerScoresByBallotAndTeamParams) ([]GetSpeakerScoresByBallotAndTeamRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpeakerScoresByBallotAndTeam, arg.Ballotid, arg.Teamid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpeakerScoresByBallotAndTeamRow{}
+	for rows.Next() {
+		var i GetSpeakerScoresByBallotAndTeamRow
+		if err := rows.Scan(
+			&i.Scoreid,
+			&i.Speakerid,
+			&i.Ballotid,
+			&i.Speakerrank,
+			&i.Speakerpoints,
+			&i.Feedback,
+			&i.Teamid,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpeechCountingRules = `-- name: GetSpeechCountingRules :one
+SELECT tf.IronSpeeches, tf.SubstituteSpeeches
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1
+`
+
+type GetSpeechCountingRulesRow struct {
+	Ironspeeches       string `json:"ironspeeches"`
+	Substitutespeeches string `json:"substitutespeeches"`
+}
+
+func (q *Queries) GetSpeechCountingRules(ctx context.Context, tournamentid int32) (GetSpeechCountingRulesRow, error) {
+	row := q.db.QueryRowContext(ctx, getSpeechCountingRules, tournamentid)
+	var i GetSpeechCountingRulesRow
+	err := row.Scan(&i.Ironspeeches, &i.Substitutespeeches)
+	return i, err
+}
+
+const getStoredTeamScores = `-- name: GetStoredTeamScores :many
+SELECT ts.TeamID, ts.DebateID,
+       CAST(COALESCE(ts.TotalScore, 0) AS TEXT) AS TotalScore,
+       COALESCE(ts.Rank, 0)::integer AS Rank,
+       ts.TeamPoints
+FROM TeamScores ts
+JOIN Debates d ON ts.DebateID = d.DebateID
+JOIN Ballots b ON d.DebateID = b.DebateID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY ts.DebateID, ts.TeamID
+`
+
+type GetStoredTeamScoresRow struct {
+	Teamid     sql.NullInt32 `json:"teamid"`
+	Debateid   sql.NullInt32 `json:"debateid"`
+	Totalscore string        `json:"totalscore"`
+	Rank       int32         `json:"rank"`
+	Teampoints int32         `json:"teampoints"`
+}
+
+// The TeamScores of the tournament's recorded debates.
+func (q *Queries) GetStoredTeamScores(ctx context.Context, tournamentid int32) ([]GetStoredTeamScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getStoredTeamScores, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetStoredTeamScoresRow{}
+	for rows.Next() {
+		var i GetStoredTeamScoresRow
+		if err := rows.Scan(
+			&i.Teamid,
+			&i.Debateid,
+			&i.Totalscore,
+			&i.Rank,
+			&i.Teampoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStoredTeamTotals = `-- name: GetStoredTeamTotals :many
+SELECT TeamID, Name,
+       COALESCE(TotalWins, 0)::integer AS TotalWins,
+       CAST(COALESCE(TotalSpeakerPoints, 0) AS TEXT) AS TotalSpeakerPoints,
+       CAST(COALESCE(AverageRank, 0) AS TEXT) AS AverageRank,
+       COALESCE(TotalTeamPoints, 0)::integer AS TotalTeamPoints
+FROM Teams
+WHERE TournamentID = $1
+ORDER BY TeamID
 `
 
-type GetSpeakerScoresByBallotRow struct {
-	Scoreid       int32          `json:"scoreid"`
-	Speakerid     int32          `json:"speakerid"`
-	Firstname     string         `json:"firstname"`
-	Lastname      string         `json:"lastname"`
-	Speakerrank   int32          `json:"speakerrank"`
-	Speakerpoints string         `json:"speakerpoints"`
-	Feedback      sql.NullString `json:"feedback"`
-	Teamid        int32          `json:"teamid"`
-	Teamname      string         `json:"teamname"`
+type GetStoredTeamTotalsRow struct {
+	Teamid             int32  `json:"teamid"`
+	Name               string `json:"name"`
+	Totalwins          int32  `json:"totalwins"`
+	Totalspeakerpoints string `json:"totalspeakerpoints"`
+	Averagerank        string `json:"averagerank"`
+	Totalteampoints    int32  `json:"totalteampoints"`
 }
 
-func (q *Queries) GetSpeakerScoresByBallot(ctx context.Context, ballotid int32) ([]GetSpeakerScoresByBallotRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSpeakerScoresByBallot, ballotid)
+func (q *Queries) GetStoredTeamTotals(ctx context.Context, tournamentid int32) ([]GetStoredTeamTotalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getStoredTeamTotals, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetSpeakerScoresByBallotRow{}
+	items := []GetStoredTeamTotalsRow{}
 	for rows.Next() {
-		var i GetSpeakerScoresByBallotRow
+		var i GetStoredTeamTotalsRow
 		if err := rows.Scan(
-			&i.Scoreid,
-			&i.Speakerid,
-			&i.Firstname,
-			&i.Lastname,
-			&i.Speakerrank,
-			&i.Speakerpoints,
-			&i.Feedback,
 			&i.Teamid,
-			&i.Teamname,
+			&i.Name,
+			&i.Totalwins,
+			&i.Totalspeakerpoints,
+			&i.Averagerank,
+			&i.Totalteampoints,
 		); err != nil {
 			return nil, err
 		}
@@ -2261,48 +4803,26 @@ func (q *Queries) GetSpeakerScoresByBallot(ctx context.Context, ballotid int32)
 	return items, nil
 }
 
-const getSpeakerScoresByBallotAndTeam = `-- name: GetSpeakerScoresByBallotAndTeam :many
-SELECT ss.ScoreID, ss.SpeakerID, ss.BallotID, ss.SpeakerRank,
-       ss.SpeakerPoints, ss.Feedback, tm.TeamID
-FROM SpeakerScores ss
-         JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-WHERE ss.BallotID = $1 AND tm.TeamID = $2
-ORDER BY ss.SpeakerRank
+const getStudentBreakCategoriesByTeam = `-- name: GetStudentBreakCategoriesByTeam :many
+SELECT sbc.StudentID, sbc.CategoryID
+FROM StudentBreakCategories sbc
+         JOIN TeamMembers tm ON sbc.StudentID = tm.StudentID
+         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN BreakCategories bc ON sbc.CategoryID = bc.CategoryID AND bc.TournamentID = t.TournamentID
+WHERE tm.TeamID = $1
+ORDER BY sbc.StudentID, sbc.CategoryID
 `
 
-type GetSpeakerScoresByBallotAndTeamParams struct {
-	Ballotid int32 `json:"ballotid"`
-	Teamid   int32 `json:"teamid"`
-}
-
-type GetSpeakerScoresByBallotAndTeamRow struct {
-	Scoreid       int32          `json:"scoreid"`
-	Speakerid     int32          `json:"speakerid"`
-	Ballotid      int32          `json:"ballotid"`
-	Speakerrank   int32          `json:"speakerrank"`
-	Speakerpoints string         `json:"speakerpoints"`
-	Feedback      sql.NullString `json:"feedback"`
-	Teamid        int32          `json:"teamid"`
-}
-
-func (q *Queries) GetSpeakerScoresByBallotAndTeam(ctx context.Context, arg GetSpeakerScoresByBallotAndTeamParams) ([]GetSpeakerScoresByBallotAndTeamRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSpeakerScoresByBallotAndTeam, arg.Ballotid, arg.Teamid)
+func (q *Queries) GetStudentBreakCategoriesByTeam(ctx context.Context, teamid int32) ([]Studentbreakcategory, error) {
+	rows, err := q.db.QueryContext(ctx, getStudentBreakCategoriesByTeam, teamid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetSpeakerScoresByBallotAndTeamRow{}
+	items := []Studentbreakcategory{}
 	for rows.Next() {
-		var i GetSpeakerScoresByBallotAndTeamRow
-		if err := rows.Scan(
-			&i.Scoreid,
-			&i.Speakerid,
-			&i.Ballotid,
-			&i.Speakerrank,
-			&i.Speakerpoints,
-			&i.Feedback,
-			&i.Teamid,
-		); err != nil {
+		var i Studentbreakcategory
+		if err := rows.Scan(&i.Studentid, &i.Categoryid); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -2583,12 +5103,79 @@ func (q *Queries) GetStudentTournamentStats(ctx context.Context, studentid int32
 	return i, err
 }
 
+const getTabTournament = `-- name: GetTabTournament :one
+SELECT TournamentID, Name, Motions, EndDate
+FROM Tournaments
+WHERE TournamentID = $1 AND deleted_at IS NULL
+`
+
+type GetTabTournamentRow struct {
+	Tournamentid int32                 `json:"tournamentid"`
+	Name         string                `json:"name"`
+	Motions      pqtype.NullRawMessage `json:"motions"`
+	Enddate      time.Time             `json:"enddate"`
+}
+
+func (q *Queries) GetTabTournament(ctx context.Context, tournamentid int32) (GetTabTournamentRow, error) {
+	row := q.db.QueryRowContext(ctx, getTabTournament, tournamentid)
+	var i GetTabTournamentRow
+	err := row.Scan(
+		&i.Tournamentid,
+		&i.Name,
+		&i.Motions,
+		&i.Enddate,
+	)
+	return i, err
+}
+
+const getTeamAffiliations = `-- name: GetTeamAffiliations :many
+SELECT tm.TeamID, tm.StudentID, s.SchoolID, t.NeedsAccessibleRoom
+FROM TeamMembers tm
+         JOIN Teams t ON tm.TeamID = t.TeamID
+         JOIN Students s ON tm.StudentID = s.StudentID
+WHERE t.TournamentID = $1
+`
+
+type GetTeamAffiliationsRow struct {
+	Teamid              int32 `json:"teamid"`
+	Studentid           int32 `json:"studentid"`
+	Schoolid            int32 `json:"schoolid"`
+	Needsaccessibleroom bool  `json:"needsaccessibleroom"`
+}
+
+func (q *Queries) GetTeamAffiliations(ctx context.Context, tournamentid int32) ([]GetTeamAffiliationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamAffiliations, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamAffiliationsRow{}
+	for rows.Next() {
+		var i GetTeamAffiliationsRow
+		if err := rows.Scan(
+			&i.Teamid,
+			&i.Studentid,
+			&i.Schoolid,
+			&i.Needsaccessibleroom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamAverageRank = `-- name: GetTeamAverageRank :one
 WITH speaker_ranks AS (
     SELECT ss.SpeakerRank
     FROM SpeakerScores ss
-    JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-    WHERE tm.TeamID = $1 AND ss.BallotID = $2
+    WHERE ss.TeamID = $1 AND ss.BallotID = $2
 )
 SELECT
     AVG(SpeakerRank)::FLOAT as AvgRank,
@@ -2615,20 +5202,166 @@ func (q *Queries) GetTeamAverageRank(ctx context.Context, arg GetTeamAverageRank
 	return i, err
 }
 
+const getTeamBallotResults = `-- name: GetTeamBallotResults :many
+SELECT d.DebateID, d.RoundNumber, d.IsEliminationRound,
+       COALESCE(r.RoomName, '') AS RoomName,
+       t.TeamID, t.Name AS TeamName,
+       (CASE t.TeamID WHEN d.Team1ID THEN 1 WHEN d.Team2ID THEN 2 WHEN d.Team3ID THEN 3 ELSE 4 END)::integer AS Side,
+       CAST(COALESCE(CASE t.TeamID
+                         WHEN d.Team1ID THEN b.Team1TotalScore
+                         WHEN d.Team2ID THEN b.Team2TotalScore
+                         WHEN d.Team3ID THEN b.Team3TotalScore
+                         ELSE b.Team4TotalScore
+                     END, 0) AS TEXT) AS TotalScore,
+       b.Verdict = t.Name AS Won,
+       CAST(COALESCE((SELECT AVG(ss.SpeakerRank)
+                      FROM SpeakerScores ss
+                      WHERE ss.BallotID = b.BallotID AND ss.TeamID = t.TeamID), 0) AS TEXT) AS SpeakerRank,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM TeamBreakCategories tbc WHERE tbc.TeamID = t.TeamID AND tbc.CategoryID = $2)) AS Eligible
+FROM Debates d
+JOIN Ballots b ON d.DebateID = b.DebateID
+JOIN Teams t ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+LEFT JOIN Rooms r ON d.RoomID = r.RoomID
+WHERE d.TournamentID = $1
+  AND b.RecordingStatus = 'Recorded'
+ORDER BY d.IsEliminationRound, d.RoundNumber, d.DebateID, Side
+`
+
+type GetTeamBallotResultsParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Column2      int32 `json:"column_2"`
+}
+
+type GetTeamBallotResultsRow struct {
+	Debateid           int32        `json:"debateid"`
+	Roundnumber        int32        `json:"roundnumber"`
+	Iseliminationround bool         `json:"iseliminationround"`
+	Roomname           string       `json:"roomname"`
+	Teamid             int32        `json:"teamid"`
+	Teamname           string       `json:"teamname"`
+	Side               int32        `json:"side"`
+	Totalscore         string       `json:"totalscore"`
+	Won                bool         `json:"won"`
+	Speakerrank        string       `json:"speakerrank"`
+	Eligible           sql.NullBool `json:"eligible"`
+}
+
+// One row per team of each recorded debate, read from the ballot itself
+// rather than the TeamScores the triggers keep. SpeakerRank is the average
+// rank of the team's speeches on the ballot, 0 without any. Eligible is
+// whether the team may break in category $2, always true for 0.
+func (q *Queries) GetTeamBallotResults(ctx context.Context, arg GetTeamBallotResultsParams) ([]GetTeamBallotResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamBallotResults, arg.Tournamentid, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamBallotResultsRow{}
+	for rows.Next() {
+		var i GetTeamBallotResultsRow
+		if err := rows.Scan(
+			&i.Debateid,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Roomname,
+			&i.Teamid,
+			&i.Teamname,
+			&i.Side,
+			&i.Totalscore,
+			&i.Won,
+			&i.Speakerrank,
+			&i.Eligible,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamBreakCategories = `-- name: GetTeamBreakCategories :many
+SELECT CategoryID
+FROM TeamBreakCategories
+WHERE TeamID = $1
+ORDER BY CategoryID
+`
+
+func (q *Queries) GetTeamBreakCategories(ctx context.Context, teamid int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamBreakCategories, teamid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var categoryid int32
+		if err := rows.Scan(&categoryid); err != nil {
+			return nil, err
+		}
+		items = append(items, categoryid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamBreakCategoriesByTournament = `-- name: GetTeamBreakCategoriesByTournament :many
+SELECT tbc.TeamID, tbc.CategoryID
+FROM TeamBreakCategories tbc
+         JOIN BreakCategories bc ON tbc.CategoryID = bc.CategoryID
+WHERE bc.TournamentID = $1
+`
+
+func (q *Queries) GetTeamBreakCategoriesByTournament(ctx context.Context, tournamentid int32) ([]Teambreakcategory, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamBreakCategoriesByTournament, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Teambreakcategory{}
+	for rows.Next() {
+		var i Teambreakcategory
+		if err := rows.Scan(&i.Teamid, &i.Categoryid); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamByID = `-- name: GetTeamByID :one
-SELECT t.TeamID, t.Name, t.TournamentID,
+SELECT t.TeamID, t.Name, t.TournamentID, t.NeedsAccessibleRoom,
        array_agg(tm.StudentID) as SpeakerIDs
 FROM Teams t
 LEFT JOIN TeamMembers tm ON t.TeamID = tm.TeamID
 WHERE t.TeamID = $1
-GROUP BY t.TeamID, t.Name, t.TournamentID
+GROUP BY t.TeamID, t.Name, t.TournamentID, t.NeedsAccessibleRoom
 `
 
 type GetTeamByIDRow struct {
-	Teamid       int32       `json:"teamid"`
-	Name         string      `json:"name"`
-	Tournamentid int32       `json:"tournamentid"`
-	Speakerids   interface{} `json:"speakerids"`
+	Teamid              int32       `json:"teamid"`
+	Name                string      `json:"name"`
+	Tournamentid        int32       `json:"tournamentid"`
+	Needsaccessibleroom bool        `json:"needsaccessibleroom"`
+	Speakerids          interface{} `json:"speakerids"`
 }
 
 func (q *Queries) GetTeamByID(ctx context.Context, teamid int32) (GetTeamByIDRow, error) {
@@ -2638,39 +5371,178 @@ func (q *Queries) GetTeamByID(ctx context.Context, teamid int32) (GetTeamByIDRow
 		&i.Teamid,
 		&i.Name,
 		&i.Tournamentid,
+		&i.Needsaccessibleroom,
 		&i.Speakerids,
 	)
 	return i, err
 }
 
-const getTeamMembers = `-- name: GetTeamMembers :many
-SELECT tm.TeamID, tm.StudentID, s.FirstName, s.LastName
-FROM TeamMembers tm
-JOIN Students s ON tm.StudentID = s.StudentID
-WHERE tm.TeamID = $1
+const getTeamMembers = `-- name: GetTeamMembers :many
+SELECT tm.TeamID, tm.StudentID, s.FirstName, s.LastName
+FROM TeamMembers tm
+JOIN Students s ON tm.StudentID = s.StudentID
+WHERE tm.TeamID = $1
+`
+
+type GetTeamMembersRow struct {
+	Teamid    int32  `json:"teamid"`
+	Studentid int32  `json:"studentid"`
+	Firstname string `json:"firstname"`
+	Lastname  string `json:"lastname"`
+}
+
+func (q *Queries) GetTeamMembers(ctx context.Context, teamid int32) ([]GetTeamMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamMembers, teamid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamMembersRow{}
+	for rows.Next() {
+		var i GetTeamMembersRow
+		if err := rows.Scan(
+			&i.Teamid,
+			&i.Studentid,
+			&i.Firstname,
+			&i.Lastname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamSchoolNames = `-- name: GetTeamSchoolNames :many
+SELECT DISTINCT tm.TeamID, sch.SchoolName
+FROM TeamMembers tm
+JOIN Teams t ON tm.TeamID = t.TeamID
+JOIN Students stu ON tm.StudentID = stu.StudentID
+JOIN Schools sch ON stu.SchoolID = sch.SchoolID
+WHERE t.TournamentID = $1
+ORDER BY tm.TeamID, sch.SchoolName
+`
+
+type GetTeamSchoolNamesRow struct {
+	Teamid     int32  `json:"teamid"`
+	Schoolname string `json:"schoolname"`
+}
+
+func (q *Queries) GetTeamSchoolNames(ctx context.Context, tournamentid int32) ([]GetTeamSchoolNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamSchoolNames, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamSchoolNamesRow{}
+	for rows.Next() {
+		var i GetTeamSchoolNamesRow
+		if err := rows.Scan(&i.Teamid, &i.Schoolname); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamSideCounts = `-- name: GetTeamSideCounts :many
+SELECT t.TeamID, t.Name,
+       COUNT(d.DebateID) FILTER (WHERE d.Team1ID = t.TeamID)::integer AS PropositionCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team2ID = t.TeamID)::integer AS OppositionCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team3ID = t.TeamID)::integer AS ClosingGovernmentCount,
+       COUNT(d.DebateID) FILTER (WHERE d.Team4ID = t.TeamID)::integer AS ClosingOppositionCount
+FROM Teams t
+LEFT JOIN Debates d ON t.TeamID IN (d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID)
+    AND d.TournamentID = t.TournamentID
+    AND d.IsEliminationRound = false
+WHERE t.TournamentID = $1
+GROUP BY t.TeamID, t.Name
+ORDER BY t.TeamID
+`
+
+type GetTeamSideCountsRow struct {
+	Teamid                 int32  `json:"teamid"`
+	Name                   string `json:"name"`
+	Propositioncount       int32  `json:"propositioncount"`
+	Oppositioncount        int32  `json:"oppositioncount"`
+	Closinggovernmentcount int32  `json:"closinggovernmentcount"`
+	Closingoppositioncount int32  `json:"closingoppositioncount"`
+}
+
+func (q *Queries) GetTeamSideCounts(ctx context.Context, tournamentid int32) ([]GetTeamSideCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamSideCounts, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamSideCountsRow{}
+	for rows.Next() {
+		var i GetTeamSideCountsRow
+		if err := rows.Scan(
+			&i.Teamid,
+			&i.Name,
+			&i.Propositioncount,
+			&i.Oppositioncount,
+			&i.Closinggovernmentcount,
+			&i.Closingoppositioncount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamStandingsForPairing = `-- name: GetTeamStandingsForPairing :many
+SELECT TeamID, Name, TotalWins, TotalSpeakerPoints, AverageRank, TotalTeamPoints
+FROM Teams
+WHERE TournamentID = $1
+ORDER BY TeamID
 `
 
-type GetTeamMembersRow struct {
-	Teamid    int32  `json:"teamid"`
-	Studentid int32  `json:"studentid"`
-	Firstname string `json:"firstname"`
-	Lastname  string `json:"lastname"`
+type GetTeamStandingsForPairingRow struct {
+	Teamid             int32          `json:"teamid"`
+	Name               string         `json:"name"`
+	Totalwins          sql.NullInt32  `json:"totalwins"`
+	Totalspeakerpoints sql.NullString `json:"totalspeakerpoints"`
+	Averagerank        sql.NullString `json:"averagerank"`
+	Totalteampoints    sql.NullInt32  `json:"totalteampoints"`
 }
 
-func (q *Queries) GetTeamMembers(ctx context.Context, teamid int32) ([]GetTeamMembersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTeamMembers, teamid)
+func (q *Queries) GetTeamStandingsForPairing(ctx context.Context, tournamentid int32) ([]GetTeamStandingsForPairingRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamStandingsForPairing, tournamentid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetTeamMembersRow{}
+	items := []GetTeamStandingsForPairingRow{}
 	for rows.Next() {
-		var i GetTeamMembersRow
+		var i GetTeamStandingsForPairingRow
 		if err := rows.Scan(
 			&i.Teamid,
-			&i.Studentid,
-			&i.Firstname,
-			&i.Lastname,
+			&i.Name,
+			&i.Totalwins,
+			&i.Totalspeakerpoints,
+			&i.Averagerank,
+			&i.Totalteampoints,
 		); err != nil {
 			return nil, err
 		}
@@ -2715,22 +5587,23 @@ SELECT t.TeamID, t.Name, t.TournamentID,
         WHERE ((d.Team1ID = t.TeamID AND b.Team1TotalScore > b.Team2TotalScore)
            OR (d.Team2ID = t.TeamID AND b.Team2TotalScore > b.Team1TotalScore))
            AND d.TournamentID = $1) as Wins,
-       l.Name as LeagueName
+       l.Name as LeagueName, t.NeedsAccessibleRoom
 FROM Teams t
 LEFT JOIN TeamMembers tm ON t.TeamID = tm.TeamID
 JOIN Tournaments tour ON t.TournamentID = tour.TournamentID
 JOIN Leagues l ON tour.LeagueID = l.LeagueID
 WHERE t.TournamentID = $1
-GROUP BY t.TeamID, t.Name, t.TournamentID, l.Name
+GROUP BY t.TeamID, t.Name, t.TournamentID, l.Name, t.NeedsAccessibleRoom
 `
 
 type GetTeamsByTournamentRow struct {
-	Teamid       int32       `json:"teamid"`
-	Name         string      `json:"name"`
-	Tournamentid int32       `json:"tournamentid"`
-	Speakerids   interface{} `json:"speakerids"`
-	Wins         int64       `json:"wins"`
-	Leaguename   string      `json:"leaguename"`
+	Teamid              int32       `json:"teamid"`
+	Name                string      `json:"name"`
+	Tournamentid        int32       `json:"tournamentid"`
+	Speakerids          interface{} `json:"speakerids"`
+	Wins                int64       `json:"wins"`
+	Leaguename          string      `json:"leaguename"`
+	Needsaccessibleroom bool        `json:"needsaccessibleroom"`
 }
 
 func (q *Queries) GetTeamsByTournament(ctx context.Context, tournamentid int32) ([]GetTeamsByTournamentRow, error) {
@@ -2749,6 +5622,7 @@ func (q *Queries) GetTeamsByTournament(ctx context.Context, tournamentid int32)
 			&i.Speakerids,
 			&i.Wins,
 			&i.Leaguename,
+			&i.Needsaccessibleroom,
 		); err != nil {
 			return nil, err
 		}
@@ -2763,73 +5637,65 @@ func (q *Queries) GetTeamsByTournament(ctx context.Context, tournamentid int32)
 	return items, nil
 }
 
-const getTopPerformingTeams = `-- name: GetTopPerformingTeams :many
-WITH ballot_check AS (
-    SELECT COUNT(*) = 0 AS all_recorded
-    FROM Ballots b
-             JOIN Debates d ON b.DebateID = d.DebateID
-    WHERE d.TournamentID = $1 AND d.IsEliminationRound = false AND b.RecordingStatus != 'Recorded'
-),
-     team_performance AS (
-         SELECT
-             t.TeamID,
-             t.Name,
-             t.TournamentID,
-             COALESCE(t.TotalWins, 0) as Wins,
-             COALESCE(t.TotalSpeakerPoints, 0) as TotalSpeakerPoints,
-             COALESCE(t.AverageRank, 99) as AverageRank  -- Use 99 as default for ranking purposes
-         FROM
-             Teams t
-         WHERE
-             t.TournamentID = $1
-           AND EXISTS (
-             -- Only include teams that have participated in debates
-             SELECT 1 FROM Debates d
-             WHERE (d.Team1ID = t.TeamID OR d.Team2ID = t.TeamID)
-               AND d.IsEliminationRound = false
-         )
-     )
-SELECT
-    tp.TeamID, tp.Name, tp.TournamentID, tp.Wins, tp.TotalSpeakerPoints, tp.AverageRank
-FROM
-    team_performance tp, ballot_check
-WHERE
-    ballot_check.all_recorded = true
-ORDER BY
-    tp.Wins DESC, tp.TotalSpeakerPoints DESC, tp.AverageRank ASC
-LIMIT $2
+const getTiebreakChains = `-- name: GetTiebreakChains :one
+SELECT CAST(COALESCE(t.TeamTiebreaks, tf.TeamTiebreaks) AS TEXT[]) AS TeamTiebreaks,
+       CAST(COALESCE(t.SpeakerTiebreaks, tf.SpeakerTiebreaks) AS TEXT[]) AS SpeakerTiebreaks
+FROM Tournaments t
+JOIN TournamentFormats tf ON t.FormatID = tf.FormatID
+WHERE t.TournamentID = $1
+`
+
+type GetTiebreakChainsRow struct {
+	Teamtiebreaks    []string `json:"teamtiebreaks"`
+	Speakertiebreaks []string `json:"speakertiebreaks"`
+}
+
+// The tournament's tiebreak chains, or its format's when it has none.
+func (q *Queries) GetTiebreakChains(ctx context.Context, tournamentid int32) (GetTiebreakChainsRow, error) {
+	row := q.db.QueryRowContext(ctx, getTiebreakChains, tournamentid)
+	var i GetTiebreakChainsRow
+	err := row.Scan(pq.Array(&i.Teamtiebreaks), pq.Array(&i.Speakertiebreaks))
+	return i, err
+}
+
+const getTournamentByes = `-- name: GetTournamentByes :many
+SELECT b.RoundNumber, b.TeamID, t.Name AS TeamName,
+       ($2::int = 0
+           OR EXISTS (SELECT 1 FROM BreakCategories bc WHERE bc.CategoryID = $2 AND bc.IsGeneral)
+           OR EXISTS (SELECT 1 FROM TeamBreakCategories tbc WHERE tbc.TeamID = t.TeamID AND tbc.CategoryID = $2)) AS Eligible
+FROM Byes b
+JOIN Teams t ON b.TeamID = t.TeamID
+WHERE b.TournamentID = $1
+ORDER BY b.RoundNumber, t.Name
 `
 
-type GetTopPerformingTeamsParams struct {
+type GetTournamentByesParams struct {
 	Tournamentid int32 `json:"tournamentid"`
-	Limit        int32 `json:"limit"`
+	Column2      int32 `json:"column_2"`
 }
 
-type GetTopPerformingTeamsRow struct {
-	Teamid             int32  `json:"teamid"`
-	Name               string `json:"name"`
-	Tournamentid       int32  `json:"tournamentid"`
-	Wins               int32  `json:"wins"`
-	Totalspeakerpoints string `json:"totalspeakerpoints"`
-	Averagerank        string `json:"averagerank"`
+type GetTournamentByesRow struct {
+	Roundnumber int32        `json:"roundnumber"`
+	Teamid      int32        `json:"teamid"`
+	Teamname    string       `json:"teamname"`
+	Eligible    sql.NullBool `json:"eligible"`
 }
 
-func (q *Queries) GetTopPerformingTeams(ctx context.Context, arg GetTopPerformingTeamsParams) ([]GetTopPerformingTeamsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTopPerformingTeams, arg.Tournamentid, arg.Limit)
+// Eligible is whether the team may break in category $2, always true for 0.
+func (q *Queries) GetTournamentByes(ctx context.Context, arg GetTournamentByesParams) ([]GetTournamentByesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTournamentByes, arg.Tournamentid, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetTopPerformingTeamsRow{}
+	items := []GetTournamentByesRow{}
 	for rows.Next() {
-		var i GetTopPerformingTeamsRow
+		var i GetTournamentByesRow
 		if err := rows.Scan(
+			&i.Roundnumber,
 			&i.Teamid,
-			&i.Name,
-			&i.Tournamentid,
-			&i.Wins,
-			&i.Totalspeakerpoints,
-			&i.Averagerank,
+			&i.Teamname,
+			&i.Eligible,
 		); err != nil {
 			return nil, err
 		}
@@ -2889,8 +5755,7 @@ WITH TeamDebateScores AS (
                           FROM SpeakerScores ss
                                    JOIN Ballots b ON ss.BallotID = b.BallotID
                                    JOIN Debates d ON b.DebateID = d.DebateID
-                                   JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-                          WHERE tm.TeamID = tds.TeamID
+                          WHERE ss.TeamID = tds.TeamID
                             AND d.IsEliminationRound = false
                             AND d.TournamentID = $1
                           GROUP BY b.DebateID
@@ -2938,344 +5803,44 @@ WITH TeamDebateScores AS (
          SELECT
              SchoolName,
              TeamCount,
-             TotalWins,
-             CAST(AverageRank AS text) AS AverageRank,
-             CAST(TotalPoints AS text) AS TotalPoints,
-             RANK() OVER (ORDER BY TotalWins DESC, TotalPoints DESC, AverageRank ASC) AS place
-         FROM SchoolAggregates
-     )
-SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place
-FROM (
-         -- Top 3 schools
-         SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place FROM RankedSchools WHERE place <= 3
-         UNION ALL
-         -- Filtered schools beyond top 3
-         SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place FROM RankedSchools
-         WHERE place > 3 AND ($4::text IS NULL OR SchoolName ILIKE '%' || $4 || '%')
-     ) combined
-ORDER BY place
-LIMIT $2 OFFSET $3
-`
-
-type GetTournamentSchoolRankingParams struct {
-	Tournamentid int32  `json:"tournamentid"`
-	Limit        int32  `json:"limit"`
-	Offset       int32  `json:"offset"`
-	Column4      string `json:"column_4"`
-}
-
-type GetTournamentSchoolRankingRow struct {
-	Schoolname  string `json:"schoolname"`
-	Teamcount   int64  `json:"teamcount"`
-	Totalwins   int64  `json:"totalwins"`
-	Averagerank string `json:"averagerank"`
-	Totalpoints string `json:"totalpoints"`
-	Place       int64  `json:"place"`
-}
-
-// Final output with pagination and search
-func (q *Queries) GetTournamentSchoolRanking(ctx context.Context, arg GetTournamentSchoolRankingParams) ([]GetTournamentSchoolRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTournamentSchoolRanking,
-		arg.Tournamentid,
-		arg.Limit,
-		arg.Offset,
-		arg.Column4,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []GetTournamentSchoolRankingRow{}
-	for rows.Next() {
-		var i GetTournamentSchoolRankingRow
-		if err := rows.Scan(
-			&i.Schoolname,
-			&i.Teamcount,
-			&i.Totalwins,
-			&i.Averagerank,
-			&i.Totalpoints,
-			&i.Place,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getTournamentSchoolRankingCount = `-- name: GetTournamentSchoolRankingCount :one
-WITH TeamSchools AS (
-    -- Get one school per team that participated in preliminary debates
-    SELECT DISTINCT s.SchoolID
-    FROM Teams t
-             JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-             JOIN Ballots b ON d.DebateID = b.DebateID
-        -- Join to get exactly one school per team
-             JOIN (
-        SELECT DISTINCT tm.TeamID, s.SchoolID
-        FROM TeamMembers tm
-                 JOIN Students stu ON tm.StudentID = stu.StudentID
-                 JOIN Schools s ON stu.SchoolID = s.SchoolID
-    ) s ON t.TeamID = s.TeamID
-             JOIN Tournaments tour ON tour.TournamentID = $1
-             LEFT JOIN Leagues l ON tour.LeagueID = l.LeagueID
-    WHERE t.TournamentID = $1
-      AND d.TournamentID = $1
-      AND d.IsEliminationRound = false
-      AND b.RecordingStatus = 'Recorded'
-      AND (l.Name != 'DAC' OR l.Name IS NULL)
-)
-SELECT COUNT(*) FROM TeamSchools
-`
-
-func (q *Queries) GetTournamentSchoolRankingCount(ctx context.Context, tournamentid int32) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getTournamentSchoolRankingCount, tournamentid)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const getTournamentStudentRanking = `-- name: GetTournamentStudentRanking :many
-WITH PrelimDebates AS (
-    -- First identify all preliminary debates for this tournament
-    SELECT d.DebateID
-    FROM Debates d
-    WHERE d.TournamentID = $1
-      AND (d.IsEliminationRound = false OR d.IsEliminationRound IS NULL)
-),
-     StudentScores AS (
-         -- Get speaker scores for each student in preliminary debates only
-         SELECT
-             s.StudentID,
-             s.FirstName || ' ' || s.LastName AS StudentName,
-             sch.SchoolName,
-             t.TeamID,
-             COUNT(DISTINCT CASE WHEN (
-                 (d.Team1ID = t.TeamID AND b.Verdict = (SELECT tm1.Name FROM Teams tm1 WHERE tm1.TeamID = d.Team1ID)) OR
-                 (d.Team2ID = t.TeamID AND b.Verdict = (SELECT tm2.Name FROM Teams tm2 WHERE tm2.TeamID = d.Team2ID))
-                 ) THEN d.DebateID END) AS Wins,
-             CAST(SUM(ss.SpeakerPoints) AS DECIMAL(10,2)) AS TotalPoints,
-             AVG(ss.SpeakerRank) AS AverageRank
-         FROM Students s
-                  JOIN TeamMembers tm ON s.StudentID = tm.StudentID
-                  JOIN Teams t ON tm.TeamID = t.TeamID
-                  JOIN Schools sch ON s.SchoolID = sch.SchoolID
-                  JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-                  JOIN PrelimDebates pd ON d.DebateID = pd.DebateID
-                  JOIN Ballots b ON d.DebateID = b.DebateID
-                  JOIN SpeakerScores ss ON b.BallotID = ss.BallotID AND s.StudentID = ss.SpeakerID
-         WHERE t.TournamentID = $1
-         GROUP BY s.StudentID, StudentName, sch.SchoolName, t.TeamID
-     ),
-     RankedStudents AS (
-         SELECT
-             StudentID,
-             StudentName,
-             SchoolName,
-             Wins,
-             TotalPoints,
-             AverageRank,
-             -- Always use ROW_NUMBER for unique sequential ranking
-             ROW_NUMBER() OVER (ORDER BY
-                 TotalPoints DESC,
-                 Wins DESC,
-                 AverageRank ASC) as place,
-             -- Use DENSE_RANK for the original ranking logic (used in filtering)
-             DENSE_RANK() OVER (ORDER BY
-                 TotalPoints DESC,
-                 Wins DESC,
-                 AverageRank ASC) as dense_place
-         FROM StudentScores
-     )
-SELECT
-    StudentID,
-    StudentName,
-    SchoolName,
-    Wins,
-    TotalPoints,
-    AverageRank,
-    place
-FROM RankedStudents
-WHERE
-   -- Handle NULL search and provide search criteria
-    ($4::text IS NULL) OR
-    (dense_place <= 3) OR
-    (StudentName ILIKE '%' || COALESCE($4, '') || '%') OR
-    (SchoolName ILIKE '%' || COALESCE($4, '') || '%')
-ORDER BY place
-LIMIT $2 OFFSET $3
-`
-
-type GetTournamentStudentRankingParams struct {
-	Tournamentid int32  `json:"tournamentid"`
-	Limit        int32  `json:"limit"`
-	Offset       int32  `json:"offset"`
-	Column4      string `json:"column_4"`
-}
-
-type GetTournamentStudentRankingRow struct {
-	Studentid   int32       `json:"studentid"`
-	Studentname interface{} `json:"studentname"`
-	Schoolname  string      `json:"schoolname"`
-	Wins        int64       `json:"wins"`
-	Totalpoints string      `json:"totalpoints"`
-	Averagerank float64     `json:"averagerank"`
-	Place       int64       `json:"place"`
-}
-
-func (q *Queries) GetTournamentStudentRanking(ctx context.Context, arg GetTournamentStudentRankingParams) ([]GetTournamentStudentRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTournamentStudentRanking,
-		arg.Tournamentid,
-		arg.Limit,
-		arg.Offset,
-		arg.Column4,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []GetTournamentStudentRankingRow{}
-	for rows.Next() {
-		var i GetTournamentStudentRankingRow
-		if err := rows.Scan(
-			&i.Studentid,
-			&i.Studentname,
-			&i.Schoolname,
-			&i.Wins,
-			&i.Totalpoints,
-			&i.Averagerank,
-			&i.Place,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getTournamentTeamsRanking = `-- name: GetTournamentTeamsRanking :many
-WITH TeamScoreData AS (
-    SELECT
-        t.TeamID,
-        t.Name AS TeamName,
-        -- Count wins from ballots where debate is not elimination round
-        COUNT(DISTINCT CASE WHEN
-                                ((d.Team1ID = t.TeamID AND b.Verdict = t1.Name) OR
-                                 (d.Team2ID = t.TeamID AND b.Verdict = t2.Name)) AND
-                                d.IsEliminationRound = false
-                                THEN d.DebateID END) AS Wins,
-        -- Sum speaker points from non-elimination debates
-        CAST(SUM(CASE WHEN d.IsEliminationRound = false THEN
-                          CASE
-                              WHEN d.Team1ID = t.TeamID THEN COALESCE(b.Team1TotalScore, 0)
-                              WHEN d.Team2ID = t.TeamID THEN COALESCE(b.Team2TotalScore, 0)
-                              ELSE 0
-                              END
-                      ELSE 0 END) AS DECIMAL(10,2)) AS TotalPoints,
-        -- Calculate average rank correctly - average of speaker averages per debate
-        CAST(AVG(
-            -- Get the average speaker rank within each debate
-                (SELECT AVG(ss.SpeakerRank)
-                 FROM SpeakerScores ss
-                          JOIN Ballots b2 ON ss.BallotID = b2.BallotID
-                          JOIN TeamMembers tm ON ss.SpeakerID = tm.StudentID
-                 WHERE b2.DebateID = d.DebateID
-                   AND tm.TeamID = t.TeamID
-                   AND d.IsEliminationRound = false)
-             ) AS DECIMAL(5,2)) AS AverageRank
-    FROM Teams t
-             JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-             JOIN Teams t1 ON d.Team1ID = t1.TeamID
-             JOIN Teams t2 ON d.Team2ID = t2.TeamID
-             LEFT JOIN Ballots b ON d.DebateID = b.DebateID
-    WHERE t.TournamentID = $1
-      AND d.TournamentID = $1
-      AND b.RecordingStatus = 'Recorded'
-    GROUP BY t.TeamID, t.Name
-),
-     TeamSchools AS (
-         SELECT
-             tsd.TeamID,
-             tsd.TeamName,
-             tsd.Wins,
-             tsd.TotalPoints,
-             COALESCE(tsd.AverageRank, 99) AS AverageRank,
-             ARRAY_AGG(DISTINCT s.SchoolName) FILTER (WHERE s.SchoolName IS NOT NULL) AS SchoolNames
-         FROM
-             TeamScoreData tsd
-                 LEFT JOIN TeamMembers tm ON tsd.TeamID = tm.TeamID
-                 LEFT JOIN Students stu ON tm.StudentID = stu.StudentID
-                 LEFT JOIN Schools s ON stu.SchoolID = s.SchoolID
-         GROUP BY
-             tsd.TeamID, tsd.TeamName, tsd.Wins, tsd.TotalPoints, tsd.AverageRank
-     ),
-     RankedTeams AS (
-         SELECT
-             TeamID,
-             TeamName,
-             SchoolNames,
-             Wins,
-             CAST(TotalPoints AS text) AS TotalPoints,
-             CAST(AverageRank AS text) AS AverageRank,
-             RANK() OVER (ORDER BY Wins DESC,
-                 TotalPoints DESC,
-                 AverageRank ASC) as place
-         FROM
-             TeamSchools
-     ),
-     TopThree AS (
-         SELECT teamid, teamname, schoolnames, wins, totalpoints, averagerank, place FROM RankedTeams WHERE place <= 3
-     ),
-     FilteredTeams AS (
-         SELECT teamid, teamname, schoolnames, wins, totalpoints, averagerank, place FROM RankedTeams
-         WHERE $4::text IS NULL
-            OR TeamName ILIKE '%' || $4 || '%'
-            OR EXISTS (
-             SELECT 1
-             FROM unnest(SchoolNames) AS school
-             WHERE school ILIKE '%' || $4 || '%'
-         )
+             TotalWins,
+             CAST(AverageRank AS text) AS AverageRank,
+             CAST(TotalPoints AS text) AS TotalPoints,
+             RANK() OVER (ORDER BY TotalWins DESC, TotalPoints DESC, AverageRank ASC) AS place
+         FROM SchoolAggregates
      )
-SELECT teamid, teamname, schoolnames, wins, totalpoints, averagerank, place FROM (
-                  SELECT teamid, teamname, schoolnames, wins, totalpoints, averagerank, place FROM TopThree
-                  UNION ALL
-                  SELECT teamid, teamname, schoolnames, wins, totalpoints, averagerank, place FROM FilteredTeams WHERE place > 3
-              ) combined
+SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place
+FROM (
+         -- Top 3 schools
+         SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place FROM RankedSchools WHERE place <= 3
+         UNION ALL
+         -- Filtered schools beyond top 3
+         SELECT schoolname, teamcount, totalwins, averagerank, totalpoints, place FROM RankedSchools
+         WHERE place > 3 AND ($4::text IS NULL OR SchoolName ILIKE '%' || $4 || '%')
+     ) combined
 ORDER BY place
 LIMIT $2 OFFSET $3
 `
 
-type GetTournamentTeamsRankingParams struct {
+type GetTournamentSchoolRankingParams struct {
 	Tournamentid int32  `json:"tournamentid"`
 	Limit        int32  `json:"limit"`
 	Offset       int32  `json:"offset"`
 	Column4      string `json:"column_4"`
 }
 
-type GetTournamentTeamsRankingRow struct {
-	Teamid      int32       `json:"teamid"`
-	Teamname    string      `json:"teamname"`
-	Schoolnames interface{} `json:"schoolnames"`
-	Wins        int64       `json:"wins"`
-	Totalpoints string      `json:"totalpoints"`
-	Averagerank string      `json:"averagerank"`
-	Place       int64       `json:"place"`
+type GetTournamentSchoolRankingRow struct {
+	Schoolname  string `json:"schoolname"`
+	Teamcount   int64  `json:"teamcount"`
+	Totalwins   int64  `json:"totalwins"`
+	Averagerank string `json:"averagerank"`
+	Totalpoints string `json:"totalpoints"`
+	Place       int64  `json:"place"`
 }
 
-func (q *Queries) GetTournamentTeamsRanking(ctx context.Context, arg GetTournamentTeamsRankingParams) ([]GetTournamentTeamsRankingRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTournamentTeamsRanking,
+// Final output with pagination and search
+func (q *Queries) GetTournamentSchoolRanking(ctx context.Context, arg GetTournamentSchoolRankingParams) ([]GetTournamentSchoolRankingRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTournamentSchoolRanking,
 		arg.Tournamentid,
 		arg.Limit,
 		arg.Offset,
@@ -3285,16 +5850,15 @@ func (q *Queries) GetTournamentTeamsRanking(ctx context.Context, arg GetTourname
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetTournamentTeamsRankingRow{}
+	items := []GetTournamentSchoolRankingRow{}
 	for rows.Next() {
-		var i GetTournamentTeamsRankingRow
+		var i GetTournamentSchoolRankingRow
 		if err := rows.Scan(
-			&i.Teamid,
-			&i.Teamname,
-			&i.Schoolnames,
-			&i.Wins,
-			&i.Totalpoints,
+			&i.Schoolname,
+			&i.Teamcount,
+			&i.Totalwins,
 			&i.Averagerank,
+			&i.Totalpoints,
 			&i.Place,
 		); err != nil {
 			return nil, err
@@ -3310,19 +5874,33 @@ func (q *Queries) GetTournamentTeamsRanking(ctx context.Context, arg GetTourname
 	return items, nil
 }
 
-const getTournamentTeamsRankingCount = `-- name: GetTournamentTeamsRankingCount :one
-SELECT COUNT(DISTINCT t.TeamID)
-FROM Teams t
-         JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
-         JOIN Ballots b ON d.DebateID = b.DebateID
-WHERE t.TournamentID = $1
-  AND d.TournamentID = $1
-  AND d.IsEliminationRound = false
-  AND b.RecordingStatus = 'Recorded'
+const getTournamentSchoolRankingCount = `-- name: GetTournamentSchoolRankingCount :one
+WITH TeamSchools AS (
+    -- Get one school per team that participated in preliminary debates
+    SELECT DISTINCT s.SchoolID
+    FROM Teams t
+             JOIN Debates d ON (t.TeamID = d.Team1ID OR t.TeamID = d.Team2ID)
+             JOIN Ballots b ON d.DebateID = b.DebateID
+        -- Join to get exactly one school per team
+             JOIN (
+        SELECT DISTINCT tm.TeamID, s.SchoolID
+        FROM TeamMembers tm
+                 JOIN Students stu ON tm.StudentID = stu.StudentID
+                 JOIN Schools s ON stu.SchoolID = s.SchoolID
+    ) s ON t.TeamID = s.TeamID
+             JOIN Tournaments tour ON tour.TournamentID = $1
+             LEFT JOIN Leagues l ON tour.LeagueID = l.LeagueID
+    WHERE t.TournamentID = $1
+      AND d.TournamentID = $1
+      AND d.IsEliminationRound = false
+      AND b.RecordingStatus = 'Recorded'
+      AND (l.Name != 'DAC' OR l.Name IS NULL)
+)
+SELECT COUNT(*) FROM TeamSchools
 `
 
-func (q *Queries) GetTournamentTeamsRankingCount(ctx context.Context, tournamentid int32) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getTournamentTeamsRankingCount, tournamentid)
+func (q *Queries) GetTournamentSchoolRankingCount(ctx context.Context, tournamentid int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTournamentSchoolRankingCount, tournamentid)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -3636,6 +6214,20 @@ func (q *Queries) MarkStudentFeedbackAsRead(ctx context.Context, arg MarkStudent
 	return err
 }
 
+const releaseRoundDraw = `-- name: ReleaseRoundDraw :exec
+INSERT INTO ReleasedDraws (RoundID, DebateID, RoomID, Team1ID, Team2ID, Team3ID, Team4ID, HeadJudgeID, JudgeIDs)
+SELECT d.RoundID, d.DebateID, d.RoomID, d.Team1ID, d.Team2ID, d.Team3ID, d.Team4ID,
+       (SELECT ja.JudgeID FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID AND ja.IsHeadJudge LIMIT 1),
+       ARRAY(SELECT ja.JudgeID FROM JudgeAssignments ja WHERE ja.DebateID = d.DebateID ORDER BY ja.JudgeID)
+FROM Debates d
+WHERE d.RoundID = $1
+`
+
+func (q *Queries) ReleaseRoundDraw(ctx context.Context, roundid int32) error {
+	_, err := q.db.ExecContext(ctx, releaseRoundDraw, roundid)
+	return err
+}
+
 const removeTeamMembers = `-- name: RemoveTeamMembers :exec
 DELETE FROM TeamMembers
 WHERE TeamID = $1
@@ -3655,6 +6247,10 @@ SET Team1TotalScore = $2,
     Team1Feedback = $6,
     Team2Feedback = $7,
     head_judge_submitted = $8,
+    Team3TotalScore = $9,
+    Team4TotalScore = $10,
+    Team3Feedback = $11,
+    Team4Feedback = $12,
     last_updated_at = CURRENT_TIMESTAMP
 WHERE BallotID = $1
 `
@@ -3668,6 +6264,10 @@ type ResetBallotAfterTeamChangeParams struct {
 	Team1feedback      sql.NullString `json:"team1feedback"`
 	Team2feedback      sql.NullString `json:"team2feedback"`
 	HeadJudgeSubmitted sql.NullBool   `json:"head_judge_submitted"`
+	Team3totalscore    sql.NullString `json:"team3totalscore"`
+	Team4totalscore    sql.NullString `json:"team4totalscore"`
+	Team3feedback      sql.NullString `json:"team3feedback"`
+	Team4feedback      sql.NullString `json:"team4feedback"`
 }
 
 func (q *Queries) ResetBallotAfterTeamChange(ctx context.Context, arg ResetBallotAfterTeamChangeParams) error {
@@ -3680,10 +6280,99 @@ func (q *Queries) ResetBallotAfterTeamChange(ctx context.Context, arg ResetBallo
 		arg.Team1feedback,
 		arg.Team2feedback,
 		arg.HeadJudgeSubmitted,
+		arg.Team3totalscore,
+		arg.Team4totalscore,
+		arg.Team3feedback,
+		arg.Team4feedback,
 	)
 	return err
 }
 
+const setDoubleEntryBallots = `-- name: SetDoubleEntryBallots :one
+UPDATE Tournaments
+SET DoubleEntryBallots = $2
+WHERE TournamentID = $1 AND deleted_at IS NULL
+RETURNING DoubleEntryBallots
+`
+
+type SetDoubleEntryBallotsParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Doubleentryballots bool  `json:"doubleentryballots"`
+}
+
+func (q *Queries) SetDoubleEntryBallots(ctx context.Context, arg SetDoubleEntryBallotsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, setDoubleEntryBallots, arg.Tournamentid, arg.Doubleentryballots)
+	var doubleentryballots bool
+	err := row.Scan(&doubleentryballots)
+	return doubleentryballots, err
+}
+
+const setEliminationBracketNodeDebate = `-- name: SetEliminationBracketNodeDebate :exec
+UPDATE EliminationBracketNodes
+SET DebateID = $2
+WHERE NodeID = $1
+`
+
+type SetEliminationBracketNodeDebateParams struct {
+	Nodeid   int32         `json:"nodeid"`
+	Debateid sql.NullInt32 `json:"debateid"`
+}
+
+func (q *Queries) SetEliminationBracketNodeDebate(ctx context.Context, arg SetEliminationBracketNodeDebateParams) error {
+	_, err := q.db.ExecContext(ctx, setEliminationBracketNodeDebate, arg.Nodeid, arg.Debateid)
+	return err
+}
+
+const setEliminationBracketNodeTeam1 = `-- name: SetEliminationBracketNodeTeam1 :exec
+UPDATE EliminationBracketNodes
+SET Team1ID = $2, Team1Seed = $3
+WHERE NodeID = $1
+`
+
+type SetEliminationBracketNodeTeam1Params struct {
+	Nodeid    int32         `json:"nodeid"`
+	Team1id   sql.NullInt32 `json:"team1id"`
+	Team1seed sql.NullInt32 `json:"team1seed"`
+}
+
+func (q *Queries) SetEliminationBracketNodeTeam1(ctx context.Context, arg SetEliminationBracketNodeTeam1Params) error {
+	_, err := q.db.ExecContext(ctx, setEliminationBracketNodeTeam1, arg.Nodeid, arg.Team1id, arg.Team1seed)
+	return err
+}
+
+const setEliminationBracketNodeTeam2 = `-- name: SetEliminationBracketNodeTeam2 :exec
+UPDATE EliminationBracketNodes
+SET Team2ID = $2, Team2Seed = $3
+WHERE NodeID = $1
+`
+
+type SetEliminationBracketNodeTeam2Params struct {
+	Nodeid    int32         `json:"nodeid"`
+	Team2id   sql.NullInt32 `json:"team2id"`
+	Team2seed sql.NullInt32 `json:"team2seed"`
+}
+
+func (q *Queries) SetEliminationBracketNodeTeam2(ctx context.Context, arg SetEliminationBracketNodeTeam2Params) error {
+	_, err := q.db.ExecContext(ctx, setEliminationBracketNodeTeam2, arg.Nodeid, arg.Team2id, arg.Team2seed)
+	return err
+}
+
+const setEliminationBracketNodeWinner = `-- name: SetEliminationBracketNodeWinner :exec
+UPDATE EliminationBracketNodes
+SET WinnerTeamID = $2
+WHERE NodeID = $1
+`
+
+type SetEliminationBracketNodeWinnerParams struct {
+	Nodeid       int32         `json:"nodeid"`
+	Winnerteamid sql.NullInt32 `json:"winnerteamid"`
+}
+
+func (q *Queries) SetEliminationBracketNodeWinner(ctx context.Context, arg SetEliminationBracketNodeWinnerParams) error {
+	_, err := q.db.ExecContext(ctx, setEliminationBracketNodeWinner, arg.Nodeid, arg.Winnerteamid)
+	return err
+}
+
 const setRankingVisibility = `-- name: SetRankingVisibility :exec
 INSERT INTO RankingVisibility (TournamentID, RankingType, VisibleTo, IsVisible)
 VALUES ($1, $2, $3, $4)
@@ -3708,6 +6397,62 @@ func (q *Queries) SetRankingVisibility(ctx context.Context, arg SetRankingVisibi
 	return err
 }
 
+const setRoundDraw = `-- name: SetRoundDraw :exec
+UPDATE Rounds
+SET DrawSeed = $2, DrawInput = $3
+WHERE RoundID = $1
+`
+
+type SetRoundDrawParams struct {
+	Roundid   int32                 `json:"roundid"`
+	Drawseed  sql.NullInt64         `json:"drawseed"`
+	Drawinput pqtype.NullRawMessage `json:"drawinput"`
+}
+
+func (q *Queries) SetRoundDraw(ctx context.Context, arg SetRoundDrawParams) error {
+	_, err := q.db.ExecContext(ctx, setRoundDraw, arg.Roundid, arg.Drawseed, arg.Drawinput)
+	return err
+}
+
+const setRoundReleased = `-- name: SetRoundReleased :exec
+UPDATE Rounds
+SET ReleasedAt = CURRENT_TIMESTAMP
+WHERE RoundID = $1
+`
+
+func (q *Queries) SetRoundReleased(ctx context.Context, roundid int32) error {
+	_, err := q.db.ExecContext(ctx, setRoundReleased, roundid)
+	return err
+}
+
+const setTeamTotals = `-- name: SetTeamTotals :exec
+UPDATE Teams
+SET TotalWins = $2,
+    TotalSpeakerPoints = $3,
+    AverageRank = $4,
+    TotalTeamPoints = $5
+WHERE TeamID = $1
+`
+
+type SetTeamTotalsParams struct {
+	Teamid             int32          `json:"teamid"`
+	Totalwins          sql.NullInt32  `json:"totalwins"`
+	Totalspeakerpoints sql.NullString `json:"totalspeakerpoints"`
+	Averagerank        sql.NullString `json:"averagerank"`
+	Totalteampoints    sql.NullInt32  `json:"totalteampoints"`
+}
+
+func (q *Queries) SetTeamTotals(ctx context.Context, arg SetTeamTotalsParams) error {
+	_, err := q.db.ExecContext(ctx, setTeamTotals,
+		arg.Teamid,
+		arg.Totalwins,
+		arg.Totalspeakerpoints,
+		arg.Averagerank,
+		arg.Totalteampoints,
+	)
+	return err
+}
+
 const teamHasDebates = `-- name: TeamHasDebates :one
 SELECT EXISTS (
     SELECT 1 FROM Debates
@@ -3726,7 +6471,8 @@ const updateBallot = `-- name: UpdateBallot :exec
 UPDATE Ballots
 SET Team1TotalScore = $2, Team2TotalScore = $3, RecordingStatus = $4, Verdict = $5,
     Team1Feedback = $6, Team2Feedback = $7, last_updated_by = $8,
-    last_updated_at = CURRENT_TIMESTAMP, head_judge_submitted = $9
+    last_updated_at = CURRENT_TIMESTAMP, head_judge_submitted = $9,
+    Team3TotalScore = $10, Team4TotalScore = $11, Team3Feedback = $12, Team4Feedback = $13
 WHERE BallotID = $1
 `
 
@@ -3740,6 +6486,10 @@ type UpdateBallotParams struct {
 	Team2feedback      sql.NullString `json:"team2feedback"`
 	LastUpdatedBy      sql.NullInt32  `json:"last_updated_by"`
 	HeadJudgeSubmitted sql.NullBool   `json:"head_judge_submitted"`
+	Team3totalscore    sql.NullString `json:"team3totalscore"`
+	Team4totalscore    sql.NullString `json:"team4totalscore"`
+	Team3feedback      sql.NullString `json:"team3feedback"`
+	Team4feedback      sql.NullString `json:"team4feedback"`
 }
 
 func (q *Queries) UpdateBallot(ctx context.Context, arg UpdateBallotParams) error {
@@ -3753,7 +6503,89 @@ func (q *Queries) UpdateBallot(ctx context.Context, arg UpdateBallotParams) erro
 		arg.Team2feedback,
 		arg.LastUpdatedBy,
 		arg.HeadJudgeSubmitted,
+		arg.Team3totalscore,
+		arg.Team4totalscore,
+		arg.Team3feedback,
+		arg.Team4feedback,
+	)
+	return err
+}
+
+const updateBreakCategory = `-- name: UpdateBreakCategory :one
+UPDATE BreakCategories
+SET Name = $3, BreakSize = $4, Priority = $5, IsGeneral = $6
+WHERE CategoryID = $1 AND TournamentID = $2
+RETURNING categoryid, tournamentid, name, breaksize, priority, isgeneral
+`
+
+type UpdateBreakCategoryParams struct {
+	Categoryid   int32  `json:"categoryid"`
+	Tournamentid int32  `json:"tournamentid"`
+	Name         string `json:"name"`
+	Breaksize    int32  `json:"breaksize"`
+	Priority     int32  `json:"priority"`
+	Isgeneral    bool   `json:"isgeneral"`
+}
+
+func (q *Queries) UpdateBreakCategory(ctx context.Context, arg UpdateBreakCategoryParams) (Breakcategory, error) {
+	row := q.db.QueryRowContext(ctx, updateBreakCategory,
+		arg.Categoryid,
+		arg.Tournamentid,
+		arg.Name,
+		arg.Breaksize,
+		arg.Priority,
+		arg.Isgeneral,
+	)
+	var i Breakcategory
+	err := row.Scan(
+		&i.Categoryid,
+		&i.Tournamentid,
+		&i.Name,
+		&i.Breaksize,
+		&i.Priority,
+		&i.Isgeneral,
+	)
+	return i, err
+}
+
+const updateBuilding = `-- name: UpdateBuilding :one
+UPDATE Buildings
+SET Name = $2, Address = $3
+WHERE BuildingID = $1
+RETURNING buildingid, tournamentid, name, address
+`
+
+type UpdateBuildingParams struct {
+	Buildingid int32  `json:"buildingid"`
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+}
+
+func (q *Queries) UpdateBuilding(ctx context.Context, arg UpdateBuildingParams) (Building, error) {
+	row := q.db.QueryRowContext(ctx, updateBuilding, arg.Buildingid, arg.Name, arg.Address)
+	var i Building
+	err := row.Scan(
+		&i.Buildingid,
+		&i.Tournamentid,
+		&i.Name,
+		&i.Address,
 	)
+	return i, err
+}
+
+const updateJudgeMaxTimesJudgingTeam = `-- name: UpdateJudgeMaxTimesJudgingTeam :exec
+UPDATE Volunteers
+SET MaxTimesJudgingTeam = $2
+WHERE UserID = $1
+`
+
+type UpdateJudgeMaxTimesJudgingTeamParams struct {
+	Userid              int32         `json:"userid"`
+	Maxtimesjudgingteam sql.NullInt32 `json:"maxtimesjudgingteam"`
+}
+
+func (q *Queries) UpdateJudgeMaxTimesJudgingTeam(ctx context.Context, arg UpdateJudgeMaxTimesJudgingTeamParams) error {
+	_, err := q.db.ExecContext(ctx, updateJudgeMaxTimesJudgingTeam, arg.Userid, arg.Maxtimesjudgingteam)
 	return err
 }
 
@@ -3805,18 +6637,26 @@ func (q *Queries) UpdateJudgeRoom(ctx context.Context, arg UpdateJudgeRoomParams
 
 const updatePairing = `-- name: UpdatePairing :exec
 UPDATE Debates
-SET Team1ID = $2, Team2ID = $3
+SET Team1ID = $2, Team2ID = $3, Team3ID = $4, Team4ID = $5
 WHERE DebateID = $1
 `
 
 type UpdatePairingParams struct {
-	Debateid int32 `json:"debateid"`
-	Team1id  int32 `json:"team1id"`
-	Team2id  int32 `json:"team2id"`
+	Debateid int32         `json:"debateid"`
+	Team1id  int32         `json:"team1id"`
+	Team2id  int32         `json:"team2id"`
+	Team3id  sql.NullInt32 `json:"team3id"`
+	Team4id  sql.NullInt32 `json:"team4id"`
 }
 
 func (q *Queries) UpdatePairing(ctx context.Context, arg UpdatePairingParams) error {
-	_, err := q.db.ExecContext(ctx, updatePairing, arg.Debateid, arg.Team1id, arg.Team2id)
+	_, err := q.db.ExecContext(ctx, updatePairing,
+		arg.Debateid,
+		arg.Team1id,
+		arg.Team2id,
+		arg.Team3id,
+		arg.Team4id,
+	)
 	return err
 }
 
@@ -3824,7 +6664,7 @@ const updateRoom = `-- name: UpdateRoom :one
 UPDATE Rooms
 SET RoomName = $2
 WHERE RoomID = $1
-RETURNING roomid, roomname, location, capacity, tournamentid
+RETURNING roomid, roomname, location, capacity, tournamentid, buildingid, isaccessible
 `
 
 type UpdateRoomParams struct {
@@ -3841,33 +6681,46 @@ func (q *Queries) UpdateRoom(ctx context.Context, arg UpdateRoomParams) (Room, e
 		&i.Location,
 		&i.Capacity,
 		&i.Tournamentid,
+		&i.Buildingid,
+		&i.Isaccessible,
 	)
 	return i, err
 }
 
-const updateSpeakerScore = `-- name: UpdateSpeakerScore :exec
-UPDATE SpeakerScores
-SET SpeakerRank = $3, SpeakerPoints = $4, Feedback = $5
-WHERE BallotID = $1 AND SpeakerID = $2
+const updateRoomVenue = `-- name: UpdateRoomVenue :one
+UPDATE Rooms
+SET Location = $2, Capacity = $3, BuildingID = $4, IsAccessible = $5
+WHERE RoomID = $1
+RETURNING roomid, roomname, location, capacity, tournamentid, buildingid, isaccessible
 `
 
-type UpdateSpeakerScoreParams struct {
-	Ballotid      int32          `json:"ballotid"`
-	Speakerid     int32          `json:"speakerid"`
-	Speakerrank   int32          `json:"speakerrank"`
-	Speakerpoints string         `json:"speakerpoints"`
-	Feedback      sql.NullString `json:"feedback"`
+type UpdateRoomVenueParams struct {
+	Roomid       int32         `json:"roomid"`
+	Location     string        `json:"location"`
+	Capacity     int32         `json:"capacity"`
+	Buildingid   sql.NullInt32 `json:"buildingid"`
+	Isaccessible bool          `json:"isaccessible"`
 }
 
-func (q *Queries) UpdateSpeakerScore(ctx context.Context, arg UpdateSpeakerScoreParams) error {
-	_, err := q.db.ExecContext(ctx, updateSpeakerScore,
-		arg.Ballotid,
-		arg.Speakerid,
-		arg.Speakerrank,
-		arg.Speakerpoints,
-		arg.Feedback,
+func (q *Queries) UpdateRoomVenue(ctx context.Context, arg UpdateRoomVenueParams) (Room, error) {
+	row := q.db.QueryRowContext(ctx, updateRoomVenue,
+		arg.Roomid,
+		arg.Location,
+		arg.Capacity,
+		arg.Buildingid,
+		arg.Isaccessible,
 	)
-	return err
+	var i Room
+	err := row.Scan(
+		&i.Roomid,
+		&i.Roomname,
+		&i.Location,
+		&i.Capacity,
+		&i.Tournamentid,
+		&i.Buildingid,
+		&i.Isaccessible,
+	)
+	return i, err
 }
 
 const updateTeam = `-- name: UpdateTeam :exec
@@ -3886,6 +6739,22 @@ func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) error {
 	return err
 }
 
+const updateTeamNeedsAccessibleRoom = `-- name: UpdateTeamNeedsAccessibleRoom :exec
+UPDATE Teams
+SET NeedsAccessibleRoom = $2
+WHERE TeamID = $1
+`
+
+type UpdateTeamNeedsAccessibleRoomParams struct {
+	Teamid              int32 `json:"teamid"`
+	Needsaccessibleroom bool  `json:"needsaccessibleroom"`
+}
+
+func (q *Queries) UpdateTeamNeedsAccessibleRoom(ctx context.Context, arg UpdateTeamNeedsAccessibleRoomParams) error {
+	_, err := q.db.ExecContext(ctx, updateTeamNeedsAccessibleRoom, arg.Teamid, arg.Needsaccessibleroom)
+	return err
+}
+
 const updateTeamScore = `-- name: UpdateTeamScore :exec
 UPDATE TeamScores
 SET TotalScore = $3, IsElimination = $4
@@ -3967,3 +6836,119 @@ func (q *Queries) UpdateTeamStats(ctx context.Context, arg UpdateTeamStatsParams
 	_, err := q.db.ExecContext(ctx, updateTeamStats, arg.Teamid, arg.Tournamentid)
 	return err
 }
+
+const upsertJudgeBallot = `-- name: UpsertJudgeBallot :one
+INSERT INTO JudgeBallots (BallotID, JudgeID, Team1TotalScore, Team1Feedback, Team2TotalScore, Team2Feedback,
+                          Team3TotalScore, Team3Feedback, Team4TotalScore, Team4Feedback, Verdict)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (BallotID, JudgeID) DO UPDATE SET
+    Team1TotalScore = EXCLUDED.Team1TotalScore,
+    Team1Feedback = EXCLUDED.Team1Feedback,
+    Team2TotalScore = EXCLUDED.Team2TotalScore,
+    Team2Feedback = EXCLUDED.Team2Feedback,
+    Team3TotalScore = EXCLUDED.Team3TotalScore,
+    Team3Feedback = EXCLUDED.Team3Feedback,
+    Team4TotalScore = EXCLUDED.Team4TotalScore,
+    Team4Feedback = EXCLUDED.Team4Feedback,
+    Verdict = EXCLUDED.Verdict,
+    SubmittedAt = CURRENT_TIMESTAMP
+RETURNING JudgeBallotID
+`
+
+type UpsertJudgeBallotParams struct {
+	Ballotid        int32          `json:"ballotid"`
+	Judgeid         int32          `json:"judgeid"`
+	Team1totalscore sql.NullString `json:"team1totalscore"`
+	Team1feedback   sql.NullString `json:"team1feedback"`
+	Team2totalscore sql.NullString `json:"team2totalscore"`
+	Team2feedback   sql.NullString `json:"team2feedback"`
+	Team3totalscore sql.NullString `json:"team3totalscore"`
+	Team3feedback   sql.NullString `json:"team3feedback"`
+	Team4totalscore sql.NullString `json:"team4totalscore"`
+	Team4feedback   sql.NullString `json:"team4feedback"`
+	Verdict         string         `json:"verdict"`
+}
+
+func (q *Queries) UpsertJudgeBallot(ctx context.Context, arg UpsertJudgeBallotParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, upsertJudgeBallot,
+		arg.Ballotid,
+		arg.Judgeid,
+		arg.Team1totalscore,
+		arg.Team1feedback,
+		arg.Team2totalscore,
+		arg.Team2feedback,
+		arg.Team3totalscore,
+		arg.Team3feedback,
+		arg.Team4totalscore,
+		arg.Team4feedback,
+		arg.Verdict,
+	)
+	var judgeballotid int32
+	err := row.Scan(&judgeballotid)
+	return judgeballotid, err
+}
+
+const upsertSpeakerScore = `-- name: UpsertSpeakerScore :exec
+INSERT INTO SpeakerScores (BallotID, TeamID, Position, SpeakerID, SpeakerRank, SpeakerPoints, Feedback, ReplyPoints)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (BallotID, TeamID, Position)
+    DO UPDATE SET SpeakerID = EXCLUDED.SpeakerID, SpeakerRank = EXCLUDED.SpeakerRank, SpeakerPoints = EXCLUDED.SpeakerPoints,
+                  Feedback = EXCLUDED.Feedback, ReplyPoints = EXCLUDED.ReplyPoints
+`
+
+type UpsertSpeakerScoreParams struct {
+	Ballotid      int32          `json:"ballotid"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
+	Speakerid     int32          `json:"speakerid"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Replypoints   string         `json:"replypoints"`
+}
+
+func (q *Queries) UpsertSpeakerScore(ctx context.Context, arg UpsertSpeakerScoreParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSpeakerScore,
+		arg.Ballotid,
+		arg.Teamid,
+		arg.Position,
+		arg.Speakerid,
+		arg.Speakerrank,
+		arg.Speakerpoints,
+		arg.Feedback,
+		arg.Replypoints,
+	)
+	return err
+}
+
+const upsertTeamScore = `-- name: UpsertTeamScore :exec
+INSERT INTO TeamScores (TeamID, DebateID, TotalScore, Rank, TeamPoints, IsElimination)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (TeamID, DebateID)
+DO UPDATE SET
+    TotalScore = EXCLUDED.TotalScore,
+    Rank = EXCLUDED.Rank,
+    TeamPoints = EXCLUDED.TeamPoints,
+    IsElimination = EXCLUDED.IsElimination
+`
+
+type UpsertTeamScoreParams struct {
+	Teamid        sql.NullInt32  `json:"teamid"`
+	Debateid      sql.NullInt32  `json:"debateid"`
+	Totalscore    sql.NullString `json:"totalscore"`
+	Rank          sql.NullInt32  `json:"rank"`
+	Teampoints    int32          `json:"teampoints"`
+	Iselimination sql.NullBool   `json:"iselimination"`
+}
+
+func (q *Queries) UpsertTeamScore(ctx context.Context, arg UpsertTeamScoreParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTeamScore,
+		arg.Teamid,
+		arg.Debateid,
+		arg.Totalscore,
+		arg.Rank,
+		arg.Teampoints,
+		arg.Iselimination,
+	)
+	return err
+}
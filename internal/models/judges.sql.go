@@ -88,6 +88,43 @@ func (q *Queries) CheckHeadJudgeExists(ctx context.Context, arg CheckHeadJudgeEx
 	return exists, err
 }
 
+const checkInJudge = `-- name: CheckInJudge :one
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable, CheckedInAt)
+VALUES ($1, $2, $3, $4, TRUE, CURRENT_TIMESTAMP)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = TRUE,
+    CheckedInAt = CURRENT_TIMESTAMP,
+    MarkedAbsentAt = NULL
+RETURNING tournamentid, judgeid, roundnumber, iseliminationround, isavailable, checkedinat, markedabsentat
+`
+
+type CheckInJudgeParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Judgeid            int32 `json:"judgeid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+func (q *Queries) CheckInJudge(ctx context.Context, arg CheckInJudgeParams) (Judgeavailability, error) {
+	row := q.db.QueryRowContext(ctx, checkInJudge,
+		arg.Tournamentid,
+		arg.Judgeid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+	)
+	var i Judgeavailability
+	err := row.Scan(
+		&i.Tournamentid,
+		&i.Judgeid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Isavailable,
+		&i.Checkedinat,
+		&i.Markedabsentat,
+	)
+	return i, err
+}
+
 const demoteCurrentHeadJudge = `-- name: DemoteCurrentHeadJudge :exec
 UPDATE judgeassignments ja
 SET isheadjudge = false
@@ -230,6 +267,47 @@ func (q *Queries) GetJudgeAssignment(ctx context.Context, arg GetJudgeAssignment
 	return i, err
 }
 
+const getJudgeAvailabilityByTournament = `-- name: GetJudgeAvailabilityByTournament :many
+SELECT tournamentid, judgeid, roundnumber, iseliminationround, isavailable, checkedinat, markedabsentat FROM JudgeAvailability
+WHERE TournamentID = $1 AND IsEliminationRound = $2
+`
+
+type GetJudgeAvailabilityByTournamentParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+func (q *Queries) GetJudgeAvailabilityByTournament(ctx context.Context, arg GetJudgeAvailabilityByTournamentParams) ([]Judgeavailability, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeAvailabilityByTournament, arg.Tournamentid, arg.Iseliminationround)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Judgeavailability{}
+	for rows.Next() {
+		var i Judgeavailability
+		if err := rows.Scan(
+			&i.Tournamentid,
+			&i.Judgeid,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Isavailable,
+			&i.Checkedinat,
+			&i.Markedabsentat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getJudgeInRoom = `-- name: GetJudgeInRoom :one
 SELECT ja.assignmentid, ja.tournamentid, ja.judgeid, ja.debateid, ja.roundnumber, ja.iselimination, ja.isheadjudge, d.roomid as roomid, d.debateid
 FROM judgeassignments ja
@@ -282,6 +360,248 @@ func (q *Queries) GetJudgeInRoom(ctx context.Context, arg GetJudgeInRoomParams)
 	return i, err
 }
 
+const getJudgeRoster = `-- name: GetJudgeRoster :many
+SELECT DISTINCT ON (u.UserID)
+    u.UserID AS JudgeID, u.Name, v.iDebateVolunteerID,
+    COALESCE(av.IsAvailable, TRUE) AS IsAvailable, av.CheckedInAt, av.MarkedAbsentAt,
+    d.DebateID, d.RoomID, r.RoomName, ja.IsHeadJudge
+FROM Users u
+JOIN Volunteers v ON u.UserID = v.UserID
+JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+LEFT JOIN JudgeAvailability av ON av.TournamentID = ti.TournamentID AND av.JudgeID = u.UserID
+    AND av.RoundNumber = $1 AND av.IsEliminationRound = $2
+LEFT JOIN JudgeAssignments ja ON ja.TournamentID = ti.TournamentID AND ja.JudgeID = u.UserID
+    AND ja.RoundNumber = $1 AND ja.IsElimination = $2
+LEFT JOIN Debates d ON d.DebateID = ja.DebateID
+LEFT JOIN Rooms r ON r.RoomID = d.RoomID
+WHERE ti.TournamentID = $3
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
+ORDER BY u.UserID
+`
+
+type GetJudgeRosterParams struct {
+	RoundNumber        int32 `json:"round_number"`
+	IsEliminationRound bool  `json:"is_elimination_round"`
+	TournamentID       int32 `json:"tournament_id"`
+}
+
+type GetJudgeRosterRow struct {
+	Judgeid            int32          `json:"judgeid"`
+	Name               string         `json:"name"`
+	Idebatevolunteerid sql.NullString `json:"idebatevolunteerid"`
+	Isavailable        bool           `json:"isavailable"`
+	Checkedinat        sql.NullTime   `json:"checkedinat"`
+	Markedabsentat     sql.NullTime   `json:"markedabsentat"`
+	Debateid           sql.NullInt32  `json:"debateid"`
+	Roomid             sql.NullInt32  `json:"roomid"`
+	Roomname           sql.NullString `json:"roomname"`
+	Isheadjudge        sql.NullBool   `json:"isheadjudge"`
+}
+
+func (q *Queries) GetJudgeRoster(ctx context.Context, arg GetJudgeRosterParams) ([]GetJudgeRosterRow, error) {
+	rows, err := q.db.QueryContext(ctx, getJudgeRoster, arg.RoundNumber, arg.IsEliminationRound, arg.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetJudgeRosterRow{}
+	for rows.Next() {
+		var i GetJudgeRosterRow
+		if err := rows.Scan(
+			&i.Judgeid,
+			&i.Name,
+			&i.Idebatevolunteerid,
+			&i.Isavailable,
+			&i.Checkedinat,
+			&i.Markedabsentat,
+			&i.Debateid,
+			&i.Roomid,
+			&i.Roomname,
+			&i.Isheadjudge,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isTournamentJudge = `-- name: IsTournamentJudge :one
+SELECT EXISTS (
+    SELECT 1
+    FROM Volunteers v
+    JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+    WHERE v.UserID = $2
+      AND ti.TournamentID = $1
+      AND ti.Status = 'accepted'
+      AND ti.InviteeRole = 'volunteer'
+)
+`
+
+type IsTournamentJudgeParams struct {
+	Tournamentid int32 `json:"tournamentid"`
+	Userid       int32 `json:"userid"`
+}
+
+func (q *Queries) IsTournamentJudge(ctx context.Context, arg IsTournamentJudgeParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isTournamentJudge, arg.Tournamentid, arg.Userid)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const markJudgeAbsent = `-- name: MarkJudgeAbsent :one
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable, MarkedAbsentAt)
+VALUES ($1, $2, $3, $4, FALSE, CURRENT_TIMESTAMP)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = FALSE,
+    CheckedInAt = NULL,
+    MarkedAbsentAt = CURRENT_TIMESTAMP
+RETURNING tournamentid, judgeid, roundnumber, iseliminationround, isavailable, checkedinat, markedabsentat
+`
+
+type MarkJudgeAbsentParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Judgeid            int32 `json:"judgeid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+func (q *Queries) MarkJudgeAbsent(ctx context.Context, arg MarkJudgeAbsentParams) (Judgeavailability, error) {
+	row := q.db.QueryRowContext(ctx, markJudgeAbsent,
+		arg.Tournamentid,
+		arg.Judgeid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+	)
+	var i Judgeavailability
+	err := row.Scan(
+		&i.Tournamentid,
+		&i.Judgeid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Isavailable,
+		&i.Checkedinat,
+		&i.Markedabsentat,
+	)
+	return i, err
+}
+
+const replaceReleasedDrawJudge = `-- name: ReplaceReleasedDrawJudge :exec
+UPDATE ReleasedDraws rd
+SET JudgeIDs = ARRAY(
+        SELECT DISTINCT j
+        FROM unnest(array_append(array_remove(rd.JudgeIDs, $1::int), NULLIF($2::int, 0))) AS j
+        WHERE j IS NOT NULL
+        ORDER BY j
+    ),
+    HeadJudgeID = CASE WHEN rd.HeadJudgeID = $1::int THEN NULLIF($3::int, 0) ELSE rd.HeadJudgeID END
+FROM Rounds r
+WHERE rd.DebateID = $4::int AND rd.RoundID = r.RoundID AND r.ReleasedAt IS NOT NULL
+`
+
+type ReplaceReleasedDrawJudgeParams struct {
+	JudgeID       int32 `json:"judge_id"`
+	ReplacementID int32 `json:"replacement_id"`
+	HeadJudgeID   int32 `json:"head_judge_id"`
+	DebateID      int32 `json:"debate_id"`
+}
+
+// Moves the seat of a judge taken off a released debate to their replacement,
+// or drops it when there is none (replacement 0). When the judge chaired the
+// debate, the head judge given takes the chair.
+func (q *Queries) ReplaceReleasedDrawJudge(ctx context.Context, arg ReplaceReleasedDrawJudgeParams) error {
+	_, err := q.db.ExecContext(ctx, replaceReleasedDrawJudge,
+		arg.JudgeID,
+		arg.ReplacementID,
+		arg.HeadJudgeID,
+		arg.DebateID,
+	)
+	return err
+}
+
+const setBallotJudge = `-- name: SetBallotJudge :exec
+UPDATE Ballots
+SET JudgeID = $2,
+    last_updated_by = $2,
+    last_updated_at = CURRENT_TIMESTAMP
+WHERE DebateID = $1
+`
+
+type SetBallotJudgeParams struct {
+	Debateid int32 `json:"debateid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+func (q *Queries) SetBallotJudge(ctx context.Context, arg SetBallotJudgeParams) error {
+	_, err := q.db.ExecContext(ctx, setBallotJudge, arg.Debateid, arg.Judgeid)
+	return err
+}
+
+const setHeadJudge = `-- name: SetHeadJudge :exec
+UPDATE JudgeAssignments
+SET IsHeadJudge = TRUE
+WHERE DebateID = $1 AND JudgeID = $2
+`
+
+type SetHeadJudgeParams struct {
+	Debateid int32 `json:"debateid"`
+	Judgeid  int32 `json:"judgeid"`
+}
+
+func (q *Queries) SetHeadJudge(ctx context.Context, arg SetHeadJudgeParams) error {
+	_, err := q.db.ExecContext(ctx, setHeadJudge, arg.Debateid, arg.Judgeid)
+	return err
+}
+
+const setJudgeAvailability = `-- name: SetJudgeAvailability :one
+INSERT INTO JudgeAvailability (TournamentID, JudgeID, RoundNumber, IsEliminationRound, IsAvailable)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (TournamentID, JudgeID, RoundNumber, IsEliminationRound) DO UPDATE
+SET IsAvailable = EXCLUDED.IsAvailable,
+    CheckedInAt = CASE WHEN EXCLUDED.IsAvailable THEN JudgeAvailability.CheckedInAt END,
+    MarkedAbsentAt = CASE WHEN EXCLUDED.IsAvailable THEN NULL ELSE JudgeAvailability.MarkedAbsentAt END
+RETURNING tournamentid, judgeid, roundnumber, iseliminationround, isavailable, checkedinat, markedabsentat
+`
+
+type SetJudgeAvailabilityParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Judgeid            int32 `json:"judgeid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+	Isavailable        bool  `json:"isavailable"`
+}
+
+// Declaring a judge unavailable undoes their check-in; declaring them
+// available again clears an absence.
+func (q *Queries) SetJudgeAvailability(ctx context.Context, arg SetJudgeAvailabilityParams) (Judgeavailability, error) {
+	row := q.db.QueryRowContext(ctx, setJudgeAvailability,
+		arg.Tournamentid,
+		arg.Judgeid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+		arg.Isavailable,
+	)
+	var i Judgeavailability
+	err := row.Scan(
+		&i.Tournamentid,
+		&i.Judgeid,
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Isavailable,
+		&i.Checkedinat,
+		&i.Markedabsentat,
+	)
+	return i, err
+}
+
 const swapJudges = `-- name: SwapJudges :exec
 WITH judge_a_info AS (
     SELECT ja.debateid as a_debateid, ja.isheadjudge as a_isheadjudge
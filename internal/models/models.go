@@ -25,6 +25,56 @@ type Ballot struct {
 	LastUpdatedBy      sql.NullInt32  `json:"last_updated_by"`
 	LastUpdatedAt      sql.NullTime   `json:"last_updated_at"`
 	HeadJudgeSubmitted sql.NullBool   `json:"head_judge_submitted"`
+	Team3totalscore    sql.NullString `json:"team3totalscore"`
+	Team3feedback      sql.NullString `json:"team3feedback"`
+	Team4totalscore    sql.NullString `json:"team4totalscore"`
+	Team4feedback      sql.NullString `json:"team4feedback"`
+}
+
+type Ballotentry struct {
+	Entryid     int32           `json:"entryid"`
+	Ballotid    int32           `json:"ballotid"`
+	Judgeid     int32           `json:"judgeid"`
+	Enteredby   int32           `json:"enteredby"`
+	Snapshot    json.RawMessage `json:"snapshot"`
+	Enteredat   time.Time       `json:"enteredat"`
+	Confirmedat sql.NullTime    `json:"confirmedat"`
+}
+
+type Ballotversion struct {
+	Versionid     int32           `json:"versionid"`
+	Ballotid      int32           `json:"ballotid"`
+	Versionnumber int32           `json:"versionnumber"`
+	Judgeid       int32           `json:"judgeid"`
+	Snapshot      json.RawMessage `json:"snapshot"`
+	Createdby     int32           `json:"createdby"`
+	Createdat     time.Time       `json:"createdat"`
+	Reason        sql.NullString  `json:"reason"`
+	Revertedfrom  sql.NullInt32   `json:"revertedfrom"`
+}
+
+type Breakcategory struct {
+	Categoryid   int32  `json:"categoryid"`
+	Tournamentid int32  `json:"tournamentid"`
+	Name         string `json:"name"`
+	Breaksize    int32  `json:"breaksize"`
+	Priority     int32  `json:"priority"`
+	Isgeneral    bool   `json:"isgeneral"`
+}
+
+type Building struct {
+	Buildingid   int32  `json:"buildingid"`
+	Tournamentid int32  `json:"tournamentid"`
+	Name         string `json:"name"`
+	Address      string `json:"address"`
+}
+
+type Bye struct {
+	Byeid        int32 `json:"byeid"`
+	Tournamentid int32 `json:"tournamentid"`
+	Roundid      int32 `json:"roundid"`
+	Roundnumber  int32 `json:"roundnumber"`
+	Teamid       int32 `json:"teamid"`
 }
 
 type Communication struct {
@@ -42,17 +92,20 @@ type Countrycode struct {
 }
 
 type Debate struct {
-	Debateid           int32        `json:"debateid"`
-	Roundid            int32        `json:"roundid"`
-	Roundnumber        int32        `json:"roundnumber"`
-	Iseliminationround bool         `json:"iseliminationround"`
-	Tournamentid       int32        `json:"tournamentid"`
-	Team1id            int32        `json:"team1id"`
-	Team2id            int32        `json:"team2id"`
-	Starttime          time.Time    `json:"starttime"`
-	Endtime            sql.NullTime `json:"endtime"`
-	Roomid             int32        `json:"roomid"`
-	Status             string       `json:"status"`
+	Debateid           int32          `json:"debateid"`
+	Roundid            int32          `json:"roundid"`
+	Roundnumber        int32          `json:"roundnumber"`
+	Iseliminationround bool           `json:"iseliminationround"`
+	Tournamentid       int32          `json:"tournamentid"`
+	Team1id            int32          `json:"team1id"`
+	Team2id            int32          `json:"team2id"`
+	Starttime          time.Time      `json:"starttime"`
+	Endtime            sql.NullTime   `json:"endtime"`
+	Roomid             int32          `json:"roomid"`
+	Status             string         `json:"status"`
+	Team3id            sql.NullInt32  `json:"team3id"`
+	Team4id            sql.NullInt32  `json:"team4id"`
+	Panelquality       sql.NullString `json:"panelquality"`
 }
 
 type Debatejudge struct {
@@ -60,6 +113,22 @@ type Debatejudge struct {
 	Judgeid  int32 `json:"judgeid"`
 }
 
+type Eliminationbracketnode struct {
+	Nodeid       int32         `json:"nodeid"`
+	Tournamentid int32         `json:"tournamentid"`
+	Roundnumber  int32         `json:"roundnumber"`
+	Position     int32         `json:"position"`
+	Parentnodeid sql.NullInt32 `json:"parentnodeid"`
+	Parentslot   sql.NullInt32 `json:"parentslot"`
+	Team1id      sql.NullInt32 `json:"team1id"`
+	Team1seed    sql.NullInt32 `json:"team1seed"`
+	Team2id      sql.NullInt32 `json:"team2id"`
+	Team2seed    sql.NullInt32 `json:"team2seed"`
+	Debateid     sql.NullInt32 `json:"debateid"`
+	Winnerteamid sql.NullInt32 `json:"winnerteamid"`
+	Categoryid   sql.NullInt32 `json:"categoryid"`
+}
+
 type Judgeassignment struct {
 	Assignmentid  int32 `json:"assignmentid"`
 	Tournamentid  int32 `json:"tournamentid"`
@@ -70,6 +139,43 @@ type Judgeassignment struct {
 	Isheadjudge   bool  `json:"isheadjudge"`
 }
 
+type Judgeavailability struct {
+	Tournamentid       int32        `json:"tournamentid"`
+	Judgeid            int32        `json:"judgeid"`
+	Roundnumber        int32        `json:"roundnumber"`
+	Iseliminationround bool         `json:"iseliminationround"`
+	Isavailable        bool         `json:"isavailable"`
+	Checkedinat        sql.NullTime `json:"checkedinat"`
+	Markedabsentat     sql.NullTime `json:"markedabsentat"`
+}
+
+type Judgeballot struct {
+	Judgeballotid   int32          `json:"judgeballotid"`
+	Ballotid        int32          `json:"ballotid"`
+	Judgeid         int32          `json:"judgeid"`
+	Team1totalscore sql.NullString `json:"team1totalscore"`
+	Team1feedback   sql.NullString `json:"team1feedback"`
+	Team2totalscore sql.NullString `json:"team2totalscore"`
+	Team2feedback   sql.NullString `json:"team2feedback"`
+	Team3totalscore sql.NullString `json:"team3totalscore"`
+	Team3feedback   sql.NullString `json:"team3feedback"`
+	Team4totalscore sql.NullString `json:"team4totalscore"`
+	Team4feedback   sql.NullString `json:"team4feedback"`
+	Verdict         string         `json:"verdict"`
+	Submittedat     sql.NullTime   `json:"submittedat"`
+}
+
+type Judgeconflict struct {
+	Conflictid   int32          `json:"conflictid"`
+	Judgeid      int32          `json:"judgeid"`
+	Conflicttype string         `json:"conflicttype"`
+	Schoolid     sql.NullInt32  `json:"schoolid"`
+	Teamid       sql.NullInt32  `json:"teamid"`
+	Studentid    sql.NullInt32  `json:"studentid"`
+	Reason       sql.NullString `json:"reason"`
+	Createdat    sql.NullTime   `json:"createdat"`
+}
+
 type Judgefeedback struct {
 	Feedbackid             int32           `json:"feedbackid"`
 	Judgeid                sql.NullInt32   `json:"judgeid"`
@@ -86,6 +192,18 @@ type Judgefeedback struct {
 	Createdat              sql.NullTime    `json:"createdat"`
 }
 
+type Judgespeakerscore struct {
+	Scoreid       int32          `json:"scoreid"`
+	Judgeballotid int32          `json:"judgeballotid"`
+	Speakerid     int32          `json:"speakerid"`
+	Speakerrank   int32          `json:"speakerrank"`
+	Speakerpoints string         `json:"speakerpoints"`
+	Feedback      sql.NullString `json:"feedback"`
+	Replypoints   string         `json:"replypoints"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
+}
+
 type League struct {
 	Leagueid   int32           `json:"leagueid"`
 	Name       string          `json:"name"`
@@ -94,6 +212,30 @@ type League struct {
 	DeletedAt  sql.NullTime    `json:"deleted_at"`
 }
 
+type Motionbank struct {
+	Motionid   int32         `json:"motionid"`
+	Text       string        `json:"text"`
+	Infoslide  string        `json:"infoslide"`
+	Difficulty string        `json:"difficulty"`
+	Createdby  sql.NullInt32 `json:"createdby"`
+	Createdat  time.Time     `json:"createdat"`
+}
+
+type Motionrelease struct {
+	Releaseid          int32         `json:"releaseid"`
+	Tournamentid       int32         `json:"tournamentid"`
+	Roundnumber        int32         `json:"roundnumber"`
+	Iseliminationround bool          `json:"iseliminationround"`
+	Releasedat         time.Time     `json:"releasedat"`
+	Prependsat         time.Time     `json:"prependsat"`
+	Releasedby         sql.NullInt32 `json:"releasedby"`
+}
+
+type Motiontag struct {
+	Motionid int32  `json:"motionid"`
+	Tag      string `json:"tag"`
+}
+
 type Notification struct {
 	Notificationid int32          `json:"notificationid"`
 	Userid         int32          `json:"userid"`
@@ -124,6 +266,15 @@ type Pairinghistory struct {
 	Iselimination bool  `json:"iselimination"`
 }
 
+type Publishedtab struct {
+	Tabid        int32           `json:"tabid"`
+	Tournamentid int32           `json:"tournamentid"`
+	Version      int32           `json:"version"`
+	Snapshot     json.RawMessage `json:"snapshot"`
+	Publishedby  int32           `json:"publishedby"`
+	Publishedat  time.Time       `json:"publishedat"`
+}
+
 type Rankingvisibility struct {
 	Tournamentid int32        `json:"tournamentid"`
 	Rankingtype  string       `json:"rankingtype"`
@@ -133,27 +284,60 @@ type Rankingvisibility struct {
 	Updatedat    sql.NullTime `json:"updatedat"`
 }
 
+type Releaseddraw struct {
+	Roundid     int32         `json:"roundid"`
+	Debateid    int32         `json:"debateid"`
+	Roomid      int32         `json:"roomid"`
+	Team1id     int32         `json:"team1id"`
+	Team2id     int32         `json:"team2id"`
+	Team3id     sql.NullInt32 `json:"team3id"`
+	Team4id     sql.NullInt32 `json:"team4id"`
+	Headjudgeid sql.NullInt32 `json:"headjudgeid"`
+	Judgeids    []int32       `json:"judgeids"`
+}
+
 type Room struct {
 	Roomid       int32         `json:"roomid"`
 	Roomname     string        `json:"roomname"`
 	Location     string        `json:"location"`
 	Capacity     int32         `json:"capacity"`
 	Tournamentid sql.NullInt32 `json:"tournamentid"`
+	Buildingid   sql.NullInt32 `json:"buildingid"`
+	Isaccessible bool          `json:"isaccessible"`
+}
+
+type Roomavailability struct {
+	Availabilityid int32     `json:"availabilityid"`
+	Roomid         int32     `json:"roomid"`
+	Starttime      time.Time `json:"starttime"`
+	Endtime        time.Time `json:"endtime"`
 }
 
 type Round struct {
-	Roundid            int32 `json:"roundid"`
+	Roundid            int32                 `json:"roundid"`
+	Tournamentid       int32                 `json:"tournamentid"`
+	Roundnumber        int32                 `json:"roundnumber"`
+	Iseliminationround bool                  `json:"iseliminationround"`
+	Releasedat         sql.NullTime          `json:"releasedat"`
+	Drawseed           sql.NullInt64         `json:"drawseed"`
+	Drawinput          pqtype.NullRawMessage `json:"drawinput"`
+}
+
+type Roundmotion struct {
 	Tournamentid       int32 `json:"tournamentid"`
 	Roundnumber        int32 `json:"roundnumber"`
 	Iseliminationround bool  `json:"iseliminationround"`
+	Motionid           int32 `json:"motionid"`
 }
 
 type Schedule struct {
-	Scheduleid    int32     `json:"scheduleid"`
-	Tournamentid  int32     `json:"tournamentid"`
-	Roundid       int32     `json:"roundid"`
-	Debateid      int32     `json:"debateid"`
-	Scheduledtime time.Time `json:"scheduledtime"`
+	Scheduleid         int32     `json:"scheduleid"`
+	Tournamentid       int32     `json:"tournamentid"`
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Starttime          time.Time `json:"starttime"`
+	Endtime            time.Time `json:"endtime"`
+	Ispinned           bool      `json:"ispinned"`
 }
 
 type School struct {
@@ -198,6 +382,9 @@ type Speakerscore struct {
 	Speakerpoints string         `json:"speakerpoints"`
 	Feedback      sql.NullString `json:"feedback"`
 	Isread        sql.NullBool   `json:"isread"`
+	Replypoints   string         `json:"replypoints"`
+	Teamid        int32          `json:"teamid"`
+	Position      int32          `json:"position"`
 }
 
 type Student struct {
@@ -214,6 +401,11 @@ type Student struct {
 	Userid           int32          `json:"userid"`
 }
 
+type Studentbreakcategory struct {
+	Studentid  int32 `json:"studentid"`
+	Categoryid int32 `json:"categoryid"`
+}
+
 type Studenttransfer struct {
 	Transferid   int32          `json:"transferid"`
 	Studentid    int32          `json:"studentid"`
@@ -224,12 +416,19 @@ type Studenttransfer struct {
 }
 
 type Team struct {
-	Teamid             int32          `json:"teamid"`
-	Name               string         `json:"name"`
-	Tournamentid       int32          `json:"tournamentid"`
-	Totalwins          sql.NullInt32  `json:"totalwins"`
-	Totalspeakerpoints sql.NullString `json:"totalspeakerpoints"`
-	Averagerank        sql.NullString `json:"averagerank"`
+	Teamid              int32          `json:"teamid"`
+	Name                string         `json:"name"`
+	Tournamentid        int32          `json:"tournamentid"`
+	Totalwins           sql.NullInt32  `json:"totalwins"`
+	Totalspeakerpoints  sql.NullString `json:"totalspeakerpoints"`
+	Averagerank         sql.NullString `json:"averagerank"`
+	Totalteampoints     sql.NullInt32  `json:"totalteampoints"`
+	Needsaccessibleroom bool           `json:"needsaccessibleroom"`
+}
+
+type Teambreakcategory struct {
+	Teamid     int32 `json:"teamid"`
+	Categoryid int32 `json:"categoryid"`
 }
 
 type Teammember struct {
@@ -244,6 +443,7 @@ type Teamscore struct {
 	Totalscore    sql.NullString `json:"totalscore"`
 	Rank          sql.NullInt32  `json:"rank"`
 	Iselimination sql.NullBool   `json:"iselimination"`
+	Teampoints    int32          `json:"teampoints"`
 }
 
 type Tournament struct {
@@ -268,6 +468,15 @@ type Tournament struct {
 	YesterdayTotalCount          sql.NullInt32         `json:"yesterday_total_count"`
 	YesterdayUpcomingCount       sql.NullInt32         `json:"yesterday_upcoming_count"`
 	YesterdayActiveDebatersCount sql.NullInt32         `json:"yesterday_active_debaters_count"`
+	Doubleentryballots           bool                  `json:"doubleentryballots"`
+	Teamtiebreaks                []string              `json:"teamtiebreaks"`
+	Speakertiebreaks             []string              `json:"speakertiebreaks"`
+	Roundminutes                 int32                 `json:"roundminutes"`
+	Breakminutes                 int32                 `json:"breakminutes"`
+	Daystarttime                 time.Time             `json:"daystarttime"`
+	Dayendtime                   time.Time             `json:"dayendtime"`
+	Lunchstarttime               sql.NullTime          `json:"lunchstarttime"`
+	Lunchminutes                 int32                 `json:"lunchminutes"`
 }
 
 type Tournamentexpense struct {
@@ -289,11 +498,25 @@ type Tournamentexpense struct {
 }
 
 type Tournamentformat struct {
-	Formatid        int32          `json:"formatid"`
-	Formatname      string         `json:"formatname"`
-	Description     sql.NullString `json:"description"`
-	Speakersperteam int32          `json:"speakersperteam"`
-	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Formatid           int32          `json:"formatid"`
+	Formatname         string         `json:"formatname"`
+	Description        sql.NullString `json:"description"`
+	Speakersperteam    int32          `json:"speakersperteam"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Teamsperdebate     int32          `json:"teamsperdebate"`
+	Minspeakerpoints   string         `json:"minspeakerpoints"`
+	Maxspeakerpoints   string         `json:"maxspeakerpoints"`
+	Speakerpointstep   string         `json:"speakerpointstep"`
+	Allowlowpointwins  bool           `json:"allowlowpointwins"`
+	Replyspeeches      bool           `json:"replyspeeches"`
+	Minreplypoints     string         `json:"minreplypoints"`
+	Maxreplypoints     string         `json:"maxreplypoints"`
+	Tiehandling        string         `json:"tiehandling"`
+	Teamtiebreaks      []string       `json:"teamtiebreaks"`
+	Speakertiebreaks   []string       `json:"speakertiebreaks"`
+	Ironspeeches       string         `json:"ironspeeches"`
+	Substitutespeeches string         `json:"substitutespeeches"`
+	Byeresult          string         `json:"byeresult"`
 }
 
 type Tournamentinvitation struct {
@@ -364,6 +587,7 @@ type Volunteer struct {
 	YesterdayRoundsJudged        sql.NullInt32  `json:"yesterday_rounds_judged"`
 	YesterdayTournamentsAttended sql.NullInt32  `json:"yesterday_tournaments_attended"`
 	YesterdayUpcomingTournaments sql.NullInt32  `json:"yesterday_upcoming_tournaments"`
+	Maxtimesjudgingteam          sql.NullInt32  `json:"maxtimesjudgingteam"`
 }
 
 type Volunteerrating struct {
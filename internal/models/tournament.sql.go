@@ -15,6 +15,22 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const addBankMotionTag = `-- name: AddBankMotionTag :exec
+INSERT INTO MotionTags (MotionID, Tag)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddBankMotionTagParams struct {
+	Motionid int32  `json:"motionid"`
+	Tag      string `json:"tag"`
+}
+
+func (q *Queries) AddBankMotionTag(ctx context.Context, arg AddBankMotionTagParams) error {
+	_, err := q.db.ExecContext(ctx, addBankMotionTag, arg.Motionid, arg.Tag)
+	return err
+}
+
 const bulkUpdateInvitationStatus = `-- name: BulkUpdateInvitationStatus :many
 UPDATE TournamentInvitations
 SET Status = $2, updated_at = CURRENT_TIMESTAMP
@@ -59,6 +75,50 @@ func (q *Queries) BulkUpdateInvitationStatus(ctx context.Context, arg BulkUpdate
 	return items, nil
 }
 
+const countBankMotionUses = `-- name: CountBankMotionUses :one
+SELECT COUNT(*) FROM RoundMotions
+WHERE MotionID = $1
+`
+
+func (q *Queries) CountBankMotionUses(ctx context.Context, motionid int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBankMotionUses, motionid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createBankMotion = `-- name: CreateBankMotion :one
+INSERT INTO MotionBank (Text, InfoSlide, Difficulty, CreatedBy)
+VALUES ($1, $2, $3, $4)
+RETURNING motionid, text, infoslide, difficulty, createdby, createdat
+`
+
+type CreateBankMotionParams struct {
+	Text       string        `json:"text"`
+	Infoslide  string        `json:"infoslide"`
+	Difficulty string        `json:"difficulty"`
+	Createdby  sql.NullInt32 `json:"createdby"`
+}
+
+func (q *Queries) CreateBankMotion(ctx context.Context, arg CreateBankMotionParams) (Motionbank, error) {
+	row := q.db.QueryRowContext(ctx, createBankMotion,
+		arg.Text,
+		arg.Infoslide,
+		arg.Difficulty,
+		arg.Createdby,
+	)
+	var i Motionbank
+	err := row.Scan(
+		&i.Motionid,
+		&i.Text,
+		&i.Infoslide,
+		&i.Difficulty,
+		&i.Createdby,
+		&i.Createdat,
+	)
+	return i, err
+}
+
 const createInvitation = `-- name: CreateInvitation :one
 INSERT INTO TournamentInvitations (TournamentID, InviteeID, InviteeRole, Status)
 VALUES ($1, $2, $3, $4)
@@ -119,6 +179,74 @@ func (q *Queries) CreateLeague(ctx context.Context, arg CreateLeagueParams) (Lea
 	return i, err
 }
 
+const createMotionRelease = `-- name: CreateMotionRelease :one
+INSERT INTO MotionReleases (TournamentID, RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt, ReleasedBy)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (TournamentID, RoundNumber, IsEliminationRound) DO NOTHING
+RETURNING RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt
+`
+
+type CreateMotionReleaseParams struct {
+	Tournamentid       int32         `json:"tournamentid"`
+	Roundnumber        int32         `json:"roundnumber"`
+	Iseliminationround bool          `json:"iseliminationround"`
+	Releasedat         time.Time     `json:"releasedat"`
+	Prependsat         time.Time     `json:"prependsat"`
+	Releasedby         sql.NullInt32 `json:"releasedby"`
+}
+
+type CreateMotionReleaseRow struct {
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Releasedat         time.Time `json:"releasedat"`
+	Prependsat         time.Time `json:"prependsat"`
+}
+
+func (q *Queries) CreateMotionRelease(ctx context.Context, arg CreateMotionReleaseParams) (CreateMotionReleaseRow, error) {
+	row := q.db.QueryRowContext(ctx, createMotionRelease,
+		arg.Tournamentid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+		arg.Releasedat,
+		arg.Prependsat,
+		arg.Releasedby,
+	)
+	var i CreateMotionReleaseRow
+	err := row.Scan(
+		&i.Roundnumber,
+		&i.Iseliminationround,
+		&i.Releasedat,
+		&i.Prependsat,
+	)
+	return i, err
+}
+
+const createRoundSchedule = `-- name: CreateRoundSchedule :exec
+INSERT INTO Schedules (TournamentID, RoundNumber, IsEliminationRound, StartTime, EndTime, IsPinned)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateRoundScheduleParams struct {
+	Tournamentid       int32     `json:"tournamentid"`
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Starttime          time.Time `json:"starttime"`
+	Endtime            time.Time `json:"endtime"`
+	Ispinned           bool      `json:"ispinned"`
+}
+
+func (q *Queries) CreateRoundSchedule(ctx context.Context, arg CreateRoundScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, createRoundSchedule,
+		arg.Tournamentid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+		arg.Starttime,
+		arg.Endtime,
+		arg.Ispinned,
+	)
+	return err
+}
+
 const createSchoolRegistration = `-- name: CreateSchoolRegistration :one
 INSERT INTO SchoolTournamentRegistrations (
     SchoolID, TournamentID, PlannedTeamsCount,
@@ -173,12 +301,12 @@ INSERT INTO Tournaments (
     Name, StartDate, EndDate, Location, FormatID, LeagueID,
     CoordinatorID, NumberOfPreliminaryRounds, NumberOfEliminationRounds,
     JudgesPerDebatePreliminary, JudgesPerDebateElimination, TournamentFee,
-    ImageUrl, Motions
+    ImageUrl, Motions, TeamTiebreaks, SpeakerTiebreaks
 )
 VALUES (
-           $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+           $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
        )
-RETURNING tournamentid, name, startdate, enddate, location, formatid, leagueid, coordinatorid, numberofpreliminaryrounds, numberofeliminationrounds, judgesperdebatepreliminary, judgesperdebateelimination, tournamentfee, imageurl, motions, created_at, updated_at, deleted_at, yesterday_total_count, yesterday_upcoming_count, yesterday_active_debaters_count
+RETURNING tournamentid, name, startdate, enddate, location, formatid, leagueid, coordinatorid, numberofpreliminaryrounds, numberofeliminationrounds, judgesperdebatepreliminary, judgesperdebateelimination, tournamentfee, imageurl, motions, created_at, updated_at, deleted_at, yesterday_total_count, yesterday_upcoming_count, yesterday_active_debaters_count, doubleentryballots, teamtiebreaks, speakertiebreaks, roundminutes, breakminutes, daystarttime, dayendtime, lunchstarttime, lunchminutes
 `
 
 type CreateTournamentEntryParams struct {
@@ -196,6 +324,8 @@ type CreateTournamentEntryParams struct {
 	Tournamentfee              string                `json:"tournamentfee"`
 	Imageurl                   sql.NullString        `json:"imageurl"`
 	Motions                    pqtype.NullRawMessage `json:"motions"`
+	Teamtiebreaks              []string              `json:"teamtiebreaks"`
+	Speakertiebreaks           []string              `json:"speakertiebreaks"`
 }
 
 // Tournament Queries
@@ -215,6 +345,8 @@ func (q *Queries) CreateTournamentEntry(ctx context.Context, arg CreateTournamen
 		arg.Tournamentfee,
 		arg.Imageurl,
 		arg.Motions,
+		pq.Array(arg.Teamtiebreaks),
+		pq.Array(arg.Speakertiebreaks),
 	)
 	var i Tournament
 	err := row.Scan(
@@ -239,6 +371,15 @@ func (q *Queries) CreateTournamentEntry(ctx context.Context, arg CreateTournamen
 		&i.YesterdayTotalCount,
 		&i.YesterdayUpcomingCount,
 		&i.YesterdayActiveDebatersCount,
+		&i.Doubleentryballots,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Roundminutes,
+		&i.Breakminutes,
+		&i.Daystarttime,
+		&i.Dayendtime,
+		&i.Lunchstarttime,
+		&i.Lunchminutes,
 	)
 	return i, err
 }
@@ -301,20 +442,55 @@ func (q *Queries) CreateTournamentExpenses(ctx context.Context, arg CreateTourna
 }
 
 const createTournamentFormat = `-- name: CreateTournamentFormat :one
-INSERT INTO TournamentFormats (FormatName, Description, SpeakersPerTeam)
-VALUES ($1, $2, $3)
-RETURNING formatid, formatname, description, speakersperteam, deleted_at
+INSERT INTO TournamentFormats (FormatName, Description, SpeakersPerTeam, TeamsPerDebate,
+                               MinSpeakerPoints, MaxSpeakerPoints, SpeakerPointStep, AllowLowPointWins,
+                               ReplySpeeches, MinReplyPoints, MaxReplyPoints, TieHandling, TeamTiebreaks, SpeakerTiebreaks,
+                               IronSpeeches, SubstituteSpeeches, ByeResult)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+RETURNING formatid, formatname, description, speakersperteam, deleted_at, teamsperdebate, minspeakerpoints, maxspeakerpoints, speakerpointstep, allowlowpointwins, replyspeeches, minreplypoints, maxreplypoints, tiehandling, teamtiebreaks, speakertiebreaks, ironspeeches, substitutespeeches, byeresult
 `
 
 type CreateTournamentFormatParams struct {
-	Formatname      string         `json:"formatname"`
-	Description     sql.NullString `json:"description"`
-	Speakersperteam int32          `json:"speakersperteam"`
+	Formatname         string         `json:"formatname"`
+	Description        sql.NullString `json:"description"`
+	Speakersperteam    int32          `json:"speakersperteam"`
+	Teamsperdebate     int32          `json:"teamsperdebate"`
+	Minspeakerpoints   string         `json:"minspeakerpoints"`
+	Maxspeakerpoints   string         `json:"maxspeakerpoints"`
+	Speakerpointstep   string         `json:"speakerpointstep"`
+	Allowlowpointwins  bool           `json:"allowlowpointwins"`
+	Replyspeeches      bool           `json:"replyspeeches"`
+	Minreplypoints     string         `json:"minreplypoints"`
+	Maxreplypoints     string         `json:"maxreplypoints"`
+	Tiehandling        string         `json:"tiehandling"`
+	Teamtiebreaks      []string       `json:"teamtiebreaks"`
+	Speakertiebreaks   []string       `json:"speakertiebreaks"`
+	Ironspeeches       string         `json:"ironspeeches"`
+	Substitutespeeches string         `json:"substitutespeeches"`
+	Byeresult          string         `json:"byeresult"`
 }
 
 // Tournament Format Queries
 func (q *Queries) CreateTournamentFormat(ctx context.Context, arg CreateTournamentFormatParams) (Tournamentformat, error) {
-	row := q.db.QueryRowContext(ctx, createTournamentFormat, arg.Formatname, arg.Description, arg.Speakersperteam)
+	row := q.db.QueryRowContext(ctx, createTournamentFormat,
+		arg.Formatname,
+		arg.Description,
+		arg.Speakersperteam,
+		arg.Teamsperdebate,
+		arg.Minspeakerpoints,
+		arg.Maxspeakerpoints,
+		arg.Speakerpointstep,
+		arg.Allowlowpointwins,
+		arg.Replyspeeches,
+		arg.Minreplypoints,
+		arg.Maxreplypoints,
+		arg.Tiehandling,
+		pq.Array(arg.Teamtiebreaks),
+		pq.Array(arg.Speakertiebreaks),
+		arg.Ironspeeches,
+		arg.Substitutespeeches,
+		arg.Byeresult,
+	)
 	var i Tournamentformat
 	err := row.Scan(
 		&i.Formatid,
@@ -322,10 +498,68 @@ func (q *Queries) CreateTournamentFormat(ctx context.Context, arg CreateTourname
 		&i.Description,
 		&i.Speakersperteam,
 		&i.DeletedAt,
+		&i.Teamsperdebate,
+		&i.Minspeakerpoints,
+		&i.Maxspeakerpoints,
+		&i.Speakerpointstep,
+		&i.Allowlowpointwins,
+		&i.Replyspeeches,
+		&i.Minreplypoints,
+		&i.Maxreplypoints,
+		&i.Tiehandling,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Ironspeeches,
+		&i.Substitutespeeches,
+		&i.Byeresult,
 	)
 	return i, err
 }
 
+const deleteBankMotion = `-- name: DeleteBankMotion :execrows
+DELETE FROM MotionBank m
+WHERE m.MotionID = $1
+  AND NOT EXISTS (SELECT 1 FROM RoundMotions rm WHERE rm.MotionID = m.MotionID)
+`
+
+func (q *Queries) DeleteBankMotion(ctx context.Context, motionid int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBankMotion, motionid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteBankMotionTags = `-- name: DeleteBankMotionTags :exec
+DELETE FROM MotionTags
+WHERE MotionID = $1
+`
+
+func (q *Queries) DeleteBankMotionTags(ctx context.Context, motionid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteBankMotionTags, motionid)
+	return err
+}
+
+const deleteDetachedRoundMotions = `-- name: DeleteDetachedRoundMotions :exec
+DELETE FROM RoundMotions rm
+USING MotionBank mb, Tournaments t
+WHERE rm.MotionID = mb.MotionID
+  AND rm.TournamentID = t.TournamentID
+  AND rm.TournamentID = $1
+  AND NOT EXISTS (
+      SELECT 1
+      FROM jsonb_array_elements(COALESCE(t.Motions->(CASE WHEN rm.IsEliminationRound THEN 'elimination' ELSE 'preliminary' END), '[]'::jsonb)) AS m(Motion)
+      WHERE CAST(m.Motion->>'roundNumber' AS INTEGER) = rm.RoundNumber
+        AND m.Motion->>'text' = mb.Text)
+`
+
+// Drops the links of rounds whose motion was since edited away from the bank
+// motion's text.
+func (q *Queries) DeleteDetachedRoundMotions(ctx context.Context, tournamentid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteDetachedRoundMotions, tournamentid)
+	return err
+}
+
 const deleteInvitation = `-- name: DeleteInvitation :exec
 DELETE FROM TournamentInvitations WHERE InvitationID = $1
 `
@@ -346,6 +580,16 @@ func (q *Queries) DeleteLeagueByID(ctx context.Context, leagueid int32) error {
 	return err
 }
 
+const deletePlannedSchedule = `-- name: DeletePlannedSchedule :exec
+DELETE FROM Schedules
+WHERE TournamentID = $1 AND NOT IsPinned
+`
+
+func (q *Queries) DeletePlannedSchedule(ctx context.Context, tournamentid int32) error {
+	_, err := q.db.ExecContext(ctx, deletePlannedSchedule, tournamentid)
+	return err
+}
+
 const deleteTournamentByID = `-- name: DeleteTournamentByID :exec
 UPDATE Tournaments
 SET deleted_at = CURRENT_TIMESTAMP
@@ -368,8 +612,18 @@ func (q *Queries) DeleteTournamentFormatByID(ctx context.Context, formatid int32
 	return err
 }
 
+const deleteTournamentSchedule = `-- name: DeleteTournamentSchedule :exec
+DELETE FROM Schedules
+WHERE TournamentID = $1
+`
+
+func (q *Queries) DeleteTournamentSchedule(ctx context.Context, tournamentid int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTournamentSchedule, tournamentid)
+	return err
+}
+
 const getActiveTournaments = `-- name: GetActiveTournaments :many
-SELECT tournamentid, name, startdate, enddate, location, formatid, leagueid, coordinatorid, numberofpreliminaryrounds, numberofeliminationrounds, judgesperdebatepreliminary, judgesperdebateelimination, tournamentfee, imageurl, motions, created_at, updated_at, deleted_at, yesterday_total_count, yesterday_upcoming_count, yesterday_active_debaters_count FROM Tournaments
+SELECT tournamentid, name, startdate, enddate, location, formatid, leagueid, coordinatorid, numberofpreliminaryrounds, numberofeliminationrounds, judgesperdebatepreliminary, judgesperdebateelimination, tournamentfee, imageurl, motions, created_at, updated_at, deleted_at, yesterday_total_count, yesterday_upcoming_count, yesterday_active_debaters_count, doubleentryballots, teamtiebreaks, speakertiebreaks, roundminutes, breakminutes, daystarttime, dayendtime, lunchstarttime, lunchminutes FROM Tournaments
 WHERE StartDate > CURRENT_TIMESTAMP
   AND deleted_at IS NULL
 ORDER BY StartDate
@@ -406,6 +660,15 @@ func (q *Queries) GetActiveTournaments(ctx context.Context) ([]Tournament, error
 			&i.YesterdayTotalCount,
 			&i.YesterdayUpcomingCount,
 			&i.YesterdayActiveDebatersCount,
+			&i.Doubleentryballots,
+			pq.Array(&i.Teamtiebreaks),
+			pq.Array(&i.Speakertiebreaks),
+			&i.Roundminutes,
+			&i.Breakminutes,
+			&i.Daystarttime,
+			&i.Dayendtime,
+			&i.Lunchstarttime,
+			&i.Lunchminutes,
 		); err != nil {
 			return nil, err
 		}
@@ -420,6 +683,54 @@ func (q *Queries) GetActiveTournaments(ctx context.Context) ([]Tournament, error
 	return items, nil
 }
 
+const getBankMotion = `-- name: GetBankMotion :one
+SELECT motionid, text, infoslide, difficulty, createdby, createdat FROM MotionBank
+WHERE MotionID = $1
+`
+
+func (q *Queries) GetBankMotion(ctx context.Context, motionid int32) (Motionbank, error) {
+	row := q.db.QueryRowContext(ctx, getBankMotion, motionid)
+	var i Motionbank
+	err := row.Scan(
+		&i.Motionid,
+		&i.Text,
+		&i.Infoslide,
+		&i.Difficulty,
+		&i.Createdby,
+		&i.Createdat,
+	)
+	return i, err
+}
+
+const getBankMotionTags = `-- name: GetBankMotionTags :many
+SELECT Tag FROM MotionTags
+WHERE MotionID = $1
+ORDER BY Tag
+`
+
+func (q *Queries) GetBankMotionTags(ctx context.Context, motionid int32) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getBankMotionTags, motionid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getInvitationByID = `-- name: GetInvitationByID :one
 SELECT invitationid, tournamentid, inviteeid, inviteerole, status, created_at, updated_at, remindersentat FROM TournamentInvitations WHERE InvitationID = $1
 `
@@ -590,6 +901,48 @@ func (q *Queries) GetLeagueByID(ctx context.Context, leagueid int32) (League, er
 	return i, err
 }
 
+const getMotionReleases = `-- name: GetMotionReleases :many
+SELECT RoundNumber, IsEliminationRound, ReleasedAt, PrepEndsAt
+FROM MotionReleases
+WHERE TournamentID = $1
+ORDER BY IsEliminationRound, RoundNumber
+`
+
+type GetMotionReleasesRow struct {
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Releasedat         time.Time `json:"releasedat"`
+	Prependsat         time.Time `json:"prependsat"`
+}
+
+func (q *Queries) GetMotionReleases(ctx context.Context, tournamentid int32) ([]GetMotionReleasesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMotionReleases, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetMotionReleasesRow{}
+	for rows.Next() {
+		var i GetMotionReleasesRow
+		if err := rows.Scan(
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Releasedat,
+			&i.Prependsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPendingInvitations = `-- name: GetPendingInvitations :many
 SELECT
     ti.invitationid, ti.tournamentid, ti.inviteeid, ti.inviteerole, ti.status, ti.created_at, ti.updated_at, ti.remindersentat,
@@ -698,6 +1051,30 @@ func (q *Queries) GetRegistrationCurrency(ctx context.Context, tournamentid int3
 	return currency, err
 }
 
+const getRoundSchedule = `-- name: GetRoundSchedule :one
+SELECT StartTime, EndTime
+FROM Schedules
+WHERE TournamentID = $1 AND RoundNumber = $2 AND IsEliminationRound = $3
+`
+
+type GetRoundScheduleParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+}
+
+type GetRoundScheduleRow struct {
+	Starttime time.Time `json:"starttime"`
+	Endtime   time.Time `json:"endtime"`
+}
+
+func (q *Queries) GetRoundSchedule(ctx context.Context, arg GetRoundScheduleParams) (GetRoundScheduleRow, error) {
+	row := q.db.QueryRowContext(ctx, getRoundSchedule, arg.Tournamentid, arg.Roundnumber, arg.Iseliminationround)
+	var i GetRoundScheduleRow
+	err := row.Scan(&i.Starttime, &i.Endtime)
+	return i, err
+}
+
 const getSchoolRegistration = `-- name: GetSchoolRegistration :one
 SELECT
     str.registrationid, str.schoolid, str.tournamentid, str.plannedteamscount, str.actualteamscount, str.amountperteam, str.totalamount, str.discountamount, str.actualpaidamount, str.paymentstatus, str.paymentdate, str.currency, str.createdat, str.updatedat, str.createdby, str.updatedby,
@@ -783,7 +1160,7 @@ func (q *Queries) GetSchoolRegistration(ctx context.Context, arg GetSchoolRegist
 }
 
 const getTournamentByID = `-- name: GetTournamentByID :one
-SELECT t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count, tf.FormatName, tf.Description AS FormatDescription, tf.SpeakersPerTeam,
+SELECT t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count, t.doubleentryballots, t.teamtiebreaks, t.speakertiebreaks, t.roundminutes, t.breakminutes, t.daystarttime, t.dayendtime, t.lunchstarttime, t.lunchminutes, tf.FormatName, tf.Description AS FormatDescription, tf.SpeakersPerTeam, tf.TeamsPerDebate,
        l.Name AS LeagueName, l.LeagueType, l.Details AS LeagueDetails,
        u.Name AS CoordinatorName, u.UserID AS CoordinatorID
 FROM Tournaments t
@@ -815,9 +1192,19 @@ type GetTournamentByIDRow struct {
 	YesterdayTotalCount          sql.NullInt32         `json:"yesterday_total_count"`
 	YesterdayUpcomingCount       sql.NullInt32         `json:"yesterday_upcoming_count"`
 	YesterdayActiveDebatersCount sql.NullInt32         `json:"yesterday_active_debaters_count"`
+	Doubleentryballots           bool                  `json:"doubleentryballots"`
+	Teamtiebreaks                []string              `json:"teamtiebreaks"`
+	Speakertiebreaks             []string              `json:"speakertiebreaks"`
+	Roundminutes                 int32                 `json:"roundminutes"`
+	Breakminutes                 int32                 `json:"breakminutes"`
+	Daystarttime                 time.Time             `json:"daystarttime"`
+	Dayendtime                   time.Time             `json:"dayendtime"`
+	Lunchstarttime               sql.NullTime          `json:"lunchstarttime"`
+	Lunchminutes                 int32                 `json:"lunchminutes"`
 	Formatname                   string                `json:"formatname"`
 	Formatdescription            sql.NullString        `json:"formatdescription"`
 	Speakersperteam              int32                 `json:"speakersperteam"`
+	Teamsperdebate               int32                 `json:"teamsperdebate"`
 	Leaguename                   string                `json:"leaguename"`
 	Leaguetype                   string                `json:"leaguetype"`
 	Leaguedetails                json.RawMessage       `json:"leaguedetails"`
@@ -850,9 +1237,19 @@ func (q *Queries) GetTournamentByID(ctx context.Context, tournamentid int32) (Ge
 		&i.YesterdayTotalCount,
 		&i.YesterdayUpcomingCount,
 		&i.YesterdayActiveDebatersCount,
+		&i.Doubleentryballots,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Roundminutes,
+		&i.Breakminutes,
+		&i.Daystarttime,
+		&i.Dayendtime,
+		&i.Lunchstarttime,
+		&i.Lunchminutes,
 		&i.Formatname,
 		&i.Formatdescription,
 		&i.Speakersperteam,
+		&i.Teamsperdebate,
 		&i.Leaguename,
 		&i.Leaguetype,
 		&i.Leaguedetails,
@@ -891,7 +1288,7 @@ func (q *Queries) GetTournamentExpenses(ctx context.Context, tournamentid int32)
 }
 
 const getTournamentFormatByID = `-- name: GetTournamentFormatByID :one
-SELECT formatid, formatname, description, speakersperteam, deleted_at FROM TournamentFormats
+SELECT formatid, formatname, description, speakersperteam, deleted_at, teamsperdebate, minspeakerpoints, maxspeakerpoints, speakerpointstep, allowlowpointwins, replyspeeches, minreplypoints, maxreplypoints, tiehandling, teamtiebreaks, speakertiebreaks, ironspeeches, substitutespeeches, byeresult FROM TournamentFormats
 WHERE FormatID = $1 AND deleted_at IS NULL
 `
 
@@ -904,10 +1301,63 @@ func (q *Queries) GetTournamentFormatByID(ctx context.Context, formatid int32) (
 		&i.Description,
 		&i.Speakersperteam,
 		&i.DeletedAt,
+		&i.Teamsperdebate,
+		&i.Minspeakerpoints,
+		&i.Maxspeakerpoints,
+		&i.Speakerpointstep,
+		&i.Allowlowpointwins,
+		&i.Replyspeeches,
+		&i.Minreplypoints,
+		&i.Maxreplypoints,
+		&i.Tiehandling,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Ironspeeches,
+		&i.Substitutespeeches,
+		&i.Byeresult,
 	)
 	return i, err
 }
 
+const getTournamentParticipantUserIDs = `-- name: GetTournamentParticipantUserIDs :many
+SELECT s.UserID
+FROM Students s
+JOIN TeamMembers tm ON tm.StudentID = s.StudentID
+JOIN Teams t ON t.TeamID = tm.TeamID
+WHERE t.TournamentID = $1
+UNION
+SELECT v.UserID
+FROM Volunteers v
+JOIN TournamentInvitations ti ON ti.InviteeID = v.iDebateVolunteerID
+WHERE ti.TournamentID = $1
+  AND ti.Status = 'accepted'
+  AND ti.InviteeRole = 'volunteer'
+`
+
+// The students on the tournament's teams and its accepted volunteers.
+func (q *Queries) GetTournamentParticipantUserIDs(ctx context.Context, tournamentid int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getTournamentParticipantUserIDs, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var userid int32
+		if err := rows.Scan(&userid); err != nil {
+			return nil, err
+		}
+		items = append(items, userid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTournamentRegistrations = `-- name: GetTournamentRegistrations :many
 SELECT
     DATE(ti.updated_at) AS registration_date,
@@ -951,6 +1401,94 @@ func (q *Queries) GetTournamentRegistrations(ctx context.Context) ([]GetTourname
 	return items, nil
 }
 
+const getTournamentSchedule = `-- name: GetTournamentSchedule :many
+SELECT RoundNumber, IsEliminationRound, StartTime, EndTime, IsPinned
+FROM Schedules
+WHERE TournamentID = $1
+ORDER BY IsEliminationRound, RoundNumber
+`
+
+type GetTournamentScheduleRow struct {
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Starttime          time.Time `json:"starttime"`
+	Endtime            time.Time `json:"endtime"`
+	Ispinned           bool      `json:"ispinned"`
+}
+
+func (q *Queries) GetTournamentSchedule(ctx context.Context, tournamentid int32) ([]GetTournamentScheduleRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTournamentSchedule, tournamentid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTournamentScheduleRow{}
+	for rows.Next() {
+		var i GetTournamentScheduleRow
+		if err := rows.Scan(
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Starttime,
+			&i.Endtime,
+			&i.Ispinned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTournamentScheduleSettings = `-- name: GetTournamentScheduleSettings :one
+SELECT TournamentID, StartDate, EndDate, NumberOfPreliminaryRounds, NumberOfEliminationRounds,
+       RoundMinutes, BreakMinutes,
+       to_char(DayStartTime, 'HH24:MI')::text AS DayStartTime,
+       to_char(DayEndTime, 'HH24:MI')::text AS DayEndTime,
+       COALESCE(to_char(LunchStartTime, 'HH24:MI'), '')::text AS LunchStartTime,
+       LunchMinutes
+FROM Tournaments
+WHERE TournamentID = $1 AND deleted_at IS NULL
+`
+
+type GetTournamentScheduleSettingsRow struct {
+	Tournamentid              int32     `json:"tournamentid"`
+	Startdate                 time.Time `json:"startdate"`
+	Enddate                   time.Time `json:"enddate"`
+	Numberofpreliminaryrounds int32     `json:"numberofpreliminaryrounds"`
+	Numberofeliminationrounds int32     `json:"numberofeliminationrounds"`
+	Roundminutes              int32     `json:"roundminutes"`
+	Breakminutes              int32     `json:"breakminutes"`
+	Daystarttime              string    `json:"daystarttime"`
+	Dayendtime                string    `json:"dayendtime"`
+	Lunchstarttime            string    `json:"lunchstarttime"`
+	Lunchminutes              int32     `json:"lunchminutes"`
+}
+
+func (q *Queries) GetTournamentScheduleSettings(ctx context.Context, tournamentid int32) (GetTournamentScheduleSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, getTournamentScheduleSettings, tournamentid)
+	var i GetTournamentScheduleSettingsRow
+	err := row.Scan(
+		&i.Tournamentid,
+		&i.Startdate,
+		&i.Enddate,
+		&i.Numberofpreliminaryrounds,
+		&i.Numberofeliminationrounds,
+		&i.Roundminutes,
+		&i.Breakminutes,
+		&i.Daystarttime,
+		&i.Dayendtime,
+		&i.Lunchstarttime,
+		&i.Lunchminutes,
+	)
+	return i, err
+}
+
 const getTournamentStats = `-- name: GetTournamentStats :one
 
 WITH CurrentStats AS (
@@ -1060,6 +1598,120 @@ func (q *Queries) GetUserDetailsForInvitation(ctx context.Context, userid int32)
 	return i, err
 }
 
+const listBankMotions = `-- name: ListBankMotions :many
+SELECT m.MotionID, m.Text, m.InfoSlide, m.Difficulty,
+       ARRAY(SELECT mt.Tag FROM MotionTags mt WHERE mt.MotionID = m.MotionID ORDER BY mt.Tag)::text[] AS Tags,
+       (SELECT COUNT(*) FROM RoundMotions rm WHERE rm.MotionID = m.MotionID) AS TimesUsed,
+       EXISTS (SELECT 1
+               FROM RoundMotions rm
+               JOIN Tournaments t ON rm.TournamentID = t.TournamentID
+               WHERE rm.MotionID = m.MotionID AND t.LeagueID = $1::int AND t.deleted_at IS NULL) AS UsedInLeague,
+       COALESCE(s.Debates, 0)::int AS Debates,
+       COALESCE(s.PropositionWins, 0)::int AS PropositionWins
+FROM MotionBank m
+LEFT JOIN (
+    SELECT rm.MotionID,
+           COUNT(DISTINCT d.DebateID) AS Debates,
+           COUNT(DISTINCT d.DebateID) FILTER (WHERE b.Verdict = t1.Name) AS PropositionWins
+    FROM RoundMotions rm
+    JOIN Debates d ON d.TournamentID = rm.TournamentID
+                  AND d.RoundNumber = rm.RoundNumber
+                  AND d.IsEliminationRound = rm.IsEliminationRound
+    JOIN Ballots b ON b.DebateID = d.DebateID
+    JOIN Teams t1 ON d.Team1ID = t1.TeamID
+    WHERE d.Team3ID IS NULL
+      AND b.RecordingStatus = 'Recorded'
+    GROUP BY rm.MotionID
+) s ON s.MotionID = m.MotionID
+WHERE ($2::text = '' OR EXISTS (SELECT 1 FROM MotionTags mt WHERE mt.MotionID = m.MotionID AND mt.Tag = LOWER($2::text)))
+  AND ($3::text = '' OR m.Difficulty = $3::text)
+  AND ($4::text = ''
+    OR LOWER(m.Text) LIKE LOWER('%' || $4::text || '%')
+    OR LOWER(m.InfoSlide) LIKE LOWER('%' || $4::text || '%'))
+  AND NOT ($5::bool AND EXISTS (
+      SELECT 1
+      FROM RoundMotions rm
+      JOIN Tournaments t ON rm.TournamentID = t.TournamentID
+      WHERE rm.MotionID = m.MotionID AND t.LeagueID = $1::int AND t.deleted_at IS NULL))
+ORDER BY CASE
+             WHEN $6::bool AND COALESCE(s.Debates, 0) > 0
+                 THEN ABS(s.PropositionWins::float / s.Debates - 0.5)
+             WHEN $6::bool THEN 1
+             ELSE 0
+         END,
+         m.MotionID
+LIMIT $8 OFFSET $7
+`
+
+type ListBankMotionsParams struct {
+	LeagueID            int32  `json:"league_id"`
+	Tag                 string `json:"tag"`
+	Difficulty          string `json:"difficulty"`
+	SearchQuery         string `json:"search_query"`
+	ExcludeUsedInLeague bool   `json:"exclude_used_in_league"`
+	BalancedFirst       bool   `json:"balanced_first"`
+	PageOffset          int32  `json:"page_offset"`
+	PageSize            int32  `json:"page_size"`
+}
+
+type ListBankMotionsRow struct {
+	Motionid        int32    `json:"motionid"`
+	Text            string   `json:"text"`
+	Infoslide       string   `json:"infoslide"`
+	Difficulty      string   `json:"difficulty"`
+	Tags            []string `json:"tags"`
+	Timesused       int64    `json:"timesused"`
+	Usedinleague    bool     `json:"usedinleague"`
+	Debates         int32    `json:"debates"`
+	Propositionwins int32    `json:"propositionwins"`
+}
+
+// Debates and PropositionWins count the recorded two-team debates of the
+// rounds the motion was attached to, and the ones the first team won. With
+// balanced_first, motions closest to an even split come first, then those
+// never debated.
+func (q *Queries) ListBankMotions(ctx context.Context, arg ListBankMotionsParams) ([]ListBankMotionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBankMotions,
+		arg.LeagueID,
+		arg.Tag,
+		arg.Difficulty,
+		arg.SearchQuery,
+		arg.ExcludeUsedInLeague,
+		arg.BalancedFirst,
+		arg.PageOffset,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBankMotionsRow{}
+	for rows.Next() {
+		var i ListBankMotionsRow
+		if err := rows.Scan(
+			&i.Motionid,
+			&i.Text,
+			&i.Infoslide,
+			&i.Difficulty,
+			pq.Array(&i.Tags),
+			&i.Timesused,
+			&i.Usedinleague,
+			&i.Debates,
+			&i.Propositionwins,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listLeaguesPaginated = `-- name: ListLeaguesPaginated :many
 SELECT leagueid, name, leaguetype, details, deleted_at FROM Leagues
 WHERE deleted_at IS NULL
@@ -1105,7 +1757,7 @@ func (q *Queries) ListLeaguesPaginated(ctx context.Context, arg ListLeaguesPagin
 }
 
 const listTournamentFormatsPaginated = `-- name: ListTournamentFormatsPaginated :many
-SELECT formatid, formatname, description, speakersperteam, deleted_at FROM TournamentFormats
+SELECT formatid, formatname, description, speakersperteam, deleted_at, teamsperdebate, minspeakerpoints, maxspeakerpoints, speakerpointstep, allowlowpointwins, replyspeeches, minreplypoints, maxreplypoints, tiehandling, teamtiebreaks, speakertiebreaks, ironspeeches, substitutespeeches, byeresult FROM TournamentFormats
 WHERE deleted_at IS NULL
   AND (LOWER(FormatName) LIKE LOWER('%' || COALESCE($3::text, '') || '%')
     OR LOWER(Description) LIKE LOWER('%' || COALESCE($3::text, '') || '%')
@@ -1135,6 +1787,20 @@ func (q *Queries) ListTournamentFormatsPaginated(ctx context.Context, arg ListTo
 			&i.Description,
 			&i.Speakersperteam,
 			&i.DeletedAt,
+			&i.Teamsperdebate,
+			&i.Minspeakerpoints,
+			&i.Maxspeakerpoints,
+			&i.Speakerpointstep,
+			&i.Allowlowpointwins,
+			&i.Replyspeeches,
+			&i.Minreplypoints,
+			&i.Maxreplypoints,
+			&i.Tiehandling,
+			pq.Array(&i.Teamtiebreaks),
+			pq.Array(&i.Speakertiebreaks),
+			&i.Ironspeeches,
+			&i.Substitutespeeches,
+			&i.Byeresult,
 		); err != nil {
 			return nil, err
 		}
@@ -1238,7 +1904,7 @@ func (q *Queries) ListTournamentRegistrations(ctx context.Context, arg ListTourn
 
 const listTournamentsPaginated = `-- name: ListTournamentsPaginated :many
 SELECT
-    t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count,
+    t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count, t.doubleentryballots, t.teamtiebreaks, t.speakertiebreaks, t.roundminutes, t.breakminutes, t.daystarttime, t.dayendtime, t.lunchstarttime, t.lunchminutes,
     tf.FormatName,
     l.Name AS LeagueName,
     u.Name AS CoordinatorName,
@@ -1298,6 +1964,15 @@ type ListTournamentsPaginatedRow struct {
 	YesterdayTotalCount          sql.NullInt32         `json:"yesterday_total_count"`
 	YesterdayUpcomingCount       sql.NullInt32         `json:"yesterday_upcoming_count"`
 	YesterdayActiveDebatersCount sql.NullInt32         `json:"yesterday_active_debaters_count"`
+	Doubleentryballots           bool                  `json:"doubleentryballots"`
+	Teamtiebreaks                []string              `json:"teamtiebreaks"`
+	Speakertiebreaks             []string              `json:"speakertiebreaks"`
+	Roundminutes                 int32                 `json:"roundminutes"`
+	Breakminutes                 int32                 `json:"breakminutes"`
+	Daystarttime                 time.Time             `json:"daystarttime"`
+	Dayendtime                   time.Time             `json:"dayendtime"`
+	Lunchstarttime               sql.NullTime          `json:"lunchstarttime"`
+	Lunchminutes                 int32                 `json:"lunchminutes"`
 	Formatname                   string                `json:"formatname"`
 	Leaguename                   string                `json:"leaguename"`
 	Coordinatorname              string                `json:"coordinatorname"`
@@ -1337,6 +2012,15 @@ func (q *Queries) ListTournamentsPaginated(ctx context.Context, arg ListTourname
 			&i.YesterdayTotalCount,
 			&i.YesterdayUpcomingCount,
 			&i.YesterdayActiveDebatersCount,
+			&i.Doubleentryballots,
+			pq.Array(&i.Teamtiebreaks),
+			pq.Array(&i.Speakertiebreaks),
+			&i.Roundminutes,
+			&i.Breakminutes,
+			&i.Daystarttime,
+			&i.Dayendtime,
+			&i.Lunchstarttime,
+			&i.Lunchminutes,
 			&i.Formatname,
 			&i.Leaguename,
 			&i.Coordinatorname,
@@ -1357,6 +2041,78 @@ func (q *Queries) ListTournamentsPaginated(ctx context.Context, arg ListTourname
 	return items, nil
 }
 
+const searchMotionArchive = `-- name: SearchMotionArchive :many
+SELECT t.TournamentID, t.Name AS TournamentName, t.StartDate,
+       CAST(m.Motion->>'roundNumber' AS INTEGER) AS RoundNumber,
+       m.IsEliminationRound,
+       CAST(COALESCE(m.Motion->>'text', '') AS TEXT) AS MotionText,
+       CAST(COALESCE(m.Motion->>'infoSlide', '') AS TEXT) AS InfoSlide
+FROM Tournaments t
+CROSS JOIN LATERAL (
+    SELECT p.Motion, FALSE AS IsEliminationRound
+    FROM jsonb_array_elements(COALESCE(t.Motions->'preliminary', '[]'::jsonb)) AS p(Motion)
+    UNION ALL
+    SELECT e.Motion, TRUE
+    FROM jsonb_array_elements(COALESCE(t.Motions->'elimination', '[]'::jsonb)) AS e(Motion)
+) m
+WHERE t.deleted_at IS NULL
+  AND t.EndDate < CURRENT_TIMESTAMP
+  AND (LOWER(m.Motion->>'text') LIKE LOWER('%' || COALESCE($1::text, '') || '%')
+    OR LOWER(m.Motion->>'infoSlide') LIKE LOWER('%' || COALESCE($1::text, '') || '%')
+    OR LOWER(t.Name) LIKE LOWER('%' || COALESCE($1::text, '') || '%')
+    OR COALESCE($1::text, '') = '')
+ORDER BY t.StartDate DESC, t.TournamentID, m.IsEliminationRound, RoundNumber
+LIMIT $3 OFFSET $2
+`
+
+type SearchMotionArchiveParams struct {
+	SearchQuery string `json:"search_query"`
+	PageOffset  int32  `json:"page_offset"`
+	PageSize    int32  `json:"page_size"`
+}
+
+type SearchMotionArchiveRow struct {
+	Tournamentid       int32     `json:"tournamentid"`
+	Tournamentname     string    `json:"tournamentname"`
+	Startdate          time.Time `json:"startdate"`
+	Roundnumber        int32     `json:"roundnumber"`
+	Iseliminationround bool      `json:"iseliminationround"`
+	Motiontext         string    `json:"motiontext"`
+	Infoslide          string    `json:"infoslide"`
+}
+
+// Every motion of the tournaments that have ended, newest tournament first.
+func (q *Queries) SearchMotionArchive(ctx context.Context, arg SearchMotionArchiveParams) ([]SearchMotionArchiveRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMotionArchive, arg.SearchQuery, arg.PageOffset, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchMotionArchiveRow{}
+	for rows.Next() {
+		var i SearchMotionArchiveRow
+		if err := rows.Scan(
+			&i.Tournamentid,
+			&i.Tournamentname,
+			&i.Startdate,
+			&i.Roundnumber,
+			&i.Iseliminationround,
+			&i.Motiontext,
+			&i.Infoslide,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchTournaments = `-- name: SearchTournaments :many
 SELECT TournamentID, Name
 FROM Tournaments
@@ -1394,6 +2150,54 @@ func (q *Queries) SearchTournaments(ctx context.Context, lower string) ([]Search
 	return items, nil
 }
 
+const stampDebateTimes = `-- name: StampDebateTimes :exec
+UPDATE Debates d
+SET StartTime = s.StartTime, EndTime = s.EndTime
+FROM Schedules s
+WHERE s.TournamentID = d.TournamentID
+  AND s.RoundNumber = d.RoundNumber
+  AND s.IsEliminationRound = d.IsEliminationRound
+  AND d.TournamentID = $1
+`
+
+func (q *Queries) StampDebateTimes(ctx context.Context, tournamentid int32) error {
+	_, err := q.db.ExecContext(ctx, stampDebateTimes, tournamentid)
+	return err
+}
+
+const updateBankMotion = `-- name: UpdateBankMotion :one
+UPDATE MotionBank
+SET Text = $2, InfoSlide = $3, Difficulty = $4
+WHERE MotionID = $1
+RETURNING motionid, text, infoslide, difficulty, createdby, createdat
+`
+
+type UpdateBankMotionParams struct {
+	Motionid   int32  `json:"motionid"`
+	Text       string `json:"text"`
+	Infoslide  string `json:"infoslide"`
+	Difficulty string `json:"difficulty"`
+}
+
+func (q *Queries) UpdateBankMotion(ctx context.Context, arg UpdateBankMotionParams) (Motionbank, error) {
+	row := q.db.QueryRowContext(ctx, updateBankMotion,
+		arg.Motionid,
+		arg.Text,
+		arg.Infoslide,
+		arg.Difficulty,
+	)
+	var i Motionbank
+	err := row.Scan(
+		&i.Motionid,
+		&i.Text,
+		&i.Infoslide,
+		&i.Difficulty,
+		&i.Createdby,
+		&i.Createdat,
+	)
+	return i, err
+}
+
 const updateInvitationStatus = `-- name: UpdateInvitationStatus :one
 UPDATE TournamentInvitations
 SET Status = $2, updated_at = CURRENT_TIMESTAMP
@@ -1564,11 +2368,13 @@ SET Name = $2,
     JudgesPerDebateElimination = $11,
     TournamentFee = $12,
     ImageUrl = $13,
-    Motions = $14
+    Motions = $14,
+    TeamTiebreaks = $15,
+    SpeakerTiebreaks = $16
 FROM debate_check
 WHERE t.TournamentID = $1
   AND NOT debate_check.has_debates
-RETURNING t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count,
+RETURNING t.tournamentid, t.name, t.startdate, t.enddate, t.location, t.formatid, t.leagueid, t.coordinatorid, t.numberofpreliminaryrounds, t.numberofeliminationrounds, t.judgesperdebatepreliminary, t.judgesperdebateelimination, t.tournamentfee, t.imageurl, t.motions, t.created_at, t.updated_at, t.deleted_at, t.yesterday_total_count, t.yesterday_upcoming_count, t.yesterday_active_debaters_count, t.doubleentryballots, t.teamtiebreaks, t.speakertiebreaks, t.roundminutes, t.breakminutes, t.daystarttime, t.dayendtime, t.lunchstarttime, t.lunchminutes,
     CASE
         WHEN debate_check.has_debates THEN 'Cannot update: Debates exist'::text
         ELSE NULL
@@ -1590,6 +2396,8 @@ type UpdateTournamentDetailsParams struct {
 	Tournamentfee              string                `json:"tournamentfee"`
 	Imageurl                   sql.NullString        `json:"imageurl"`
 	Motions                    pqtype.NullRawMessage `json:"motions"`
+	Teamtiebreaks              []string              `json:"teamtiebreaks"`
+	Speakertiebreaks           []string              `json:"speakertiebreaks"`
 }
 
 type UpdateTournamentDetailsRow struct {
@@ -1614,6 +2422,15 @@ type UpdateTournamentDetailsRow struct {
 	YesterdayTotalCount          sql.NullInt32         `json:"yesterday_total_count"`
 	YesterdayUpcomingCount       sql.NullInt32         `json:"yesterday_upcoming_count"`
 	YesterdayActiveDebatersCount sql.NullInt32         `json:"yesterday_active_debaters_count"`
+	Doubleentryballots           bool                  `json:"doubleentryballots"`
+	Teamtiebreaks                []string              `json:"teamtiebreaks"`
+	Speakertiebreaks             []string              `json:"speakertiebreaks"`
+	Roundminutes                 int32                 `json:"roundminutes"`
+	Breakminutes                 int32                 `json:"breakminutes"`
+	Daystarttime                 time.Time             `json:"daystarttime"`
+	Dayendtime                   time.Time             `json:"dayendtime"`
+	Lunchstarttime               sql.NullTime          `json:"lunchstarttime"`
+	Lunchminutes                 int32                 `json:"lunchminutes"`
 	ErrorMessage                 interface{}           `json:"error_message"`
 }
 
@@ -1633,6 +2450,8 @@ func (q *Queries) UpdateTournamentDetails(ctx context.Context, arg UpdateTournam
 		arg.Tournamentfee,
 		arg.Imageurl,
 		arg.Motions,
+		pq.Array(arg.Teamtiebreaks),
+		pq.Array(arg.Speakertiebreaks),
 	)
 	var i UpdateTournamentDetailsRow
 	err := row.Scan(
@@ -1657,6 +2476,15 @@ func (q *Queries) UpdateTournamentDetails(ctx context.Context, arg UpdateTournam
 		&i.YesterdayTotalCount,
 		&i.YesterdayUpcomingCount,
 		&i.YesterdayActiveDebatersCount,
+		&i.Doubleentryballots,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Roundminutes,
+		&i.Breakminutes,
+		&i.Daystarttime,
+		&i.Dayendtime,
+		&i.Lunchstarttime,
+		&i.Lunchminutes,
 		&i.ErrorMessage,
 	)
 	return i, err
@@ -1727,16 +2555,34 @@ func (q *Queries) UpdateTournamentExpenses(ctx context.Context, arg UpdateTourna
 
 const updateTournamentFormatDetails = `-- name: UpdateTournamentFormatDetails :one
 UPDATE TournamentFormats
-SET FormatName = $2, Description = $3, SpeakersPerTeam = $4
+SET FormatName = $2, Description = $3, SpeakersPerTeam = $4, TeamsPerDebate = $5,
+    MinSpeakerPoints = $6, MaxSpeakerPoints = $7, SpeakerPointStep = $8, AllowLowPointWins = $9,
+    ReplySpeeches = $10, MinReplyPoints = $11, MaxReplyPoints = $12, TieHandling = $13,
+    TeamTiebreaks = $14, SpeakerTiebreaks = $15, IronSpeeches = $16, SubstituteSpeeches = $17,
+    ByeResult = $18
 WHERE FormatID = $1
-RETURNING formatid, formatname, description, speakersperteam, deleted_at
+RETURNING formatid, formatname, description, speakersperteam, deleted_at, teamsperdebate, minspeakerpoints, maxspeakerpoints, speakerpointstep, allowlowpointwins, replyspeeches, minreplypoints, maxreplypoints, tiehandling, teamtiebreaks, speakertiebreaks, ironspeeches, substitutespeeches, byeresult
 `
 
 type UpdateTournamentFormatDetailsParams struct {
-	Formatid        int32          `json:"formatid"`
-	Formatname      string         `json:"formatname"`
-	Description     sql.NullString `json:"description"`
-	Speakersperteam int32          `json:"speakersperteam"`
+	Formatid           int32          `json:"formatid"`
+	Formatname         string         `json:"formatname"`
+	Description        sql.NullString `json:"description"`
+	Speakersperteam    int32          `json:"speakersperteam"`
+	Teamsperdebate     int32          `json:"teamsperdebate"`
+	Minspeakerpoints   string         `json:"minspeakerpoints"`
+	Maxspeakerpoints   string         `json:"maxspeakerpoints"`
+	Speakerpointstep   string         `json:"speakerpointstep"`
+	Allowlowpointwins  bool           `json:"allowlowpointwins"`
+	Replyspeeches      bool           `json:"replyspeeches"`
+	Minreplypoints     string         `json:"minreplypoints"`
+	Maxreplypoints     string         `json:"maxreplypoints"`
+	Tiehandling        string         `json:"tiehandling"`
+	Teamtiebreaks      []string       `json:"teamtiebreaks"`
+	Speakertiebreaks   []string       `json:"speakertiebreaks"`
+	Ironspeeches       string         `json:"ironspeeches"`
+	Substitutespeeches string         `json:"substitutespeeches"`
+	Byeresult          string         `json:"byeresult"`
 }
 
 func (q *Queries) UpdateTournamentFormatDetails(ctx context.Context, arg UpdateTournamentFormatDetailsParams) (Tournamentformat, error) {
@@ -1745,6 +2591,20 @@ func (q *Queries) UpdateTournamentFormatDetails(ctx context.Context, arg UpdateT
 		arg.Formatname,
 		arg.Description,
 		arg.Speakersperteam,
+		arg.Teamsperdebate,
+		arg.Minspeakerpoints,
+		arg.Maxspeakerpoints,
+		arg.Speakerpointstep,
+		arg.Allowlowpointwins,
+		arg.Replyspeeches,
+		arg.Minreplypoints,
+		arg.Maxreplypoints,
+		arg.Tiehandling,
+		pq.Array(arg.Teamtiebreaks),
+		pq.Array(arg.Speakertiebreaks),
+		arg.Ironspeeches,
+		arg.Substitutespeeches,
+		arg.Byeresult,
 	)
 	var i Tournamentformat
 	err := row.Scan(
@@ -1753,6 +2613,95 @@ func (q *Queries) UpdateTournamentFormatDetails(ctx context.Context, arg UpdateT
 		&i.Description,
 		&i.Speakersperteam,
 		&i.DeletedAt,
+		&i.Teamsperdebate,
+		&i.Minspeakerpoints,
+		&i.Maxspeakerpoints,
+		&i.Speakerpointstep,
+		&i.Allowlowpointwins,
+		&i.Replyspeeches,
+		&i.Minreplypoints,
+		&i.Maxreplypoints,
+		&i.Tiehandling,
+		pq.Array(&i.Teamtiebreaks),
+		pq.Array(&i.Speakertiebreaks),
+		&i.Ironspeeches,
+		&i.Substitutespeeches,
+		&i.Byeresult,
 	)
 	return i, err
 }
+
+const updateTournamentMotions = `-- name: UpdateTournamentMotions :exec
+UPDATE Tournaments
+SET Motions = $2
+WHERE TournamentID = $1
+`
+
+type UpdateTournamentMotionsParams struct {
+	Tournamentid int32                 `json:"tournamentid"`
+	Motions      pqtype.NullRawMessage `json:"motions"`
+}
+
+func (q *Queries) UpdateTournamentMotions(ctx context.Context, arg UpdateTournamentMotionsParams) error {
+	_, err := q.db.ExecContext(ctx, updateTournamentMotions, arg.Tournamentid, arg.Motions)
+	return err
+}
+
+const updateTournamentScheduleSettings = `-- name: UpdateTournamentScheduleSettings :exec
+UPDATE Tournaments
+SET RoundMinutes = $2,
+    BreakMinutes = $3,
+    DayStartTime = CAST($4::text AS TIME),
+    DayEndTime = CAST($5::text AS TIME),
+    LunchStartTime = CAST(NULLIF($6::text, '') AS TIME),
+    LunchMinutes = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE TournamentID = $1
+`
+
+type UpdateTournamentScheduleSettingsParams struct {
+	Tournamentid int32  `json:"tournamentid"`
+	Roundminutes int32  `json:"roundminutes"`
+	Breakminutes int32  `json:"breakminutes"`
+	Column4      string `json:"column_4"`
+	Column5      string `json:"column_5"`
+	Column6      string `json:"column_6"`
+	Lunchminutes int32  `json:"lunchminutes"`
+}
+
+func (q *Queries) UpdateTournamentScheduleSettings(ctx context.Context, arg UpdateTournamentScheduleSettingsParams) error {
+	_, err := q.db.ExecContext(ctx, updateTournamentScheduleSettings,
+		arg.Tournamentid,
+		arg.Roundminutes,
+		arg.Breakminutes,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+		arg.Lunchminutes,
+	)
+	return err
+}
+
+const upsertRoundMotion = `-- name: UpsertRoundMotion :exec
+INSERT INTO RoundMotions (TournamentID, RoundNumber, IsEliminationRound, MotionID)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (TournamentID, RoundNumber, IsEliminationRound) DO UPDATE
+SET MotionID = EXCLUDED.MotionID
+`
+
+type UpsertRoundMotionParams struct {
+	Tournamentid       int32 `json:"tournamentid"`
+	Roundnumber        int32 `json:"roundnumber"`
+	Iseliminationround bool  `json:"iseliminationround"`
+	Motionid           int32 `json:"motionid"`
+}
+
+func (q *Queries) UpsertRoundMotion(ctx context.Context, arg UpsertRoundMotionParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRoundMotion,
+		arg.Tournamentid,
+		arg.Roundnumber,
+		arg.Iseliminationround,
+		arg.Motionid,
+	)
+	return err
+}
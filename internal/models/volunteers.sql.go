@@ -17,7 +17,7 @@ INSERT INTO Volunteers (
   IsEnrolledInUniversity, NationalID
 )
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-RETURNING volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments
+RETURNING volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam
 `
 
 type CreateVolunteerParams struct {
@@ -69,6 +69,7 @@ func (q *Queries) CreateVolunteer(ctx context.Context, arg CreateVolunteerParams
 		&i.YesterdayRoundsJudged,
 		&i.YesterdayTournamentsAttended,
 		&i.YesterdayUpcomingTournaments,
+		&i.Maxtimesjudgingteam,
 	)
 	return i, err
 }
@@ -84,7 +85,7 @@ func (q *Queries) DeleteVolunteer(ctx context.Context, volunteerid int32) error
 }
 
 const getAllVolunteers = `-- name: GetAllVolunteers :many
-SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments FROM Volunteers
+SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam FROM Volunteers
 `
 
 func (q *Queries) GetAllVolunteers(ctx context.Context) ([]Volunteer, error) {
@@ -114,6 +115,7 @@ func (q *Queries) GetAllVolunteers(ctx context.Context) ([]Volunteer, error) {
 			&i.YesterdayRoundsJudged,
 			&i.YesterdayTournamentsAttended,
 			&i.YesterdayUpcomingTournaments,
+			&i.Maxtimesjudgingteam,
 		); err != nil {
 			return nil, err
 		}
@@ -140,7 +142,7 @@ func (q *Queries) GetTotalVolunteerCount(ctx context.Context) (int64, error) {
 }
 
 const getVolunteerByID = `-- name: GetVolunteerByID :one
-SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments FROM Volunteers
+SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam FROM Volunteers
 WHERE VolunteerID = $1
 `
 
@@ -165,12 +167,13 @@ func (q *Queries) GetVolunteerByID(ctx context.Context, volunteerid int32) (Volu
 		&i.YesterdayRoundsJudged,
 		&i.YesterdayTournamentsAttended,
 		&i.YesterdayUpcomingTournaments,
+		&i.Maxtimesjudgingteam,
 	)
 	return i, err
 }
 
 const getVolunteerByIDebateID = `-- name: GetVolunteerByIDebateID :one
-SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments FROM Volunteers
+SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam FROM Volunteers
 WHERE iDebateVolunteerID = $1
 `
 
@@ -195,12 +198,13 @@ func (q *Queries) GetVolunteerByIDebateID(ctx context.Context, idebatevolunteeri
 		&i.YesterdayRoundsJudged,
 		&i.YesterdayTournamentsAttended,
 		&i.YesterdayUpcomingTournaments,
+		&i.Maxtimesjudgingteam,
 	)
 	return i, err
 }
 
 const getVolunteerByUserID = `-- name: GetVolunteerByUserID :one
-SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments FROM volunteers
+SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam FROM volunteers
 WHERE UserID = $1 LIMIT 1
 `
 
@@ -225,12 +229,13 @@ func (q *Queries) GetVolunteerByUserID(ctx context.Context, userid int32) (Volun
 		&i.YesterdayRoundsJudged,
 		&i.YesterdayTournamentsAttended,
 		&i.YesterdayUpcomingTournaments,
+		&i.Maxtimesjudgingteam,
 	)
 	return i, err
 }
 
 const getVolunteersPaginated = `-- name: GetVolunteersPaginated :many
-SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments
+SELECT volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam
 FROM Volunteers
 ORDER BY VolunteerID
 LIMIT $1 OFFSET $2
@@ -268,6 +273,7 @@ func (q *Queries) GetVolunteersPaginated(ctx context.Context, arg GetVolunteersP
 			&i.YesterdayRoundsJudged,
 			&i.YesterdayTournamentsAttended,
 			&i.YesterdayUpcomingTournaments,
+			&i.Maxtimesjudgingteam,
 		); err != nil {
 			return nil, err
 		}
@@ -288,7 +294,7 @@ SET FirstName = $2, LastName = $3, DateOfBirth = $4, Role = $5, GraduateYear = $
     Password = $7, SafeGuardCertificate = $8, HasInternship = $9,
     IsEnrolledInUniversity = $10, NationalID = $11
 WHERE VolunteerID = $1
-RETURNING volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments
+RETURNING volunteerid, idebatevolunteerid, firstname, lastname, gender, dateofbirth, nationalid, role, graduateyear, password, safeguardcertificate, hasinternship, isenrolledinuniversity, userid, yesterday_rounds_judged, yesterday_tournaments_attended, yesterday_upcoming_tournaments, maxtimesjudgingteam
 `
 
 type UpdateVolunteerParams struct {
@@ -338,6 +344,7 @@ func (q *Queries) UpdateVolunteer(ctx context.Context, arg UpdateVolunteerParams
 		&i.YesterdayRoundsJudged,
 		&i.YesterdayTournamentsAttended,
 		&i.YesterdayUpcomingTournaments,
+		&i.Maxtimesjudgingteam,
 	)
 	return i, err
 }
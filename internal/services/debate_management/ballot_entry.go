@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+)
+
+// Statuses of a ballot keyed in under double entry
+const (
+	entryAwaitingConfirmation = "awaiting_confirmation"
+	entryConflict             = "conflict"
+	entryConfirmed            = "confirmed"
+)
+
+// SetBallotEntryMode turns double entry of ballots on or off for a tournament.
+// Under double entry a ballot keyed in for a judge only counts once a different
+// user has keyed in the same ballot.
+func (s *BallotService) SetBallotEntryMode(ctx context.Context, req *debate_management.SetBallotEntryModeRequest) (*debate_management.SetBallotEntryModeResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	_, err := queries.SetDoubleEntryBallots(ctx, models.SetDoubleEntryBallotsParams{
+		Tournamentid:       req.GetTournamentId(),
+		Doubleentryballots: req.GetDoubleEntry(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("tournament %d not found", req.GetTournamentId())
+		}
+		return nil, fmt.Errorf("failed to set ballot entry mode: %v", err)
+	}
+
+	message := "Ballots are entered once"
+	if req.GetDoubleEntry() {
+		message = "Ballots keyed in for judges must be entered twice"
+	}
+	return &debate_management.SetBallotEntryModeResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// GetBallotConflicts lists the judges' ballots of a round that different users
+// keyed in differently and that are still waiting for a matching entry.
+func (s *BallotService) GetBallotConflicts(ctx context.Context, req *debate_management.GetBallotConflictsRequest) ([]*debate_management.BallotConflict, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.GetPendingBallotEntriesByTournamentAndRound(ctx, models.GetPendingBallotEntriesByTournamentAndRoundParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsElimination(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot entries: %v", err)
+	}
+
+	// Entries come ordered by ballot and judge
+	var pending []*debate_management.BallotConflict
+	var conflict *debate_management.BallotConflict
+	for _, row := range rows {
+		if conflict == nil || conflict.BallotId != row.Ballotid || conflict.JudgeId != row.Judgeid {
+			conflict = &debate_management.BallotConflict{
+				BallotId:  row.Ballotid,
+				JudgeId:   row.Judgeid,
+				JudgeName: row.Judgename,
+			}
+			pending = append(pending, conflict)
+		}
+
+		snapshot := &debate_management.Ballot{}
+		if err := protojson.Unmarshal(row.Snapshot, snapshot); err != nil {
+			return nil, fmt.Errorf("failed to read ballot entry %d: %v", row.Entryid, err)
+		}
+		conflict.Entries = append(conflict.Entries, &debate_management.BallotEntry{
+			EntryId:       row.Entryid,
+			EnteredBy:     row.Enteredby,
+			EnteredByName: row.Enteredbyname,
+			EnteredAt:     row.Enteredat.String(),
+			Ballot:        snapshot,
+		})
+	}
+
+	// A ballot keyed in by one user only is waiting for its second entry
+	conflicts := make([]*debate_management.BallotConflict, 0, len(pending))
+	for _, conflict := range pending {
+		if n := len(conflict.Entries); n >= 2 {
+			conflict.Changes = ballotChanges(conflict.Entries[n-2].GetBallot(), conflict.Entries[n-1].GetBallot())
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts, nil
+}
+
+// enterBallot keeps a ballot keyed in for a judge under double entry, replacing
+// the user's earlier entry. When it matches an entry by a different user the
+// entries are confirmed and the judge's ballot is submitted; otherwise the
+// differences with the latest entry of another user are returned.
+func enterBallot(ctx context.Context, queries *models.Queries, current models.GetBallotByIDRow, votes []panelVote, vote int, ballot *debate_management.Ballot, verdict string, revision ballotRevision) (string, []*debate_management.BallotChange, error) {
+	judgeID := votes[vote].judge.GetJudgeId()
+	snapshot := ballotSnapshot(current, ballot, verdict)
+	data, err := protojson.Marshal(snapshot)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to save ballot entry: %v", err)
+	}
+
+	err = queries.DeleteUserPendingBallotEntries(ctx, models.DeleteUserPendingBallotEntriesParams{
+		Ballotid:  current.Ballotid,
+		Judgeid:   judgeID,
+		Enteredby: revision.createdBy,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to replace ballot entry: %v", err)
+	}
+	entries, err := queries.GetPendingBallotEntries(ctx, models.GetPendingBallotEntriesParams{
+		Ballotid: current.Ballotid,
+		Judgeid:  judgeID,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get ballot entries: %v", err)
+	}
+	_, err = queries.CreateBallotEntry(ctx, models.CreateBallotEntryParams{
+		Ballotid:  current.Ballotid,
+		Judgeid:   judgeID,
+		Enteredby: revision.createdBy,
+		Snapshot:  data,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to save ballot entry: %v", err)
+	}
+	if len(entries) == 0 {
+		return entryAwaitingConfirmation, nil, nil
+	}
+
+	var conflicts []*debate_management.BallotChange
+	for _, entry := range entries {
+		other := &debate_management.Ballot{}
+		if err := protojson.Unmarshal(entry.Snapshot, other); err != nil {
+			return "", nil, fmt.Errorf("failed to read ballot entry %d: %v", entry.Entryid, err)
+		}
+		conflicts = ballotChanges(other, snapshot)
+		if len(conflicts) > 0 {
+			continue
+		}
+
+		err = queries.ConfirmBallotEntries(ctx, models.ConfirmBallotEntriesParams{
+			Ballotid: current.Ballotid,
+			Judgeid:  judgeID,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to confirm ballot entries: %v", err)
+		}
+		if err := submitJudgeBallot(ctx, queries, current, votes, vote, ballot, verdict, revision); err != nil {
+			return "", nil, err
+		}
+		return entryConfirmed, nil, nil
+	}
+	return entryConflict, conflicts, nil
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+)
+
+// ballotRevision is who saved a version of a judge's ballot and why.
+// revertedFrom is the version number a revert restored.
+type ballotRevision struct {
+	createdBy    int32
+	reason       string
+	revertedFrom int32
+}
+
+// GetBallotHistory returns every version of the judges' ballots of a debate,
+// oldest first, each with its changes against the same judge's previous
+// version.
+func (s *BallotService) GetBallotHistory(ctx context.Context, req *debate_management.GetBallotHistoryRequest) ([]*debate_management.BallotVersion, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.GetBallotVersions(ctx, req.GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot history: %v", err)
+	}
+
+	versions := make([]*debate_management.BallotVersion, len(rows))
+	previous := make(map[int32]*debate_management.Ballot)
+	for i, row := range rows {
+		snapshot := &debate_management.Ballot{}
+		if err := protojson.Unmarshal(row.Snapshot, snapshot); err != nil {
+			return nil, fmt.Errorf("failed to read version %d of ballot %d: %v", row.Versionnumber, row.Ballotid, err)
+		}
+
+		versions[i] = &debate_management.BallotVersion{
+			VersionNumber: row.Versionnumber,
+			JudgeId:       row.Judgeid,
+			JudgeName:     row.Judgename,
+			CreatedBy:     row.Createdby,
+			CreatedByName: row.Createdbyname,
+			CreatedAt:     row.Createdat.String(),
+			Reason:        row.Reason.String,
+			RevertedFrom:  row.Revertedfrom.Int32,
+			Ballot:        snapshot,
+		}
+		if before, ok := previous[row.Judgeid]; ok {
+			versions[i].Changes = ballotChanges(before, snapshot)
+		}
+		previous[row.Judgeid] = snapshot
+	}
+
+	return versions, nil
+}
+
+// RevertBallot restores a judge's ballot to an earlier version. The restored
+// ballot is saved as a new version and the debate is decided again.
+func (s *BallotService) RevertBallot(ctx context.Context, req *debate_management.RevertBallotRequest) (*debate_management.Ballot, error) {
+	claims, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("invalid user ID in token")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	version, err := queries.GetBallotVersion(ctx, models.GetBallotVersionParams{
+		Ballotid:      req.GetBallotId(),
+		Versionnumber: req.GetVersionNumber(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("ballot %d has no version %d", req.GetBallotId(), req.GetVersionNumber())
+		}
+		return nil, fmt.Errorf("failed to get ballot version: %v", err)
+	}
+	snapshot := &debate_management.Ballot{}
+	if err := protojson.Unmarshal(version.Snapshot, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to read ballot version: %v", err)
+	}
+
+	currentBallot, err := queries.GetBallotByID(ctx, req.GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current ballot state: %v", err)
+	}
+	if snapshot.GetTeam1().GetTeamId() != currentBallot.Team1id || snapshot.GetTeam2().GetTeamId() != currentBallot.Team2id ||
+		snapshot.GetTeam3().GetTeamId() != currentBallot.Team3id.Int32 || snapshot.GetTeam4().GetTeamId() != currentBallot.Team4id.Int32 {
+		return nil, fmt.Errorf("version %d was recorded for different teams and cannot be restored", req.GetVersionNumber())
+	}
+
+	panelRows, err := queries.GetBallotPanel(ctx, req.GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot panel: %v", err)
+	}
+	votes := ballotPanel(panelRows, currentBallot.Team3id.Valid && currentBallot.Team4id.Valid)
+	vote := -1
+	for i := range votes {
+		if votes[i].judge.GetJudgeId() == version.Judgeid {
+			vote = i
+		}
+	}
+	if vote == -1 {
+		return nil, fmt.Errorf("judge %d is no longer on the panel of this debate", version.Judgeid)
+	}
+	prepareBallotSpeeches(snapshot, currentBallot)
+
+	err = submitJudgeBallot(ctx, queries, currentBallot, votes, vote, snapshot, snapshot.GetVerdict(), ballotRevision{
+		createdBy:    int32(userID),
+		reason:       req.GetReason(),
+		revertedFrom: version.Versionnumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedBallot, err := queries.GetBallotByID(ctx, req.GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated ballot: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	convertedBallot := convertBallot(updatedBallot)
+	applyPanelSummary(convertedBallot, votes)
+	return convertedBallot, nil
+}
+
+// saveBallotVersion keeps a judge's ballot as it was just saved. Only the
+// scores, feedback and verdict are kept, with the teams of the debate.
+func saveBallotVersion(ctx context.Context, queries *models.Queries, current models.GetBallotByIDRow, judgeID int32, ballot *debate_management.Ballot, verdict string, revision ballotRevision) error {
+	data, err := protojson.Marshal(ballotSnapshot(current, ballot, verdict))
+	if err != nil {
+		return fmt.Errorf("failed to save ballot version: %v", err)
+	}
+
+	_, err = queries.CreateBallotVersion(ctx, models.CreateBallotVersionParams{
+		Ballotid:     current.Ballotid,
+		Judgeid:      judgeID,
+		Snapshot:     data,
+		Createdby:    revision.createdBy,
+		Reason:       sql.NullString{String: revision.reason, Valid: revision.reason != ""},
+		Revertedfrom: sql.NullInt32{Int32: revision.revertedFrom, Valid: revision.revertedFrom != 0},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save ballot version: %v", err)
+	}
+	return nil
+}
+
+// ballotSnapshot is the part of a judge's ballot that is kept: the scores,
+// feedback and verdict, with the teams of the debate.
+func ballotSnapshot(current models.GetBallotByIDRow, ballot *debate_management.Ballot, verdict string) *debate_management.Ballot {
+	snapshot := &debate_management.Ballot{
+		BallotId: current.Ballotid,
+		Verdict:  verdict,
+		Team1:    ballotVersionTeam(ballot.GetTeam1(), current.Team1id),
+		Team2:    ballotVersionTeam(ballot.GetTeam2(), current.Team2id),
+	}
+	if current.Team3id.Valid && current.Team4id.Valid {
+		snapshot.Team3 = ballotVersionTeam(ballot.GetTeam3(), current.Team3id.Int32)
+		snapshot.Team4 = ballotVersionTeam(ballot.GetTeam4(), current.Team4id.Int32)
+	}
+	return snapshot
+}
+
+func ballotVersionTeam(team *debate_management.Team, teamID int32) *debate_management.Team {
+	saved := &debate_management.Team{
+		TeamId:      teamID,
+		TotalPoints: team.GetTotalPoints(),
+		Feedback:    team.GetFeedback(),
+	}
+	for _, speaker := range team.GetSpeakers() {
+		saved.Speakers = append(saved.Speakers, &debate_management.Speaker{
+			SpeakerId:   speaker.GetSpeakerId(),
+			Rank:        speaker.GetRank(),
+			Points:      speaker.GetPoints(),
+			Feedback:    speaker.GetFeedback(),
+			ReplyPoints: speaker.GetReplyPoints(),
+			Position:    speaker.GetPosition(),
+		})
+	}
+	return saved
+}
+
+// ballotChanges lists what differs between two versions of a judge's ballot.
+// Speeches are compared by speaking position.
+func ballotChanges(before, after *debate_management.Ballot) []*debate_management.BallotChange {
+	var changes []*debate_management.BallotChange
+	compare := func(field string, speaker *debate_management.Speaker, was, is string) {
+		if was != is {
+			changes = append(changes, &debate_management.BallotChange{
+				Field:     field,
+				SpeakerId: speaker.GetSpeakerId(),
+				Position:  speaker.GetPosition(),
+				Before:    was,
+				After:     is,
+			})
+		}
+	}
+	points := func(value float64) string {
+		return fmt.Sprintf("%.2f", value)
+	}
+	// A speech missing from one of the versions has every field blank there,
+	// so added and removed speeches are reported alike
+	compareSpeech := func(was, is *debate_management.Speaker) {
+		values := func(speaker *debate_management.Speaker) [5]string {
+			if speaker == nil {
+				return [5]string{}
+			}
+			return [5]string{
+				strconv.Itoa(int(speaker.GetSpeakerId())),
+				strconv.Itoa(int(speaker.GetRank())),
+				points(speaker.GetPoints()),
+				points(speaker.GetReplyPoints()),
+				speaker.GetFeedback(),
+			}
+		}
+		speaker := is
+		if speaker == nil {
+			speaker = was
+		}
+		wasValues, isValues := values(was), values(is)
+		for f, field := range []string{"speaker.speaker_id", "speaker.rank", "speaker.points", "speaker.reply_points", "speaker.feedback"} {
+			compare(field, speaker, wasValues[f], isValues[f])
+		}
+	}
+
+	compare("verdict", nil, before.GetVerdict(), after.GetVerdict())
+
+	beforeTeams := []*debate_management.Team{before.GetTeam1(), before.GetTeam2(), before.GetTeam3(), before.GetTeam4()}
+	afterTeams := []*debate_management.Team{after.GetTeam1(), after.GetTeam2(), after.GetTeam3(), after.GetTeam4()}
+	for i, team := range afterTeams {
+		if team == nil {
+			continue
+		}
+		prefix := fmt.Sprintf("team%d", i+1)
+		compare(prefix+".total_points", nil, points(beforeTeams[i].GetTotalPoints()), points(team.GetTotalPoints()))
+		compare(prefix+".feedback", nil, beforeTeams[i].GetFeedback(), team.GetFeedback())
+
+		beforeSpeeches := make(map[int32]*debate_management.Speaker)
+		for _, speaker := range positionedSpeeches(beforeTeams[i]) {
+			beforeSpeeches[speaker.GetPosition()] = speaker
+		}
+		for _, speaker := range positionedSpeeches(team) {
+			compareSpeech(beforeSpeeches[speaker.GetPosition()], speaker)
+			delete(beforeSpeeches, speaker.GetPosition())
+		}
+		// Speeches scored before but not after
+		for _, speaker := range positionedSpeeches(beforeTeams[i]) {
+			if _, ok := beforeSpeeches[speaker.GetPosition()]; ok {
+				compareSpeech(speaker, nil)
+			}
+		}
+	}
+	return changes
+}
+
+// positionedSpeeches returns a team's speeches with their speaking positions.
+// Versions saved before positions were kept are numbered in the order they
+// are listed.
+func positionedSpeeches(team *debate_management.Team) []*debate_management.Speaker {
+	speeches := make([]*debate_management.Speaker, len(team.GetSpeakers()))
+	for j, speaker := range team.GetSpeakers() {
+		if speaker.GetPosition() == 0 {
+			speaker = proto.Clone(speaker).(*debate_management.Speaker)
+			speaker.Position = int32(j + 1)
+		}
+		speeches[j] = speaker
+	}
+	return speeches
+}
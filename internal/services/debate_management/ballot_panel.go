@@ -0,0 +1,376 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+)
+
+// panelVote is a judge voting on a debate and the verdict of their ballot, if
+// they have submitted it.
+type panelVote struct {
+	judge       *debate_management.Judge
+	isHeadJudge bool
+	submitted   bool
+	verdict     string
+}
+
+// ballotPanel returns the judges who vote on a debate, head judge first.
+// British Parliamentary panels confer and only the chair submits a ballot.
+func ballotPanel(rows []models.GetBallotPanelRow, isBritishParliamentary bool) []panelVote {
+	votes := make([]panelVote, 0, len(rows))
+	for _, row := range rows {
+		if isBritishParliamentary && !row.Isheadjudge {
+			continue
+		}
+		votes = append(votes, panelVote{
+			judge:       &debate_management.Judge{JudgeId: row.Judgeid, Name: row.Judgename},
+			isHeadJudge: row.Isheadjudge,
+			submitted:   row.Verdict.Valid,
+			verdict:     row.Verdict.String,
+		})
+	}
+	return votes
+}
+
+// ballotPanels groups the panels of a round's debates by ballot.
+func ballotPanels(rows []models.GetBallotPanelsByTournamentAndRoundRow) map[int32][]models.GetBallotPanelRow {
+	panels := make(map[int32][]models.GetBallotPanelRow)
+	for _, row := range rows {
+		panels[row.Ballotid] = append(panels[row.Ballotid], models.GetBallotPanelRow{
+			Judgeid:     row.Judgeid,
+			Judgename:   row.Judgename,
+			Isheadjudge: row.Isheadjudge,
+			Verdict:     row.Verdict,
+		})
+	}
+	return panels
+}
+
+// majorityVerdict returns the verdict most submitted ballots agree on. The head
+// judge's ballot breaks a tie; while it is missing a tie has no verdict.
+func majorityVerdict(votes []panelVote) string {
+	counts := make(map[string]int)
+	most := 0
+	for _, vote := range votes {
+		if vote.submitted {
+			counts[vote.verdict]++
+			most = max(most, counts[vote.verdict])
+		}
+	}
+
+	var leaders []string
+	for _, vote := range votes {
+		if vote.submitted && counts[vote.verdict] == most && !slices.Contains(leaders, vote.verdict) {
+			leaders = append(leaders, vote.verdict)
+		}
+	}
+	if len(leaders) == 1 {
+		return leaders[0]
+	}
+	for _, vote := range votes {
+		if vote.isHeadJudge && vote.submitted {
+			return vote.verdict
+		}
+	}
+	return ""
+}
+
+func panelComplete(votes []panelVote) bool {
+	for _, vote := range votes {
+		if !vote.submitted {
+			return false
+		}
+	}
+	return len(votes) > 0
+}
+
+// applyPanelSummary fills in how the panel of a debate split, which judges
+// dissented from the majority and which have yet to submit.
+func applyPanelSummary(ballot *debate_management.Ballot, votes []panelVote) {
+	if len(votes) == 0 {
+		return
+	}
+
+	decision := majorityVerdict(votes)
+	majority := 0
+	ballot.HeadJudgeSubmitted = false
+	for _, vote := range votes {
+		switch {
+		case !vote.submitted:
+			ballot.PendingJudges = append(ballot.PendingJudges, vote.judge)
+		case vote.verdict == decision:
+			majority++
+		default:
+			ballot.DissentingJudges = append(ballot.DissentingJudges, vote.judge)
+		}
+		if vote.isHeadJudge && vote.submitted {
+			ballot.HeadJudgeSubmitted = true
+		}
+	}
+	if decision != "" {
+		ballot.PanelSplit = fmt.Sprintf("%d-%d", majority, len(ballot.DissentingJudges))
+	}
+}
+
+// saveJudgeBallot stores one judge's ballot of a debate, replacing any ballot
+// the judge submitted before.
+func saveJudgeBallot(ctx context.Context, queries *models.Queries, judgeID int32, ballot *debate_management.Ballot, verdict string) error {
+	judgeBallotID, err := queries.UpsertJudgeBallot(ctx, models.UpsertJudgeBallotParams{
+		Ballotid:        ballot.GetBallotId(),
+		Judgeid:         judgeID,
+		Team1totalscore: optionalTeamScore(ballot.GetTeam1()),
+		Team1feedback:   optionalTeamFeedback(ballot.GetTeam1()),
+		Team2totalscore: optionalTeamScore(ballot.GetTeam2()),
+		Team2feedback:   optionalTeamFeedback(ballot.GetTeam2()),
+		Team3totalscore: optionalTeamScore(ballot.GetTeam3()),
+		Team3feedback:   optionalTeamFeedback(ballot.GetTeam3()),
+		Team4totalscore: optionalTeamScore(ballot.GetTeam4()),
+		Team4feedback:   optionalTeamFeedback(ballot.GetTeam4()),
+		Verdict:         verdict,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save judge ballot: %v", err)
+	}
+
+	if err := queries.DeleteJudgeSpeakerScores(ctx, judgeBallotID); err != nil {
+		return fmt.Errorf("failed to clear judge speaker scores: %v", err)
+	}
+	for _, team := range ballotTeams(ballot) {
+		for _, speaker := range team.GetSpeakers() {
+			err := queries.CreateJudgeSpeakerScore(ctx, models.CreateJudgeSpeakerScoreParams{
+				Judgeballotid: judgeBallotID,
+				Speakerid:     speaker.GetSpeakerId(),
+				Speakerrank:   speaker.GetRank(),
+				Speakerpoints: fmt.Sprintf("%.2f", speaker.GetPoints()),
+				Feedback:      sql.NullString{String: speaker.GetFeedback(), Valid: speaker.GetFeedback() != ""},
+				Replypoints:   fmt.Sprintf("%.2f", speaker.GetReplyPoints()),
+				Teamid:        team.GetTeamId(),
+				Position:      speaker.GetPosition(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save judge speaker score: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// submitJudgeBallot saves the ballot of the judge at votes[vote] together with
+// a new version of it, and decides the debate once every judge on the panel
+// has voted.
+func submitJudgeBallot(ctx context.Context, queries *models.Queries, current models.GetBallotByIDRow, votes []panelVote, vote int, ballot *debate_management.Ballot, verdict string, revision ballotRevision) error {
+	judgeID := votes[vote].judge.GetJudgeId()
+	if err := saveJudgeBallot(ctx, queries, judgeID, ballot, verdict); err != nil {
+		return err
+	}
+	if err := saveBallotVersion(ctx, queries, current, judgeID, ballot, verdict, revision); err != nil {
+		return err
+	}
+	votes[vote].submitted = true
+	votes[vote].verdict = verdict
+
+	if !panelComplete(votes) {
+		return nil
+	}
+	log.Printf("Recording panel decision for ballotID=%d", current.Ballotid)
+	decision, err := recordPanelDecision(ctx, queries, current, votes, revision.createdBy)
+	if err != nil {
+		return err
+	}
+
+	// Move the winner of a two-team elimination debate up the bracket
+	if current.Iseliminationround && !current.Team3id.Valid {
+		if err := advanceBracketWinner(ctx, queries, current, decision); err != nil {
+			log.Printf("Failed to advance elimination bracket winner: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// recordPanelDecision records the panel's decision on the debate's ballot: the
+// majority verdict, with team totals and speaker points averaged over the
+// judges who voted. Feedback is the head judge's. Returns the verdict.
+func recordPanelDecision(ctx context.Context, queries *models.Queries, current models.GetBallotByIDRow, votes []panelVote, updatedBy int32) (string, error) {
+	verdict := majorityVerdict(votes)
+	voting := make(map[int32]bool, len(votes))
+	var headJudgeID int32
+	for _, vote := range votes {
+		voting[vote.judge.GetJudgeId()] = true
+		if vote.isHeadJudge {
+			headJudgeID = vote.judge.GetJudgeId()
+		}
+	}
+
+	judgeBallots, err := queries.GetJudgeBallots(ctx, current.Ballotid)
+	if err != nil {
+		return "", fmt.Errorf("failed to get judge ballots: %v", err)
+	}
+
+	decision := &debate_management.Ballot{
+		BallotId: current.Ballotid,
+		Team1:    &debate_management.Team{TeamId: current.Team1id},
+		Team2:    &debate_management.Team{TeamId: current.Team2id},
+		Team3:    convertOptionalTeamToProto(current.Team3id, current.Team3name),
+		Team4:    convertOptionalTeamToProto(current.Team4id, current.Team4name),
+	}
+	teams := ballotTeams(decision)
+	counted := 0
+	for _, judgeBallot := range judgeBallots {
+		if !voting[judgeBallot.Judgeid] {
+			continue
+		}
+		counted++
+		totals := []sql.NullString{judgeBallot.Team1totalscore, judgeBallot.Team2totalscore, judgeBallot.Team3totalscore, judgeBallot.Team4totalscore}
+		feedback := []sql.NullString{judgeBallot.Team1feedback, judgeBallot.Team2feedback, judgeBallot.Team3feedback, judgeBallot.Team4feedback}
+		for i, team := range teams {
+			points, _ := strconv.ParseFloat(totals[i].String, 64)
+			team.TotalPoints += points
+			if judgeBallot.Judgeid == headJudgeID {
+				team.Feedback = feedback[i].String
+			}
+		}
+	}
+	if counted > 0 {
+		for _, team := range teams {
+			team.TotalPoints /= float64(counted)
+		}
+	}
+
+	err = queries.UpdateBallot(ctx, models.UpdateBallotParams{
+		Ballotid:           current.Ballotid,
+		Team1totalscore:    optionalTeamScore(decision.GetTeam1()),
+		Team2totalscore:    optionalTeamScore(decision.GetTeam2()),
+		Recordingstatus:    "Recorded",
+		Verdict:            verdict,
+		Team1feedback:      optionalTeamFeedback(decision.GetTeam1()),
+		Team2feedback:      optionalTeamFeedback(decision.GetTeam2()),
+		LastUpdatedBy:      sql.NullInt32{Int32: updatedBy, Valid: true},
+		HeadJudgeSubmitted: sql.NullBool{Bool: true, Valid: true},
+		Team3totalscore:    optionalTeamScore(decision.GetTeam3()),
+		Team4totalscore:    optionalTeamScore(decision.GetTeam4()),
+		Team3feedback:      optionalTeamFeedback(decision.GetTeam3()),
+		Team4feedback:      optionalTeamFeedback(decision.GetTeam4()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update ballot: %v", err)
+	}
+
+	if err := recordPanelSpeakerScores(ctx, queries, current.Ballotid, voting, headJudgeID); err != nil {
+		return "", err
+	}
+	if err := updateTeamScores(ctx, queries, decision); err != nil {
+		return "", err
+	}
+	return verdict, nil
+}
+
+// recordPanelSpeakerScores averages the rank and points of each speech over
+// the judges who scored it. A speech is a team's speaking position; should
+// the judges disagree on who gave it, the head judge's speaker is recorded.
+func recordPanelSpeakerScores(ctx context.Context, queries *models.Queries, ballotID int32, voting map[int32]bool, headJudgeID int32) error {
+	scores, err := queries.GetPanelSpeakerScores(ctx, ballotID)
+	if err != nil {
+		return fmt.Errorf("failed to get judge speaker scores: %v", err)
+	}
+
+	type speech struct {
+		teamID, position int32
+	}
+	type speechTotal struct {
+		speakerID                 int32
+		rank, points, replyPoints float64
+		judges                    int
+		feedback                  sql.NullString
+	}
+	totals := make(map[speech]*speechTotal)
+	var speeches []speech
+	for _, score := range scores {
+		if !voting[score.Judgeid] {
+			continue
+		}
+		key := speech{teamID: score.Teamid, position: score.Position}
+		total, ok := totals[key]
+		if !ok {
+			total = &speechTotal{speakerID: score.Speakerid}
+			totals[key] = total
+			speeches = append(speeches, key)
+		}
+		points, _ := strconv.ParseFloat(score.Speakerpoints, 64)
+		replyPoints, _ := strconv.ParseFloat(score.Replypoints, 64)
+		total.rank += float64(score.Speakerrank)
+		total.points += points
+		total.replyPoints += replyPoints
+		total.judges++
+		if score.Judgeid == headJudgeID {
+			total.speakerID = score.Speakerid
+			total.feedback = score.Feedback
+		}
+	}
+
+	for _, key := range speeches {
+		total := totals[key]
+		err := queries.UpsertSpeakerScore(ctx, models.UpsertSpeakerScoreParams{
+			Ballotid:      ballotID,
+			Teamid:        key.teamID,
+			Position:      key.position,
+			Speakerid:     total.speakerID,
+			Speakerrank:   int32(math.Round(total.rank / float64(total.judges))),
+			Speakerpoints: fmt.Sprintf("%.2f", total.points/float64(total.judges)),
+			Feedback:      total.feedback,
+			Replypoints:   fmt.Sprintf("%.2f", total.replyPoints/float64(total.judges)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update speaker score: %v", err)
+		}
+	}
+	return nil
+}
+
+// applyJudgeBallot shows a judge their own submitted ballot of a debate in
+// place of the panel decision.
+func applyJudgeBallot(ctx context.Context, queries *models.Queries, ballot *debate_management.Ballot, judgeID int32) error {
+	judgeBallots, err := queries.GetJudgeBallots(ctx, ballot.GetBallotId())
+	if err != nil {
+		return fmt.Errorf("failed to get judge ballots: %v", err)
+	}
+	for _, judgeBallot := range judgeBallots {
+		if judgeBallot.Judgeid != judgeID {
+			continue
+		}
+
+		scores, err := queries.GetJudgeSpeakerScores(ctx, models.GetJudgeSpeakerScoresParams{
+			Ballotid: ballot.GetBallotId(),
+			Judgeid:  judgeID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get judge speaker scores: %v", err)
+		}
+
+		ballot.Verdict = judgeBallot.Verdict
+		totals := []sql.NullString{judgeBallot.Team1totalscore, judgeBallot.Team2totalscore, judgeBallot.Team3totalscore, judgeBallot.Team4totalscore}
+		feedback := []sql.NullString{judgeBallot.Team1feedback, judgeBallot.Team2feedback, judgeBallot.Team3feedback, judgeBallot.Team4feedback}
+		speakerScores := make([]models.GetSpeakerScoresByBallotRow, len(scores))
+		for i, score := range scores {
+			speakerScores[i] = models.GetSpeakerScoresByBallotRow(score)
+		}
+		for i, team := range ballotTeams(ballot) {
+			team.TotalPoints, _ = strconv.ParseFloat(totals[i].String, 64)
+			team.Feedback = feedback[i].String
+			team.Speakers = nil
+			team.SpeakerNames = nil
+		}
+		assignSpeakersToTeams(ballot, speakerScores)
+		setBritishParliamentaryTeamPoints(ballot)
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+)
+
+// BallotViolation is a ballot field that breaks its format's scoring rules.
+// Field is the path of the field in the ballot, e.g. "team1.speakers[0].points".
+type BallotViolation struct {
+	Field   string
+	Message string
+}
+
+// BallotValidationError rejects a ballot that breaks its format's scoring rules.
+type BallotValidationError struct {
+	Violations []BallotViolation
+}
+
+func (e *BallotValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Field + ": " + violation.Message
+	}
+	return "invalid ballot: " + strings.Join(messages, "; ")
+}
+
+// scoringRules are the rules of a tournament's format that ballots are checked against.
+type scoringRules struct {
+	speakersPerTeam   int32
+	minSpeakerPoints  float64
+	maxSpeakerPoints  float64
+	speakerPointStep  float64
+	allowLowPointWins bool
+	replySpeeches     bool
+	minReplyPoints    float64
+	maxReplyPoints    float64
+	tieHandling       string
+}
+
+func convertScoringRules(row models.GetBallotScoringRulesRow) scoringRules {
+	rules := scoringRules{
+		speakersPerTeam:   row.Speakersperteam,
+		allowLowPointWins: row.Allowlowpointwins,
+		replySpeeches:     row.Replyspeeches,
+		tieHandling:       row.Tiehandling,
+	}
+	rules.minSpeakerPoints, _ = strconv.ParseFloat(row.Minspeakerpoints, 64)
+	rules.maxSpeakerPoints, _ = strconv.ParseFloat(row.Maxspeakerpoints, 64)
+	rules.speakerPointStep, _ = strconv.ParseFloat(row.Speakerpointstep, 64)
+	rules.minReplyPoints, _ = strconv.ParseFloat(row.Minreplypoints, 64)
+	rules.maxReplyPoints, _ = strconv.ParseFloat(row.Maxreplypoints, 64)
+	return rules
+}
+
+// pointsEqual compares scores to the hundredth of a point they are stored with.
+func pointsEqual(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// speakerPointsError describes why speaker points break the format's range or
+// step, or returns "" if they are valid.
+func (r scoringRules) speakerPointsError(points float64) string {
+	if points < r.minSpeakerPoints || points > r.maxSpeakerPoints {
+		return fmt.Sprintf("speaker points must be between %g and %g", r.minSpeakerPoints, r.maxSpeakerPoints)
+	}
+	if r.speakerPointStep > 0 {
+		steps := (points - r.minSpeakerPoints) / r.speakerPointStep
+		if math.Abs(steps-math.Round(steps)) > 1e-6 {
+			return fmt.Sprintf("speaker points must go up in steps of %g from %g", r.speakerPointStep, r.minSpeakerPoints)
+		}
+	}
+	return ""
+}
+
+// validateBallot checks a submitted ballot against the scoring rules of its
+// format: speaker and reply points in range, speaker ranks unique across the
+// debate, team totals adding up to their speakers' points, and a verdict that
+// names a team in the debate, respecting the format's low-point win and tie
+// rules. British Parliamentary ballots never allow tied team totals.
+//
+// Speeches are placed by speaking position, one speech per position. A
+// speaker may give two speeches for a team short a member, and a student who
+// is not on any team of the debate may substitute; members maps each team of
+// the debate to its students.
+func validateBallot(ballot *debate_management.Ballot, current models.GetBallotByIDRow, rules scoringRules, members map[int32]map[int32]bool) []BallotViolation {
+	var violations []BallotViolation
+	violate := func(field string, format string, args ...interface{}) {
+		violations = append(violations, BallotViolation{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	slots := []*debate_management.Team{ballot.GetTeam1(), ballot.GetTeam2()}
+	names := []string{current.Team1name, current.Team2name}
+	if current.Team3id.Valid && current.Team4id.Valid {
+		slots = append(slots, ballot.GetTeam3(), ballot.GetTeam4())
+		names = append(names, current.Team3name.String, current.Team4name.String)
+	}
+
+	speakerCount := 0
+	for _, team := range slots {
+		speakerCount += len(team.GetSpeakers())
+	}
+
+	teamIDs := []int32{current.Team1id, current.Team2id, current.Team3id.Int32, current.Team4id.Int32}
+	rankedBy := make(map[int32]string)
+	speeches := make(map[int32]int)
+	spokeFor := make(map[int32]int)
+	missing := false
+	for i, team := range slots {
+		prefix := fmt.Sprintf("team%d", i+1)
+		if team == nil {
+			violate(prefix, "scores for %s are missing", names[i])
+			missing = true
+			continue
+		}
+
+		speakerTotal := 0.0
+		replies := 0
+		positionedBy := make(map[int32]string)
+		for j, speaker := range team.GetSpeakers() {
+			field := fmt.Sprintf("%s.speakers[%d]", prefix, j)
+
+			switch position := speaker.GetPosition(); {
+			case position < 1 || (rules.speakersPerTeam > 0 && position > rules.speakersPerTeam):
+				violate(field+".position", "position must be between 1 and %d", rules.speakersPerTeam)
+			case positionedBy[position] != "":
+				violate(field+".position", "position %d is already given to %s", position, positionedBy[position])
+			default:
+				positionedBy[position] = field
+			}
+
+			speakerID := speaker.GetSpeakerId()
+			for k, teamID := range teamIDs[:len(slots)] {
+				if k != i && members[teamID][speakerID] {
+					violate(field+".speaker_id", "speaker %d is a member of %s", speakerID, names[k])
+				}
+			}
+			if k, ok := spokeFor[speakerID]; ok && k != i {
+				violate(field+".speaker_id", "speaker %d already spoke for %s", speakerID, names[k])
+			}
+			spokeFor[speakerID] = i
+			if speeches[speakerID]++; speeches[speakerID] > 2 {
+				violate(field+".speaker_id", "speaker %d cannot give more than two speeches", speakerID)
+			}
+
+			if message := rules.speakerPointsError(speaker.GetPoints()); message != "" {
+				violate(field+".points", "%s", message)
+			}
+
+			switch rank := speaker.GetRank(); {
+			case rank < 1 || int(rank) > speakerCount:
+				violate(field+".rank", "rank must be between 1 and %d", speakerCount)
+			case rankedBy[rank] != "":
+				violate(field+".rank", "rank %d is already given to %s", rank, rankedBy[rank])
+			default:
+				rankedBy[rank] = field
+			}
+
+			if reply := speaker.GetReplyPoints(); reply != 0 {
+				replies++
+				switch {
+				case !rules.replySpeeches:
+					violate(field+".reply_points", "this format has no reply speeches")
+				case reply < rules.minReplyPoints || reply > rules.maxReplyPoints:
+					violate(field+".reply_points", "reply points must be between %g and %g", rules.minReplyPoints, rules.maxReplyPoints)
+				}
+			}
+			speakerTotal += speaker.GetPoints() + speaker.GetReplyPoints()
+		}
+
+		if len(team.GetSpeakers()) == 0 {
+			continue
+		}
+		if rules.replySpeeches && replies != 1 {
+			violate(prefix+".speakers", "exactly one speaker of %s must give the reply speech", names[i])
+		}
+		if !pointsEqual(team.GetTotalPoints(), speakerTotal) {
+			violate(prefix+".total_points", "total points %.2f do not add up to the speakers' %.2f", team.GetTotalPoints(), speakerTotal)
+		}
+	}
+	if missing {
+		return violations
+	}
+
+	// British Parliamentary rooms are placed by team totals
+	if len(slots) == 4 {
+		for i := range slots {
+			for j := i + 1; j < len(slots); j++ {
+				if pointsEqual(slots[i].GetTotalPoints(), slots[j].GetTotalPoints()) {
+					violate(fmt.Sprintf("team%d.total_points", j+1), "british parliamentary ballots cannot have tied team totals: %s and %s both have %.2f",
+						names[i], names[j], slots[j].GetTotalPoints())
+				}
+			}
+		}
+		return violations
+	}
+
+	winner := -1
+	for i, name := range names {
+		if ballot.GetVerdict() == name {
+			winner = i
+		}
+	}
+	if winner == -1 {
+		violate("verdict", "verdict must name %s or %s", names[0], names[1])
+		return violations
+	}
+
+	loser := 1 - winner
+	switch {
+	case pointsEqual(slots[winner].GetTotalPoints(), slots[loser].GetTotalPoints()):
+		if rules.tieHandling == "reject" {
+			violate("team2.total_points", "team totals cannot be tied")
+		}
+	case slots[winner].GetTotalPoints() < slots[loser].GetTotalPoints() && !rules.allowLowPointWins:
+		violate("verdict", "low-point wins are not allowed: %s scored fewer points than %s", names[winner], names[loser])
+	}
+	return violations
+}
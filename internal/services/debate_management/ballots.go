@@ -22,8 +22,11 @@ func NewBallotService(db *sql.DB) *BallotService {
 	return &BallotService{db: db}
 }
 
+// GetBallots returns the ballots of a round. Non-admins only get the ballots
+// of released debates, so a draft draw stays hidden until it is released.
 func (s *BallotService) GetBallots(ctx context.Context, req *debate_management.GetBallotsRequest) ([]*debate_management.Ballot, error) {
-	if err := s.validateAuthentication(req.GetToken()); err != nil {
+	isAdmin, err := s.isAdmin(req.GetToken())
+	if err != nil {
 		return nil, err
 	}
 
@@ -32,12 +35,27 @@ func (s *BallotService) GetBallots(ctx context.Context, req *debate_management.G
 		Tournamentid:       req.GetTournamentId(),
 		Roundnumber:        req.GetRoundNumber(),
 		Iseliminationround: req.GetIsElimination(),
+		Column4:            !isAdmin,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ballots: %v", err)
 	}
 
-	return convertBallots(ballots), nil
+	panelRows, err := queries.GetBallotPanelsByTournamentAndRound(ctx, models.GetBallotPanelsByTournamentAndRoundParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsElimination(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot panels: %v", err)
+	}
+	panels := ballotPanels(panelRows)
+
+	convertedBallots := convertBallots(ballots)
+	for _, ballot := range convertedBallots {
+		applyPanelSummary(ballot, ballotPanel(panels[ballot.GetBallotId()], ballot.GetTeam3() != nil && ballot.GetTeam4() != nil))
+	}
+	return convertedBallots, nil
 }
 
 func (s *BallotService) GetBallot(ctx context.Context, req *debate_management.GetBallotRequest) (*debate_management.Ballot, error) {
@@ -68,36 +86,19 @@ func (s *BallotService) GetBallot(ctx context.Context, req *debate_management.Ge
 		return nil, fmt.Errorf("failed to get speaker scores: %v", err)
 	}
 
-	// Create maps to store speakers for each team
-	team1Speakers := make([]*debate_management.Speaker, 0)
-	team2Speakers := make([]*debate_management.Speaker, 0)
-	team1Names := make([]string, 0)
-	team2Names := make([]string, 0)
-
 	// Sort speakers into their respective teams
-	for _, score := range speakerScores {
-		speaker := convertSpeakerScore(score)
-		if score.Teamid == convertedBallot.Team1.TeamId {
-			team1Speakers = append(team1Speakers, speaker)
-			team1Names = append(team1Names, speaker.Name)
-		} else if score.Teamid == convertedBallot.Team2.TeamId {
-			team2Speakers = append(team2Speakers, speaker)
-			team2Names = append(team2Names, speaker.Name)
-		} else {
-			log.Printf("Warning: Speaker %d doesn't match either team (Team1: %d, Team2: %d)",
-				speaker.SpeakerId, convertedBallot.Team1.TeamId, convertedBallot.Team2.TeamId)
-		}
-	}
+	assignSpeakersToTeams(convertedBallot, speakerScores)
 
 	// Sort speakers by rank within each team
-	sortSpeakersByRank(team1Speakers)
-	sortSpeakersByRank(team2Speakers)
+	for _, team := range ballotTeams(convertedBallot) {
+		sortSpeakersByRank(team.Speakers)
+	}
 
-	// Assign sorted speakers to teams
-	convertedBallot.Team1.Speakers = team1Speakers
-	convertedBallot.Team1.SpeakerNames = team1Names
-	convertedBallot.Team2.Speakers = team2Speakers
-	convertedBallot.Team2.SpeakerNames = team2Names
+	panelRows, err := queries.GetBallotPanel(ctx, req.GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot panel: %v", err)
+	}
+	applyPanelSummary(convertedBallot, ballotPanel(panelRows, ballot.Team3id.Valid && ballot.Team4id.Valid))
 
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
@@ -106,6 +107,72 @@ func (s *BallotService) GetBallot(ctx context.Context, req *debate_management.Ge
 	return convertedBallot, nil
 }
 
+// assignSpeakersToTeams puts each speaker score on the ballot team it belongs to.
+func assignSpeakersToTeams(ballot *debate_management.Ballot, speakerScores []models.GetSpeakerScoresByBallotRow) {
+	teams := ballotTeams(ballot)
+	for _, score := range speakerScores {
+		speaker := convertSpeakerScore(score)
+		matched := false
+		for _, team := range teams {
+			if score.Teamid == team.TeamId {
+				team.Speakers = append(team.Speakers, speaker)
+				team.SpeakerNames = append(team.SpeakerNames, speaker.Name)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			log.Printf("Warning: Speaker %d doesn't match any team in ballot %d", speaker.SpeakerId, ballot.BallotId)
+		}
+	}
+}
+
+// ballotTeams returns the teams on a ballot in seating order: two for
+// two-team formats, four (OG, OO, CG, CO) for British Parliamentary.
+func ballotTeams(ballot *debate_management.Ballot) []*debate_management.Team {
+	teams := make([]*debate_management.Team, 0, 4)
+	for _, team := range []*debate_management.Team{ballot.GetTeam1(), ballot.GetTeam2(), ballot.GetTeam3(), ballot.GetTeam4()} {
+		if team != nil {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// prepareBallotSpeeches sets the teams of a submitted ballot from its debate
+// and numbers the speeches of each team in the order they are listed when
+// the ballot gives no speaking positions.
+func prepareBallotSpeeches(ballot *debate_management.Ballot, current models.GetBallotByIDRow) {
+	teamIDs := []int32{current.Team1id, current.Team2id, current.Team3id.Int32, current.Team4id.Int32}
+	for i, team := range []*debate_management.Team{ballot.GetTeam1(), ballot.GetTeam2(), ballot.GetTeam3(), ballot.GetTeam4()} {
+		if team == nil {
+			continue
+		}
+		team.TeamId = teamIDs[i]
+		for j, speaker := range team.GetSpeakers() {
+			if speaker.GetPosition() == 0 {
+				speaker.Position = int32(j + 1)
+			}
+		}
+	}
+}
+
+// ballotTeamMembers returns the members of each team of a ballot's debate.
+func ballotTeamMembers(ctx context.Context, queries *models.Queries, ballotID int32) (map[int32]map[int32]bool, error) {
+	rows, err := queries.GetBallotTeamMembers(ctx, ballotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %v", err)
+	}
+	members := make(map[int32]map[int32]bool)
+	for _, row := range rows {
+		if members[row.Teamid] == nil {
+			members[row.Teamid] = make(map[int32]bool)
+		}
+		members[row.Teamid][row.Studentid] = true
+	}
+	return members, nil
+}
+
 func sortSpeakersByRank(speakers []*debate_management.Speaker) {
 	sort.Slice(speakers, func(i, j int) bool {
 		return speakers[i].Rank < speakers[j].Rank
@@ -135,21 +202,27 @@ func (s *BallotService) GetBallotByJudgeID(ctx context.Context, req *debate_mana
 	}
 
 	// Assign speaker scores to teams
-	for _, score := range speakerScores {
-		speaker := convertSpeakerScore(score)
-		if score.Teamid == convertedBallot.Team1.TeamId {
-			convertedBallot.Team1.Speakers = append(convertedBallot.Team1.Speakers, speaker)
-			convertedBallot.Team1.SpeakerNames = append(convertedBallot.Team1.SpeakerNames, speaker.Name)
-		} else if score.Teamid == convertedBallot.Team2.TeamId {
-			convertedBallot.Team2.Speakers = append(convertedBallot.Team2.Speakers, speaker)
-			convertedBallot.Team2.SpeakerNames = append(convertedBallot.Team2.SpeakerNames, speaker.Name)
-		}
+	assignSpeakersToTeams(convertedBallot, speakerScores)
+
+	// A judge who already voted sees their own ballot
+	if err := applyJudgeBallot(ctx, queries, convertedBallot, req.GetJudgeId()); err != nil {
+		return nil, err
 	}
 
+	panelRows, err := queries.GetBallotPanel(ctx, ballot.Ballotid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot panel: %v", err)
+	}
+	applyPanelSummary(convertedBallot, ballotPanel(panelRows, ballot.Team3id.Valid && ballot.Team4id.Valid))
+
 	return convertedBallot, nil
 }
 
-func (s *BallotService) UpdateBallot(ctx context.Context, req *debate_management.UpdateBallotRequest) (*debate_management.Ballot, error) {
+// UpdateBallot submits a judge's ballot. When the tournament uses double entry,
+// a ballot keyed in by anyone but the judge is only submitted once a second
+// user has keyed in the same ballot; the response then carries the entry's
+// status and any conflicts with the other user's entry.
+func (s *BallotService) UpdateBallot(ctx context.Context, req *debate_management.UpdateBallotRequest) (*debate_management.UpdateBallotResponse, error) {
 	claims, err := utils.ValidateToken(req.GetToken())
 	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %v", err)
@@ -173,19 +246,7 @@ func (s *BallotService) UpdateBallot(ctx context.Context, req *debate_management
 
 	queries := models.New(s.db).WithTx(tx)
 
-	// Check if the user is admin or head judge
 	isAdmin := userRole == "admin"
-	isHeadJudge, err := queries.IsHeadJudgeForBallot(ctx, models.IsHeadJudgeForBallotParams{
-		Ballotid: req.GetBallot().GetBallotId(),
-		Judgeid:  int32(userID),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to check if user is head judge: %v", err)
-	}
-
-	if !isAdmin && !isHeadJudge {
-		return nil, fmt.Errorf("unauthorized: only admins or the head judge can update this ballot")
-	}
 
 	// Get the current ballot state
 	currentBallot, err := queries.GetBallotByID(ctx, req.GetBallot().GetBallotId())
@@ -193,58 +254,98 @@ func (s *BallotService) UpdateBallot(ctx context.Context, req *debate_management
 		return nil, fmt.Errorf("failed to get current ballot state: %v", err)
 	}
 	log.Printf("Current ballot state: %+v\n", currentBallot)
+	isBritishParliamentary := currentBallot.Team3id.Valid && currentBallot.Team4id.Valid
 
-	// Check if head judge has already submitted
-	if !isAdmin && currentBallot.HeadJudgeSubmitted.Bool {
-		log.Printf("Ballot %d has already been submitted by the head judge\n", req.GetBallot().GetBallotId())
-		return nil, fmt.Errorf("ballot can be submitted only once. head judge already submitted")
+	panelRows, err := queries.GetBallotPanel(ctx, req.GetBallot().GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ballot panel: %v", err)
 	}
+	votes := ballotPanel(panelRows, isBritishParliamentary)
 
-	// Update main ballot information
-	log.Printf("Updating main ballot information: ballotID=%d", req.GetBallot().GetBallotId())
-	err = queries.UpdateBallot(ctx, models.UpdateBallotParams{
-		Ballotid: req.GetBallot().GetBallotId(),
-		Team1totalscore: sql.NullString{
-			String: fmt.Sprintf("%.2f", req.GetBallot().GetTeam1().GetTotalPoints()),
-			Valid:  true,
-		},
-		Team2totalscore: sql.NullString{
-			String: fmt.Sprintf("%.2f", req.GetBallot().GetTeam2().GetTotalPoints()),
-			Valid:  true,
-		},
-		Recordingstatus: "Recorded",
-		Verdict:         req.GetBallot().GetVerdict(),
-		Team1feedback:   sql.NullString{String: req.GetBallot().GetTeam1().GetFeedback(), Valid: true},
-		Team2feedback:   sql.NullString{String: req.GetBallot().GetTeam2().GetFeedback(), Valid: true},
-		LastUpdatedBy: sql.NullInt32{
-			Int32: int32(userID),
-			Valid: true,
-		},
-		HeadJudgeSubmitted: sql.NullBool{
-			Bool:  isHeadJudge,
-			Valid: true,
-		},
-	})
-	if err != nil {
-		log.Printf("Failed to update ballot: %v", err)
-		return nil, fmt.Errorf("failed to update ballot: %v", err)
+	// Judges submit their own ballot; admins submit for a panel judge, the head judge by default
+	judgeID := int32(userID)
+	if isAdmin {
+		judgeID = req.GetJudgeId()
+	}
+	vote := -1
+	for i := range votes {
+		if votes[i].judge.GetJudgeId() == judgeID || (judgeID == 0 && votes[i].isHeadJudge) {
+			vote = i
+		}
+	}
+	if vote == -1 {
+		if isBritishParliamentary {
+			return nil, fmt.Errorf("unauthorized: british parliamentary panels confer and only the head judge submits the ballot")
+		}
+		if !isAdmin {
+			return nil, fmt.Errorf("unauthorized: only admins or judges on the panel can update this ballot")
+		}
+		return nil, fmt.Errorf("judge %d is not on the panel of this debate", judgeID)
 	}
-	log.Printf("Successfully updated main ballot information for ballotID=%d", req.GetBallot().GetBallotId())
+	judgeID = votes[vote].judge.GetJudgeId()
 
-	// Update speaker scores
-	log.Println("Updating speaker scores")
-	err = updateSpeakerScores(ctx, queries, req.GetBallot())
+	// Check if the judge has already submitted
+	if !isAdmin && votes[vote].submitted {
+		log.Printf("Ballot %d has already been submitted by judge %d\n", req.GetBallot().GetBallotId(), judgeID)
+		return nil, fmt.Errorf("ballot can be submitted only once. judge already submitted")
+	}
+
+	// Check the ballot against the scoring rules of the tournament's format
+	rules, err := queries.GetBallotScoringRules(ctx, req.GetBallot().GetBallotId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scoring rules: %v", err)
+	}
+	members, err := ballotTeamMembers(ctx, queries, req.GetBallot().GetBallotId())
 	if err != nil {
-		log.Printf("Failed to update speaker scores: %v", err)
 		return nil, err
 	}
+	prepareBallotSpeeches(req.GetBallot(), currentBallot)
+	if violations := validateBallot(req.GetBallot(), currentBallot, convertScoringRules(rules), members); len(violations) > 0 {
+		return nil, &BallotValidationError{Violations: violations}
+	}
 
-	// Update team scores
-	log.Println("Updating team scores")
-	err = updateTeamScores(ctx, queries, req.GetBallot())
+	// British Parliamentary ballots rank four teams; the verdict is the first-placed team
+	verdict := req.GetBallot().GetVerdict()
+	if isBritishParliamentary {
+		verdict, err = britishParliamentaryVerdict(req.GetBallot())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doubleEntry, err := queries.GetBallotEntryMode(ctx, req.GetBallot().GetBallotId())
 	if err != nil {
-		log.Printf("Failed to update team scores: %v", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to get ballot entry mode: %v", err)
+	}
+
+	response := &debate_management.UpdateBallotResponse{}
+	revision := ballotRevision{
+		createdBy: int32(userID),
+		reason:    req.GetReason(),
+	}
+	if doubleEntry && judgeID != int32(userID) {
+		log.Printf("Entering ballot of judge %d for confirmation: ballotID=%d", judgeID, req.GetBallot().GetBallotId())
+		response.EntryStatus, response.Conflicts, err = enterBallot(ctx, queries, currentBallot, votes, vote, req.GetBallot(), verdict, revision)
+		if err != nil {
+			log.Printf("Failed to enter judge ballot: %v", err)
+			return nil, err
+		}
+	} else {
+		log.Printf("Saving ballot of judge %d: ballotID=%d", judgeID, req.GetBallot().GetBallotId())
+		err = submitJudgeBallot(ctx, queries, currentBallot, votes, vote, req.GetBallot(), verdict, revision)
+		if err != nil {
+			log.Printf("Failed to submit judge ballot: %v", err)
+			return nil, err
+		}
+
+		// Entries keyed in for the judge are superseded by the submitted ballot
+		err = queries.DeletePendingBallotEntries(ctx, models.DeletePendingBallotEntriesParams{
+			Ballotid: req.GetBallot().GetBallotId(),
+			Judgeid:  judgeID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to clear ballot entries: %v", err)
+		}
 	}
 
 	// Fetch the updated ballot
@@ -260,32 +361,9 @@ func (s *BallotService) UpdateBallot(ctx context.Context, req *debate_management
 	}
 
 	log.Printf("Successfully updated ballot: %+v", updatedBallot)
-	return convertBallot(updatedBallot), nil
-}
-
-func updateSpeakerScores(ctx context.Context, queries *models.Queries, ballot *debate_management.Ballot) error {
-	log.Println("Starting updateSpeakerScores")
-
-	for _, team := range []*debate_management.Team{ballot.GetTeam1(), ballot.GetTeam2()} {
-		for _, speaker := range team.GetSpeakers() {
-			log.Printf("Updating speaker score: speakerID=%d, rank=%d, points=%.2f, feedback=%s",
-				speaker.GetSpeakerId(), speaker.GetRank(), speaker.GetPoints(), speaker.GetFeedback())
-			err := queries.UpdateSpeakerScore(ctx, models.UpdateSpeakerScoreParams{
-				Ballotid:      ballot.GetBallotId(),
-				Speakerid:     speaker.GetSpeakerId(),
-				Speakerrank:   int32(speaker.GetRank()),
-				Speakerpoints: fmt.Sprintf("%.2f", speaker.GetPoints()),
-				Feedback:      sql.NullString{String: speaker.GetFeedback(), Valid: speaker.GetFeedback() != ""},
-			})
-			if err != nil {
-				log.Printf("Failed to update speaker score: %v", err)
-				return fmt.Errorf("failed to update speaker score: %v", err)
-			}
-		}
-	}
-
-	log.Println("Finished updateSpeakerScores successfully")
-	return nil
+	response.Ballot = convertBallot(updatedBallot)
+	applyPanelSummary(response.Ballot, votes)
+	return response, nil
 }
 
 func updateTeamScores(ctx context.Context, queries *models.Queries, ballot *debate_management.Ballot) error {
@@ -309,10 +387,76 @@ func updateTeamScores(ctx context.Context, queries *models.Queries, ballot *deba
 		return err
 	}
 
+	// Update TeamScores for the closing teams of a British Parliamentary room
+	for _, team := range []*debate_management.Team{ballot.GetTeam3(), ballot.GetTeam4()} {
+		if team == nil {
+			continue
+		}
+		err = updateTeamScore(ctx, queries, team.GetTeamId(), team.GetTotalPoints(), ballot.GetBallotId())
+		if err != nil {
+			log.Printf("Failed to update closing team score: %v", err)
+			return err
+		}
+	}
+
 	log.Println("Finished updateTeamScores successfully")
 	return nil
 }
 
+// britishParliamentaryVerdict checks that the four teams of a BP ballot can be
+// ranked by their total points and returns the name of the first-placed team.
+func britishParliamentaryVerdict(ballot *debate_management.Ballot) (string, error) {
+	teams := ballotTeams(ballot)
+	if len(teams) != 4 {
+		return "", fmt.Errorf("british parliamentary ballots need scores for all four teams")
+	}
+
+	placed := rankBallotTeams(teams)
+	for i := 1; i < len(placed); i++ {
+		if placed[i].GetTotalPoints() == placed[i-1].GetTotalPoints() {
+			return "", fmt.Errorf("british parliamentary ballots cannot have tied team totals: %s and %s both have %.2f",
+				placed[i-1].GetName(), placed[i].GetName(), placed[i].GetTotalPoints())
+		}
+	}
+	return placed[0].GetName(), nil
+}
+
+// rankBallotTeams orders the teams of a ballot from first to last place.
+func rankBallotTeams(teams []*debate_management.Team) []*debate_management.Team {
+	placed := make([]*debate_management.Team, len(teams))
+	copy(placed, teams)
+	sort.SliceStable(placed, func(i, j int) bool {
+		return placed[i].GetTotalPoints() > placed[j].GetTotalPoints()
+	})
+	return placed
+}
+
+// setBritishParliamentaryTeamPoints fills in the 3/2/1/0 team points of a
+// recorded BP ballot from the team totals.
+func setBritishParliamentaryTeamPoints(ballot *debate_management.Ballot) {
+	teams := ballotTeams(ballot)
+	if len(teams) != 4 || ballot.GetRecordingStatus() != "Recorded" {
+		return
+	}
+	for place, team := range rankBallotTeams(teams) {
+		team.TeamPoints = int32(len(teams) - 1 - place)
+	}
+}
+
+func optionalTeamScore(team *debate_management.Team) sql.NullString {
+	if team == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: fmt.Sprintf("%.2f", team.GetTotalPoints()), Valid: true}
+}
+
+func optionalTeamFeedback(team *debate_management.Team) sql.NullString {
+	if team == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: team.GetFeedback(), Valid: true}
+}
+
 func updateTeamScore(ctx context.Context, queries *models.Queries, teamID int32, totalScore float64, debateID int32) error {
 	log.Printf("Starting updateTeamScore: teamID=%d, totalScore=%.2f, debateID=%d",
 		teamID, totalScore, debateID)
@@ -337,16 +481,20 @@ func convertSpeakerScore(score models.GetSpeakerScoresByBallotRow) *debate_manag
 		log.Printf("Warning: Failed to parse speaker points '%s': %v", score.Speakerpoints, err)
 		points = 0
 	}
+	replyPoints, _ := strconv.ParseFloat(score.Replypoints, 64)
 
 	return &debate_management.Speaker{
-		SpeakerId: score.Speakerid,
-		Name:      strings.TrimSpace(score.Firstname + " " + score.Lastname),
-		ScoreId:   score.Scoreid,
-		Rank:      int32(score.Speakerrank),
-		Points:    points,
-		Feedback:  score.Feedback.String,
-		TeamId:    score.Teamid,
-		TeamName:  score.Teamname,
+		SpeakerId:    score.Speakerid,
+		Name:         strings.TrimSpace(score.Firstname + " " + score.Lastname),
+		ScoreId:      score.Scoreid,
+		Rank:         int32(score.Speakerrank),
+		Points:       points,
+		Feedback:     score.Feedback.String,
+		TeamId:       score.Teamid,
+		TeamName:     score.Teamname,
+		ReplyPoints:  replyPoints,
+		Position:     score.Position,
+		IsSubstitute: score.Issubstitute,
 	}
 }
 
@@ -371,6 +519,8 @@ func convertBallots(dbBallots []models.GetBallotsByTournamentAndRoundRow) []*deb
 				TeamId: dbBallot.Team2id,
 				Name:   dbBallot.Team2name,
 			},
+			Team3:           convertOptionalTeamToProto(dbBallot.Team3id, dbBallot.Team3name),
+			Team4:           convertOptionalTeamToProto(dbBallot.Team4id, dbBallot.Team4name),
 			RecordingStatus: dbBallot.Recordingstatus,
 			Verdict:         dbBallot.Verdict,
 		}
@@ -382,7 +532,7 @@ func convertBallot(dbBallot models.GetBallotByIDRow) *debate_management.Ballot {
 	team1TotalPoints, _ := strconv.ParseFloat(dbBallot.Team1totalscore.String, 64)
 	team2TotalPoints, _ := strconv.ParseFloat(dbBallot.Team2totalscore.String, 64)
 
-	return &debate_management.Ballot{
+	ballot := &debate_management.Ballot{
 		BallotId:      dbBallot.Ballotid,
 		RoundNumber:   dbBallot.Roundnumber,
 		IsElimination: dbBallot.Iseliminationround,
@@ -411,14 +561,19 @@ func convertBallot(dbBallot models.GetBallotByIDRow) *debate_management.Ballot {
 		LastUpdatedBy:      dbBallot.LastUpdatedBy.Int32,
 		LastUpdatedAt:      dbBallot.LastUpdatedAt.Time.String(),
 		HeadJudgeSubmitted: dbBallot.HeadJudgeSubmitted.Bool,
+		Team3:              convertOptionalBallotTeam(dbBallot.Team3id, dbBallot.Team3name, dbBallot.Team3totalscore, dbBallot.Team3feedback),
+		Team4:              convertOptionalBallotTeam(dbBallot.Team4id, dbBallot.Team4name, dbBallot.Team4totalscore, dbBallot.Team4feedback),
 	}
+	setBritishParliamentaryTeamPoints(ballot)
+
+	return ballot
 }
 
 func convertJudgeBallot(dbBallot models.GetBallotByJudgeIDRow) *debate_management.Ballot {
 	team1TotalPoints, _ := strconv.ParseFloat(dbBallot.Team1totalscore.String, 64)
 	team2TotalPoints, _ := strconv.ParseFloat(dbBallot.Team2totalscore.String, 64)
 
-	return &debate_management.Ballot{
+	ballot := &debate_management.Ballot{
 		BallotId:      dbBallot.Ballotid,
 		RoundNumber:   dbBallot.Roundnumber,
 		IsElimination: dbBallot.Iseliminationround,
@@ -447,7 +602,24 @@ func convertJudgeBallot(dbBallot models.GetBallotByJudgeIDRow) *debate_managemen
 		LastUpdatedBy:      dbBallot.LastUpdatedBy.Int32,
 		LastUpdatedAt:      dbBallot.LastUpdatedAt.Time.String(),
 		HeadJudgeSubmitted: dbBallot.HeadJudgeSubmitted.Bool,
+		Team3:              convertOptionalBallotTeam(dbBallot.Team3id, dbBallot.Team3name, dbBallot.Team3totalscore, dbBallot.Team3feedback),
+		Team4:              convertOptionalBallotTeam(dbBallot.Team4id, dbBallot.Team4name, dbBallot.Team4totalscore, dbBallot.Team4feedback),
 	}
+	setBritishParliamentaryTeamPoints(ballot)
+
+	return ballot
+}
+
+// convertOptionalBallotTeam converts the nullable Team3/Team4 columns of a
+// British Parliamentary ballot.
+func convertOptionalBallotTeam(teamID sql.NullInt32, name sql.NullString, totalScore sql.NullString, feedback sql.NullString) *debate_management.Team {
+	team := convertOptionalTeamToProto(teamID, name)
+	if team == nil {
+		return nil
+	}
+	team.TotalPoints, _ = strconv.ParseFloat(totalScore.String, 64)
+	team.Feedback = feedback.String
+	return team
 }
 
 func (s *BallotService) GetSpeakerScores(ctx context.Context, ballotID int32) ([]*debate_management.Speaker, error) {
@@ -459,17 +631,7 @@ func (s *BallotService) GetSpeakerScores(ctx context.Context, ballotID int32) ([
 
 	speakers := make([]*debate_management.Speaker, len(scores))
 	for i, score := range scores {
-		points, _ := strconv.ParseFloat(score.Speakerpoints, 64)
-		speakers[i] = &debate_management.Speaker{
-			SpeakerId: score.Speakerid,
-			Name:      score.Firstname + " " + score.Lastname,
-			ScoreId:   score.Scoreid,
-			Rank:      int32(score.Speakerrank),
-			Points:    points,
-			Feedback:  score.Feedback.String,
-			TeamId:    score.Teamid,
-			TeamName:  score.Teamname,
-		}
+		speakers[i] = convertSpeakerScore(score)
 	}
 
 	return speakers, nil
@@ -482,3 +644,27 @@ func (s *BallotService) validateAuthentication(token string) error {
 	}
 	return nil
 }
+
+func (s *BallotService) isAdmin(token string) (bool, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return false, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, _ := claims["user_role"].(string)
+	return userRole == "admin", nil
+}
+
+func (s *BallotService) validateAdminRole(token string) (map[string]interface{}, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return nil, fmt.Errorf("unauthorized: only admins can perform this action")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/internal/utils"
+)
+
+type BreakCategoryService struct {
+	db *sql.DB
+}
+
+func NewBreakCategoryService(db *sql.DB) *BreakCategoryService {
+	return &BreakCategoryService{db: db}
+}
+
+func (s *BreakCategoryService) GetBreakCategories(ctx context.Context, req *debate_management.GetBreakCategoriesRequest) ([]*debate_management.BreakCategory, error) {
+	if err := s.validateAuthentication(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	categories, err := queries.GetBreakCategories(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get break categories: %v", err)
+	}
+
+	return convertBreakCategories(categories), nil
+}
+
+// UpdateBreakCategories replaces the tournament's break categories. Categories
+// with an ID are updated, those without one are created, and the rest are
+// deleted together with the team and speaker eligibility that refers to them.
+func (s *BreakCategoryService) UpdateBreakCategories(ctx context.Context, req *debate_management.UpdateBreakCategoriesRequest) ([]*debate_management.BreakCategory, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	for _, category := range req.GetCategories() {
+		if category.GetName() == "" {
+			return nil, fmt.Errorf("break category name is required")
+		}
+		if names[category.GetName()] {
+			return nil, fmt.Errorf("duplicate break category %s", category.GetName())
+		}
+		names[category.GetName()] = true
+		if category.GetBreakSize() < 2 {
+			return nil, fmt.Errorf("break category %s must break at least 2 teams", category.GetName())
+		}
+		if category.GetPriority() < 1 {
+			return nil, fmt.Errorf("break category %s must have a priority of at least 1", category.GetName())
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	current, err := queries.GetBreakCategories(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get break categories: %v", err)
+	}
+
+	kept := make(map[int32]bool)
+	for _, category := range req.GetCategories() {
+		if category.GetCategoryId() != 0 {
+			kept[category.GetCategoryId()] = true
+		}
+	}
+
+	// Delete first so a new category may take the name of a removed one
+	for _, category := range current {
+		if kept[category.Categoryid] {
+			continue
+		}
+		hasBracket, err := queries.BreakCategoryHasBracket(ctx, sql.NullInt32{Int32: category.Categoryid, Valid: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check elimination bracket: %v", err)
+		}
+		if hasBracket {
+			return nil, fmt.Errorf("cannot remove break category %s: its elimination bracket has already been created", category.Name)
+		}
+		if err := queries.DeleteBreakCategory(ctx, category.Categoryid); err != nil {
+			return nil, fmt.Errorf("failed to delete break category: %v", err)
+		}
+	}
+
+	for _, category := range req.GetCategories() {
+		if category.GetCategoryId() == 0 {
+			_, err = queries.CreateBreakCategory(ctx, models.CreateBreakCategoryParams{
+				Tournamentid: req.GetTournamentId(),
+				Name:         category.GetName(),
+				Breaksize:    category.GetBreakSize(),
+				Priority:     category.GetPriority(),
+				Isgeneral:    category.GetIsGeneral(),
+			})
+		} else {
+			_, err = queries.UpdateBreakCategory(ctx, models.UpdateBreakCategoryParams{
+				Categoryid:   category.GetCategoryId(),
+				Tournamentid: req.GetTournamentId(),
+				Name:         category.GetName(),
+				Breaksize:    category.GetBreakSize(),
+				Priority:     category.GetPriority(),
+				Isgeneral:    category.GetIsGeneral(),
+			})
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("break category %d not found in tournament %d", category.GetCategoryId(), req.GetTournamentId())
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to save break category %s: %v", category.GetName(), err)
+		}
+	}
+
+	categories, err := queries.GetBreakCategories(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get break categories: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return convertBreakCategories(categories), nil
+}
+
+// replaceBreakCategories sets the break categories a team and its speakers are
+// eligible for. Every category must belong to the team's tournament.
+func replaceBreakCategories(ctx context.Context, queries *models.Queries, tournamentID int32, teamID int32, teamCategoryIDs []int32, speakers []*debate_management.Speaker) error {
+	categories, err := queries.GetBreakCategories(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to get break categories: %v", err)
+	}
+	valid := make(map[int32]bool, len(categories))
+	for _, category := range categories {
+		valid[category.Categoryid] = true
+	}
+
+	if err := queries.DeleteTeamBreakCategories(ctx, teamID); err != nil {
+		return fmt.Errorf("failed to clear team break categories: %v", err)
+	}
+	for _, categoryID := range teamCategoryIDs {
+		if !valid[categoryID] {
+			return fmt.Errorf("break category %d not found in tournament %d", categoryID, tournamentID)
+		}
+		err := queries.AddTeamBreakCategory(ctx, models.AddTeamBreakCategoryParams{
+			Teamid:     teamID,
+			Categoryid: categoryID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add team break category: %v", err)
+		}
+	}
+
+	for _, speaker := range speakers {
+		err := queries.DeleteStudentBreakCategories(ctx, models.DeleteStudentBreakCategoriesParams{
+			Studentid:    speaker.GetSpeakerId(),
+			Tournamentid: tournamentID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to clear speaker break categories: %v", err)
+		}
+		for _, categoryID := range speaker.GetBreakCategoryIds() {
+			if !valid[categoryID] {
+				return fmt.Errorf("break category %d not found in tournament %d", categoryID, tournamentID)
+			}
+			err := queries.AddStudentBreakCategory(ctx, models.AddStudentBreakCategoryParams{
+				Studentid:  speaker.GetSpeakerId(),
+				Categoryid: categoryID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add speaker break category: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadBreakCategories fills in the break categories of a team and its speakers.
+func loadBreakCategories(ctx context.Context, queries *models.Queries, team *debate_management.Team) error {
+	teamCategories, err := queries.GetTeamBreakCategories(ctx, team.GetTeamId())
+	if err != nil {
+		return fmt.Errorf("failed to get team break categories: %v", err)
+	}
+	team.BreakCategoryIds = teamCategories
+
+	speakerCategories, err := queries.GetStudentBreakCategoriesByTeam(ctx, team.GetTeamId())
+	if err != nil {
+		return fmt.Errorf("failed to get speaker break categories: %v", err)
+	}
+	bySpeaker := make(map[int32][]int32)
+	for _, sc := range speakerCategories {
+		bySpeaker[sc.Studentid] = append(bySpeaker[sc.Studentid], sc.Categoryid)
+	}
+	for _, speaker := range team.GetSpeakers() {
+		speaker.BreakCategoryIds = bySpeaker[speaker.GetSpeakerId()]
+	}
+
+	return nil
+}
+
+func convertBreakCategories(categories []models.Breakcategory) []*debate_management.BreakCategory {
+	converted := make([]*debate_management.BreakCategory, len(categories))
+	for i, category := range categories {
+		converted[i] = &debate_management.BreakCategory{
+			CategoryId: category.Categoryid,
+			Name:       category.Name,
+			BreakSize:  category.Breaksize,
+			Priority:   category.Priority,
+			IsGeneral:  category.Isgeneral,
+		}
+	}
+	return converted
+}
+
+func (s *BreakCategoryService) validateAuthentication(token string) error {
+	_, err := utils.ValidateToken(token)
+	if err != nil {
+		return fmt.Errorf("authentication failed: %v", err)
+	}
+	return nil
+}
+
+func (s *BreakCategoryService) validateAdminRole(token string) (map[string]interface{}, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return nil, fmt.Errorf("unauthorized: only admins can perform this action")
+	}
+
+	return claims, nil
+}
@@ -43,8 +43,15 @@ func (s *JudgeAssignmentService) UpdateJudgeAssignment(ctx context.Context, op J
 	}
 	defer tx.Rollback()
 
-	queries := models.New(s.db).WithTx(tx)
+	if err := updateJudgeAssignment(ctx, models.New(s.db).WithTx(tx), op); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
 
+// updateJudgeAssignment moves a judge to another room of a round, swapping
+// them with the judge already there, within the caller's transaction.
+func updateJudgeAssignment(ctx context.Context, queries *models.Queries, op JudgeUpdateOperation) error {
 	// Ensure "Unassigned" room exists
 	unassignedRoom, err := queries.EnsureUnassignedRoomExists(ctx, sql.NullInt32{
 		Int32: op.TournamentID,
@@ -187,7 +194,7 @@ func (s *JudgeAssignmentService) UpdateJudgeAssignment(ctx context.Context, op J
 	}
 	// Scenario 5: Both unassigned - nothing happens
 
-	return tx.Commit()
+	return nil
 }
 
 func (s *JudgeAssignmentService) validateHeadJudgePresence(ctx context.Context, queries *models.Queries, params models.CheckHeadJudgeExistsParams) error {
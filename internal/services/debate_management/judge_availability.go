@@ -0,0 +1,496 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/internal/utils"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// Roster statuses of a judge in a round.
+const (
+	judgeCheckedIn    = "checked_in"
+	judgeNotCheckedIn = "not_checked_in"
+	judgeUnavailable  = "unavailable"
+	judgeAbsent       = "absent"
+)
+
+// SetJudgeAvailability records the rounds a judge can and cannot sit. Judges
+// declare their own availability; admins can declare it for any judge.
+func (s *JudgeService) SetJudgeAvailability(ctx context.Context, req *debate_management.SetJudgeAvailabilityRequest) (*debate_management.SetJudgeAvailabilityResponse, error) {
+	if err := s.validateJudgeOrAdmin(req.GetToken(), req.GetJudgeId()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	if err := validateTournamentJudge(ctx, queries, req.GetTournamentId(), req.GetJudgeId()); err != nil {
+		return nil, err
+	}
+
+	for _, round := range req.GetRounds() {
+		if err := validateJudgeRound(ctx, queries, req.GetTournamentId(), round.GetRoundNumber(), round.GetIsEliminationRound()); err != nil {
+			return nil, err
+		}
+		_, err := queries.SetJudgeAvailability(ctx, models.SetJudgeAvailabilityParams{
+			Tournamentid:       req.GetTournamentId(),
+			Judgeid:            req.GetJudgeId(),
+			Roundnumber:        round.GetRoundNumber(),
+			Iseliminationround: round.GetIsEliminationRound(),
+			Isavailable:        round.GetIsAvailable(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to set judge availability: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return &debate_management.SetJudgeAvailabilityResponse{
+		Success: true,
+		Message: "Judge availability updated successfully",
+	}, nil
+}
+
+// CheckInJudge records that a judge has turned up for a round. Judges check
+// themselves in; admins can check in any judge.
+func (s *JudgeService) CheckInJudge(ctx context.Context, req *debate_management.CheckInJudgeRequest) (*debate_management.CheckInJudgeResponse, error) {
+	if err := s.validateJudgeOrAdmin(req.GetToken(), req.GetJudgeId()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+
+	if err := validateTournamentJudge(ctx, queries, req.GetTournamentId(), req.GetJudgeId()); err != nil {
+		return nil, err
+	}
+	if err := validateJudgeRound(ctx, queries, req.GetTournamentId(), req.GetRoundNumber(), req.GetIsEliminationRound()); err != nil {
+		return nil, err
+	}
+
+	availability, err := queries.CheckInJudge(ctx, models.CheckInJudgeParams{
+		Tournamentid:       req.GetTournamentId(),
+		Judgeid:            req.GetJudgeId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsEliminationRound(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check in judge: %v", err)
+	}
+
+	return &debate_management.CheckInJudgeResponse{
+		Success:     true,
+		Message:     "Judge checked in successfully",
+		CheckedInAt: formatDebateTime(availability.Checkedinat),
+	}, nil
+}
+
+// GetJudgeRoster lists the tournament's judges with their status and room in
+// a round.
+func (s *JudgeService) GetJudgeRoster(ctx context.Context, req *debate_management.GetJudgeRosterRequest) (*debate_management.GetJudgeRosterResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.GetJudgeRoster(ctx, models.GetJudgeRosterParams{
+		TournamentID:       req.GetTournamentId(),
+		RoundNumber:        req.GetRoundNumber(),
+		IsEliminationRound: req.GetIsEliminationRound(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge roster: %v", err)
+	}
+
+	response := &debate_management.GetJudgeRosterResponse{
+		Judges: make([]*debate_management.JudgeRosterEntry, len(rows)),
+	}
+	for _, row := range rows {
+		if row.Checkedinat.Valid {
+			response.CheckInRequired = true
+		}
+	}
+
+	for i, row := range rows {
+		entry := &debate_management.JudgeRosterEntry{
+			JudgeId:        row.Judgeid,
+			Name:           row.Name,
+			IdebateId:      row.Idebatevolunteerid.String,
+			CheckedInAt:    formatDebateTime(row.Checkedinat),
+			MarkedAbsentAt: formatDebateTime(row.Markedabsentat),
+		}
+
+		switch {
+		case row.Markedabsentat.Valid:
+			entry.Status = judgeAbsent
+			response.Absent++
+		case !row.Isavailable:
+			entry.Status = judgeUnavailable
+			response.Unavailable++
+		case row.Checkedinat.Valid:
+			entry.Status = judgeCheckedIn
+			entry.CanBeAllocated = true
+			response.CheckedIn++
+		default:
+			entry.Status = judgeNotCheckedIn
+			entry.CanBeAllocated = !response.CheckInRequired
+			response.NotCheckedIn++
+		}
+
+		if row.Debateid.Valid {
+			entry.Room = &debate_management.RoomInfo{
+				RoomId:      row.Roomid.Int32,
+				RoomName:    row.Roomname.String,
+				IsHeadJudge: row.Isheadjudge.Bool,
+			}
+		}
+		response.Judges[i] = entry
+	}
+
+	return response, nil
+}
+
+// MarkJudgeAbsent makes a judge unavailable for a round and gives their seat
+// to the best judge who can sit the round and is not yet allocated to it.
+// When nobody can take the seat, the panel sits one judge short; a head judge
+// is then succeeded by the strongest judge left on the panel, and a room left
+// without any judge is reported for an admin to staff.
+func (s *JudgeService) MarkJudgeAbsent(ctx context.Context, req *debate_management.MarkJudgeAbsentRequest) (*debate_management.MarkJudgeAbsentResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	if err := validateJudgeRound(ctx, queries, req.GetTournamentId(), req.GetRoundNumber(), req.GetIsEliminationRound()); err != nil {
+		return nil, err
+	}
+
+	judge, err := queries.GetJudgeByID(ctx, req.GetJudgeId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge: %v", err)
+	}
+
+	assignment, err := queries.GetJudgeAssignment(ctx, models.GetJudgeAssignmentParams{
+		Tournamentid:  req.GetTournamentId(),
+		Judgeid:       req.GetJudgeId(),
+		Roundnumber:   req.GetRoundNumber(),
+		Iselimination: req.GetIsEliminationRound(),
+	})
+	hasRoom := err == nil
+	if err != nil && !isNoRowsError(err) {
+		return nil, fmt.Errorf("failed to get judge assignment: %v", err)
+	}
+
+	var ballots []models.Ballot
+	if hasRoom {
+		ballots, err = queries.GetBallotsByDebateID(ctx, assignment.Debateid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ballots: %v", err)
+		}
+		if err := checkBallotsOpen(ctx, queries, ballots, judge); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = queries.MarkJudgeAbsent(ctx, models.MarkJudgeAbsentParams{
+		Tournamentid:       req.GetTournamentId(),
+		Judgeid:            req.GetJudgeId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsEliminationRound(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark judge absent: %v", err)
+	}
+
+	if !hasRoom {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit transaction: %v", err)
+		}
+		return &debate_management.MarkJudgeAbsentResponse{
+			Success: true,
+			Message: fmt.Sprintf("%s marked absent; they had no room in the round", judge.Name),
+		}, nil
+	}
+
+	err = queries.UnassignJudge(ctx, models.UnassignJudgeParams{
+		Judgeid:       req.GetJudgeId(),
+		Tournamentid:  req.GetTournamentId(),
+		Roundnumber:   req.GetRoundNumber(),
+		Iselimination: req.GetIsEliminationRound(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unassign judge: %v", err)
+	}
+
+	room, err := queries.GetRoomByID(ctx, assignment.Roomid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room: %v", err)
+	}
+
+	replacement, err := reallocateJudgeSeat(ctx, queries, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	newHeadJudgeID := int32(0)
+	if assignment.Isheadjudge {
+		if replacement != nil {
+			newHeadJudgeID = int32(replacement.ID)
+		} else {
+			newHeadJudgeID, err = strongestPanelist(ctx, queries, assignment)
+			if err != nil {
+				return nil, err
+			}
+			if newHeadJudgeID != 0 {
+				err = queries.SetHeadJudge(ctx, models.SetHeadJudgeParams{
+					Debateid: assignment.Debateid,
+					Judgeid:  newHeadJudgeID,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to set head judge: %v", err)
+				}
+			}
+		}
+
+		// The head judge owns the debate's ballot. A room left without any
+		// judge keeps it with the absent judge until an admin seats a judge.
+		if newHeadJudgeID != 0 && len(ballots) > 0 {
+			err = queries.SetBallotJudge(ctx, models.SetBallotJudgeParams{
+				Debateid: assignment.Debateid,
+				Judgeid:  newHeadJudgeID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to transfer ballot ownership: %v", err)
+			}
+		}
+	}
+
+	// Students and judges see a released round as it was released, so the
+	// change is made there too
+	releasedDraw := models.ReplaceReleasedDrawJudgeParams{
+		JudgeID:     req.GetJudgeId(),
+		HeadJudgeID: newHeadJudgeID,
+		DebateID:    assignment.Debateid,
+	}
+	if replacement != nil {
+		releasedDraw.ReplacementID = int32(replacement.ID)
+		releasedDraw.HeadJudgeID = int32(replacement.ID)
+	}
+	if err := queries.ReplaceReleasedDrawJudge(ctx, releasedDraw); err != nil {
+		return nil, fmt.Errorf("failed to update released draw: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	response := &debate_management.MarkJudgeAbsentResponse{
+		Success:   true,
+		PairingId: assignment.Debateid,
+		Room: &debate_management.RoomInfo{
+			RoomId:      room.Roomid,
+			RoomName:    room.Roomname,
+			IsHeadJudge: assignment.Isheadjudge,
+		},
+	}
+	switch {
+	case replacement != nil:
+		response.ReplacementJudge = &debate_management.Judge{
+			JudgeId: int32(replacement.ID),
+			Name:    replacement.Name,
+		}
+		response.Message = fmt.Sprintf("%s marked absent and replaced by %s in room %s", judge.Name, replacement.Name, room.Roomname)
+	case assignment.Isheadjudge && newHeadJudgeID == 0:
+		response.RoomWithoutJudge = true
+		response.Message = fmt.Sprintf("%s marked absent; no available judge could take their seat, so room %s has no judge", judge.Name, room.Roomname)
+	default:
+		response.Message = fmt.Sprintf("%s marked absent; no available judge could take their seat in room %s", judge.Name, room.Roomname)
+	}
+	return response, nil
+}
+
+// checkBallotsOpen refuses to take a judge off a debate whose result is in,
+// or whose ballot the judge has already submitted.
+func checkBallotsOpen(ctx context.Context, queries *models.Queries, ballots []models.Ballot, judge models.GetJudgeByIDRow) error {
+	for _, ballot := range ballots {
+		if ballot.Recordingstatus == "Recorded" {
+			return fmt.Errorf("the ballot of the debate judged by %s is already recorded", judge.Name)
+		}
+		panel, err := queries.GetBallotPanel(ctx, ballot.Ballotid)
+		if err != nil {
+			return fmt.Errorf("failed to get ballot panel: %v", err)
+		}
+		for _, vote := range panel {
+			if vote.Judgeid == judge.Userid && vote.Verdict.Valid {
+				return fmt.Errorf("%s has already submitted a ballot for the debate", judge.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// reallocateJudgeSeat seats the best judge who can sit the round and is not
+// allocated to it yet in the debate of the assignment, with the assignment's
+// head judge role. It returns nil when no judge can take the seat.
+func reallocateJudgeSeat(ctx context.Context, queries *models.Queries, assignment models.GetJudgeAssignmentRow) (*pairing_algorithm.Judge, error) {
+	debate, err := queries.GetDebateByID(ctx, assignment.Debateid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get debate: %v", err)
+	}
+
+	teamIDs := []int32{debate.Team1id, debate.Team2id}
+	if debate.Team3id.Valid && debate.Team4id.Valid {
+		teamIDs = append(teamIDs, debate.Team3id.Int32, debate.Team4id.Int32)
+	}
+	teams := make([]*pairing_algorithm.Team, len(teamIDs))
+	for i, teamID := range teamIDs {
+		teams[i] = &pairing_algorithm.Team{ID: int(teamID)}
+	}
+	if err := loadTeamAffiliations(ctx, queries, assignment.Tournamentid, teams); err != nil {
+		return nil, err
+	}
+	algorithmDebate := &pairing_algorithm.Debate{Team1: teams[0], Team2: teams[1]}
+	if len(teams) == pairing_algorithm.TeamsPerBritishParliamentaryRoom {
+		algorithmDebate.Team3 = teams[2]
+		algorithmDebate.Team4 = teams[3]
+	}
+
+	judges, err := loadAlgorithmJudges(ctx, queries, assignment.Tournamentid, []int32{assignment.Roundnumber}, assignment.Iselimination)
+	if err != nil {
+		return nil, err
+	}
+
+	seated, err := queries.GetJudgesByTournamentAndRound(ctx, models.GetJudgesByTournamentAndRoundParams{
+		Tournamentid:  assignment.Tournamentid,
+		Roundnumber:   assignment.Roundnumber,
+		Iselimination: assignment.Iselimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge assignments: %v", err)
+	}
+	isSeated := make(map[int]bool, len(seated))
+	for _, judge := range seated {
+		isSeated[int(judge.Userid)] = true
+	}
+	candidates := make([]*pairing_algorithm.Judge, 0, len(judges))
+	for _, judge := range judges {
+		if !isSeated[judge.ID] {
+			candidates = append(candidates, judge)
+		}
+	}
+
+	replacement := pairing_algorithm.ReplacementJudge(algorithmDebate, candidates, int(assignment.Roundnumber))
+	if replacement == nil {
+		return nil, nil
+	}
+
+	err = queries.AssignJudgeToDebate(ctx, models.AssignJudgeToDebateParams{
+		Tournamentid:  assignment.Tournamentid,
+		Judgeid:       int32(replacement.ID),
+		Debateid:      assignment.Debateid,
+		Roundnumber:   assignment.Roundnumber,
+		Iselimination: assignment.Iselimination,
+		Isheadjudge:   assignment.Isheadjudge,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign judge to debate: %v", err)
+	}
+	return replacement, nil
+}
+
+// strongestPanelist returns the highest-rated judge left on the debate of the
+// assignment, or 0 when the panel is empty.
+func strongestPanelist(ctx context.Context, queries *models.Queries, assignment models.GetJudgeAssignmentRow) (int32, error) {
+	panel, err := queries.GetJudgesForDebate(ctx, assignment.Debateid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get debate judges: %v", err)
+	}
+	if len(panel) == 0 {
+		return 0, nil
+	}
+
+	strengths, err := queries.GetJudgeStrengths(ctx, assignment.Tournamentid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get judge strengths: %v", err)
+	}
+	scores := make(map[int32]float64, len(strengths))
+	for _, strength := range strengths {
+		scores[strength.Judgeid] = pairing_algorithm.JudgeScore(strength.Averagerating, int(strength.Ratingcount), int(strength.Eliminationdebates))
+	}
+
+	best := panel[0].Judgeid
+	for _, judge := range panel[1:] {
+		if scores[judge.Judgeid] > scores[best] || (scores[judge.Judgeid] == scores[best] && judge.Judgeid < best) {
+			best = judge.Judgeid
+		}
+	}
+	return best, nil
+}
+
+// validateTournamentJudge checks that the user judges the tournament.
+func validateTournamentJudge(ctx context.Context, queries *models.Queries, tournamentID int32, judgeID int32) error {
+	isJudge, err := queries.IsTournamentJudge(ctx, models.IsTournamentJudgeParams{
+		Tournamentid: tournamentID,
+		Userid:       judgeID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check tournament judge: %v", err)
+	}
+	if !isJudge {
+		return fmt.Errorf("user %d is not a judge of tournament %d", judgeID, tournamentID)
+	}
+	return nil
+}
+
+// validateJudgeRound checks that the tournament has the round.
+func validateJudgeRound(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) error {
+	tournament, err := queries.GetTournamentByID(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to get tournament: %v", err)
+	}
+	rounds := tournament.Numberofpreliminaryrounds
+	if isElimination {
+		rounds = tournament.Numberofeliminationrounds
+	}
+	if roundNumber < 1 || roundNumber > rounds {
+		return fmt.Errorf("invalid round number: %d", roundNumber)
+	}
+	return nil
+}
+
+// validateJudgeOrAdmin lets admins act for any judge and judges act for
+// themselves.
+func (s *JudgeService) validateJudgeOrAdmin(token string, judgeID int32) error {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, _ := claims["user_role"].(string)
+	if userRole == "admin" {
+		return nil
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok || int32(userID) != judgeID {
+		return fmt.Errorf("unauthorized: judges can only update their own availability")
+	}
+	return nil
+}
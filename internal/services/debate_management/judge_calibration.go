@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/internal/utils"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// GetJudgeCalibration reports the speaker points each judge of a tournament
+// gave across their recorded ballots, flags the ballots far from the average
+// ballot and, when asked, ranks the speakers on judge-normalised points.
+func (s *RankingService) GetJudgeCalibration(ctx context.Context, req *debate_management.JudgeCalibrationRequest) (*debate_management.JudgeCalibrationResponse, error) {
+	claims, err := utils.ValidateToken(req.GetToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return nil, status.Error(codes.PermissionDenied, "only admins can view judge calibration")
+	}
+
+	threshold := req.GetOutlierThreshold()
+	if threshold < 0 {
+		return nil, status.Error(codes.InvalidArgument, "outlier threshold cannot be negative")
+	}
+	if threshold == 0 {
+		threshold = pairing_algorithm.DefaultOutlierThreshold
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.GetJudgeSpeechesByTournament(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get judge speaker scores: %v", err)
+	}
+
+	speeches := make([]pairing_algorithm.JudgeSpeech, len(rows))
+	judgeNames := make(map[int]string)
+	ballots := make(map[int]models.GetJudgeSpeechesByTournamentRow)
+	for i, row := range rows {
+		points, err := strconv.ParseFloat(row.Speakerpoints, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse speaker points of judge %d: %v", row.Judgeid, err)
+		}
+		speeches[i] = pairing_algorithm.JudgeSpeech{
+			JudgeID:  int(row.Judgeid),
+			BallotID: int(row.Judgeballotid),
+			DebateID: int(row.Debateid),
+			TeamID:   int(row.Teamid),
+			Position: int(row.Position),
+			Points:   points,
+		}
+		judgeNames[int(row.Judgeid)] = row.Judgename
+		ballots[int(row.Judgeballotid)] = row
+	}
+
+	report := pairing_algorithm.CalibrateJudges(speeches, threshold)
+	response := &debate_management.JudgeCalibrationResponse{
+		MeanPoints: report.Mean,
+		StdDev:     report.StdDev,
+	}
+	for _, judge := range report.Judges {
+		response.Judges = append(response.Judges, &debate_management.JudgeCalibration{
+			JudgeId:       int32(judge.JudgeID),
+			JudgeName:     judgeNames[judge.JudgeID],
+			Ballots:       int32(judge.Ballots),
+			Speeches:      int32(judge.Speeches),
+			MeanPoints:    judge.Mean,
+			StdDev:        judge.StdDev,
+			MinPoints:     judge.Min,
+			MaxPoints:     judge.Max,
+			RoomDeviation: judge.RoomDeviation,
+		})
+	}
+	for _, outlier := range report.Outliers {
+		ballot := ballots[outlier.BallotID]
+		response.Outliers = append(response.Outliers, &debate_management.OutlierBallot{
+			JudgeBallotId: int32(outlier.BallotID),
+			JudgeId:       int32(outlier.JudgeID),
+			JudgeName:     judgeNames[outlier.JudgeID],
+			DebateId:      int32(outlier.DebateID),
+			RoundNumber:   ballot.Roundnumber,
+			IsElimination: ballot.Iseliminationround,
+			MeanPoints:    outlier.Mean,
+			RoomAverage:   outlier.RoomAverage,
+			ZScore:        outlier.ZScore,
+		})
+	}
+
+	if !req.GetIncludeNormalisedRanking() {
+		return response, nil
+	}
+
+	// A speech judged by a panel counts the average of its judges'
+	// normalised points, as its recorded points average the judges'
+	normalised := pairing_algorithm.NormaliseSpeeches(speeches, report)
+	sums := make(map[speechKey]float64)
+	counts := make(map[speechKey]int)
+	for i, speech := range speeches {
+		key := speechKey{debateID: int32(speech.DebateID), teamID: int32(speech.TeamID), position: int32(speech.Position)}
+		sums[key] += normalised[i]
+		counts[key]++
+	}
+	points := make(map[speechKey]float64, len(sums))
+	for key, sum := range sums {
+		points[key] = sum / float64(counts[key])
+	}
+
+	standings, schools, _, err := rankSpeakers(ctx, queries, req.GetTournamentId(), 0, points)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rank speakers on normalised points: %v", err)
+	}
+	for _, standing := range standings {
+		response.NormalisedRanking = append(response.NormalisedRanking, convertSpeakerStanding(standing, schools))
+	}
+
+	return response, nil
+}
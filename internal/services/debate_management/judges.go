@@ -134,12 +134,20 @@ func (s *JudgeService) GetJudge(ctx context.Context, req *debate_management.GetJ
 		return nil, fmt.Errorf("failed to get tournament: %v", err)
 	}
 
+	// Get judge conflicts of interest
+	conflicts, err := queries.GetJudgeConflicts(ctx, int32(req.GetJudgeId()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge conflicts: %v", err)
+	}
+
 	response := &debate_management.GetJudgeResponse{
-		JudgeId:     int32(judge.Judgeid),
-		Name:        judge.Name,
-		IdebateId:   judge.Idebatevolunteerid.String,
-		Preliminary: make(map[int32]*debate_management.RoomInfo),
-		Elimination: make(map[int32]*debate_management.RoomInfo),
+		JudgeId:             int32(judge.Judgeid),
+		Name:                judge.Name,
+		IdebateId:           judge.Idebatevolunteerid.String,
+		Preliminary:         make(map[int32]*debate_management.RoomInfo),
+		Elimination:         make(map[int32]*debate_management.RoomInfo),
+		Conflicts:           convertJudgeConflicts(conflicts),
+		MaxTimesJudgingTeam: judge.Maxtimesjudgingteam.Int32,
 	}
 
 	// Create maps for quick access to room assignments
@@ -201,8 +209,20 @@ func (s *JudgeService) UpdateJudge(ctx context.Context, req *debate_management.U
 		return nil, err
 	}
 
-	assignmentService := NewJudgeAssignmentService(s.db)
-	queries := models.New(s.db)
+	// The conflicts and every round are updated together or not at all
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	if req.GetUpdateConflicts() {
+		if err := replaceJudgeConflicts(ctx, queries, req); err != nil {
+			return nil, err
+		}
+	}
 
 	// Process preliminary rounds
 	for roundNumber, roomInfo := range req.GetPreliminary() {
@@ -222,7 +242,7 @@ func (s *JudgeService) UpdateJudge(ctx context.Context, req *debate_management.U
 		}
 
 		// Update with the simplified swap logic
-		err = assignmentService.UpdateJudgeAssignment(ctx, JudgeUpdateOperation{
+		err = updateJudgeAssignment(ctx, queries, JudgeUpdateOperation{
 			TournamentID:   req.GetTournamentId(),
 			JudgeID:        req.GetJudgeId(),
 			RoundNumber:    roundNumber,
@@ -256,7 +276,7 @@ func (s *JudgeService) UpdateJudge(ctx context.Context, req *debate_management.U
 		}
 
 		// Update with the simplified swap logic
-		err = assignmentService.UpdateJudgeAssignment(ctx, JudgeUpdateOperation{
+		err = updateJudgeAssignment(ctx, queries, JudgeUpdateOperation{
 			TournamentID:   req.GetTournamentId(),
 			JudgeID:        int32(req.GetJudgeId()),
 			RoundNumber:    int32(roundNumber),
@@ -272,12 +292,87 @@ func (s *JudgeService) UpdateJudge(ctx context.Context, req *debate_management.U
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
 	return &debate_management.UpdateJudgeResponse{
 		Success: true,
 		Message: "Judge assignments updated successfully",
 	}, nil
 }
 
+// replaceJudgeConflicts swaps the judge's conflicts of interest and team limit
+// for the ones in the request, within the caller's transaction.
+func replaceJudgeConflicts(ctx context.Context, queries *models.Queries, req *debate_management.UpdateJudgeRequest) error {
+	if req.GetMaxTimesJudgingTeam() < 0 {
+		return fmt.Errorf("max times judging a team cannot be negative")
+	}
+
+	if err := queries.DeleteJudgeConflicts(ctx, req.GetJudgeId()); err != nil {
+		return fmt.Errorf("failed to delete judge conflicts: %v", err)
+	}
+
+	for _, conflict := range req.GetConflicts() {
+		params := models.CreateJudgeConflictParams{
+			Judgeid:      req.GetJudgeId(),
+			Conflicttype: conflict.GetConflictType(),
+			Reason:       sql.NullString{String: conflict.GetReason(), Valid: conflict.GetReason() != ""},
+		}
+
+		switch conflict.GetConflictType() {
+		case "school":
+			if conflict.GetSchoolId() <= 0 {
+				return fmt.Errorf("school conflicts require a school ID")
+			}
+			params.Schoolid = sql.NullInt32{Int32: conflict.GetSchoolId(), Valid: true}
+		case "team":
+			if conflict.GetTeamId() <= 0 {
+				return fmt.Errorf("team conflicts require a team ID")
+			}
+			params.Teamid = sql.NullInt32{Int32: conflict.GetTeamId(), Valid: true}
+		case "student":
+			if conflict.GetStudentId() <= 0 {
+				return fmt.Errorf("student conflicts require a student ID")
+			}
+			params.Studentid = sql.NullInt32{Int32: conflict.GetStudentId(), Valid: true}
+		default:
+			return fmt.Errorf("invalid conflict type %q: must be school, team or student", conflict.GetConflictType())
+		}
+
+		if _, err := queries.CreateJudgeConflict(ctx, params); err != nil {
+			return fmt.Errorf("failed to create judge conflict: %v", err)
+		}
+	}
+
+	err := queries.UpdateJudgeMaxTimesJudgingTeam(ctx, models.UpdateJudgeMaxTimesJudgingTeamParams{
+		Userid:              req.GetJudgeId(),
+		Maxtimesjudgingteam: sql.NullInt32{Int32: req.GetMaxTimesJudgingTeam(), Valid: req.GetMaxTimesJudgingTeam() > 0},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update judge team limit: %v", err)
+	}
+	return nil
+}
+
+func convertJudgeConflicts(conflicts []models.GetJudgeConflictsRow) []*debate_management.JudgeConflict {
+	result := make([]*debate_management.JudgeConflict, len(conflicts))
+	for i, conflict := range conflicts {
+		result[i] = &debate_management.JudgeConflict{
+			ConflictId:   conflict.Conflictid,
+			ConflictType: conflict.Conflicttype,
+			SchoolId:     conflict.Schoolid.Int32,
+			SchoolName:   conflict.Schoolname.String,
+			TeamId:       conflict.Teamid.Int32,
+			TeamName:     conflict.Teamname.String,
+			StudentId:    conflict.Studentid.Int32,
+			StudentName:  conflict.Studentname,
+			Reason:       conflict.Reason.String,
+		}
+	}
+	return result
+}
+
 func (s *JudgeService) validateAuthentication(token string) error {
 	_, err := utils.ValidateToken(token)
 	if err != nil {
@@ -3,16 +3,21 @@ package services
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
-	"math"
+	"math/rand"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
 	"github.com/iRankHub/backend/internal/models"
+	tournamentservices "github.com/iRankHub/backend/internal/services/tournament_management"
 	"github.com/iRankHub/backend/internal/utils"
 	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+	"github.com/sqlc-dev/pqtype"
 )
 
 type PairingService struct {
@@ -23,42 +28,179 @@ func NewPairingService(db *sql.DB) *PairingService {
 	return &PairingService{db: db}
 }
 
+// GetPairings returns the draw of a round. Admins see the draft, which may
+// have been edited since it was last released; everyone else sees the draw as
+// last released.
 func (s *PairingService) GetPairings(ctx context.Context, req *debate_management.GetPairingsRequest) (*debate_management.GetPairingsResponse, error) {
-	if err := s.validateAuthentication(req.GetToken()); err != nil {
+	isAdmin, err := s.isAdmin(req.GetToken())
+	if err != nil {
 		return nil, err
 	}
 
 	queries := models.New(s.db)
-	dbPairings, err := queries.GetPairings(ctx, models.GetPairingsParams{
+	params := models.GetPairingsParams{
 		Tournamentid:       req.GetTournamentId(),
 		Roundnumber:        req.GetRoundNumber(),
 		Iseliminationround: req.GetIsElimination(),
-	})
+	}
+
+	isReleased, err := isRoundReleased(ctx, queries, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pairings: %v", err)
+		return nil, err
+	}
+
+	var dbPairings []models.GetPairingsRow
+	if isAdmin {
+		dbPairings, err = queries.GetPairings(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pairings: %v", err)
+		}
+	} else {
+		dbPairings, err = getReleasedPairings(ctx, queries, params)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	pairings := make([]*debate_management.Pairing, len(dbPairings))
 	for i, dbPairing := range dbPairings {
-		pairings[i] = &debate_management.Pairing{
-			PairingId:          dbPairing.Debateid,
-			RoundNumber:        dbPairing.Roundnumber,
-			IsEliminationRound: dbPairing.Iseliminationround,
-			RoomId:             dbPairing.Roomid,
-			RoomName:           dbPairing.Roomname,
-			Team1: &debate_management.Team{
-				TeamId: dbPairing.Team1id,
-				Name:   dbPairing.Team1name,
-			},
-			Team2: &debate_management.Team{
-				TeamId: dbPairing.Team2id,
-				Name:   dbPairing.Team2name,
-			},
-			HeadJudgeName: dbPairing.Headjudgename.String,
+		pairings[i] = convertPairing(dbPairing)
+	}
+
+	response := &debate_management.GetPairingsResponse{Pairings: pairings, IsReleased: isReleased}
+
+	// Byes are part of the draw, so only admins see them before it is released
+	if !req.GetIsElimination() && (isAdmin || isReleased) {
+		byes, err := queries.GetRoundByes(ctx, models.GetRoundByesParams{
+			Tournamentid: req.GetTournamentId(),
+			Roundnumber:  req.GetRoundNumber(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get byes: %v", err)
+		}
+		for _, bye := range byes {
+			response.Byes = append(response.Byes, &debate_management.Bye{
+				TeamId:      bye.Teamid,
+				TeamName:    bye.Teamname,
+				RoundNumber: req.GetRoundNumber(),
+			})
+		}
+	}
+
+	return response, nil
+}
+
+func convertPairing(dbPairing models.GetPairingsRow) *debate_management.Pairing {
+	return &debate_management.Pairing{
+		PairingId:          dbPairing.Debateid,
+		RoundNumber:        dbPairing.Roundnumber,
+		IsEliminationRound: dbPairing.Iseliminationround,
+		RoomId:             dbPairing.Roomid,
+		RoomName:           dbPairing.Roomname,
+		Team1: &debate_management.Team{
+			TeamId: dbPairing.Team1id,
+			Name:   dbPairing.Team1name,
+		},
+		Team2: &debate_management.Team{
+			TeamId: dbPairing.Team2id,
+			Name:   dbPairing.Team2name,
+		},
+		Team3:         convertOptionalTeamToProto(dbPairing.Team3id, dbPairing.Team3name),
+		Team4:         convertOptionalTeamToProto(dbPairing.Team4id, dbPairing.Team4name),
+		HeadJudgeName: dbPairing.Headjudgename.String,
+		PanelQuality:  numericToFloat(dbPairing.Panelquality),
+		BreakCategory: dbPairing.Breakcategoryname.String,
+		StartTime:     dbPairing.Starttime.Format(debateTimeLayout),
+		EndTime:       formatDebateTime(dbPairing.Endtime),
+	}
+}
+
+func (s *PairingService) GetEliminationBracket(ctx context.Context, req *debate_management.GetEliminationBracketRequest) (*debate_management.GetEliminationBracketResponse, error) {
+	isAdmin, err := s.isAdmin(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	dbNodes, err := queries.GetEliminationBracket(ctx, models.GetEliminationBracketParams{
+		Tournamentid: req.GetTournamentId(),
+		Column2:      req.GetCategoryId(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get elimination bracket: %v", err)
+	}
+
+	// Only admins see where debates of unreleased rounds take place
+	var releasedDebates map[int32]bool
+	if !isAdmin {
+		debateIDs, err := queries.GetReleasedDebateIDs(ctx, req.GetTournamentId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get released debates: %v", err)
+		}
+		releasedDebates = make(map[int32]bool, len(debateIDs))
+		for _, debateID := range debateIDs {
+			releasedDebates[debateID] = true
+		}
+	}
+
+	response := &debate_management.GetEliminationBracketResponse{
+		RootNodeIds: make(map[int32]int32),
+	}
+	nodes := make(map[int32]*debate_management.BracketNode, len(dbNodes))
+	for _, dbNode := range dbNodes {
+		node := &debate_management.BracketNode{
+			NodeId:       dbNode.Nodeid,
+			RoundNumber:  dbNode.Roundnumber,
+			Position:     dbNode.Position,
+			ParentNodeId: dbNode.Parentnodeid.Int32,
+			Team1:        convertBracketTeam(dbNode.Team1id, dbNode.Team1name, dbNode.Team1seed),
+			Team2:        convertBracketTeam(dbNode.Team2id, dbNode.Team2name, dbNode.Team2seed),
+			DebateId:     dbNode.Debateid.Int32,
+			RoomId:       dbNode.Roomid.Int32,
+			RoomName:     dbNode.Roomname.String,
+			WinnerTeamId: dbNode.Winnerteamid.Int32,
+			CategoryId:   dbNode.Categoryid,
+		}
+		if !isAdmin && !releasedDebates[node.DebateId] {
+			node.DebateId, node.RoomId, node.RoomName = 0, 0, ""
+		}
+		nodes[node.NodeId] = node
+		response.Nodes = append(response.Nodes, node)
+
+		if dbNode.Roundnumber > response.NumberOfRounds {
+			response.NumberOfRounds = dbNode.Roundnumber
+		}
+		if !dbNode.Parentnodeid.Valid {
+			response.RootNodeIds[dbNode.Categoryid] = dbNode.Nodeid
+		}
+	}
+
+	// A single root is only unambiguous with a single bracket
+	if len(response.RootNodeIds) == 1 {
+		for _, rootNodeID := range response.RootNodeIds {
+			response.RootNodeId = rootNodeID
+		}
+	}
+
+	// Nodes are ordered by position, so children are listed top of the bracket first
+	for _, dbNode := range dbNodes {
+		if parent, ok := nodes[dbNode.Parentnodeid.Int32]; ok {
+			parent.ChildNodeIds = append(parent.ChildNodeIds, dbNode.Nodeid)
 		}
 	}
 
-	return &debate_management.GetPairingsResponse{Pairings: pairings}, nil
+	return response, nil
+}
+
+func convertBracketTeam(teamID sql.NullInt32, name sql.NullString, seed sql.NullInt32) *debate_management.BracketTeam {
+	if !teamID.Valid {
+		return nil
+	}
+	return &debate_management.BracketTeam{
+		TeamId: teamID.Int32,
+		Name:   name.String,
+		Seed:   seed.Int32,
+	}
 }
 
 func (s *PairingService) UpdatePairings(ctx context.Context, req *debate_management.UpdatePairingsRequest) (*debate_management.UpdatePairingsResponse, error) {
@@ -84,18 +226,32 @@ func (s *PairingService) UpdatePairings(ctx context.Context, req *debate_managem
 			return nil, fmt.Errorf("failed to get debate: %v", err)
 		}
 
-		// Get the current teams in the debate before updating
-		oldTeam1ID := debate.Team1id
-		oldTeam2ID := debate.Team2id
+		// British Parliamentary rooms keep their closing teams unless new ones are given
+		isBritishParliamentary := debate.Team3id.Valid && debate.Team4id.Valid
+		team3ID, team4ID := debate.Team3id, debate.Team4id
+		if pairing.GetTeam3() != nil || pairing.GetTeam4() != nil {
+			if !isBritishParliamentary {
+				return nil, fmt.Errorf("debate %d has two teams: only british parliamentary rooms have a third and fourth team", pairing.GetPairingId())
+			}
+			if pairing.GetTeam3() != nil {
+				team3ID = sql.NullInt32{Int32: pairing.GetTeam3().GetTeamId(), Valid: true}
+			}
+			if pairing.GetTeam4() != nil {
+				team4ID = sql.NullInt32{Int32: pairing.GetTeam4().GetTeamId(), Valid: true}
+			}
+		}
 
 		// Only proceed with special handling if teams are actually changing
-		teamsChanged := oldTeam1ID != pairing.GetTeam1().GetTeamId() || oldTeam2ID != pairing.GetTeam2().GetTeamId()
+		teamsChanged := debate.Team1id != pairing.GetTeam1().GetTeamId() || debate.Team2id != pairing.GetTeam2().GetTeamId() ||
+			debate.Team3id != team3ID || debate.Team4id != team4ID
 
 		// Update the teams in the debate
 		err = queries.UpdatePairing(ctx, models.UpdatePairingParams{
 			Debateid: pairing.GetPairingId(),
 			Team1id:  pairing.GetTeam1().GetTeamId(),
 			Team2id:  pairing.GetTeam2().GetTeamId(),
+			Team3id:  team3ID,
+			Team4id:  team4ID,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to update pairing: %v", err)
@@ -123,8 +279,18 @@ func (s *PairingService) UpdatePairings(ctx context.Context, req *debate_managem
 					return nil, fmt.Errorf("failed to create new speaker scores: %v", err)
 				}
 
+				// The judges' ballots were for the old teams
+				err = queries.DeleteJudgeBallots(ctx, ballot.Ballotid)
+				if err != nil {
+					return nil, fmt.Errorf("failed to delete judge ballots: %v", err)
+				}
+				err = queries.DeleteBallotEntries(ctx, ballot.Ballotid)
+				if err != nil {
+					return nil, fmt.Errorf("failed to delete ballot entries: %v", err)
+				}
+
 				// Reset the ballot to "not yet" status since teams changed
-				err = queries.ResetBallotAfterTeamChange(ctx, models.ResetBallotAfterTeamChangeParams{
+				resetParams := models.ResetBallotAfterTeamChangeParams{
 					Ballotid: ballot.Ballotid,
 					Team1totalscore: sql.NullString{
 						String: "0",
@@ -142,7 +308,14 @@ func (s *PairingService) UpdatePairings(ctx context.Context, req *debate_managem
 						Bool:  false,
 						Valid: true,
 					},
-				})
+				}
+				if isBritishParliamentary {
+					resetParams.Team3totalscore = sql.NullString{String: "0", Valid: true}
+					resetParams.Team4totalscore = sql.NullString{String: "0", Valid: true}
+					resetParams.Team3feedback = sql.NullString{String: "", Valid: true}
+					resetParams.Team4feedback = sql.NullString{String: "", Valid: true}
+				}
+				err = queries.ResetBallotAfterTeamChange(ctx, resetParams)
 				if err != nil {
 					return nil, fmt.Errorf("failed to reset ballot after team change: %v", err)
 				}
@@ -169,7 +342,10 @@ func (s *PairingService) UpdatePairings(ctx context.Context, req *debate_managem
 				TeamId: updatedPairing.Team2id,
 				Name:   updatedPairing.Team2name,
 			},
+			Team3:         convertOptionalTeamToProto(updatedPairing.Team3id, updatedPairing.Team3name),
+			Team4:         convertOptionalTeamToProto(updatedPairing.Team4id, updatedPairing.Team4name),
 			HeadJudgeName: updatedPairing.Headjudgename.String,
+			PanelQuality:  numericToFloat(updatedPairing.Panelquality),
 		})
 	}
 
@@ -200,16 +376,47 @@ func (s *PairingService) GeneratePreliminaryPairings(ctx context.Context, req *d
 		return nil, fmt.Errorf("failed to get tournament: %v", err)
 	}
 
-	pairings, err := s.generatePreliminaryPairings(ctx, queries, tournament, req.GetTournamentId())
+	var pairings []*debate_management.Pairing
+	if tournament.Teamsperdebate == pairing_algorithm.TeamsPerBritishParliamentaryRoom {
+		if req.GetRoundNumber() <= 0 {
+			return nil, fmt.Errorf("british parliamentary tournaments are paired one round at a time: round_number is required")
+		}
+		pairings, err = s.generateBritishParliamentaryRound(ctx, queries, tournament, req.GetTournamentId(), req.GetRoundNumber())
+	} else if req.GetRoundNumber() > 0 {
+		pairings, err = s.generatePowerPairedRound(ctx, queries, tournament, req.GetTournamentId(), req.GetRoundNumber())
+	} else {
+		pairings, err = s.generatePreliminaryPairings(ctx, queries, tournament, req.GetTournamentId())
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	sideCounts, err := queries.GetTeamSideCounts(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team side counts: %v", err)
+	}
+
+	byes, err := queries.GetTournamentByes(ctx, models.GetTournamentByesParams{Tournamentid: req.GetTournamentId()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get byes: %v", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	return &debate_management.GeneratePairingsResponse{Pairings: pairings}, nil
+	response := &debate_management.GeneratePairingsResponse{
+		Pairings:   pairings,
+		SideCounts: convertSideCountsToProto(sideCounts),
+	}
+	for _, bye := range byes {
+		response.Byes = append(response.Byes, &debate_management.Bye{
+			TeamId:      bye.Teamid,
+			TeamName:    bye.Teamname,
+			RoundNumber: bye.Roundnumber,
+		})
+	}
+	return response, nil
 }
 
 func (s *PairingService) GenerateEliminationPairings(ctx context.Context, req *debate_management.GenerateEliminationPairingsRequest) (*debate_management.GeneratePairingsResponse, error) {
@@ -232,7 +439,16 @@ func (s *PairingService) GenerateEliminationPairings(ctx context.Context, req *d
 		return nil, fmt.Errorf("failed to get tournament: %v", err)
 	}
 
-	pairings, err := s.generateEliminationPairings(ctx, queries, tournament, req.GetTournamentId(), req.GetRoundNumber())
+	var pairings []*debate_management.Pairing
+	if tournament.Teamsperdebate == pairing_algorithm.TeamsPerBritishParliamentaryRoom {
+		if req.GetBreakSize() != 0 || req.GetBracketMode() != "" || req.GetCategoryId() != 0 {
+			return nil, fmt.Errorf("break size, bracket mode and break categories are only supported for two-team formats")
+		}
+		pairings, err = s.generateEliminationPairings(ctx, queries, tournament, req.GetTournamentId(), req.GetRoundNumber())
+	} else {
+		pairings, err = s.generateBracketEliminationRound(ctx, queries, tournament, req.GetTournamentId(), req.GetRoundNumber(),
+			req.GetCategoryId(), req.GetBreakSize(), pairing_algorithm.BracketMode(req.GetBracketMode()))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -286,21 +502,6 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 		return nil, fmt.Errorf("failed to get teams: %v", err)
 	}
 
-	// If the number of teams is odd, create a "Public Speaking" team
-	if len(teams)%2 != 0 {
-		publicSpeakingTeam, err := txQueries.CreateTeam(ctx, models.CreateTeamParams{
-			Name:         "Public Speaking",
-			Tournamentid: tournamentID,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create Public Speaking team: %v", err)
-		}
-		teams = append(teams, models.GetTeamsByTournamentRow{
-			Teamid: publicSpeakingTeam.Teamid,
-			Name:   publicSpeakingTeam.Name,
-		})
-	}
-
 	// Convert teams to the format expected by the pairing algorithm
 	algorithmTeams := make([]*pairing_algorithm.Team, len(teams))
 	for i, team := range teams {
@@ -311,45 +512,26 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 		}
 	}
 
-	// Get available judges
-	judges, err := txQueries.GetAvailableJudges(ctx, tournamentID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get available judges: %v", err)
+	// Load team schools and students for the judge conflict checks
+	if err := loadTeamAffiliations(ctx, txQueries, tournamentID, algorithmTeams); err != nil {
+		return nil, err
 	}
 
-	algorithmJudges := make([]*pairing_algorithm.Judge, len(judges))
-	for i, judge := range judges {
-		algorithmJudges[i] = &pairing_algorithm.Judge{
-			ID:   int(judge.Userid),
-			Name: judge.Name,
-		}
+	rounds := make([]int32, tournament.Numberofpreliminaryrounds)
+	for i := range rounds {
+		rounds[i] = int32(i + 1)
 	}
 
-	// Create or get rooms
-	rooms, err := txQueries.GetRoomsByTournament(ctx, sql.NullInt32{Int32: tournamentID, Valid: true})
+	// Get the judges, with the rounds each of them cannot sit
+	algorithmJudges, err := loadAlgorithmJudges(ctx, txQueries, tournamentID, rounds, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rooms: %v", err)
+		return nil, err
 	}
 
-	if len(rooms) < len(teams)/2 {
-		neededRooms := len(teams)/2 - len(rooms)
-		for i := 0; i < neededRooms; i++ {
-			roomName := fmt.Sprintf("Room %d", len(rooms)+1)
-			room, err := txQueries.CreateRoom(ctx, models.CreateRoomParams{
-				Roomname:     roomName,
-				Location:     "TBD",
-				Capacity:     int32(tournament.Judgesperdebatepreliminary + 12),
-				Tournamentid: sql.NullInt32{Int32: tournamentID, Valid: true},
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to create room: %v", err)
-			}
-			rooms = append(rooms, room)
-		}
-	}
-	roomIDs := make([]int, len(rooms))
-	for i, room := range rooms {
-		roomIDs[i] = int(room.Roomid)
+	// Create or get rooms
+	rooms, err := ensureTournamentRooms(ctx, txQueries, tournamentID, rounds, false, len(teams)/2, tournament.Judgesperdebatepreliminary)
+	if err != nil {
+		return nil, err
 	}
 
 	// Generate pairings using the pairing algorithm
@@ -358,24 +540,45 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 		JudgesPerDebate:   int(tournament.Judgesperdebatepreliminary),
 	}
 
-	debates, err := pairing_algorithm.GeneratePairings(algorithmTeams, algorithmJudges, roomIDs, specs, 0, false)
+	input := &pairing_algorithm.DrawInput{Teams: algorithmTeams, Judges: algorithmJudges, Rooms: rooms, Specs: specs}
+	debates, savedInput, err := generateDraw(input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate pairings: %v", err)
 	}
 
-	// Save new pairings to the database
+	// Every round of the schedule comes from the same draw
+	for _, roundID := range roundIDs {
+		if err := saveRoundDraw(ctx, txQueries, roundID, input.Specs.Seed, savedInput); err != nil {
+			return nil, err
+		}
+	}
+
+	// Save new pairings to the database, one bye per round with an odd number of teams
 	dbPairings := make([]*debate_management.Pairing, 0, len(debates))
+	debatesPerRound := (len(teams) + 1) / 2
 	debateIndex := 0
 	for roundNumber := 1; roundNumber <= int(tournament.Numberofpreliminaryrounds); roundNumber++ {
-		for i := 0; i < len(teams)/2; i++ {
+		startTime, endTime, err := roundTimes(ctx, txQueries, tournamentID, int32(roundNumber), false)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < debatesPerRound; i++ {
 			pair := debates[debateIndex]
-			startTime := time.Now().Add(time.Duration(roundNumber) * time.Hour)
 
 			roundID, ok := roundIDs[int32(roundNumber)]
 			if !ok {
 				return nil, fmt.Errorf("round ID not found for round number %d", roundNumber)
 			}
 
+			if team := pair.ByeTeam(); team != nil {
+				if err := createBye(ctx, txQueries, tournamentID, roundID, int32(roundNumber), team); err != nil {
+					return nil, err
+				}
+				debateIndex++
+				continue
+			}
+
 			debate, err := txQueries.CreateDebate(ctx, models.CreateDebateParams{
 				Roundid:            roundID,
 				Tournamentid:       tournamentID,
@@ -385,6 +588,8 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 				Team2id:            int32(pair.Team2.ID),
 				Roomid:             int32(pair.Room),
 				Starttime:          startTime,
+				Endtime:            sql.NullTime{Time: endTime, Valid: true},
+				Panelquality:       panelQualityToNumeric(pair.PanelQuality),
 			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to create debate: %v", err)
@@ -392,8 +597,8 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 
 			// Assign judges
 			var headJudgeID int32
-			for i, judge := range pair.Judges {
-				isHeadJudge := (i == 0) || (len(pair.Judges) == 1)
+			for _, judge := range pair.Judges {
+				isHeadJudge := judge.IsHeadJudge
 				err := txQueries.AssignJudgeToDebate(ctx, models.AssignJudgeToDebateParams{
 					Tournamentid:  tournamentID,
 					Judgeid:       int32(judge.ID),
@@ -447,7 +652,10 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 					TeamId: int32(pair.Team2.ID),
 					Name:   pair.Team2.Name,
 				},
-				Judges: convertJudgesToProto(pair.Judges),
+				Judges:       convertJudgesToProto(pair.Judges),
+				PanelQuality: pair.PanelQuality,
+				StartTime:    startTime.Format(debateTimeLayout),
+				EndTime:      endTime.Format(debateTimeLayout),
 			})
 
 			// Record pairing history
@@ -473,186 +681,879 @@ func (s *PairingService) generatePreliminaryPairings(ctx context.Context, querie
 	return dbPairings, nil
 }
 
-func (s *PairingService) generateEliminationPairings(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32) ([]*debate_management.Pairing, error) {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %v", err)
-	}
-	defer tx.Rollback()
-
-	txQueries := queries.WithTx(tx)
-
-	teamsNeeded := int(math.Pow(2, float64(tournament.Numberofeliminationrounds-roundNumber+1)))
-
-	// Check if all ballots from the previous round are recorded
-	if roundNumber > 1 {
-		prevRoundBallots, err := txQueries.GetBallotsByTournamentAndRound(ctx, models.GetBallotsByTournamentAndRoundParams{
-			Tournamentid:       tournamentID,
-			Roundnumber:        roundNumber - 1,
-			Iseliminationround: true,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get previous round ballots: %v", err)
-		}
-		for _, ballot := range prevRoundBallots {
-			if ballot.Recordingstatus != "Recorded" {
-				return nil, fmt.Errorf("cannot generate pairings: not all ballots from previous round are recorded")
-			}
-		}
-	}
-
-	var teams []models.GetTeamsByTournamentRow
-	if roundNumber == 1 {
-		// For the first elimination round, get top performing teams from preliminaries
-		topTeams, err := txQueries.GetTopPerformingTeams(ctx, models.GetTopPerformingTeamsParams{
-			Tournamentid: tournamentID,
-			Limit:        int32(teamsNeeded),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get top performing teams: %v", err)
-		}
-		if len(topTeams) == 0 {
-			return nil, fmt.Errorf("cannot generate pairings: not all ballots from preliminary rounds are recorded")
-		}
-		teams = convertTopPerformingTeamsToTeamsByTournament(topTeams)
-	} else {
-		// For subsequent elimination rounds, get winning teams from the previous round
-		winningTeams, err := txQueries.GetEliminationRoundTeams(ctx, models.GetEliminationRoundTeamsParams{
-			Tournamentid: tournamentID,
-			Roundnumber:  roundNumber - 1,
-			Limit:        int32(teamsNeeded),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get winning teams from previous elimination round: %v", err)
-		}
-		teams = convertEliminationRoundTeamsToTeamsByTournament(winningTeams)
+// generatePowerPairedRound pairs a single preliminary round from the current
+// team standings and pairing history. Rounds are paired one at a time, so the
+// previous round has to be fully recorded before the next one can be drawn.
+func (s *PairingService) generatePowerPairedRound(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32) ([]*debate_management.Pairing, error) {
+	if err := checkPreliminaryRoundCanBePaired(ctx, queries, tournament, tournamentID, roundNumber); err != nil {
+		return nil, err
 	}
 
-	if len(teams) < teamsNeeded {
-		return nil, fmt.Errorf("not enough teams for elimination round %d: have %d, need %d", roundNumber, len(teams), teamsNeeded)
+	teams, err := queries.GetTeamStandingsForPairing(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team standings: %v", err)
 	}
 
 	// Convert teams to the format expected by the pairing algorithm
 	algorithmTeams := make([]*pairing_algorithm.Team, len(teams))
+	teamsByID := make(map[int32]*pairing_algorithm.Team, len(teams))
 	for i, team := range teams {
+		totalPoints, _ := strconv.ParseFloat(team.Totalspeakerpoints.String, 64)
+		averageRank, _ := strconv.ParseFloat(team.Averagerank.String, 64)
 		algorithmTeams[i] = &pairing_algorithm.Team{
-			ID:        int(team.Teamid),
-			Name:      team.Name,
-			Opponents: make(map[int]bool),
+			ID:          int(team.Teamid),
+			Name:        team.Name,
+			Wins:        int(team.Totalwins.Int32),
+			TotalPoints: totalPoints,
+			AverageRank: averageRank,
+			Opponents:   make(map[int]bool),
 		}
+		teamsByID[team.Teamid] = algorithmTeams[i]
 	}
 
-	// Get available judges
-	judges, err := txQueries.GetAvailableJudges(ctx, tournamentID)
+	// Side counts come from the debates already drawn
+	sideCounts, err := queries.GetTeamSideCounts(ctx, tournamentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get available judges: %v", err)
+		return nil, fmt.Errorf("failed to get team side counts: %v", err)
 	}
-
-	algorithmJudges := make([]*pairing_algorithm.Judge, len(judges))
-	for i, judge := range judges {
-		algorithmJudges[i] = &pairing_algorithm.Judge{
-			ID:   int(judge.Userid),
-			Name: judge.Name,
+	for _, count := range sideCounts {
+		if team, ok := teamsByID[count.Teamid]; ok {
+			team.PropositionCount = int(count.Propositioncount)
+			team.OppositionCount = int(count.Oppositioncount)
 		}
 	}
 
-	// Get or create rooms
-	rooms, err := txQueries.GetRoomsByTournament(ctx, sql.NullInt32{Int32: tournamentID, Valid: true})
+	// Previous opponents and last sides come from the pairing history
+	history, err := queries.GetPreliminaryPairingHistory(ctx, tournamentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rooms: %v", err)
+		return nil, fmt.Errorf("failed to get pairing history: %v", err)
+	}
+	for _, pairing := range history {
+		team1, ok1 := teamsByID[pairing.Team1id]
+		team2, ok2 := teamsByID[pairing.Team2id]
+		if ok1 {
+			team1.Opponents[int(pairing.Team2id)] = true
+			team1.LastSide = 1
+		}
+		if ok2 {
+			team2.Opponents[int(pairing.Team1id)] = true
+			team2.LastSide = -1
+		}
 	}
 
-	if len(rooms) < len(teams)/2 {
-		neededRooms := len(teams)/2 - len(rooms)
-		for i := 0; i < neededRooms; i++ {
-			roomName := fmt.Sprintf("Room %d", len(rooms)+i+1)
-			room, err := txQueries.CreateRoom(ctx, models.CreateRoomParams{
-				Roomname:     roomName,
-				Location:     "TBD",
-				Capacity:     int32(tournament.Judgesperdebateelimination + 12),
-				Tournamentid: sql.NullInt32{Int32: tournamentID, Valid: true},
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to create room: %v", err)
-			}
-			rooms = append(rooms, room)
+	// A team that had a bye is not given another, and its bye counts like in the standings
+	byeResult, err := queries.GetByeResult(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bye result: %v", err)
+	}
+	byes, err := queries.GetTournamentByes(ctx, models.GetTournamentByesParams{Tournamentid: tournamentID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get byes: %v", err)
+	}
+	for _, bye := range byes {
+		if team, ok := teamsByID[bye.Teamid]; ok {
+			team.AddBye(pairing_algorithm.ByeResult(byeResult))
 		}
 	}
-	roomIDs := make([]int, len(rooms))
-	for i, room := range rooms {
-		roomIDs[i] = int(room.Roomid)
+
+	// Load team schools and students for the judge conflict checks
+	if err := loadTeamAffiliations(ctx, queries, tournamentID, algorithmTeams); err != nil {
+		return nil, err
 	}
 
-	// Generate pairings using the pairing algorithm
-	specs := pairing_algorithm.TournamentSpecs{
-		PreliminaryRounds:     int(tournament.Numberofpreliminaryrounds),
-		EliminationRounds:     int(tournament.Numberofeliminationrounds),
-		JudgesPerDebate:       int(tournament.Judgesperdebateelimination),
-		TeamsAdvancingToElims: int(math.Pow(2, float64(tournament.Numberofeliminationrounds))),
+	// Get available judges
+	algorithmJudges, err := loadRoundJudges(ctx, queries, tournamentID, roundNumber, false)
+	if err != nil {
+		return nil, err
 	}
 
-	debates, err := pairing_algorithm.GeneratePairings(algorithmTeams, algorithmJudges, roomIDs, specs, int(roundNumber), true)
+	// Create or get rooms
+	rooms, err := ensureTournamentRooms(ctx, queries, tournamentID, []int32{roundNumber}, false, len(teams)/2, tournament.Judgesperdebatepreliminary)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate elimination pairings: %v", err)
+		return nil, err
 	}
 
-	// Create or get the elimination round
-	round, err := txQueries.CreateRound(ctx, models.CreateRoundParams{
-		Tournamentid:       tournamentID,
-		Roundnumber:        roundNumber,
-		Iseliminationround: true,
-	})
+	specs := pairing_algorithm.TournamentSpecs{
+		PreliminaryRounds: int(tournament.Numberofpreliminaryrounds),
+		JudgesPerDebate:   int(tournament.Judgesperdebatepreliminary),
+	}
+
+	input := &pairing_algorithm.DrawInput{Teams: algorithmTeams, Judges: algorithmJudges, Rooms: rooms, Specs: specs, RoundNumber: int(roundNumber)}
+	debates, savedInput, err := generateDraw(input)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			existingRound, err := txQueries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams{
-				Tournamentid:       tournamentID,
-				Roundnumber:        roundNumber,
-				Iseliminationround: true,
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to get existing round: %v", err)
-			}
-			round = existingRound
-		} else {
-			return nil, fmt.Errorf("failed to create round: %v", err)
-		}
+		return nil, fmt.Errorf("failed to generate pairings for round %d: %v", roundNumber, err)
 	}
 
-	dbPairings, err := s.saveDebatesToDatabase(ctx, txQueries, debates, tournamentID, roundNumber, true, round.Roundid)
+	// Create or get the preliminary round
+	round, err := getOrCreateRound(ctx, queries, tournamentID, roundNumber, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save debates to database: %v", err)
+		return nil, err
+	}
+	if err := saveRoundDraw(ctx, queries, round.Roundid, input.Specs.Seed, savedInput); err != nil {
+		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	dbPairings, err := s.saveDebatesToDatabase(ctx, queries, debates, tournamentID, roundNumber, false, round.Roundid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save debates to database: %v", err)
 	}
 
 	return dbPairings, nil
 }
 
-func (s *PairingService) saveDebatesToDatabase(ctx context.Context, queries *models.Queries, debates []*pairing_algorithm.Debate, tournamentID int32, roundNumber int32, isElimination bool, roundID int32) ([]*debate_management.Pairing, error) {
-	dbPairings := make([]*debate_management.Pairing, 0, len(debates))
-	for _, debate := range debates {
-		startTime := time.Now().Add(time.Hour) // Adjust as needed
+// generateBritishParliamentaryRound draws a single British Parliamentary
+// preliminary round: rooms of four teams by team points, with positions
+// balanced against each team's OG/OO/CG/CO history.
+func (s *PairingService) generateBritishParliamentaryRound(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32) ([]*debate_management.Pairing, error) {
+	if err := checkPreliminaryRoundCanBePaired(ctx, queries, tournament, tournamentID, roundNumber); err != nil {
+		return nil, err
+	}
 
-		dbDebate, err := queries.CreateDebate(ctx, models.CreateDebateParams{
-			Roundid:            roundID,
-			Tournamentid:       tournamentID,
-			Roundnumber:        roundNumber,
-			Iseliminationround: isElimination,
-			Team1id:            int32(debate.Team1.ID),
-			Team2id:            int32(debate.Team2.ID),
-			Roomid:             int32(debate.Room),
-			Starttime:          startTime,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to create debate: %v", err)
-		}
+	teams, err := queries.GetTeamStandingsForPairing(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team standings: %v", err)
+	}
+	if len(teams)%pairing_algorithm.TeamsPerBritishParliamentaryRoom != 0 {
+		return nil, fmt.Errorf("british parliamentary rounds need a multiple of %d teams: tournament has %d", pairing_algorithm.TeamsPerBritishParliamentaryRoom, len(teams))
+	}
+
+	// Convert teams to the format expected by the pairing algorithm
+	algorithmTeams := make([]*pairing_algorithm.Team, len(teams))
+	teamsByID := make(map[int32]*pairing_algorithm.Team, len(teams))
+	for i, team := range teams {
+		totalPoints, _ := strconv.ParseFloat(team.Totalspeakerpoints.String, 64)
+		algorithmTeams[i] = &pairing_algorithm.Team{
+			ID:          int(team.Teamid),
+			Name:        team.Name,
+			TeamPoints:  int(team.Totalteampoints.Int32),
+			TotalPoints: totalPoints,
+			Opponents:   make(map[int]bool),
+		}
+		teamsByID[team.Teamid] = algorithmTeams[i]
+	}
+
+	// Positions held so far come from the debates already drawn
+	positionCounts, err := queries.GetTeamSideCounts(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team position counts: %v", err)
+	}
+	for _, count := range positionCounts {
+		if team, ok := teamsByID[count.Teamid]; ok {
+			team.PositionCounts = [4]int{
+				pairing_algorithm.OpeningGovernment: int(count.Propositioncount),
+				pairing_algorithm.OpeningOpposition: int(count.Oppositioncount),
+				pairing_algorithm.ClosingGovernment: int(count.Closinggovernmentcount),
+				pairing_algorithm.ClosingOpposition: int(count.Closingoppositioncount),
+			}
+		}
+	}
+
+	// Load team schools and students for the judge conflict checks
+	if err := loadTeamAffiliations(ctx, queries, tournamentID, algorithmTeams); err != nil {
+		return nil, err
+	}
+
+	// Get available judges
+	algorithmJudges, err := loadRoundJudges(ctx, queries, tournamentID, roundNumber, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create or get rooms
+	rooms, err := ensureTournamentRooms(ctx, queries, tournamentID, []int32{roundNumber}, false, len(algorithmTeams)/pairing_algorithm.TeamsPerBritishParliamentaryRoom, tournament.Judgesperdebatepreliminary)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := pairing_algorithm.TournamentSpecs{
+		PreliminaryRounds: int(tournament.Numberofpreliminaryrounds),
+		JudgesPerDebate:   int(tournament.Judgesperdebatepreliminary),
+		TeamsPerDebate:    int(tournament.Teamsperdebate),
+	}
+
+	input := &pairing_algorithm.DrawInput{Teams: algorithmTeams, Judges: algorithmJudges, Rooms: rooms, Specs: specs, RoundNumber: int(roundNumber)}
+	debates, savedInput, err := generateDraw(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pairings for round %d: %v", roundNumber, err)
+	}
+
+	// Create or get the preliminary round
+	round, err := getOrCreateRound(ctx, queries, tournamentID, roundNumber, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := saveRoundDraw(ctx, queries, round.Roundid, input.Specs.Seed, savedInput); err != nil {
+		return nil, err
+	}
+
+	dbPairings, err := s.saveDebatesToDatabase(ctx, queries, debates, tournamentID, roundNumber, false, round.Roundid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save debates to database: %v", err)
+	}
+
+	return dbPairings, nil
+}
+
+// checkPreliminaryRoundCanBePaired makes sure a single preliminary round can
+// be drawn: the round exists in the tournament, has not been drawn yet, and
+// every ballot of the previous round is recorded.
+func checkPreliminaryRoundCanBePaired(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32) error {
+	if roundNumber > tournament.Numberofpreliminaryrounds {
+		return fmt.Errorf("invalid round number %d: tournament has %d preliminary rounds", roundNumber, tournament.Numberofpreliminaryrounds)
+	}
+
+	existingPairings, err := queries.GetPairings(ctx, models.GetPairingsParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get existing pairings: %v", err)
+	}
+	if len(existingPairings) > 0 {
+		return fmt.Errorf("pairings for preliminary round %d already exist", roundNumber)
+	}
+
+	// Check if all ballots from the previous round are recorded
+	if roundNumber > 1 {
+		prevRoundBallots, err := queries.GetBallotsByTournamentAndRound(ctx, models.GetBallotsByTournamentAndRoundParams{
+			Tournamentid:       tournamentID,
+			Roundnumber:        roundNumber - 1,
+			Iseliminationround: false,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get previous round ballots: %v", err)
+		}
+		if len(prevRoundBallots) == 0 {
+			return fmt.Errorf("cannot generate pairings: preliminary round %d has not been paired yet", roundNumber-1)
+		}
+		for _, ballot := range prevRoundBallots {
+			if ballot.Recordingstatus != "Recorded" {
+				return fmt.Errorf("cannot generate pairings: not all ballots from previous round are recorded")
+			}
+		}
+	}
+
+	return nil
+}
+
+// generateDraw draws the input with a new random seed. It also returns the
+// input as it was before drawing, to be saved with saveRoundDraw.
+func generateDraw(input *pairing_algorithm.DrawInput) ([]*pairing_algorithm.Debate, []byte, error) {
+	input.Specs.Seed = rand.Int63()
+	savedInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to save draw input: %v", err)
+	}
+
+	debates, err := input.Generate()
+	if err != nil {
+		return nil, nil, err
+	}
+	return debates, savedInput, nil
+}
+
+// saveRoundDraw stores the seed and input a round was drawn from, so the draw
+// can be generated again by ReplayPairings.
+func saveRoundDraw(ctx context.Context, queries *models.Queries, roundID int32, seed int64, input []byte) error {
+	err := queries.SetRoundDraw(ctx, models.SetRoundDrawParams{
+		Roundid:   roundID,
+		Drawseed:  sql.NullInt64{Int64: seed, Valid: true},
+		Drawinput: pqtype.NullRawMessage{RawMessage: input, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save round draw: %v", err)
+	}
+	return nil
+}
+
+// getOrCreateRound creates the round, or returns it when it already exists.
+func getOrCreateRound(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) (models.Round, error) {
+	round, err := queries.CreateRound(ctx, models.CreateRoundParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: isElimination,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			existingRound, err := queries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams{
+				Tournamentid:       tournamentID,
+				Roundnumber:        roundNumber,
+				Iseliminationround: isElimination,
+			})
+			if err != nil {
+				return models.Round{}, fmt.Errorf("failed to get existing round: %v", err)
+			}
+			return existingRound, nil
+		}
+		return models.Round{}, fmt.Errorf("failed to create round: %v", err)
+	}
+	return round, nil
+}
+
+// generateEliminationPairings pairs a British Parliamentary elimination round.
+// Two-team formats are paired from the elimination bracket instead, see
+// generateBracketEliminationRound.
+func (s *PairingService) generateEliminationPairings(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32) ([]*debate_management.Pairing, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	txQueries := queries.WithTx(tx)
+
+	// Rooms of four where the top two advance: the final has four teams
+	teamsNeeded := pairing_algorithm.TeamsPerBritishParliamentaryRoom << (tournament.Numberofeliminationrounds - roundNumber)
+
+	// Check if all ballots from the previous round are recorded
+	if roundNumber > 1 {
+		prevRoundBallots, err := txQueries.GetBallotsByTournamentAndRound(ctx, models.GetBallotsByTournamentAndRoundParams{
+			Tournamentid:       tournamentID,
+			Roundnumber:        roundNumber - 1,
+			Iseliminationround: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get previous round ballots: %v", err)
+		}
+		for _, ballot := range prevRoundBallots {
+			if ballot.Recordingstatus != "Recorded" {
+				return nil, fmt.Errorf("cannot generate pairings: not all ballots from previous round are recorded")
+			}
+		}
+	}
+
+	var algorithmTeams []*pairing_algorithm.Team
+	if roundNumber == 1 {
+		// For the first elimination round, the break follows the preliminary standings
+		standings, err := breakStandings(ctx, txQueries, tournamentID)
+		if err != nil {
+			return nil, err
+		}
+		if len(standings) > teamsNeeded {
+			standings = standings[:teamsNeeded]
+		}
+		algorithmTeams = standings
+	} else {
+		// For subsequent elimination rounds, the top two teams of each room advance
+		advancingTeams, err := txQueries.GetBritishParliamentaryAdvancingTeams(ctx, models.GetBritishParliamentaryAdvancingTeamsParams{
+			Tournamentid: tournamentID,
+			Roundnumber:  roundNumber - 1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get advancing teams from previous elimination round: %v", err)
+		}
+		for _, team := range convertAdvancingTeamsToTeamsByTournament(advancingTeams) {
+			algorithmTeams = append(algorithmTeams, &pairing_algorithm.Team{
+				ID:        int(team.Teamid),
+				Name:      team.Name,
+				Opponents: make(map[int]bool),
+			})
+		}
+	}
+
+	if len(algorithmTeams) < teamsNeeded {
+		return nil, fmt.Errorf("not enough teams for elimination round %d: have %d, need %d", roundNumber, len(algorithmTeams), teamsNeeded)
+	}
+
+	// Load team schools and students for the judge conflict checks
+	if err := loadTeamAffiliations(ctx, txQueries, tournamentID, algorithmTeams); err != nil {
+		return nil, err
+	}
+
+	// Get available judges
+	algorithmJudges, err := loadRoundJudges(ctx, txQueries, tournamentID, roundNumber, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get or create rooms
+	rooms, err := ensureTournamentRooms(ctx, txQueries, tournamentID, []int32{roundNumber}, true, len(algorithmTeams)/pairing_algorithm.TeamsPerBritishParliamentaryRoom, tournament.Judgesperdebateelimination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate pairings using the pairing algorithm
+	specs := pairing_algorithm.TournamentSpecs{
+		PreliminaryRounds:     int(tournament.Numberofpreliminaryrounds),
+		EliminationRounds:     int(tournament.Numberofeliminationrounds),
+		JudgesPerDebate:       int(tournament.Judgesperdebateelimination),
+		TeamsAdvancingToElims: teamsNeeded << (roundNumber - 1),
+		TeamsPerDebate:        int(tournament.Teamsperdebate),
+	}
+
+	input := &pairing_algorithm.DrawInput{Teams: algorithmTeams, Judges: algorithmJudges, Rooms: rooms, Specs: specs, RoundNumber: int(roundNumber), IsElimination: true}
+	debates, savedInput, err := generateDraw(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate elimination pairings: %v", err)
+	}
+
+	// Create or get the elimination round
+	round, err := txQueries.CreateRound(ctx, models.CreateRoundParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: true,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			existingRound, err := txQueries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams{
+				Tournamentid:       tournamentID,
+				Roundnumber:        roundNumber,
+				Iseliminationround: true,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get existing round: %v", err)
+			}
+			round = existingRound
+		} else {
+			return nil, fmt.Errorf("failed to create round: %v", err)
+		}
+	}
+	if err := saveRoundDraw(ctx, txQueries, round.Roundid, input.Specs.Seed, savedInput); err != nil {
+		return nil, err
+	}
+
+	dbPairings, err := s.saveDebatesToDatabase(ctx, txQueries, debates, tournamentID, roundNumber, true, round.Roundid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save debates to database: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return dbPairings, nil
+}
+
+// generateBracketEliminationRound pairs an elimination round of a two-team
+// format from the tournament's elimination bracket. Round 1 builds and saves
+// the bracket from the preliminary standings. Later rounds first move the
+// winners of recorded ballots up the bracket, so each debate is between the
+// teams that actually won their way into it. Tournaments with break
+// categories have one bracket per category.
+func (s *PairingService) generateBracketEliminationRound(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, roundNumber int32, categoryID int32, breakSize int32, mode pairing_algorithm.BracketMode) ([]*debate_management.Pairing, error) {
+	category, err := getBreakCategory(ctx, queries, tournamentID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	bracketParams := models.GetEliminationBracketParams{
+		Tournamentid: tournamentID,
+		Column2:      categoryID,
+	}
+	nodes, err := queries.GetEliminationBracket(ctx, bracketParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get elimination bracket: %v", err)
+	}
+
+	if len(nodes) == 0 {
+		if roundNumber != 1 {
+			return nil, fmt.Errorf("cannot generate pairings: elimination round 1 must be generated first")
+		}
+		if err := createEliminationBracket(ctx, queries, tournament, tournamentID, category, breakSize, mode); err != nil {
+			return nil, err
+		}
+	} else {
+		if breakSize != 0 || mode != "" {
+			return nil, fmt.Errorf("the elimination bracket has already been created: break size and bracket mode cannot be changed")
+		}
+		if err := advanceEliminationBracket(ctx, queries, tournamentID, categoryID, nodes); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err = queries.GetEliminationBracket(ctx, bracketParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get elimination bracket: %v", err)
+	}
+
+	// Collect the debates of the requested round
+	var roundRows []models.GetEliminationBracketRow
+	lastRound := int32(0)
+	for _, node := range nodes {
+		if node.Roundnumber > lastRound {
+			lastRound = node.Roundnumber
+		}
+		if node.Roundnumber == roundNumber {
+			roundRows = append(roundRows, node)
+		}
+	}
+	if len(roundRows) == 0 {
+		return nil, fmt.Errorf("invalid elimination round %d: the bracket has %d rounds", roundNumber, lastRound)
+	}
+
+	roundNodes := make([]*pairing_algorithm.BracketNode, len(roundRows))
+	algorithmTeams := make([]*pairing_algorithm.Team, 0, 2*len(roundRows))
+	for i, row := range roundRows {
+		if row.Debateid.Valid {
+			return nil, fmt.Errorf("elimination round %d has already been paired", roundNumber)
+		}
+		if !row.Team1id.Valid || !row.Team2id.Valid {
+			return nil, fmt.Errorf("cannot generate pairings: not all ballots from elimination round %d are recorded", roundNumber-1)
+		}
+
+		team1 := &pairing_algorithm.Team{ID: int(row.Team1id.Int32), Name: row.Team1name.String, Opponents: make(map[int]bool)}
+		team2 := &pairing_algorithm.Team{ID: int(row.Team2id.Int32), Name: row.Team2name.String, Opponents: make(map[int]bool)}
+		roundNodes[i] = &pairing_algorithm.BracketNode{
+			Round:    int(row.Roundnumber),
+			Position: int(row.Position),
+			Teams:    [2]*pairing_algorithm.Team{team1, team2},
+			Seeds:    [2]int{int(row.Team1seed.Int32), int(row.Team2seed.Int32)},
+		}
+		algorithmTeams = append(algorithmTeams, team1, team2)
+	}
+
+	// Load team schools and students for the judge conflict checks
+	if err := loadTeamAffiliations(ctx, queries, tournamentID, algorithmTeams); err != nil {
+		return nil, err
+	}
+
+	// Get available judges
+	algorithmJudges, err := loadRoundJudges(ctx, queries, tournamentID, roundNumber, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get or create rooms
+	rooms, err := ensureTournamentRooms(ctx, queries, tournamentID, []int32{roundNumber}, true, len(roundNodes), tournament.Judgesperdebateelimination)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := pairing_algorithm.TournamentSpecs{
+		PreliminaryRounds: int(tournament.Numberofpreliminaryrounds),
+		EliminationRounds: int(lastRound),
+		JudgesPerDebate:   int(tournament.Judgesperdebateelimination),
+	}
+
+	input := &pairing_algorithm.DrawInput{Judges: algorithmJudges, Rooms: rooms, Specs: specs, RoundNumber: int(roundNumber), IsElimination: true, Bracket: roundNodes}
+	debates, savedInput, err := generateDraw(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate elimination pairings: %v", err)
+	}
+
+	round, err := getOrCreateRound(ctx, queries, tournamentID, roundNumber, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := saveRoundDraw(ctx, queries, round.Roundid, input.Specs.Seed, savedInput); err != nil {
+		return nil, err
+	}
+
+	dbPairings, err := s.saveDebatesToDatabase(ctx, queries, debates, tournamentID, roundNumber, true, round.Roundid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save debates to database: %v", err)
+	}
+
+	// Link each bracket node to its debate so its ballot decides who moves on
+	for i, pairing := range dbPairings {
+		err := queries.SetEliminationBracketNodeDebate(ctx, models.SetEliminationBracketNodeDebateParams{
+			Nodeid:   roundRows[i].Nodeid,
+			Debateid: sql.NullInt32{Int32: pairing.GetPairingId(), Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to link bracket node to debate: %v", err)
+		}
+	}
+
+	return dbPairings, nil
+}
+
+// getBreakCategory returns the break category to pair, or nil for a
+// tournament without break categories.
+func getBreakCategory(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32) (*models.Breakcategory, error) {
+	categories, err := queries.GetBreakCategories(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get break categories: %v", err)
+	}
+
+	if categoryID == 0 {
+		if len(categories) > 0 {
+			return nil, fmt.Errorf("the tournament has break categories: a category ID is required")
+		}
+		return nil, nil
+	}
+	for i := range categories {
+		if categories[i].Categoryid == categoryID {
+			return &categories[i], nil
+		}
+	}
+	return nil, fmt.Errorf("break category %d not found in tournament %d", categoryID, tournamentID)
+}
+
+// createEliminationBracket seeds the top breakSize teams of the preliminary
+// standings into a new elimination bracket and saves it. For a break category
+// the breaking teams come from AllocateBreaks, and the bracket has as many
+// rounds as the category's break size needs.
+func createEliminationBracket(ctx context.Context, queries *models.Queries, tournament models.GetTournamentByIDRow, tournamentID int32, category *models.Breakcategory, breakSize int32, mode pairing_algorithm.BracketMode) error {
+	if category != nil {
+		if breakSize != 0 {
+			return fmt.Errorf("the break size of a category is set on the category")
+		}
+		breaking, err := allocateCategoryBreak(ctx, queries, tournamentID, *category)
+		if err != nil {
+			return err
+		}
+		bracket, err := pairing_algorithm.BuildEliminationBracket(breaking, pairing_algorithm.BracketRounds(len(breaking), mode), mode)
+		if err != nil {
+			return err
+		}
+		return saveEliminationBracket(ctx, queries, tournamentID, category.Categoryid, bracket)
+	}
+
+	if breakSize == 0 {
+		breakSize = 1 << tournament.Numberofeliminationrounds
+	}
+	if breakSize < 2 {
+		return fmt.Errorf("break size must be at least 2")
+	}
+
+	breaking, err := breakStandings(ctx, queries, tournamentID)
+	if err != nil {
+		return err
+	}
+	if len(breaking) < int(breakSize) {
+		return fmt.Errorf("not enough teams for a break of %d: have %d", breakSize, len(breaking))
+	}
+	breaking = breaking[:breakSize]
+
+	bracket, err := pairing_algorithm.BuildEliminationBracket(breaking, int(tournament.Numberofeliminationrounds), mode)
+	if err != nil {
+		return err
+	}
+
+	return saveEliminationBracket(ctx, queries, tournamentID, 0, bracket)
+}
+
+// allocateCategoryBreak returns the teams breaking in the category, best
+// first. Teams that also break in a higher-priority category go there instead.
+func allocateCategoryBreak(ctx context.Context, queries *models.Queries, tournamentID int32, category models.Breakcategory) ([]*pairing_algorithm.Team, error) {
+	// The whole standings, since teams breaking in other categories are skipped
+	teams, err := breakStandings(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamCategories, err := queries.GetTeamBreakCategoriesByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team break categories: %v", err)
+	}
+	eligibility := make(map[int]map[int]bool)
+	for _, tc := range teamCategories {
+		if eligibility[int(tc.Teamid)] == nil {
+			eligibility[int(tc.Teamid)] = make(map[int]bool)
+		}
+		eligibility[int(tc.Teamid)][int(tc.Categoryid)] = true
+	}
+	for _, team := range teams {
+		team.BreakCategories = eligibility[team.ID]
+	}
+
+	dbCategories, err := queries.GetBreakCategories(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get break categories: %v", err)
+	}
+	categories := make([]pairing_algorithm.BreakCategory, len(dbCategories))
+	for i, c := range dbCategories {
+		categories[i] = pairing_algorithm.BreakCategory{
+			ID:        int(c.Categoryid),
+			Name:      c.Name,
+			BreakSize: int(c.Breaksize),
+			Priority:  int(c.Priority),
+			General:   c.Isgeneral,
+		}
+	}
+
+	breaking := pairing_algorithm.AllocateBreaks(teams, categories)[int(category.Categoryid)]
+	if len(breaking) < int(category.Breaksize) {
+		return nil, fmt.Errorf("not enough eligible teams for a %s break of %d: have %d", category.Name, category.Breaksize, len(breaking))
+	}
+	return breaking, nil
+}
+
+// saveEliminationBracket saves the nodes of a new bracket. A zero categoryID
+// saves the bracket of a tournament without break categories.
+func saveEliminationBracket(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32, bracket *pairing_algorithm.EliminationBracket) error {
+	// Save from the final down so that every node can point at its parent
+	nodeIDs := make(map[*pairing_algorithm.BracketNode]int32)
+	for r := len(bracket.Rounds) - 1; r >= 0; r-- {
+		for _, node := range bracket.Rounds[r] {
+			params := models.CreateEliminationBracketNodeParams{
+				Tournamentid: tournamentID,
+				Roundnumber:  int32(node.Round),
+				Position:     int32(node.Position),
+				Categoryid:   sql.NullInt32{Int32: categoryID, Valid: categoryID != 0},
+			}
+			if node.Parent != nil {
+				params.Parentnodeid = sql.NullInt32{Int32: nodeIDs[node.Parent], Valid: true}
+				params.Parentslot = sql.NullInt32{Int32: int32(node.ParentSlot + 1), Valid: true}
+			}
+			if node.Teams[0] != nil {
+				params.Team1id = sql.NullInt32{Int32: int32(node.Teams[0].ID), Valid: true}
+				params.Team1seed = sql.NullInt32{Int32: int32(node.Seeds[0]), Valid: true}
+			}
+			if node.Teams[1] != nil {
+				params.Team2id = sql.NullInt32{Int32: int32(node.Teams[1].ID), Valid: true}
+				params.Team2seed = sql.NullInt32{Int32: int32(node.Seeds[1]), Valid: true}
+			}
+
+			nodeID, err := queries.CreateEliminationBracketNode(ctx, params)
+			if err != nil {
+				return fmt.Errorf("failed to save elimination bracket: %v", err)
+			}
+			nodeIDs[node] = nodeID
+		}
+	}
+
+	return nil
+}
+
+// advanceEliminationBracket records the winner of every bracket debate whose
+// ballot has been recorded and moves them into the next debate of the bracket.
+func advanceEliminationBracket(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32, nodes []models.GetEliminationBracketRow) error {
+	results, err := queries.GetEliminationBracketResults(ctx, models.GetEliminationBracketResultsParams{
+		Tournamentid: tournamentID,
+		Column2:      categoryID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get elimination bracket results: %v", err)
+	}
+
+	nodesByID := make(map[int32]models.GetEliminationBracketRow, len(nodes))
+	for _, node := range nodes {
+		nodesByID[node.Nodeid] = node
+	}
+
+	for _, result := range results {
+		node := nodesByID[result.Nodeid]
+		seed := bracketWinnerSeed(result.Winnerteamid, node.Team1id, node.Team1seed, node.Team2id, node.Team2seed)
+		if err := recordBracketWinner(ctx, queries, node.Nodeid, node.Parentnodeid, node.Parentslot, result.Winnerteamid, seed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advanceBracketWinner moves the winner of an elimination debate up the
+// bracket as soon as its ballot is recorded. Debates outside a bracket are left
+// alone. A result can be corrected until the next round has been paired.
+func advanceBracketWinner(ctx context.Context, queries *models.Queries, ballot models.GetBallotByIDRow, verdict string) error {
+	node, err := queries.GetEliminationBracketNodeByDebate(ctx, sql.NullInt32{Int32: ballot.Debateid, Valid: true})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get elimination bracket node: %v", err)
+	}
+
+	var winnerTeamID int32
+	switch verdict {
+	case ballot.Team1name:
+		winnerTeamID = ballot.Team1id
+	case ballot.Team2name:
+		winnerTeamID = ballot.Team2id
+	default:
+		return fmt.Errorf("verdict %q must name one of the teams in an elimination debate", verdict)
+	}
+
+	if node.Winnerteamid.Valid {
+		if node.Winnerteamid.Int32 == winnerTeamID {
+			return nil
+		}
+		if node.Parentdebateid.Valid {
+			return fmt.Errorf("cannot change the winner: the next elimination round has already been paired")
+		}
+	}
+
+	seed := bracketWinnerSeed(winnerTeamID, node.Team1id, node.Team1seed, node.Team2id, node.Team2seed)
+	return recordBracketWinner(ctx, queries, node.Nodeid, node.Parentnodeid, node.Parentslot, winnerTeamID, seed)
+}
+
+// bracketWinnerSeed returns the seed the winner carries into the next round.
+func bracketWinnerSeed(winnerTeamID int32, team1ID, team1Seed, team2ID, team2Seed sql.NullInt32) sql.NullInt32 {
+	switch {
+	case team1ID.Valid && team1ID.Int32 == winnerTeamID:
+		return team1Seed
+	case team2ID.Valid && team2ID.Int32 == winnerTeamID:
+		return team2Seed
+	}
+	return sql.NullInt32{}
+}
+
+// recordBracketWinner sets the winner of a bracket node and fills their slot in
+// the parent node. The final has no parent.
+func recordBracketWinner(ctx context.Context, queries *models.Queries, nodeID int32, parentNodeID, parentSlot sql.NullInt32, winnerTeamID int32, seed sql.NullInt32) error {
+	winner := sql.NullInt32{Int32: winnerTeamID, Valid: true}
+
+	err := queries.SetEliminationBracketNodeWinner(ctx, models.SetEliminationBracketNodeWinnerParams{
+		Nodeid:       nodeID,
+		Winnerteamid: winner,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record elimination bracket winner: %v", err)
+	}
+
+	if !parentNodeID.Valid {
+		return nil
+	}
+	if parentSlot.Int32 == 1 {
+		err = queries.SetEliminationBracketNodeTeam1(ctx, models.SetEliminationBracketNodeTeam1Params{
+			Nodeid:    parentNodeID.Int32,
+			Team1id:   winner,
+			Team1seed: seed,
+		})
+	} else {
+		err = queries.SetEliminationBracketNodeTeam2(ctx, models.SetEliminationBracketNodeTeam2Params{
+			Nodeid:    parentNodeID.Int32,
+			Team2id:   winner,
+			Team2seed: seed,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to advance elimination bracket winner: %v", err)
+	}
+
+	return nil
+}
+
+func (s *PairingService) saveDebatesToDatabase(ctx context.Context, queries *models.Queries, debates []*pairing_algorithm.Debate, tournamentID int32, roundNumber int32, isElimination bool, roundID int32) ([]*debate_management.Pairing, error) {
+	startTime, endTime, err := roundTimes(ctx, queries, tournamentID, roundNumber, isElimination)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPairings := make([]*debate_management.Pairing, 0, len(debates))
+	for _, debate := range debates {
+		if team := debate.ByeTeam(); team != nil {
+			if err := createBye(ctx, queries, tournamentID, roundID, roundNumber, team); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		dbDebate, err := queries.CreateDebate(ctx, models.CreateDebateParams{
+			Roundid:            roundID,
+			Tournamentid:       tournamentID,
+			Roundnumber:        roundNumber,
+			Iseliminationround: isElimination,
+			Team1id:            int32(debate.Team1.ID),
+			Team2id:            int32(debate.Team2.ID),
+			Roomid:             int32(debate.Room),
+			Starttime:          startTime,
+			Endtime:            sql.NullTime{Time: endTime, Valid: true},
+			Team3id:            nullTeamID(debate.Team3),
+			Team4id:            nullTeamID(debate.Team4),
+			Panelquality:       panelQualityToNumeric(debate.PanelQuality),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create debate: %v", err)
+		}
 
 		// Assign judges
 		var headJudgeID int32
-		for i, judge := range debate.Judges {
-			isHeadJudge := (i == 0) || (len(debate.Judges) == 1)
+		for _, judge := range debate.Judges {
+			isHeadJudge := judge.IsHeadJudge
 			err := queries.AssignJudgeToDebate(ctx, models.AssignJudgeToDebateParams{
 				Tournamentid:  tournamentID,
 				Judgeid:       int32(judge.ID),
@@ -707,49 +1608,376 @@ func (s *PairingService) saveDebatesToDatabase(ctx context.Context, queries *mod
 				TeamId: int32(debate.Team2.ID),
 				Name:   debate.Team2.Name,
 			},
-			Judges: convertJudgesToProto(debate.Judges),
+			Team3:        convertAlgorithmTeamToProto(debate.Team3),
+			Team4:        convertAlgorithmTeamToProto(debate.Team4),
+			Judges:       convertJudgesToProto(debate.Judges),
+			PanelQuality: debate.PanelQuality,
+			StartTime:    startTime.Format(debateTimeLayout),
+			EndTime:      endTime.Format(debateTimeLayout),
+		})
+
+		// Record pairing history, one entry per pair of teams in the room
+		teams := debate.Teams()
+		for i := 0; i < len(teams); i++ {
+			for j := i + 1; j < len(teams); j++ {
+				err = queries.CreatePairingHistory(ctx, models.CreatePairingHistoryParams{
+					Tournamentid:  tournamentID,
+					Team1id:       int32(teams[i].ID),
+					Team2id:       int32(teams[j].ID),
+					Roundnumber:   roundNumber,
+					Iselimination: isElimination,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to record pairing history: %v", err)
+				}
+			}
+		}
+	}
+
+	return dbPairings, nil
+}
+
+// createBye records that the team has no debate in the round.
+func createBye(ctx context.Context, queries *models.Queries, tournamentID int32, roundID int32, roundNumber int32, team *pairing_algorithm.Team) error {
+	err := queries.CreateBye(ctx, models.CreateByeParams{
+		Tournamentid: tournamentID,
+		Roundid:      roundID,
+		Roundnumber:  roundNumber,
+		Teamid:       int32(team.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record bye of team %s: %v", team.Name, err)
+	}
+	return nil
+}
+
+// roundTimes returns when a round starts and ends in the tournament's
+// timetable. The timetable is planned and saved first if the round is not in
+// it yet.
+func roundTimes(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) (time.Time, time.Time, error) {
+	params := models.GetRoundScheduleParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: isElimination,
+	}
+	slot, err := queries.GetRoundSchedule(ctx, params)
+	if err == sql.ErrNoRows {
+		if err := tournamentservices.ScheduleTournament(ctx, queries, tournamentID); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		slot, err = queries.GetRoundSchedule(ctx, params)
+	}
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to get the schedule of round %d: %v", roundNumber, err)
+	}
+	return slot.Starttime, slot.Endtime, nil
+}
+
+// loadRoundJudges returns the judges who can sit a single round in the format
+// expected by the pairing algorithm.
+func loadRoundJudges(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) ([]*pairing_algorithm.Judge, error) {
+	judges, err := loadAlgorithmJudges(ctx, queries, tournamentID, []int32{roundNumber}, isElimination)
+	if err != nil {
+		return nil, err
+	}
+	return pairing_algorithm.AvailableJudges(judges, int(roundNumber)), nil
+}
+
+// loadAlgorithmJudges returns the tournament's judges in the format expected
+// by the pairing algorithm, each with the given rounds they cannot sit. A
+// judge cannot sit a round they declared themselves unavailable for, and,
+// once anyone has checked in for a round, a round they have not checked in
+// for.
+func loadAlgorithmJudges(ctx context.Context, queries *models.Queries, tournamentID int32, rounds []int32, isElimination bool) ([]*pairing_algorithm.Judge, error) {
+	judges, err := queries.GetAvailableJudges(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available judges: %v", err)
+	}
+
+	availability, err := queries.GetJudgeAvailabilityByTournament(ctx, models.GetJudgeAvailabilityByTournamentParams{
+		Tournamentid:       tournamentID,
+		Iseliminationround: isElimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge availability: %v", err)
+	}
+	type judgeRound struct {
+		judgeID     int32
+		roundNumber int32
+	}
+	declared := make(map[judgeRound]models.Judgeavailability, len(availability))
+	checkInRequired := make(map[int32]bool)
+	for _, entry := range availability {
+		declared[judgeRound{entry.Judgeid, entry.Roundnumber}] = entry
+		if entry.Checkedinat.Valid {
+			checkInRequired[entry.Roundnumber] = true
+		}
+	}
+
+	algorithmJudges := make([]*pairing_algorithm.Judge, len(judges))
+	judgesByID := make(map[int32]*pairing_algorithm.Judge, len(judges))
+	for i, judge := range judges {
+		algorithmJudges[i] = &pairing_algorithm.Judge{
+			ID:   int(judge.Userid),
+			Name: judge.Name,
+			Conflicts: pairing_algorithm.JudgeConflicts{
+				SchoolIDs:           make(map[int]bool),
+				TeamIDs:             make(map[int]bool),
+				StudentIDs:          make(map[int]bool),
+				MaxTimesJudgingTeam: int(judge.Maxtimesjudgingteam.Int32),
+			},
+			JudgedTeams:       make(map[int]int),
+			UnavailableRounds: make(map[int]bool),
+		}
+		judgesByID[judge.Userid] = algorithmJudges[i]
+
+		for _, roundNumber := range rounds {
+			entry, ok := declared[judgeRound{judge.Userid, roundNumber}]
+			available := !ok || entry.Isavailable
+			if checkInRequired[roundNumber] {
+				available = ok && entry.Checkedinat.Valid
+			}
+			if !available {
+				algorithmJudges[i].UnavailableRounds[int(roundNumber)] = true
+			}
+		}
+	}
+
+	conflicts, err := queries.GetJudgeConflictsByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge conflicts: %v", err)
+	}
+	for _, conflict := range conflicts {
+		judge, ok := judgesByID[conflict.Judgeid]
+		if !ok {
+			continue
+		}
+		switch conflict.Conflicttype {
+		case "school":
+			judge.Conflicts.SchoolIDs[int(conflict.Schoolid.Int32)] = true
+		case "team":
+			judge.Conflicts.TeamIDs[int(conflict.Teamid.Int32)] = true
+		case "student":
+			judge.Conflicts.StudentIDs[int(conflict.Studentid.Int32)] = true
+		}
+	}
+
+	strengths, err := queries.GetJudgeStrengths(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge strengths: %v", err)
+	}
+	for _, strength := range strengths {
+		if judge, ok := judgesByID[strength.Judgeid]; ok {
+			judge.Score = pairing_algorithm.JudgeScore(strength.Averagerating, int(strength.Ratingcount), int(strength.Eliminationdebates))
+		}
+	}
+
+	judgedTeams, err := queries.GetJudgedTeamCounts(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judged team counts: %v", err)
+	}
+	for _, judged := range judgedTeams {
+		if judge, ok := judgesByID[judged.Judgeid]; ok {
+			judge.JudgedTeams[int(judged.Teamid)] = int(judged.Timesjudged)
+		}
+	}
+
+	return algorithmJudges, nil
+}
+
+// loadTeamAffiliations fills in the students and schools of each team so the
+// judge allocator can check them against judge conflicts, and whether the team
+// needs an accessible room.
+func loadTeamAffiliations(ctx context.Context, queries *models.Queries, tournamentID int32, teams []*pairing_algorithm.Team) error {
+	affiliations, err := queries.GetTeamAffiliations(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to get team affiliations: %v", err)
+	}
+
+	teamsByID := make(map[int]*pairing_algorithm.Team, len(teams))
+	for _, team := range teams {
+		teamsByID[team.ID] = team
+	}
+
+	for _, affiliation := range affiliations {
+		team, ok := teamsByID[int(affiliation.Teamid)]
+		if !ok {
+			continue
+		}
+		team.SpeakerIDs = append(team.SpeakerIDs, int(affiliation.Studentid))
+		team.NeedsAccessibleRoom = affiliation.Needsaccessibleroom
+		schoolID := int(affiliation.Schoolid)
+		if !slices.Contains(team.SchoolIDs, schoolID) {
+			team.SchoolIDs = append(team.SchoolIDs, schoolID)
+		}
+	}
+	return nil
+}
+
+// ensureTournamentRooms returns the tournament's rooms available for all the
+// given rounds: those without availability windows, and those with a window
+// covering each round's timetabled slot. A tournament without buildings gets
+// placeholder rooms until at least roomsNeeded are available, while one with
+// its venue set up must have enough rooms of its own.
+func ensureTournamentRooms(ctx context.Context, queries *models.Queries, tournamentID int32, rounds []int32, isElimination bool, roomsNeeded int, judgesPerDebate int32) ([]*pairing_algorithm.Room, error) {
+	var rooms []models.Room
+	for i, roundNumber := range rounds {
+		startTime, endTime, err := roundTimes(ctx, queries, tournamentID, roundNumber, isElimination)
+		if err != nil {
+			return nil, err
+		}
+		available, err := queries.GetAvailableRooms(ctx, models.GetAvailableRoomsParams{
+			Tournamentid: sql.NullInt32{Int32: tournamentID, Valid: true},
+			Starttime:    startTime,
+			Endtime:      endTime,
 		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get available rooms: %v", err)
+		}
+		if i == 0 {
+			rooms = available
+			continue
+		}
+		// Every round of a schedule is drawn with the same rooms
+		availableIDs := make(map[int32]bool, len(available))
+		for _, room := range available {
+			availableIDs[room.Roomid] = true
+		}
+		kept := rooms[:0]
+		for _, room := range rooms {
+			if availableIDs[room.Roomid] {
+				kept = append(kept, room)
+			}
+		}
+		rooms = kept
+	}
+
+	if len(rooms) < roomsNeeded {
+		buildings, err := queries.CountBuildingsByTournament(ctx, tournamentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count buildings: %v", err)
+		}
+		if buildings > 0 {
+			return nil, fmt.Errorf("not enough rooms available for %s: have %d, need %d", roundsName(rounds, isElimination), len(rooms), roomsNeeded)
+		}
+	}
 
-		// Record pairing history
-		err = queries.CreatePairingHistory(ctx, models.CreatePairingHistoryParams{
-			Tournamentid:  tournamentID,
-			Team1id:       int32(debate.Team1.ID),
-			Team2id:       int32(debate.Team2.ID),
-			Roundnumber:   roundNumber,
-			Iselimination: isElimination,
+	for len(rooms) < roomsNeeded {
+		room, err := queries.CreateRoom(ctx, models.CreateRoomParams{
+			Roomname:     fmt.Sprintf("Room %d", len(rooms)+1),
+			Location:     "TBD",
+			Capacity:     judgesPerDebate + 12,
+			Tournamentid: sql.NullInt32{Int32: tournamentID, Valid: true},
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to record pairing history: %v", err)
+			return nil, fmt.Errorf("failed to create room: %v", err)
 		}
+		rooms = append(rooms, room)
 	}
 
-	return dbPairings, nil
+	algorithmRooms := make([]*pairing_algorithm.Room, len(rooms))
+	for i, room := range rooms {
+		algorithmRooms[i] = convertAlgorithmRoom(room)
+	}
+	return algorithmRooms, nil
+}
+
+// roundsName names the rounds a draw is for in errors.
+func roundsName(rounds []int32, isElimination bool) string {
+	kind := "preliminary"
+	if isElimination {
+		kind = "elimination"
+	}
+	if len(rounds) == 1 {
+		return fmt.Sprintf("%s round %d", kind, rounds[0])
+	}
+	return fmt.Sprintf("%s rounds %d to %d", kind, rounds[0], rounds[len(rounds)-1])
 }
 
-func convertTopPerformingTeamsToTeamsByTournament(topTeams []models.GetTopPerformingTeamsRow) []models.GetTeamsByTournamentRow {
-	result := make([]models.GetTeamsByTournamentRow, len(topTeams))
-	for i, team := range topTeams {
+func convertAlgorithmRoom(room models.Room) *pairing_algorithm.Room {
+	return &pairing_algorithm.Room{
+		ID:         int(room.Roomid),
+		Capacity:   int(room.Capacity),
+		Accessible: room.Isaccessible,
+	}
+}
+
+func convertAdvancingTeamsToTeamsByTournament(advancingTeams []models.GetBritishParliamentaryAdvancingTeamsRow) []models.GetTeamsByTournamentRow {
+	result := make([]models.GetTeamsByTournamentRow, len(advancingTeams))
+	for i, team := range advancingTeams {
 		result[i] = models.GetTeamsByTournamentRow{
-			Teamid:       team.Teamid,
-			Name:         team.Name,
+			Teamid:       team.Teamid.Int32,
+			Name:         team.Teamname,
 			Tournamentid: team.Tournamentid,
 		}
 	}
 	return result
 }
 
-func convertEliminationRoundTeamsToTeamsByTournament(elimTeams []models.GetEliminationRoundTeamsRow) []models.GetTeamsByTournamentRow {
-	result := make([]models.GetTeamsByTournamentRow, len(elimTeams))
-	for i, team := range elimTeams {
-		result[i] = models.GetTeamsByTournamentRow{
-			Teamid:       int32(team.Teamid.(int64)), // Convert int64 to int32
-			Name:         team.Teamname.(string),
-			Tournamentid: team.Tournamentid,
-		}
+// nullTeamID returns the team's ID for the optional Team3ID/Team4ID columns.
+func nullTeamID(team *pairing_algorithm.Team) sql.NullInt32 {
+	if team == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(team.ID), Valid: true}
+}
+
+func convertAlgorithmTeamToProto(team *pairing_algorithm.Team) *debate_management.Team {
+	if team == nil {
+		return nil
+	}
+	return &debate_management.Team{
+		TeamId: int32(team.ID),
+		Name:   team.Name,
+	}
+}
+
+// convertOptionalTeamToProto converts the nullable Team3/Team4 columns of a
+// British Parliamentary debate.
+func convertOptionalTeamToProto(teamID sql.NullInt32, name sql.NullString) *debate_management.Team {
+	if !teamID.Valid {
+		return nil
+	}
+	return &debate_management.Team{
+		TeamId: teamID.Int32,
+		Name:   name.String,
 	}
+}
+
+func panelQualityToNumeric(quality float64) sql.NullString {
+	return sql.NullString{String: strconv.FormatFloat(quality, 'f', 2, 64), Valid: true}
+}
+
+func numericToFloat(value sql.NullString) float64 {
+	result, _ := strconv.ParseFloat(value.String, 64)
 	return result
 }
 
+// debateTimeLayout is how debate times are shown, as the tournament dates are.
+const debateTimeLayout = "2006-01-02 15:04"
+
+func formatDebateTime(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(debateTimeLayout)
+}
+
+func convertSideCountsToProto(sideCounts []models.GetTeamSideCountsRow) []*debate_management.TeamSideCount {
+	protoCounts := make([]*debate_management.TeamSideCount, len(sideCounts))
+	for i, count := range sideCounts {
+		protoCounts[i] = &debate_management.TeamSideCount{
+			TeamId:                 count.Teamid,
+			TeamName:               count.Name,
+			PropositionCount:       count.Propositioncount,
+			OppositionCount:        count.Oppositioncount,
+			ClosingGovernmentCount: count.Closinggovernmentcount,
+			ClosingOppositionCount: count.Closingoppositioncount,
+		}
+	}
+	return protoCounts
+}
+
 func convertJudgesToProto(judges []*pairing_algorithm.Judge) []*debate_management.Judge {
 	protoJudges := make([]*debate_management.Judge, len(judges))
 	for i, judge := range judges {
@@ -769,6 +1997,17 @@ func (s *PairingService) validateAuthentication(token string) error {
 	return nil
 }
 
+// isAdmin validates the token and reports whether it belongs to an admin.
+func (s *PairingService) isAdmin(token string) (bool, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return false, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, _ := claims["user_role"].(string)
+	return userRole == "admin", nil
+}
+
 func (s *PairingService) validateAdminRole(token string) (map[string]interface{}, error) {
 	claims, err := utils.ValidateToken(token)
 	if err != nil {
@@ -0,0 +1,354 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// ValidatePairings checks the draft draw of a round for rematches, side
+// imbalance, judge conflicts and rooms, judges or teams booked twice.
+func (s *PairingService) ValidatePairings(ctx context.Context, req *debate_management.ValidatePairingsRequest) (*debate_management.ValidatePairingsResponse, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := validateDraftDraw(ctx, models.New(s.db), req.GetTournamentId(), req.GetRoundNumber(), req.GetIsElimination())
+	if err != nil {
+		return nil, err
+	}
+
+	return &debate_management.ValidatePairingsResponse{Issues: issues}, nil
+}
+
+// GetPairingsDiff compares the draft draw of a round with the draw as last
+// released.
+func (s *PairingService) GetPairingsDiff(ctx context.Context, req *debate_management.GetPairingsDiffRequest) (*debate_management.GetPairingsDiffResponse, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	params := models.GetPairingsParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsElimination(),
+	}
+
+	isReleased, err := isRoundReleased(ctx, queries, params)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := queries.GetPairings(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pairings: %v", err)
+	}
+
+	released, err := getReleasedPairings(ctx, queries, params)
+	if err != nil {
+		return nil, err
+	}
+
+	releasedByID := make(map[int32]models.GetPairingsRow, len(released))
+	for _, pairing := range released {
+		releasedByID[pairing.Debateid] = pairing
+	}
+
+	response := &debate_management.GetPairingsDiffResponse{IsReleased: isReleased}
+	for _, pairing := range draft {
+		releasedPairing, ok := releasedByID[pairing.Debateid]
+		if !ok {
+			response.Changes = append(response.Changes, &debate_management.PairingChange{
+				PairingId: pairing.Debateid,
+				Change:    "added",
+				Draft:     convertPairing(pairing),
+			})
+			continue
+		}
+		delete(releasedByID, pairing.Debateid)
+
+		if changedFields := pairingChangedFields(releasedPairing, pairing); len(changedFields) > 0 {
+			response.Changes = append(response.Changes, &debate_management.PairingChange{
+				PairingId:     pairing.Debateid,
+				Change:        "changed",
+				ChangedFields: changedFields,
+				Released:      convertPairing(releasedPairing),
+				Draft:         convertPairing(pairing),
+			})
+		}
+	}
+
+	// Whatever is left was released but is no longer in the draft
+	for _, pairing := range released {
+		if _, ok := releasedByID[pairing.Debateid]; ok {
+			response.Changes = append(response.Changes, &debate_management.PairingChange{
+				PairingId: pairing.Debateid,
+				Change:    "removed",
+				Released:  convertPairing(pairing),
+			})
+		}
+	}
+
+	return response, nil
+}
+
+// ReleasePairings publishes the draft draw of a round to students and judges.
+// A draw with validation issues is only released when ignore_issues is set.
+func (s *PairingService) ReleasePairings(ctx context.Context, req *debate_management.ReleasePairingsRequest) (*debate_management.ReleasePairingsResponse, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	round, err := queries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsElimination(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("round %d has not been paired yet", req.GetRoundNumber())
+		}
+		return nil, fmt.Errorf("failed to get round: %v", err)
+	}
+
+	issues, err := validateDraftDraw(ctx, queries, req.GetTournamentId(), req.GetRoundNumber(), req.GetIsElimination())
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) > 0 && !req.GetIgnoreIssues() {
+		return &debate_management.ReleasePairingsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Draw not released: %d issues found", len(issues)),
+			Issues:  issues,
+		}, nil
+	}
+
+	if err := queries.DeleteReleasedDraw(ctx, round.Roundid); err != nil {
+		return nil, fmt.Errorf("failed to delete released draw: %v", err)
+	}
+	if err := queries.ReleaseRoundDraw(ctx, round.Roundid); err != nil {
+		return nil, fmt.Errorf("failed to release draw: %v", err)
+	}
+	if err := queries.SetRoundReleased(ctx, round.Roundid); err != nil {
+		return nil, fmt.Errorf("failed to mark round as released: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return &debate_management.ReleasePairingsResponse{
+		Success: true,
+		Message: fmt.Sprintf("Round %d released", req.GetRoundNumber()),
+		Issues:  issues,
+	}, nil
+}
+
+// isRoundReleased reports whether the round's draw has ever been released.
+func isRoundReleased(ctx context.Context, queries *models.Queries, params models.GetPairingsParams) (bool, error) {
+	round, err := queries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams(params))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get round: %v", err)
+	}
+	return round.Releasedat.Valid, nil
+}
+
+// getReleasedPairings returns the round's draw as last released, in the same
+// shape as the draft returned by GetPairings.
+func getReleasedPairings(ctx context.Context, queries *models.Queries, params models.GetPairingsParams) ([]models.GetPairingsRow, error) {
+	released, err := queries.GetReleasedPairings(ctx, models.GetReleasedPairingsParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get released pairings: %v", err)
+	}
+
+	pairings := make([]models.GetPairingsRow, len(released))
+	for i, pairing := range released {
+		pairings[i] = models.GetPairingsRow(pairing)
+	}
+	return pairings, nil
+}
+
+// pairingChangedFields lists what differs between the released and the draft
+// version of a debate.
+func pairingChangedFields(released, draft models.GetPairingsRow) []string {
+	var fields []string
+	if released.Roomid != draft.Roomid {
+		fields = append(fields, "room")
+	}
+	if released.Team1id != draft.Team1id {
+		fields = append(fields, "team1")
+	}
+	if released.Team2id != draft.Team2id {
+		fields = append(fields, "team2")
+	}
+	if released.Team3id != draft.Team3id {
+		fields = append(fields, "team3")
+	}
+	if released.Team4id != draft.Team4id {
+		fields = append(fields, "team4")
+	}
+	if released.Headjudgename != draft.Headjudgename {
+		fields = append(fields, "head_judge")
+	}
+	if !slices.Equal(released.Judgeids, draft.Judgeids) {
+		fields = append(fields, "judges")
+	}
+	return fields
+}
+
+// validateDraftDraw runs the draw checks of the pairing algorithm on the draft
+// draw of a round. Opponents, side counts and judged teams come from the other
+// rounds, as the draft may have been edited since it was generated.
+func validateDraftDraw(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) ([]*debate_management.PairingIssue, error) {
+	pairings, err := queries.GetPairings(ctx, models.GetPairingsParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: isElimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pairings: %v", err)
+	}
+	if len(pairings) == 0 {
+		return nil, fmt.Errorf("round %d has not been paired yet", roundNumber)
+	}
+
+	teamsByID := make(map[int32]*pairing_algorithm.Team)
+	var teams []*pairing_algorithm.Team
+	team := func(teamID int32, name string) *pairing_algorithm.Team {
+		if existing, ok := teamsByID[teamID]; ok {
+			return existing
+		}
+		algorithmTeam := &pairing_algorithm.Team{ID: int(teamID), Name: name, Opponents: make(map[int]bool)}
+		teamsByID[teamID] = algorithmTeam
+		teams = append(teams, algorithmTeam)
+		return algorithmTeam
+	}
+
+	debates := make([]*pairing_algorithm.Debate, len(pairings))
+	debatesByID := make(map[int32]*pairing_algorithm.Debate, len(pairings))
+	for i, pairing := range pairings {
+		debates[i] = &pairing_algorithm.Debate{
+			Team1: team(pairing.Team1id, pairing.Team1name),
+			Team2: team(pairing.Team2id, pairing.Team2name),
+			Room:  int(pairing.Roomid),
+		}
+		if pairing.Team3id.Valid && pairing.Team4id.Valid {
+			debates[i].Team3 = team(pairing.Team3id.Int32, pairing.Team3name.String)
+			debates[i].Team4 = team(pairing.Team4id.Int32, pairing.Team4name.String)
+		}
+		debatesByID[pairing.Debateid] = debates[i]
+	}
+
+	// Rematches and sides only matter across preliminary rounds
+	if !isElimination {
+		otherDebates, err := queries.GetOtherPreliminaryDebates(ctx, models.GetOtherPreliminaryDebatesParams{
+			Tournamentid: tournamentID,
+			Roundnumber:  roundNumber,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get preliminary debates: %v", err)
+		}
+		for _, debate := range otherDebates {
+			teamIDs := []int32{debate.Team1id, debate.Team2id}
+			if debate.Team3id.Valid && debate.Team4id.Valid {
+				teamIDs = append(teamIDs, debate.Team3id.Int32, debate.Team4id.Int32)
+			} else {
+				if team1, ok := teamsByID[debate.Team1id]; ok {
+					team1.PropositionCount++
+				}
+				if team2, ok := teamsByID[debate.Team2id]; ok {
+					team2.OppositionCount++
+				}
+			}
+			for _, teamID := range teamIDs {
+				if algorithmTeam, ok := teamsByID[teamID]; ok {
+					for _, opponentID := range teamIDs {
+						if opponentID != teamID {
+							algorithmTeam.Opponents[int(opponentID)] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if err := loadTeamAffiliations(ctx, queries, tournamentID, teams); err != nil {
+		return nil, err
+	}
+
+	algorithmJudges, err := loadAlgorithmJudges(ctx, queries, tournamentID, []int32{roundNumber}, isElimination)
+	if err != nil {
+		return nil, err
+	}
+	judgesByID := make(map[int32]*pairing_algorithm.Judge, len(algorithmJudges))
+	for _, judge := range algorithmJudges {
+		judgesByID[int32(judge.ID)] = judge
+	}
+
+	assignments, err := queries.GetJudgesByTournamentAndRound(ctx, models.GetJudgesByTournamentAndRoundParams{
+		Tournamentid:  tournamentID,
+		Roundnumber:   roundNumber,
+		Iselimination: isElimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge assignments: %v", err)
+	}
+	for _, assignment := range assignments {
+		debate, ok := debatesByID[assignment.Debateid]
+		if !ok {
+			continue
+		}
+		// Judges no longer available for the tournament have no conflicts on record
+		judge, ok := judgesByID[assignment.Userid]
+		if !ok {
+			judge = &pairing_algorithm.Judge{ID: int(assignment.Userid), Name: assignment.Name, JudgedTeams: make(map[int]int)}
+			judgesByID[assignment.Userid] = judge
+		}
+		// Judged team counts include this round, which is being checked
+		for _, algorithmTeam := range debate.Teams() {
+			judge.JudgedTeams[algorithmTeam.ID]--
+		}
+		debate.Judges = append(debate.Judges, judge)
+	}
+
+	dbRooms, err := queries.GetRoomsByTournament(ctx, sql.NullInt32{Int32: tournamentID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rooms: %v", err)
+	}
+	rooms := make([]*pairing_algorithm.Room, len(dbRooms))
+	for i, room := range dbRooms {
+		rooms[i] = convertAlgorithmRoom(room)
+	}
+
+	drawIssues := append(pairing_algorithm.ValidateDraw(debates), pairing_algorithm.ValidateRooms(debates, rooms)...)
+	drawIssues = append(drawIssues, pairing_algorithm.ValidateJudgeAvailability(debates, int(roundNumber))...)
+	issues := make([]*debate_management.PairingIssue, len(drawIssues))
+	for i, issue := range drawIssues {
+		issues[i] = &debate_management.PairingIssue{
+			Type:      issue.Type,
+			PairingId: pairings[issue.Debate].Debateid,
+			Message:   issue.Message,
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// ReplayPairings draws a round again from the seed and input saved when it was
+// generated, and compares the result with the round's draft draw. Nothing is
+// saved. Differences come from edits made after the round was generated.
+func (s *PairingService) ReplayPairings(ctx context.Context, req *debate_management.ReplayPairingsRequest) (*debate_management.ReplayPairingsResponse, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+
+	round, err := queries.GetRoundByTournamentAndNumber(ctx, models.GetRoundByTournamentAndNumberParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsElimination(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("round %d has not been paired yet", req.GetRoundNumber())
+		}
+		return nil, fmt.Errorf("failed to get round: %v", err)
+	}
+	if !round.Drawinput.Valid {
+		return nil, fmt.Errorf("round %d was drawn before draw seeds were saved and cannot be replayed", req.GetRoundNumber())
+	}
+
+	var input pairing_algorithm.DrawInput
+	if err := json.Unmarshal(round.Drawinput.RawMessage, &input); err != nil {
+		return nil, fmt.Errorf("failed to load draw input: %v", err)
+	}
+	debates, err := input.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate pairings: %v", err)
+	}
+
+	// A whole preliminary schedule is drawn at once: keep this round's debates
+	if input.RoundNumber == 0 && !input.IsElimination && input.Specs.PreliminaryRounds > 0 {
+		perRound := len(debates) / input.Specs.PreliminaryRounds
+		first := int(req.GetRoundNumber()-1) * perRound
+		if first+perRound > len(debates) {
+			return nil, fmt.Errorf("round %d is not part of the saved draw", req.GetRoundNumber())
+		}
+		debates = debates[first : first+perRound]
+	}
+
+	// Byes are not saved as debates
+	debates, _ = pairing_algorithm.SplitByes(debates)
+
+	saved, err := loadSavedPairings(ctx, queries, req.GetTournamentId(), req.GetRoundNumber(), req.GetIsElimination())
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := queries.GetRoomsByTournament(ctx, sql.NullInt32{Int32: req.GetTournamentId(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rooms: %v", err)
+	}
+	roomNames := make(map[int32]string, len(rooms))
+	for _, room := range rooms {
+		roomNames[room.Roomid] = room.Roomname
+	}
+
+	response := &debate_management.ReplayPairingsResponse{
+		Seed:  round.Drawseed.Int64,
+		Saved: saved,
+	}
+	for _, debate := range debates {
+		response.Regenerated = append(response.Regenerated, convertDrawnDebate(debate, req.GetRoundNumber(), req.GetIsElimination(), roomNames))
+	}
+
+	// Debates are saved in the order they are drawn
+	for i := 0; i < max(len(response.Saved), len(response.Regenerated)); i++ {
+		difference := &debate_management.PairingDifference{Position: int32(i + 1)}
+		switch {
+		case i >= len(response.Regenerated):
+			difference.PairingId = response.Saved[i].GetPairingId()
+			difference.ChangedFields = []string{"debate"}
+		case i >= len(response.Saved):
+			difference.ChangedFields = []string{"debate"}
+		default:
+			difference.PairingId = response.Saved[i].GetPairingId()
+			difference.ChangedFields = replayChangedFields(response.Saved[i], response.Regenerated[i])
+		}
+		if len(difference.ChangedFields) > 0 {
+			response.Differences = append(response.Differences, difference)
+		}
+	}
+	response.Matches = len(response.Differences) == 0
+
+	return response, nil
+}
+
+// loadSavedPairings returns the draft draw of a round with the judges of each
+// debate, head judge first.
+func loadSavedPairings(ctx context.Context, queries *models.Queries, tournamentID int32, roundNumber int32, isElimination bool) ([]*debate_management.Pairing, error) {
+	dbPairings, err := queries.GetPairings(ctx, models.GetPairingsParams{
+		Tournamentid:       tournamentID,
+		Roundnumber:        roundNumber,
+		Iseliminationround: isElimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pairings: %v", err)
+	}
+
+	assignments, err := queries.GetJudgesByTournamentAndRound(ctx, models.GetJudgesByTournamentAndRoundParams{
+		Tournamentid:  tournamentID,
+		Roundnumber:   roundNumber,
+		Iselimination: isElimination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get judge assignments: %v", err)
+	}
+
+	pairings := make([]*debate_management.Pairing, len(dbPairings))
+	pairingsByID := make(map[int32]*debate_management.Pairing, len(dbPairings))
+	for i, dbPairing := range dbPairings {
+		pairings[i] = convertPairing(dbPairing)
+		pairingsByID[dbPairing.Debateid] = pairings[i]
+	}
+	for _, assignment := range assignments {
+		pairing, ok := pairingsByID[assignment.Debateid]
+		if !ok {
+			continue
+		}
+		judge := &debate_management.Judge{JudgeId: assignment.Userid, Name: assignment.Name}
+		if assignment.Isheadjudge {
+			pairing.Judges = append([]*debate_management.Judge{judge}, pairing.Judges...)
+		} else {
+			pairing.Judges = append(pairing.Judges, judge)
+		}
+	}
+	return pairings, nil
+}
+
+// convertDrawnDebate converts a debate fresh from the pairing algorithm. The
+// first judge of the panel chairs it, as when the debate is saved.
+func convertDrawnDebate(debate *pairing_algorithm.Debate, roundNumber int32, isElimination bool, roomNames map[int32]string) *debate_management.Pairing {
+	pairing := &debate_management.Pairing{
+		RoundNumber:        roundNumber,
+		IsEliminationRound: isElimination,
+		RoomId:             int32(debate.Room),
+		RoomName:           roomNames[int32(debate.Room)],
+		Team1:              convertAlgorithmTeamToProto(debate.Team1),
+		Team2:              convertAlgorithmTeamToProto(debate.Team2),
+		Team3:              convertAlgorithmTeamToProto(debate.Team3),
+		Team4:              convertAlgorithmTeamToProto(debate.Team4),
+		Judges:             convertJudgesToProto(debate.Judges),
+		PanelQuality:       debate.PanelQuality,
+	}
+	if len(debate.Judges) > 0 {
+		pairing.HeadJudgeName = debate.Judges[0].Name
+	}
+	return pairing
+}
+
+// replayChangedFields lists what differs between a saved and a regenerated
+// debate, with the same field names as GetPairingsDiff.
+func replayChangedFields(saved, regenerated *debate_management.Pairing) []string {
+	var fields []string
+	if saved.GetRoomId() != regenerated.GetRoomId() {
+		fields = append(fields, "room")
+	}
+	if saved.GetTeam1().GetTeamId() != regenerated.GetTeam1().GetTeamId() {
+		fields = append(fields, "team1")
+	}
+	if saved.GetTeam2().GetTeamId() != regenerated.GetTeam2().GetTeamId() {
+		fields = append(fields, "team2")
+	}
+	if saved.GetTeam3().GetTeamId() != regenerated.GetTeam3().GetTeamId() {
+		fields = append(fields, "team3")
+	}
+	if saved.GetTeam4().GetTeamId() != regenerated.GetTeam4().GetTeamId() {
+		fields = append(fields, "team4")
+	}
+
+	if saved.GetHeadJudgeName() != regenerated.GetHeadJudgeName() {
+		fields = append(fields, "head_judge")
+	}
+
+	savedJudges, regeneratedJudges := judgeIDs(saved.GetJudges()), judgeIDs(regenerated.GetJudges())
+	slices.Sort(savedJudges)
+	slices.Sort(regeneratedJudges)
+	if !slices.Equal(savedJudges, regeneratedJudges) {
+		fields = append(fields, "judges")
+	}
+	return fields
+}
+
+func judgeIDs(judges []*debate_management.Judge) []int32 {
+	ids := make([]int32, len(judges))
+	for i, judge := range judges {
+		ids[i] = judge.GetJudgeId()
+	}
+	return ids
+}
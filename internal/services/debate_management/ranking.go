@@ -15,6 +15,7 @@ import (
 	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
 	"github.com/iRankHub/backend/internal/models"
 	"github.com/iRankHub/backend/internal/utils"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
 )
 
 type RankingService struct {
@@ -42,37 +43,24 @@ func (s *RankingService) GetTournamentStudentRanking(ctx context.Context, req *d
 	}
 
 	queries := models.New(s.db)
-	params := models.GetTournamentStudentRankingParams{
-		Tournamentid: req.GetTournamentId(),
-		Limit:        int32(req.GetPageSize()),
-		Offset:       int32((req.GetPage() - 1) * req.GetPageSize()),
-	}
-
-	if req.GetSearch() != "" {
-		params.Column4 = req.GetSearch()
-	}
-
-	dbRankings, err := queries.GetTournamentStudentRanking(ctx, params)
+	standings, schools, eligible, err := speakerStandings(ctx, queries, req.GetTournamentId(), req.GetCategoryId())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get tournament student ranking: %v", err)
 	}
+	standings = filterStandings(standings, eligible)
 
-	rankings := make([]*debate_management.StudentRanking, len(dbRankings))
-	for i, dbRanking := range dbRankings {
-		totalPoints, err := strconv.ParseFloat(dbRanking.Totalpoints, 64)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse total points: %v", err)
+	// The top three are listed whatever the search
+	var matching []*pairing_algorithm.Standing
+	for _, standing := range standings {
+		if standing.Place <= 3 || matchesSearch(req.GetSearch(), standing.Competitor.Name, schools[standing.Competitor.ID]) {
+			matching = append(matching, standing)
 		}
+	}
 
-		rankings[i] = &debate_management.StudentRanking{
-			StudentId:   dbRanking.Studentid,
-			StudentName: dbRanking.Studentname.(string),
-			SchoolName:  dbRanking.Schoolname,
-			TotalWins:   int32(dbRanking.Wins),
-			TotalPoints: totalPoints,
-			AverageRank: float64(dbRanking.Averagerank),
-			Place:       int32(dbRanking.Place),
-		}
+	page := paginateStandings(matching, req.GetPage(), req.GetPageSize())
+	rankings := make([]*debate_management.StudentRanking, len(page))
+	for i, standing := range page {
+		rankings[i] = convertSpeakerStanding(standing, schools)
 	}
 
 	return &debate_management.TournamentRankingResponse{
@@ -80,6 +68,27 @@ func (s *RankingService) GetTournamentStudentRanking(ctx context.Context, req *d
 	}, nil
 }
 
+// matchesSearch reports whether any of the values contains the search, ignoring
+// case. An empty search matches everything.
+func matchesSearch(search string, values ...string) bool {
+	search = strings.ToLower(search)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// paginateStandings returns a page of standings, counting pages from 1.
+func paginateStandings(standings []*pairing_algorithm.Standing, page, pageSize int32) []*pairing_algorithm.Standing {
+	offset := int((page - 1) * pageSize)
+	if offset < 0 || pageSize <= 0 || offset >= len(standings) {
+		return nil
+	}
+	return standings[offset:min(offset+int(pageSize), len(standings))]
+}
+
 func (s *RankingService) GetOverallStudentRanking(ctx context.Context, req *debate_management.OverallRankingRequest) (*debate_management.OverallRankingResponse, error) {
 	claims, err := utils.ValidateToken(req.GetToken())
 	if err != nil {
@@ -325,93 +334,84 @@ func (s *RankingService) GetTournamentTeamsRanking(ctx context.Context, req *deb
 	}
 
 	queries := models.New(s.db)
-	params := models.GetTournamentTeamsRankingParams{
-		Tournamentid: req.GetTournamentId(),
-		Limit:        int32(req.GetPageSize()),
-		Offset:       int32((req.GetPage() - 1) * req.GetPageSize()),
-	}
-
-	if req.GetSearch() != "" {
-		params.Column4 = req.GetSearch()
-	}
-
-	dbRankings, err := queries.GetTournamentTeamsRanking(ctx, params)
+	standings, eligible, err := teamStandings(ctx, queries, req.GetTournamentId(), req.GetCategoryId())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tournament teams ranking: %v", err)
 	}
+	standings = filterStandings(standings, eligible)
 
-	totalCount, err := queries.GetTournamentTeamsRankingCount(ctx, req.GetTournamentId())
+	schoolNames, err := teamSchoolNames(ctx, queries, req.GetTournamentId())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get total count: %v", err)
+		return nil, err
 	}
 
-	rankings := make([]*debate_management.TeamRanking, len(dbRankings))
-	for i, dbRanking := range dbRankings {
-		// Proper handling of SchoolNames array
-		var schoolNames []string
-
-		switch v := dbRanking.Schoolnames.(type) {
-		case []string:
-			schoolNames = v
-		case string:
-			// Handle string format, might be "{school1,school2}" or "school1,school2"
-			s := strings.TrimPrefix(v, "{")
-			s = strings.TrimSuffix(s, "}")
-			if s != "" {
-				schoolNames = strings.Split(s, ",")
-			}
-		case []interface{}:
-			// Handle array of interfaces
-			for _, item := range v {
-				if str, ok := item.(string); ok {
-					schoolNames = append(schoolNames, str)
-				}
-			}
-		default:
-			// If we can't determine the type, log it and use empty array
-			fmt.Printf("WARNING: Unknown SchoolNames type: %T for team %d\n",
-				dbRanking.Schoolnames, dbRanking.Teamid)
-			schoolNames = []string{}
-		}
-
-		// Clean up school names to remove any quotes or extra spaces
-		for j, name := range schoolNames {
-			schoolNames[j] = strings.Trim(name, "\" \t")
+	// The top three are listed whatever the search
+	var matching []*pairing_algorithm.Standing
+	for _, standing := range standings {
+		schools := schoolNames[standing.Competitor.ID]
+		if standing.Place <= 3 || matchesSearch(req.GetSearch(), append([]string{standing.Competitor.Name}, schools...)...) {
+			matching = append(matching, standing)
 		}
+	}
 
-		// Proper handling of numeric values
-		totalPoints, err := convertToFloat64(dbRanking.Totalpoints)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse total points for team %d: %v",
-				dbRanking.Teamid, err)
-		}
-
-		averageRank, err := convertToFloat64(dbRanking.Averagerank)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse average rank for team %d: %v",
-				dbRanking.Teamid, err)
-		}
-
-		// Use sequential position (i+1) instead of the place from database
-		sequentialPlace := int32(i + 1)
-
-		rankings[i] = &debate_management.TeamRanking{
-			TeamId:      dbRanking.Teamid,
-			TeamName:    strings.Trim(dbRanking.Teamname, "\" \t"),
-			SchoolNames: schoolNames,
-			Wins:        int32(dbRanking.Wins),
-			TotalPoints: totalPoints,
-			AverageRank: averageRank,
-			Place:       sequentialPlace,
-		}
+	page := paginateStandings(matching, req.GetPage(), req.GetPageSize())
+	rankings := make([]*debate_management.TeamRanking, len(page))
+	for i, standing := range page {
+		rankings[i] = convertTeamStanding(standing, schoolNames)
 	}
 
 	return &debate_management.TournamentTeamsRankingResponse{
 		Rankings:   rankings,
-		TotalCount: int32(totalCount),
+		TotalCount: int32(len(standings)),
 	}, nil
 }
 
+// teamSchoolNames returns the schools of each team of a tournament.
+func teamSchoolNames(ctx context.Context, queries *models.Queries, tournamentID int32) (map[int][]string, error) {
+	rows, err := queries.GetTeamSchoolNames(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team schools: %v", err)
+	}
+	schoolNames := make(map[int][]string)
+	for _, row := range rows {
+		schoolNames[int(row.Teamid)] = append(schoolNames[int(row.Teamid)], row.Schoolname)
+	}
+	return schoolNames, nil
+}
+
+func convertTeamStanding(standing *pairing_algorithm.Standing, schoolNames map[int][]string) *debate_management.TeamRanking {
+	competitor := standing.Competitor
+	schools := schoolNames[competitor.ID]
+	if schools == nil {
+		schools = []string{}
+	}
+	return &debate_management.TeamRanking{
+		TeamId:      int32(competitor.ID),
+		TeamName:    strings.Trim(competitor.Name, "\" \t"),
+		SchoolNames: schools,
+		Wins:        int32(competitor.Wins()),
+		TotalPoints: competitor.TotalPoints(),
+		AverageRank: competitor.AverageRank(),
+		Place:       int32(standing.Place),
+		TeamPoints:  int32(competitor.TeamPoints()),
+		DecidedBy:   string(standing.DecidedBy),
+	}
+}
+
+func convertSpeakerStanding(standing *pairing_algorithm.Standing, schools map[int]string) *debate_management.StudentRanking {
+	competitor := standing.Competitor
+	return &debate_management.StudentRanking{
+		StudentId:   int32(competitor.ID),
+		StudentName: competitor.Name,
+		SchoolName:  schools[competitor.ID],
+		TotalWins:   int32(competitor.Wins()),
+		TotalPoints: competitor.TotalPoints(),
+		AverageRank: competitor.AverageRank(),
+		Place:       int32(standing.Place),
+		DecidedBy:   string(standing.DecidedBy),
+	}
+}
+
 // Helper function to convert interface{} to float64
 func convertToFloat64(value interface{}) (float64, error) {
 	switch v := value.(type) {
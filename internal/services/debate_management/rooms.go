@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
 	"github.com/iRankHub/backend/internal/models"
@@ -29,7 +30,12 @@ func (s *RoomService) GetRooms(ctx context.Context, req *debate_management.GetRo
 		return nil, fmt.Errorf("failed to get rooms: %v", err)
 	}
 
-	roomStatuses, err := s.convertRooms(ctx, req.GetTournamentId(), dbRooms)
+	venue, err := loadVenue(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	roomStatuses, err := s.convertRooms(ctx, req.GetTournamentId(), dbRooms, venue)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert rooms: %v", err)
 	}
@@ -88,7 +94,13 @@ func (s *RoomService) UpdateRoom(ctx context.Context, req *debate_management.Upd
 		return nil, err
 	}
 
-	queries := models.New(s.db)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
 
 	updatedRoom, err := queries.UpdateRoom(ctx, models.UpdateRoomParams{
 		Roomid:   req.GetRoom().GetRoomId(),
@@ -98,7 +110,221 @@ func (s *RoomService) UpdateRoom(ctx context.Context, req *debate_management.Upd
 		return nil, fmt.Errorf("failed to update room: %v", err)
 	}
 
-	return convertRoom(updatedRoom), nil
+	// Replace where the room is and who it can take
+	if req.GetUpdateVenue() {
+		if err := validateRoomVenue(ctx, queries, updatedRoom.Tournamentid.Int32, req.GetRoom()); err != nil {
+			return nil, err
+		}
+		updatedRoom, err = queries.UpdateRoomVenue(ctx, models.UpdateRoomVenueParams{
+			Roomid:       updatedRoom.Roomid,
+			Location:     req.GetRoom().GetLocation(),
+			Capacity:     req.GetRoom().GetCapacity(),
+			Buildingid:   nullBuildingID(req.GetRoom().GetBuildingId()),
+			Isaccessible: req.GetRoom().GetIsAccessible(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update room venue: %v", err)
+		}
+	}
+
+	venue, err := loadVenue(ctx, queries, updatedRoom.Tournamentid.Int32)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return convertRoom(updatedRoom, venue), nil
+}
+
+func (s *RoomService) CreateRoom(ctx context.Context, req *debate_management.CreateRoomRequest) (*debate_management.Room, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRoom().GetRoomName() == "" {
+		return nil, fmt.Errorf("room name is required")
+	}
+
+	queries := models.New(s.db)
+	if err := validateRoomVenue(ctx, queries, req.GetTournamentId(), req.GetRoom()); err != nil {
+		return nil, err
+	}
+
+	room, err := queries.CreateRoom(ctx, models.CreateRoomParams{
+		Roomname:     req.GetRoom().GetRoomName(),
+		Location:     req.GetRoom().GetLocation(),
+		Capacity:     req.GetRoom().GetCapacity(),
+		Tournamentid: sql.NullInt32{Int32: req.GetTournamentId(), Valid: true},
+		Buildingid:   nullBuildingID(req.GetRoom().GetBuildingId()),
+		Isaccessible: req.GetRoom().GetIsAccessible(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create room: %v", err)
+	}
+
+	venue, err := loadVenue(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	return convertRoom(room, venue), nil
+}
+
+func (s *RoomService) DeleteRoom(ctx context.Context, req *debate_management.DeleteRoomRequest) (bool, string, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return false, "", err
+	}
+
+	queries := models.New(s.db)
+	deleted, err := queries.DeleteRoom(ctx, req.GetRoomId())
+	if err != nil {
+		return false, "", fmt.Errorf("failed to delete room: %v", err)
+	}
+	if deleted == 0 {
+		return false, "Room cannot be deleted because it does not exist or debates are held in it", nil
+	}
+
+	return true, "Room deleted successfully", nil
+}
+
+// SetRoomAvailability replaces the windows a room can be drawn in. A room
+// without any is available for every round.
+func (s *RoomService) SetRoomAvailability(ctx context.Context, req *debate_management.SetRoomAvailabilityRequest) (*debate_management.Room, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	room, err := queries.GetRoomByID(ctx, req.GetRoomId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room: %v", err)
+	}
+
+	if err := queries.DeleteRoomAvailability(ctx, room.Roomid); err != nil {
+		return nil, fmt.Errorf("failed to delete room availability: %v", err)
+	}
+	for _, window := range req.GetAvailability() {
+		startTime, err := time.Parse(roomTimeLayout, window.GetStartTime())
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time %q: %v", window.GetStartTime(), err)
+		}
+		endTime, err := time.Parse(roomTimeLayout, window.GetEndTime())
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time %q: %v", window.GetEndTime(), err)
+		}
+		if !startTime.Before(endTime) {
+			return nil, fmt.Errorf("availability window %s to %s must start before it ends", window.GetStartTime(), window.GetEndTime())
+		}
+		err = queries.CreateRoomAvailability(ctx, models.CreateRoomAvailabilityParams{
+			Roomid:    room.Roomid,
+			Starttime: startTime,
+			Endtime:   endTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create room availability: %v", err)
+		}
+	}
+
+	venue, err := loadVenue(ctx, queries, room.Tournamentid.Int32)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return convertRoom(room, venue), nil
+}
+
+func (s *RoomService) CreateBuilding(ctx context.Context, req *debate_management.CreateBuildingRequest) (*debate_management.Building, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBuilding().GetName() == "" {
+		return nil, fmt.Errorf("building name is required")
+	}
+
+	queries := models.New(s.db)
+	building, err := queries.CreateBuilding(ctx, models.CreateBuildingParams{
+		Tournamentid: req.GetBuilding().GetTournamentId(),
+		Name:         req.GetBuilding().GetName(),
+		Address:      req.GetBuilding().GetAddress(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create building: %v", err)
+	}
+
+	return convertBuilding(building), nil
+}
+
+func (s *RoomService) GetBuildings(ctx context.Context, req *debate_management.GetBuildingsRequest) ([]*debate_management.Building, error) {
+	if err := s.validateAuthentication(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	buildings, err := queries.GetBuildingsByTournament(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buildings: %v", err)
+	}
+
+	result := make([]*debate_management.Building, len(buildings))
+	for i, building := range buildings {
+		result[i] = convertBuilding(building)
+	}
+	return result, nil
+}
+
+func (s *RoomService) UpdateBuilding(ctx context.Context, req *debate_management.UpdateBuildingRequest) (*debate_management.Building, error) {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBuilding().GetName() == "" {
+		return nil, fmt.Errorf("building name is required")
+	}
+
+	queries := models.New(s.db)
+	building, err := queries.UpdateBuilding(ctx, models.UpdateBuildingParams{
+		Buildingid: req.GetBuilding().GetBuildingId(),
+		Name:       req.GetBuilding().GetName(),
+		Address:    req.GetBuilding().GetAddress(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update building: %v", err)
+	}
+
+	return convertBuilding(building), nil
+}
+
+// DeleteBuilding deletes a building. Its rooms are kept, in no building.
+func (s *RoomService) DeleteBuilding(ctx context.Context, req *debate_management.DeleteBuildingRequest) error {
+	_, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return err
+	}
+
+	queries := models.New(s.db)
+	if err := queries.DeleteBuilding(ctx, req.GetBuildingId()); err != nil {
+		return fmt.Errorf("failed to delete building: %v", err)
+	}
+	return nil
 }
 
 func (s *RoomService) getRoomStatus(ctx context.Context, tournamentID, roomID int32, isElimination bool) (string, error) {
@@ -161,7 +387,7 @@ func (s *RoomService) validateAuthentication(token string) error {
 	return nil
 }
 
-func (s *RoomService) convertRooms(ctx context.Context, tournamentID int32, dbRooms []models.Room) ([]*debate_management.RoomStatus, error) {
+func (s *RoomService) convertRooms(ctx context.Context, tournamentID int32, dbRooms []models.Room, venue *roomVenue) ([]*debate_management.RoomStatus, error) {
 	rooms := make([]*debate_management.RoomStatus, len(dbRooms))
 	for i, dbRoom := range dbRooms {
 		preliminaryStatus, err := s.getRoomStatus(ctx, tournamentID, dbRoom.Roomid, false)
@@ -179,12 +405,13 @@ func (s *RoomService) convertRooms(ctx context.Context, tournamentID int32, dbRo
 			RoomName:    dbRoom.Roomname,
 			Preliminary: preliminaryStatus,
 			Elimination: eliminationStatus,
+			Room:        convertRoom(dbRoom, venue),
 		}
 	}
 	return rooms, nil
 }
 
-func convertSingleRoom(dbRoom models.GetRoomByIDRow, preliminaryRounds, eliminationRounds []*debate_management.RoundStatus) *debate_management.GetRoomResponse {
+func convertSingleRoom(dbRoom models.Room, preliminaryRounds, eliminationRounds []*debate_management.RoundStatus) *debate_management.GetRoomResponse {
 	return &debate_management.GetRoomResponse{
 		RoomId:      dbRoom.Roomid,
 		Name:        dbRoom.Roomname,
@@ -193,11 +420,86 @@ func convertSingleRoom(dbRoom models.GetRoomByIDRow, preliminaryRounds, eliminat
 	}
 }
 
-func convertRoom(dbRoom models.Room) *debate_management.Room {
+// roomTimeLayout is how the availability windows of rooms are read and
+// written, as the tournament's timetable is.
+const roomTimeLayout = "2006-01-02 15:04"
+
+// roomVenue holds the building names and availability windows of a
+// tournament's rooms.
+type roomVenue struct {
+	buildingNames map[int32]string
+	availability  map[int32][]*debate_management.AvailabilityWindow
+}
+
+func loadVenue(ctx context.Context, queries *models.Queries, tournamentID int32) (*roomVenue, error) {
+	buildings, err := queries.GetBuildingsByTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buildings: %v", err)
+	}
+	windows, err := queries.GetRoomAvailability(ctx, sql.NullInt32{Int32: tournamentID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room availability: %v", err)
+	}
+
+	venue := &roomVenue{
+		buildingNames: make(map[int32]string, len(buildings)),
+		availability:  make(map[int32][]*debate_management.AvailabilityWindow),
+	}
+	for _, building := range buildings {
+		venue.buildingNames[building.Buildingid] = building.Name
+	}
+	for _, window := range windows {
+		venue.availability[window.Roomid] = append(venue.availability[window.Roomid], &debate_management.AvailabilityWindow{
+			StartTime: window.Starttime.Format(roomTimeLayout),
+			EndTime:   window.Endtime.Format(roomTimeLayout),
+		})
+	}
+	return venue, nil
+}
+
+// validateRoomVenue checks that a room seats someone and that its building
+// belongs to the room's tournament.
+func validateRoomVenue(ctx context.Context, queries *models.Queries, tournamentID int32, room *debate_management.Room) error {
+	if room.GetCapacity() <= 0 {
+		return fmt.Errorf("room capacity must be positive")
+	}
+	if room.GetBuildingId() == 0 {
+		return nil
+	}
+	buildings, err := queries.GetBuildingsByTournament(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to get buildings: %v", err)
+	}
+	for _, building := range buildings {
+		if building.Buildingid == room.GetBuildingId() {
+			return nil
+		}
+	}
+	return fmt.Errorf("building %d is not part of tournament %d", room.GetBuildingId(), tournamentID)
+}
+
+func nullBuildingID(buildingID int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: buildingID, Valid: buildingID != 0}
+}
+
+func convertRoom(dbRoom models.Room, venue *roomVenue) *debate_management.Room {
 	return &debate_management.Room{
-		RoomId:   dbRoom.Roomid,
-		RoomName: dbRoom.Roomname,
-		Location: dbRoom.Location,
-		Capacity: dbRoom.Capacity,
+		RoomId:       dbRoom.Roomid,
+		RoomName:     dbRoom.Roomname,
+		Location:     dbRoom.Location,
+		Capacity:     dbRoom.Capacity,
+		BuildingId:   dbRoom.Buildingid.Int32,
+		BuildingName: venue.buildingNames[dbRoom.Buildingid.Int32],
+		IsAccessible: dbRoom.Isaccessible,
+		Availability: venue.availability[dbRoom.Roomid],
+	}
+}
+
+func convertBuilding(dbBuilding models.Building) *debate_management.Building {
+	return &debate_management.Building{
+		BuildingId:   dbBuilding.Buildingid,
+		TournamentId: dbBuilding.Tournamentid,
+		Name:         dbBuilding.Name,
+		Address:      dbBuilding.Address,
 	}
 }
@@ -0,0 +1,339 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// tournamentTiebreaks returns the team and speaker tiebreak chains a
+// tournament is ranked by, its own or else its format's.
+func tournamentTiebreaks(ctx context.Context, queries *models.Queries, tournamentID int32) ([]pairing_algorithm.Tiebreak, []pairing_algorithm.Tiebreak, error) {
+	chains, err := queries.GetTiebreakChains(ctx, tournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tiebreak chains: %v", err)
+	}
+	teamChain, err := pairing_algorithm.ParseTiebreakChain(chains.Teamtiebreaks, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid team tiebreak chain: %v", err)
+	}
+	speakerChain, err := pairing_algorithm.ParseTiebreakChain(chains.Speakertiebreaks, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid speaker tiebreak chain: %v", err)
+	}
+	return teamChain, speakerChain, nil
+}
+
+// teamStandings ranks the teams of a tournament on their recorded preliminary
+// debates and byes by the tournament's team tiebreak chain, scoring each
+// debate from its ballot and each bye by the format's bye result. The
+// returned set holds the teams that may break in the category, every team for
+// a zero categoryID.
+func teamStandings(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32) ([]*pairing_algorithm.Standing, map[int]bool, error) {
+	chain, _, err := tournamentTiebreaks(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, scores, err := ballotTeamScores(ctx, queries, tournamentID, categoryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byeResult, err := queries.GetByeResult(ctx, tournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bye result: %v", err)
+	}
+	byes, err := queries.GetTournamentByes(ctx, models.GetTournamentByesParams{
+		Tournamentid: tournamentID,
+		Column2:      categoryID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get byes: %v", err)
+	}
+
+	// Wins first, since a team's opposition strength is the wins of the teams it met
+	wins := make(map[int]int)
+	if pairing_algorithm.ByeResult(byeResult) == pairing_algorithm.ByeWin {
+		for _, bye := range byes {
+			wins[int(bye.Teamid)]++
+		}
+	}
+	debateScores := make(map[int][]pairing_algorithm.TeamScore)
+	for i, score := range scores {
+		if rows[i].Iseliminationround {
+			continue
+		}
+		if score.Won {
+			wins[score.TeamID]++
+		}
+		debateScores[score.DebateID] = append(debateScores[score.DebateID], score)
+	}
+
+	var competitors []*pairing_algorithm.Competitor
+	byID := make(map[int]*pairing_algorithm.Competitor)
+	eligible := make(map[int]bool)
+	for i, row := range rows {
+		if row.Iseliminationround {
+			continue
+		}
+		score := scores[i]
+		competitor, ok := byID[score.TeamID]
+		if !ok {
+			competitor = &pairing_algorithm.Competitor{ID: score.TeamID, Name: row.Teamname}
+			byID[score.TeamID] = competitor
+			competitors = append(competitors, competitor)
+		}
+		if row.Eligible.Bool {
+			eligible[score.TeamID] = true
+		}
+
+		rank, err := strconv.ParseFloat(row.Speakerrank, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse average rank for team %d: %v", score.TeamID, err)
+		}
+		// A team without recorded speeches ranks behind every other
+		if rank == 0 {
+			rank = 99
+		}
+		result := pairing_algorithm.DebateResult{
+			DebateID:   score.DebateID,
+			TeamPoints: score.TeamPoints,
+			Won:        score.Won,
+			Points:     score.TotalPoints,
+			Rank:       rank,
+		}
+		for _, other := range debateScores[score.DebateID] {
+			if other.TeamID == score.TeamID {
+				continue
+			}
+			result.OpponentWins += wins[other.TeamID]
+			if other.TeamPoints < score.TeamPoints {
+				result.Beat = append(result.Beat, other.TeamID)
+			}
+		}
+		competitor.Results = append(competitor.Results, result)
+	}
+
+	// Byes are credited from the debates the team held
+	for _, bye := range byes {
+		competitor, ok := byID[int(bye.Teamid)]
+		var held []pairing_algorithm.DebateResult
+		if ok {
+			held = competitor.Results
+		}
+		credit, credited := pairing_algorithm.CreditBye(pairing_algorithm.ByeResult(byeResult), held)
+		if !credited {
+			continue
+		}
+		if !ok {
+			competitor = &pairing_algorithm.Competitor{ID: int(bye.Teamid), Name: bye.Teamname}
+			byID[competitor.ID] = competitor
+			competitors = append(competitors, competitor)
+		}
+		if bye.Eligible.Bool {
+			eligible[competitor.ID] = true
+		}
+		competitor.Results = append(competitor.Results, credit)
+	}
+
+	return pairing_algorithm.RankCompetitors(competitors, chain, int64(tournamentID)), eligible, nil
+}
+
+// ballotTeamScores scores every team of the tournament's recorded debates
+// from the ballots, as the tab engine counts them. The scores line up with
+// the returned rows.
+func ballotTeamScores(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32) ([]models.GetTeamBallotResultsRow, []pairing_algorithm.TeamScore, error) {
+	rows, err := queries.GetTeamBallotResults(ctx, models.GetTeamBallotResultsParams{
+		Tournamentid: tournamentID,
+		Column2:      categoryID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get team results: %v", err)
+	}
+
+	// Rows come ordered by round and debate, so each ballot's teams are together
+	scores := make([]pairing_algorithm.TeamScore, 0, len(rows))
+	var ballot pairing_algorithm.Ballot
+	for i, row := range rows {
+		points, err := strconv.ParseFloat(row.Totalscore, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse total points for team %d: %v", row.Teamid, err)
+		}
+		rank, err := strconv.ParseFloat(row.Speakerrank, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse average rank for team %d: %v", row.Teamid, err)
+		}
+
+		ballot.DebateID = int(row.Debateid)
+		ballot.IsElimination = row.Iseliminationround
+		ballot.Teams = append(ballot.Teams, pairing_algorithm.BallotTeam{
+			TeamID:      int(row.Teamid),
+			TotalPoints: points,
+			SpeakerRank: rank,
+			Won:         row.Won,
+		})
+		if i == len(rows)-1 || rows[i+1].Debateid != row.Debateid {
+			scores = append(scores, pairing_algorithm.ScoreBallot(ballot)...)
+			ballot = pairing_algorithm.Ballot{}
+		}
+	}
+	return rows, scores, nil
+}
+
+// speakerStandings ranks the speakers of a tournament on their recorded
+// preliminary debates by the tournament's speaker tiebreak chain. The format
+// decides whether a speaker's second speech in a debate and speeches given as
+// a substitute count. The returned maps hold each speaker's school and
+// whether they may break in the category.
+func speakerStandings(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32) ([]*pairing_algorithm.Standing, map[int]string, map[int]bool, error) {
+	return rankSpeakers(ctx, queries, tournamentID, categoryID, nil)
+}
+
+// speechKey identifies a speech by its debate, team and speaking position.
+type speechKey struct {
+	debateID int32
+	teamID   int32
+	position int32
+}
+
+// rankSpeakers ranks speakers like speakerStandings, counting the given points
+// for the speeches in points instead of their recorded speaker points.
+func rankSpeakers(ctx context.Context, queries *models.Queries, tournamentID int32, categoryID int32, points map[speechKey]float64) ([]*pairing_algorithm.Standing, map[int]string, map[int]bool, error) {
+	_, chain, err := tournamentTiebreaks(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	rules, err := queries.GetSpeechCountingRules(ctx, tournamentID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get speech counting rules: %v", err)
+	}
+	rows, err := queries.GetSpeakerPreliminaryResults(ctx, models.GetSpeakerPreliminaryResultsParams{
+		Tournamentid: tournamentID,
+		Column2:      categoryID,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get speaker results: %v", err)
+	}
+
+	var competitors []*pairing_algorithm.Competitor
+	byID := make(map[int]*pairing_algorithm.Competitor)
+	schools := make(map[int]string)
+	eligible := make(map[int]bool)
+	for _, row := range rows {
+		if row.Issubstitute && rules.Substitutespeeches == "exclude" {
+			continue
+		}
+		studentID := int(row.Studentid)
+		competitor, ok := byID[studentID]
+		if !ok {
+			name, _ := row.Studentname.(string)
+			competitor = &pairing_algorithm.Competitor{ID: studentID, Name: name}
+			byID[studentID] = competitor
+			competitors = append(competitors, competitor)
+			schools[studentID] = row.Schoolname
+		}
+		if row.Eligible.Bool {
+			eligible[studentID] = true
+		}
+
+		speechPoints, ok := points[speechKey{debateID: row.Debateid, teamID: row.Teamid, position: row.Position}]
+		if !ok {
+			speechPoints, err = strconv.ParseFloat(row.Speakerpoints, 64)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse speaker points for student %d: %v", studentID, err)
+			}
+		}
+		result := pairing_algorithm.DebateResult{
+			DebateID: int(row.Debateid),
+			Won:      row.Won,
+			Points:   speechPoints,
+			Rank:     float64(row.Speakerrank),
+		}
+
+		// Rows come ordered by speaker and debate, so an iron-person's second
+		// speech follows their first
+		if n := len(competitor.Results); n > 0 && competitor.Results[n-1].DebateID == result.DebateID {
+			switch rules.Ironspeeches {
+			case "best":
+				if result.Points > competitor.Results[n-1].Points {
+					competitor.Results[n-1] = result
+				}
+				continue
+			case "exclude":
+				competitor.Results = competitor.Results[:n-1]
+				continue
+			}
+		}
+		competitor.Results = append(competitor.Results, result)
+	}
+
+	// Speakers whose every speech was excluded are not ranked
+	ranked := competitors[:0]
+	for _, competitor := range competitors {
+		if len(competitor.Results) > 0 {
+			ranked = append(ranked, competitor)
+		}
+	}
+
+	return pairing_algorithm.RankCompetitors(ranked, chain, int64(tournamentID)), schools, eligible, nil
+}
+
+// filterStandings keeps the standings of the given competitors in the order
+// of the whole field, renumbering their places. Competitors sharing a place
+// in the whole field still share one.
+func filterStandings(standings []*pairing_algorithm.Standing, keep map[int]bool) []*pairing_algorithm.Standing {
+	var filtered []*pairing_algorithm.Standing
+	var previous *pairing_algorithm.Standing
+	for _, standing := range standings {
+		if !keep[standing.Competitor.ID] {
+			continue
+		}
+		kept := *standing
+		kept.Place = len(filtered) + 1
+		if previous != nil && previous.Place == standing.Place {
+			kept.Place = filtered[len(filtered)-1].Place
+		}
+		filtered = append(filtered, &kept)
+		previous = standing
+	}
+	return filtered
+}
+
+// breakStandings returns the teams of a tournament in the order of the
+// preliminary standings, once every preliminary ballot is recorded. Each
+// team's Standing is its position, so that teams sharing a place still break
+// in a fixed order.
+func breakStandings(ctx context.Context, queries *models.Queries, tournamentID int32) ([]*pairing_algorithm.Team, error) {
+	unrecorded, err := queries.CountUnrecordedPreliminaryBallots(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check preliminary ballots: %v", err)
+	}
+	standings, _, err := teamStandings(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+	if unrecorded > 0 || len(standings) == 0 {
+		return nil, fmt.Errorf("cannot generate pairings: not all ballots from preliminary rounds are recorded")
+	}
+
+	teams := make([]*pairing_algorithm.Team, len(standings))
+	for i, standing := range standings {
+		competitor := standing.Competitor
+		teams[i] = &pairing_algorithm.Team{
+			ID:          competitor.ID,
+			Name:        competitor.Name,
+			Wins:        competitor.Wins(),
+			TeamPoints:  competitor.TeamPoints(),
+			TotalPoints: competitor.TotalPoints(),
+			AverageRank: competitor.AverageRank(),
+			Standing:    i + 1,
+			Opponents:   make(map[int]bool),
+		}
+	}
+	return teams, nil
+}
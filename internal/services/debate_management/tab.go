@@ -0,0 +1,492 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/internal/utils"
+)
+
+type TabService struct {
+	db *sql.DB
+}
+
+func NewTabService(db *sql.DB) *TabService {
+	return &TabService{db: db}
+}
+
+// tabMotion is a motion as stored on the tournament.
+type tabMotion struct {
+	Text        string `json:"text"`
+	InfoSlide   string `json:"infoSlide"`
+	RoundNumber int32  `json:"roundNumber"`
+}
+
+// PublishTab freezes the tournament's standings, break, round results and
+// motions into a new version of its published tab. Earlier versions are kept
+// as they were.
+func (s *TabService) PublishTab(ctx context.Context, req *debate_management.PublishTabRequest) (*debate_management.PublishTabResponse, error) {
+	claims, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("invalid user ID in token")
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	unrecorded, err := queries.CountUnrecordedBallots(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ballots: %v", err)
+	}
+	if unrecorded > 0 {
+		return &debate_management.PublishTabResponse{
+			Success: false,
+			Message: fmt.Sprintf("Tab not published: %d ballots are not recorded yet", unrecorded),
+		}, nil
+	}
+
+	tab, err := buildTab(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := protojson.Marshal(tab)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tab: %v", err)
+	}
+
+	published, err := queries.CreatePublishedTab(ctx, models.CreatePublishedTabParams{
+		Tournamentid: req.GetTournamentId(),
+		Snapshot:     snapshot,
+		Publishedby:  int32(userID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish tab: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	tab.Version = published.Version
+	tab.PublishedAt = published.Publishedat.String()
+	return &debate_management.PublishTabResponse{
+		Success: true,
+		Message: fmt.Sprintf("Tab version %d published", published.Version),
+		Tab:     tab,
+	}, nil
+}
+
+// GetPublishedTab returns a published version of the tournament's tab. It
+// needs no token: the published tab is public.
+func (s *TabService) GetPublishedTab(ctx context.Context, req *debate_management.GetPublishedTabRequest) (*debate_management.GetPublishedTabResponse, error) {
+	queries := models.New(s.db)
+
+	published, tab, err := getPublishedTab(ctx, queries, req.GetTournamentId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := queries.GetPublishedTabVersions(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get published tab versions: %v", err)
+	}
+
+	// The tab stays as published, but say when ballots have changed since
+	changed, err := queries.CountBallotsChangedSince(ctx, models.CountBallotsChangedSinceParams{
+		Tournamentid:  req.GetTournamentId(),
+		LastUpdatedAt: sql.NullTime{Time: published.Publishedat, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ballots changed since publication: %v", err)
+	}
+
+	return &debate_management.GetPublishedTabResponse{
+		Tab:            tab,
+		Versions:       versions,
+		ChangedBallots: int32(changed),
+	}, nil
+}
+
+// ExportPublishedTab returns a published version of the tournament's tab as
+// a CSV or JSON file. Like GetPublishedTab it needs no token.
+func (s *TabService) ExportPublishedTab(ctx context.Context, req *debate_management.ExportPublishedTabRequest) (*debate_management.ExportPublishedTabResponse, error) {
+	_, tab, err := getPublishedTab(ctx, models.New(s.db), req.GetTournamentId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("tab-%d-v%d", tab.TournamentId, tab.Version)
+	switch req.GetFormat() {
+	case "csv":
+		content, err := tabCSV(tab)
+		if err != nil {
+			return nil, err
+		}
+		return &debate_management.ExportPublishedTabResponse{
+			FileName:    fileName + ".csv",
+			ContentType: "text/csv",
+			Content:     content,
+		}, nil
+	case "json":
+		content, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(tab)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal tab: %v", err)
+		}
+		return &debate_management.ExportPublishedTabResponse{
+			FileName:    fileName + ".json",
+			ContentType: "application/json",
+			Content:     content,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid export format %q: must be csv or json", req.GetFormat())
+	}
+}
+
+// getPublishedTab loads a version of the tournament's published tab, the
+// latest for version 0.
+func getPublishedTab(ctx context.Context, queries *models.Queries, tournamentID int32, version int32) (models.Publishedtab, *debate_management.PublishedTab, error) {
+	published, err := queries.GetPublishedTab(ctx, models.GetPublishedTabParams{
+		Tournamentid: tournamentID,
+		Column2:      version,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			if version != 0 {
+				return published, nil, fmt.Errorf("version %d of the tab of tournament %d has not been published", version, tournamentID)
+			}
+			return published, nil, fmt.Errorf("the tab of tournament %d has not been published", tournamentID)
+		}
+		return published, nil, fmt.Errorf("failed to get published tab: %v", err)
+	}
+
+	tab := &debate_management.PublishedTab{}
+	if err := protojson.Unmarshal(published.Snapshot, tab); err != nil {
+		return published, nil, fmt.Errorf("failed to read published tab: %v", err)
+	}
+	tab.Version = published.Version
+	tab.PublishedAt = published.Publishedat.String()
+	return published, tab, nil
+}
+
+// buildTab collects the tournament's tab as it stands: the team and speaker
+// standings on the preliminary rounds, the teams that broke and the results
+// and motion of every round.
+func buildTab(ctx context.Context, queries *models.Queries, tournamentID int32) (*debate_management.PublishedTab, error) {
+	tournament, err := queries.GetTabTournament(ctx, tournamentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("tournament %d not found", tournamentID)
+		}
+		return nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+
+	tab := &debate_management.PublishedTab{
+		TournamentId:   tournamentID,
+		TournamentName: tournament.Name,
+	}
+
+	standings, _, err := teamStandings(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+	schoolNames, err := teamSchoolNames(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	places := make(map[int32]int)
+	for _, standing := range standings {
+		ranking := convertTeamStanding(standing, schoolNames)
+		places[ranking.TeamId] = len(tab.TeamStandings)
+		tab.TeamStandings = append(tab.TeamStandings, ranking)
+	}
+
+	speakers, schools, _, err := speakerStandings(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, standing := range speakers {
+		tab.SpeakerStandings = append(tab.SpeakerStandings, convertSpeakerStanding(standing, schools))
+	}
+
+	tab.Breaks, err = tabBreaks(ctx, queries, tournamentID, places)
+	if err != nil {
+		return nil, err
+	}
+
+	tab.Rounds, err = tabRounds(ctx, queries, tournament)
+	if err != nil {
+		return nil, err
+	}
+
+	return tab, nil
+}
+
+// tabBreaks lists the teams of the first elimination round by break
+// category. Teams without a bracket seed are seeded in the order of the team
+// standings.
+func tabBreaks(ctx context.Context, queries *models.Queries, tournamentID int32, places map[int32]int) ([]*debate_management.TabBreak, error) {
+	rows, err := queries.GetBreakingTeams(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get breaking teams: %v", err)
+	}
+
+	var breaks []*debate_management.TabBreak
+	for _, row := range rows {
+		if len(breaks) == 0 || breaks[len(breaks)-1].CategoryName != row.Categoryname {
+			breaks = append(breaks, &debate_management.TabBreak{CategoryName: row.Categoryname})
+		}
+		category := breaks[len(breaks)-1]
+		category.Teams = append(category.Teams, &debate_management.TabBreakingTeam{
+			Seed:     row.Seed,
+			TeamId:   row.Teamid,
+			TeamName: row.Teamname,
+		})
+	}
+
+	for _, category := range breaks {
+		if !slices.ContainsFunc(category.Teams, func(team *debate_management.TabBreakingTeam) bool { return team.Seed == 0 }) {
+			continue
+		}
+		slices.SortStableFunc(category.Teams, func(a, b *debate_management.TabBreakingTeam) int {
+			return places[a.TeamId] - places[b.TeamId]
+		})
+		for i, team := range category.Teams {
+			team.Seed = int32(i + 1)
+		}
+	}
+
+	return breaks, nil
+}
+
+// tabRounds lists the results of every recorded debate by round, scored from
+// its ballot, with the round's motion and byes. Rounds with a motion but no recorded debate are listed too.
+func tabRounds(ctx context.Context, queries *models.Queries, tournament models.GetTabTournamentRow) ([]*debate_management.TabRound, error) {
+	rows, scores, err := ballotTeamScores(ctx, queries, tournament.Tournamentid, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var rounds []*debate_management.TabRound
+	for i, row := range rows {
+		if len(rounds) == 0 || rounds[len(rounds)-1].RoundNumber != row.Roundnumber || rounds[len(rounds)-1].IsElimination != row.Iseliminationround {
+			rounds = append(rounds, &debate_management.TabRound{
+				RoundNumber:   row.Roundnumber,
+				IsElimination: row.Iseliminationround,
+			})
+		}
+		round := rounds[len(rounds)-1]
+		if len(round.Debates) == 0 || round.Debates[len(round.Debates)-1].DebateId != row.Debateid {
+			round.Debates = append(round.Debates, &debate_management.TabDebate{
+				DebateId: row.Debateid,
+				RoomName: row.Roomname,
+			})
+		}
+		debate := round.Debates[len(round.Debates)-1]
+
+		score := scores[i]
+		debate.Teams = append(debate.Teams, &debate_management.TabDebateTeam{
+			TeamId:      row.Teamid,
+			TeamName:    row.Teamname,
+			Side:        row.Side,
+			TeamPoints:  int32(score.TeamPoints),
+			TotalPoints: score.TotalPoints,
+			Rank:        int32(score.Rank),
+			Won:         score.Won,
+		})
+	}
+
+	roundOf := func(roundNumber int32, isElimination bool) *debate_management.TabRound {
+		i := slices.IndexFunc(rounds, func(round *debate_management.TabRound) bool {
+			return round.RoundNumber == roundNumber && round.IsElimination == isElimination
+		})
+		if i < 0 {
+			rounds = append(rounds, &debate_management.TabRound{
+				RoundNumber:   roundNumber,
+				IsElimination: isElimination,
+			})
+			i = len(rounds) - 1
+		}
+		return rounds[i]
+	}
+
+	byes, err := queries.GetTournamentByes(ctx, models.GetTournamentByesParams{Tournamentid: tournament.Tournamentid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get byes: %v", err)
+	}
+	for _, bye := range byes {
+		round := roundOf(bye.Roundnumber, false)
+		round.Byes = append(round.Byes, &debate_management.Bye{
+			TeamId:      bye.Teamid,
+			TeamName:    bye.Teamname,
+			RoundNumber: bye.Roundnumber,
+		})
+	}
+
+	// Until the tournament ends, the motions of rounds not yet released stay
+	// off the public tab
+	if tournament.Motions.Valid {
+		releases, err := queries.GetMotionReleases(ctx, tournament.Tournamentid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get motion releases: %v", err)
+		}
+		type roundKey struct {
+			number        int32
+			isElimination bool
+		}
+		released := make(map[roundKey]bool, len(releases))
+		for _, release := range releases {
+			released[roundKey{release.Roundnumber, release.Iseliminationround}] = true
+		}
+		showUnreleased := tournament.Enddate.Before(time.Now())
+
+		var motions struct {
+			Preliminary []tabMotion `json:"preliminary"`
+			Elimination []tabMotion `json:"elimination"`
+		}
+		if err := json.Unmarshal(tournament.Motions.RawMessage, &motions); err != nil {
+			return nil, fmt.Errorf("failed to read tournament motions: %v", err)
+		}
+		addMotions := func(motions []tabMotion, isElimination bool) {
+			for _, motion := range motions {
+				if !showUnreleased && !released[roundKey{motion.RoundNumber, isElimination}] {
+					continue
+				}
+				round := roundOf(motion.RoundNumber, isElimination)
+				round.Motion = motion.Text
+				round.InfoSlide = motion.InfoSlide
+			}
+		}
+		addMotions(motions.Preliminary, false)
+		addMotions(motions.Elimination, true)
+	}
+
+	slices.SortStableFunc(rounds, func(a, b *debate_management.TabRound) int {
+		if a.IsElimination != b.IsElimination {
+			if a.IsElimination {
+				return 1
+			}
+			return -1
+		}
+		return int(a.RoundNumber - b.RoundNumber)
+	})
+	return rounds, nil
+}
+
+// tabCSV writes the tab as CSV, one section each for the team standings,
+// the speaker standings, the break, the motions and the round results,
+// separated by a blank line.
+func tabCSV(tab *debate_management.PublishedTab) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	itoa := func(i int32) string {
+		return strconv.Itoa(int(i))
+	}
+
+	records := [][]string{
+		{"Team standings"},
+		{"Place", "Team", "Schools", "Wins", "Team points", "Total points", "Average rank", "Decided by"},
+	}
+	for _, team := range tab.TeamStandings {
+		records = append(records, []string{
+			itoa(team.Place), team.TeamName, strings.Join(team.SchoolNames, "; "), itoa(team.Wins),
+			itoa(team.TeamPoints), formatFloat(team.TotalPoints), formatFloat(team.AverageRank), team.DecidedBy,
+		})
+	}
+
+	records = append(records, nil, []string{"Speaker standings"},
+		[]string{"Place", "Speaker", "School", "Wins", "Total points", "Average rank", "Decided by"})
+	for _, speaker := range tab.SpeakerStandings {
+		records = append(records, []string{
+			itoa(speaker.Place), speaker.StudentName, speaker.SchoolName, itoa(speaker.TotalWins),
+			formatFloat(speaker.TotalPoints), formatFloat(speaker.AverageRank), speaker.DecidedBy,
+		})
+	}
+
+	records = append(records, nil, []string{"Break"}, []string{"Category", "Seed", "Team"})
+	for _, category := range tab.Breaks {
+		for _, team := range category.Teams {
+			records = append(records, []string{category.CategoryName, itoa(team.Seed), team.TeamName})
+		}
+	}
+
+	records = append(records, nil, []string{"Motions"}, []string{"Round", "Elimination", "Motion", "Info slide"})
+	for _, round := range tab.Rounds {
+		if round.Motion != "" {
+			records = append(records, []string{itoa(round.RoundNumber), strconv.FormatBool(round.IsElimination), round.Motion, round.InfoSlide})
+		}
+	}
+
+	records = append(records, nil, []string{"Results"},
+		[]string{"Round", "Elimination", "Room", "Side", "Team", "Team points", "Total points", "Rank", "Won"})
+	for _, round := range tab.Rounds {
+		for _, debate := range round.Debates {
+			for _, team := range debate.Teams {
+				records = append(records, []string{
+					itoa(round.RoundNumber), strconv.FormatBool(round.IsElimination), debate.RoomName, itoa(team.Side),
+					team.TeamName, itoa(team.TeamPoints), formatFloat(team.TotalPoints), itoa(team.Rank), strconv.FormatBool(team.Won),
+				})
+			}
+		}
+		for _, bye := range round.Byes {
+			records = append(records, []string{
+				itoa(round.RoundNumber), strconv.FormatBool(round.IsElimination), "Bye", "", bye.TeamName, "", "", "", "",
+			})
+		}
+	}
+
+	// A nil record is a blank line between sections
+	for _, record := range records {
+		if record == nil {
+			w.Flush()
+			buf.WriteString("\n")
+			continue
+		}
+		if err := w.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write tab CSV: %v", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write tab CSV: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *TabService) validateAdminRole(token string) (map[string]interface{}, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return nil, fmt.Errorf("unauthorized: only admins can perform this action")
+	}
+
+	return claims, nil
+}
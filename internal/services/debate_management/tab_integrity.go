@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/debate_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// teamScoreKey identifies the TeamScores row of a team in a debate.
+type teamScoreKey struct {
+	teamID   int
+	debateID int
+}
+
+// tabRepairs is what recomputing a tournament's tab found to differ from the
+// stored aggregates, and how to put it right.
+type tabRepairs struct {
+	discrepancies []*debate_management.TabDiscrepancy
+	scores        []pairing_algorithm.TeamScore
+	staleScores   []teamScoreKey
+	totals        map[int]*pairing_algorithm.TeamTotals
+	staleTotals   []int
+}
+
+// CheckTab recomputes the tournament's team scores and totals from the
+// recorded ballots and reports where the stored aggregates differ.
+func (s *TabService) CheckTab(ctx context.Context, req *debate_management.TabIntegrityRequest) (*debate_management.TabIntegrityResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	repairs, err := recomputeTab(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	return tabIntegrityResponse(ctx, queries, req.GetTournamentId(), repairs, false)
+}
+
+// RepairTab recomputes the tournament's tab like CheckTab and overwrites the
+// stored aggregates that differ with the recomputed ones. Ballots are only
+// read, never changed.
+func (s *TabService) RepairTab(ctx context.Context, req *debate_management.TabIntegrityRequest) (*debate_management.TabIntegrityResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	// Ballots recorded while repairing would be missed, so read them as of
+	// one snapshot and let a concurrent change fail the repair
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+	repairs, err := recomputeTab(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, score := range repairs.scores {
+		err := queries.UpsertTeamScore(ctx, models.UpsertTeamScoreParams{
+			Teamid:        sql.NullInt32{Int32: int32(score.TeamID), Valid: true},
+			Debateid:      sql.NullInt32{Int32: int32(score.DebateID), Valid: true},
+			Totalscore:    sql.NullString{String: strconv.FormatFloat(score.TotalPoints, 'f', 2, 64), Valid: true},
+			Rank:          sql.NullInt32{Int32: int32(score.Rank), Valid: true},
+			Teampoints:    int32(score.TeamPoints),
+			Iselimination: sql.NullBool{Bool: score.IsElimination, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to repair score of team %d in debate %d: %v", score.TeamID, score.DebateID, err)
+		}
+	}
+
+	for _, key := range repairs.staleScores {
+		err := queries.DeleteTeamScore(ctx, models.DeleteTeamScoreParams{
+			Teamid:   sql.NullInt32{Int32: int32(key.teamID), Valid: true},
+			Debateid: sql.NullInt32{Int32: int32(key.debateID), Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete score of team %d in debate %d: %v", key.teamID, key.debateID, err)
+		}
+	}
+
+	for _, teamID := range repairs.staleTotals {
+		totals := repairs.totals[teamID]
+		if totals == nil {
+			totals = &pairing_algorithm.TeamTotals{}
+		}
+		err := queries.SetTeamTotals(ctx, models.SetTeamTotalsParams{
+			Teamid:             int32(teamID),
+			Totalwins:          sql.NullInt32{Int32: int32(totals.Wins), Valid: true},
+			Totalspeakerpoints: sql.NullString{String: strconv.FormatFloat(totals.SpeakerPoints, 'f', 2, 64), Valid: true},
+			Averagerank:        sql.NullString{String: strconv.FormatFloat(totals.AverageRank, 'f', 2, 64), Valid: true},
+			Totalteampoints:    sql.NullInt32{Int32: int32(totals.TeamPoints), Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to repair totals of team %d: %v", teamID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return tabIntegrityResponse(ctx, models.New(s.db), req.GetTournamentId(), repairs, true)
+}
+
+// recomputeTab scores every recorded debate of the tournament from its
+// ballot and compares the result with the stored TeamScores and team
+// totals.
+func recomputeTab(ctx context.Context, queries *models.Queries, tournamentID int32) (*tabRepairs, error) {
+	_, scores, err := ballotTeamScores(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	storedScores, err := queries.GetStoredTeamScores(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored team scores: %v", err)
+	}
+	storedTotals, err := queries.GetStoredTeamTotals(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored team totals: %v", err)
+	}
+
+	names := make(map[int]string, len(storedTotals))
+	for _, team := range storedTotals {
+		names[int(team.Teamid)] = team.Name
+	}
+
+	repairs := &tabRepairs{totals: pairing_algorithm.SumTeamScores(scores)}
+	report := func(teamID, debateID int, field, stored, recomputed string) {
+		repairs.discrepancies = append(repairs.discrepancies, &debate_management.TabDiscrepancy{
+			TeamId:     int32(teamID),
+			TeamName:   names[teamID],
+			DebateId:   int32(debateID),
+			Field:      field,
+			Stored:     stored,
+			Recomputed: recomputed,
+		})
+	}
+
+	stored := make(map[teamScoreKey]models.GetStoredTeamScoresRow, len(storedScores))
+	for _, row := range storedScores {
+		stored[teamScoreKey{teamID: int(row.Teamid.Int32), debateID: int(row.Debateid.Int32)}] = row
+	}
+
+	for _, score := range scores {
+		key := teamScoreKey{teamID: score.TeamID, debateID: score.DebateID}
+		row, ok := stored[key]
+		delete(stored, key)
+		if !ok {
+			report(score.TeamID, score.DebateID, "team_score", "missing", "present")
+			repairs.scores = append(repairs.scores, score)
+			continue
+		}
+
+		storedPoints, err := strconv.ParseFloat(row.Totalscore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored total points of team %d: %v", score.TeamID, err)
+		}
+		differs := false
+		if !pointsEqual(storedPoints, score.TotalPoints) {
+			report(score.TeamID, score.DebateID, "total_points", formatTabPoints(storedPoints), formatTabPoints(score.TotalPoints))
+			differs = true
+		}
+		if int(row.Rank) != score.Rank {
+			report(score.TeamID, score.DebateID, "rank", strconv.Itoa(int(row.Rank)), strconv.Itoa(score.Rank))
+			differs = true
+		}
+		if int(row.Teampoints) != score.TeamPoints {
+			report(score.TeamID, score.DebateID, "team_points", strconv.Itoa(int(row.Teampoints)), strconv.Itoa(score.TeamPoints))
+			differs = true
+		}
+		if differs {
+			repairs.scores = append(repairs.scores, score)
+		}
+	}
+
+	// Whatever is left belongs to a team no longer on the debate's ballot
+	for _, row := range storedScores {
+		key := teamScoreKey{teamID: int(row.Teamid.Int32), debateID: int(row.Debateid.Int32)}
+		if _, ok := stored[key]; ok {
+			report(key.teamID, key.debateID, "team_score", "present", "missing")
+			repairs.staleScores = append(repairs.staleScores, key)
+		}
+	}
+
+	for _, team := range storedTotals {
+		teamID := int(team.Teamid)
+		totals := repairs.totals[teamID]
+		if totals == nil {
+			totals = &pairing_algorithm.TeamTotals{}
+		}
+
+		speakerPoints, err := strconv.ParseFloat(team.Totalspeakerpoints, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored speaker points of team %d: %v", teamID, err)
+		}
+		averageRank, err := strconv.ParseFloat(team.Averagerank, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored average rank of team %d: %v", teamID, err)
+		}
+
+		differs := false
+		if int(team.Totalwins) != totals.Wins {
+			report(teamID, 0, "total_wins", strconv.Itoa(int(team.Totalwins)), strconv.Itoa(totals.Wins))
+			differs = true
+		}
+		if !pointsEqual(speakerPoints, totals.SpeakerPoints) {
+			report(teamID, 0, "total_speaker_points", formatTabPoints(speakerPoints), formatTabPoints(totals.SpeakerPoints))
+			differs = true
+		}
+		if !pointsEqual(averageRank, totals.AverageRank) {
+			report(teamID, 0, "average_rank", formatTabPoints(averageRank), formatTabPoints(totals.AverageRank))
+			differs = true
+		}
+		if int(team.Totalteampoints) != totals.TeamPoints {
+			report(teamID, 0, "total_team_points", strconv.Itoa(int(team.Totalteampoints)), strconv.Itoa(totals.TeamPoints))
+			differs = true
+		}
+		if differs {
+			repairs.staleTotals = append(repairs.staleTotals, teamID)
+		}
+	}
+
+	return repairs, nil
+}
+
+// tabIntegrityResponse reports the discrepancies found together with the
+// standings recomputed from the ballots.
+func tabIntegrityResponse(ctx context.Context, queries *models.Queries, tournamentID int32, repairs *tabRepairs, repaired bool) (*debate_management.TabIntegrityResponse, error) {
+	response := &debate_management.TabIntegrityResponse{
+		Discrepancies: repairs.discrepancies,
+		Repaired:      repaired && len(repairs.discrepancies) > 0,
+	}
+
+	standings, _, err := teamStandings(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+	schoolNames, err := teamSchoolNames(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, standing := range standings {
+		response.TeamStandings = append(response.TeamStandings, convertTeamStanding(standing, schoolNames))
+	}
+
+	speakers, schools, _, err := speakerStandings(ctx, queries, tournamentID, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, standing := range speakers {
+		response.SpeakerStandings = append(response.SpeakerStandings, convertSpeakerStanding(standing, schools))
+	}
+
+	return response, nil
+}
+
+func formatTabPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', 2, 64)
+}
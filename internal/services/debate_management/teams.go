@@ -74,8 +74,9 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *debate_management.Cre
 	log.Printf("Creating team with name: %s, tournamentId: %d", req.GetName(), req.GetTournamentId())
 	// Create the team
 	team, err := queries.CreateTeam(ctx, models.CreateTeamParams{
-		Name:         req.GetName(),
-		Tournamentid: req.GetTournamentId(),
+		Name:                req.GetName(),
+		Tournamentid:        req.GetTournamentId(),
+		Needsaccessibleroom: req.GetNeedsAccessibleRoom(),
 	})
 	if err != nil {
 		log.Printf("Failed to create team: %v", err)
@@ -96,11 +97,19 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *debate_management.Cre
 			return nil, fmt.Errorf("failed to add team member: %v", err)
 		}
 		speakers = append(speakers, &debate_management.Speaker{
-			SpeakerId: speaker.GetSpeakerId(),
-			Name:      speaker.GetName(),
+			SpeakerId:        speaker.GetSpeakerId(),
+			Name:             speaker.GetName(),
+			BreakCategoryIds: speaker.GetBreakCategoryIds(),
 		})
 	}
 
+	// Set the break categories the team and its speakers are eligible for
+	err = replaceBreakCategories(ctx, queries, req.GetTournamentId(), team.Teamid, req.GetBreakCategoryIds(), req.GetSpeakers())
+	if err != nil {
+		log.Printf("Failed to set break categories: %v", err)
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		log.Printf("Failed to commit transaction: %v", err)
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
@@ -108,9 +117,11 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *debate_management.Cre
 	log.Printf("Transaction committed successfully")
 
 	createdTeam := &debate_management.Team{
-		TeamId:   team.Teamid,
-		Name:     team.Name,
-		Speakers: speakers,
+		TeamId:              team.Teamid,
+		Name:                team.Name,
+		Speakers:            speakers,
+		BreakCategoryIds:    req.GetBreakCategoryIds(),
+		NeedsAccessibleRoom: team.Needsaccessibleroom,
 	}
 	log.Printf("Team created successfully: %+v", createdTeam)
 
@@ -147,7 +158,12 @@ func (s *TeamService) GetTeam(ctx context.Context, req *debate_management.GetTea
 		return nil, fmt.Errorf("failed to get team members: %v", err)
 	}
 
-	return convertTeam(team, speakers), nil
+	result := convertTeam(team, speakers)
+	if err := loadBreakCategories(ctx, queries, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (s *TeamService) UpdateTeam(ctx context.Context, req *debate_management.UpdateTeamRequest) (*debate_management.Team, error) {
@@ -253,13 +269,13 @@ func (s *TeamService) UpdateTeam(ctx context.Context, req *debate_management.Upd
 				// Create a map to store information about each position's scores
 				positionScores := make(map[int]models.GetSpeakerScoresByBallotAndTeamRow)
 				for _, score := range currentScores {
-					positionScores[int(score.Speakerrank)] = score
+					positionScores[int(score.Position)] = score
 				}
 
 				// Create a map of position to speaker ID for old team
 				oldSpeakerPositions := make(map[int]int32)
 				for _, score := range currentScores {
-					oldSpeakerPositions[int(score.Speakerrank)] = score.Speakerid
+					oldSpeakerPositions[int(score.Position)] = score.Speakerid
 				}
 
 				// Delete existing scores
@@ -311,11 +327,13 @@ func (s *TeamService) UpdateTeam(ctx context.Context, req *debate_management.Upd
 				for position, speakerID := range positionAssignments {
 					// Check if we have scores to preserve for this position
 					var speakerPoints string = "0"
+					var speakerRank int32 = int32(position)
 					var feedback sql.NullString = sql.NullString{String: "", Valid: false}
 
 					if oldScore, exists := positionScores[position]; exists {
 						// Preserve scores from the same position
 						speakerPoints = oldScore.Speakerpoints
+						speakerRank = oldScore.Speakerrank
 						feedback = oldScore.Feedback
 					}
 
@@ -323,9 +341,11 @@ func (s *TeamService) UpdateTeam(ctx context.Context, req *debate_management.Upd
 					err = queries.CreateSpeakerScore(ctx, models.CreateSpeakerScoreParams{
 						Ballotid:      ballot.Ballotid,
 						Speakerid:     speakerID,
-						Speakerrank:   int32(position),
+						Speakerrank:   speakerRank,
 						Speakerpoints: speakerPoints,
 						Feedback:      feedback,
+						Teamid:        req.GetTeam().GetTeamId(),
+						Position:      int32(position),
 					})
 					if err != nil {
 						return nil, fmt.Errorf("failed to create speaker score: %v", err)
@@ -361,6 +381,29 @@ func (s *TeamService) UpdateTeam(ctx context.Context, req *debate_management.Upd
 		}
 	}
 
+	// Replace the break categories the team and its speakers are eligible for
+	if req.GetUpdateBreakCategories() {
+		team, err := queries.GetTeamByID(ctx, req.GetTeam().GetTeamId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team: %v", err)
+		}
+		err = replaceBreakCategories(ctx, queries, team.Tournamentid, team.Teamid, req.GetTeam().GetBreakCategoryIds(), req.GetTeam().GetSpeakers())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Replace whether the team needs an accessible room
+	if req.GetUpdateAccessibility() {
+		err = queries.UpdateTeamNeedsAccessibleRoom(ctx, models.UpdateTeamNeedsAccessibleRoomParams{
+			Teamid:              req.GetTeam().GetTeamId(),
+			Needsaccessibleroom: req.GetTeam().GetNeedsAccessibleRoom(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update team accessibility: %v", err)
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
@@ -386,6 +429,9 @@ func (s *TeamService) GetTeamsByTournament(ctx context.Context, req *debate_mana
 			return nil, fmt.Errorf("failed to get team members: %v", err)
 		}
 		result[i] = convertTeam(team, speakers)
+		if err := loadBreakCategories(ctx, queries, result[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	return result, nil
@@ -449,16 +495,19 @@ func convertTeam(dbTeam interface{}, dbSpeakers []models.GetTeamMembersRow) *deb
 	var teamId int32
 	var name string
 	var leagueName string
+	var needsAccessibleRoom bool
 
 	switch t := dbTeam.(type) {
 	case models.GetTeamByIDRow:
 		teamId = t.Teamid
 		name = t.Name
 		leagueName = "" // GetTeamByID doesn't return league name, so we leave it empty
+		needsAccessibleRoom = t.Needsaccessibleroom
 	case models.GetTeamsByTournamentRow:
 		teamId = t.Teamid
 		name = t.Name
 		leagueName = t.Leaguename
+		needsAccessibleRoom = t.Needsaccessibleroom
 	default:
 		// Handle unexpected type
 		return nil
@@ -472,10 +521,11 @@ func convertTeam(dbTeam interface{}, dbSpeakers []models.GetTeamMembersRow) *deb
 	}
 
 	return &debate_management.Team{
-		TeamId:     teamId,
-		Name:       name,
-		Speakers:   speakers,
-		LeagueName: leagueName,
+		TeamId:              teamId,
+		Name:                name,
+		Speakers:            speakers,
+		LeagueName:          leagueName,
+		NeedsAccessibleRoom: needsAccessibleRoom,
 	}
 }
 
@@ -33,17 +33,23 @@ type Notification struct {
 }
 
 type NotificationService struct {
-	db            *sql.DB
-	queries       *models.Queries
-	conn          *amqp.Connection
-	channel       *amqp.Channel
-	queue         amqp.Queue
-	emailSender   EmailSender
-	inAppSender   InAppSender
-	subscriptions map[int32][]chan *pb.Notification
-	mu            sync.RWMutex
+	db          *sql.DB
+	queries     *models.Queries
+	conn        *amqp.Connection
+	channel     *amqp.Channel
+	queue       amqp.Queue
+	emailSender EmailSender
+	inAppSender InAppSender
 }
 
+// subscriptions are shared by every NotificationService of the process, so a
+// notification sent through any of them reaches the SubscribeToNotifications
+// streams.
+var subscriptions = struct {
+	sync.RWMutex
+	channels map[int32][]chan *pb.Notification
+}{channels: make(map[int32][]chan *pb.Notification)}
+
 func NewNotificationService(db *sql.DB) (*NotificationService, error) {
 	queries := models.New(db)
 
@@ -95,14 +101,13 @@ func NewNotificationService(db *sql.DB) (*NotificationService, error) {
 	}
 
 	service := &NotificationService{
-		db:            db,
-		queries:       queries,
-		conn:          conn,
-		channel:       ch,
-		queue:         q,
-		emailSender:   emailSender,
-		inAppSender:   NewDBInAppSender(queries),
-		subscriptions: make(map[int32][]chan *pb.Notification),
+		db:          db,
+		queries:     queries,
+		conn:        conn,
+		channel:     ch,
+		queue:       q,
+		emailSender: emailSender,
+		inAppSender: NewDBInAppSender(queries),
 	}
 
 	go service.startConsumer()
@@ -257,18 +262,18 @@ func isValidEmail(email string) bool {
 }
 
 func (s *NotificationService) RegisterNotificationChannel(userID int32, ch chan *pb.Notification) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.subscriptions[userID] = append(s.subscriptions[userID], ch)
+	subscriptions.Lock()
+	defer subscriptions.Unlock()
+	subscriptions.channels[userID] = append(subscriptions.channels[userID], ch)
 }
 
 func (s *NotificationService) UnregisterNotificationChannel(userID int32, ch chan *pb.Notification) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	channels := s.subscriptions[userID]
+	subscriptions.Lock()
+	defer subscriptions.Unlock()
+	channels := subscriptions.channels[userID]
 	for i, c := range channels {
 		if c == ch {
-			s.subscriptions[userID] = append(channels[:i], channels[i+1:]...)
+			subscriptions.channels[userID] = append(channels[:i], channels[i+1:]...)
 			close(ch)
 			break
 		}
@@ -321,9 +326,9 @@ func (s *NotificationService) SendNotification(ctx context.Context, notification
 	}
 
 	// Send to subscribed channels
-	s.mu.RLock()
-	channels := s.subscriptions[int32(userID)]
-	s.mu.RUnlock()
+	subscriptions.RLock()
+	channels := subscriptions.channels[int32(userID)]
+	subscriptions.RUnlock()
 
 	protoNotification := &pb.Notification{
 		Id:      int32(createdNotification.Notificationid),
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 
 	"github.com/iRankHub/backend/internal/grpc/proto/tournament_management"
 	"github.com/iRankHub/backend/internal/models"
 	"github.com/iRankHub/backend/internal/utils"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
 )
 
 type FormatService struct {
@@ -33,6 +35,20 @@ func (s *FormatService) CreateTournamentFormat(ctx context.Context, req *tournam
 	if req.GetDescription() == "" {
 		return nil, fmt.Errorf("description is required")
 	}
+	teamsPerDebate, err := validateTeamsPerDebate(req.GetTeamsPerDebate())
+	if err != nil {
+		return nil, err
+	}
+	rules, err := validateScoringRules(req.GetScoringRules())
+	if err != nil {
+		return nil, err
+	}
+	teamTiebreaks, speakerTiebreaks, err := validateTiebreakChains(req.GetTiebreaks())
+	if err != nil {
+		return nil, err
+	}
+	teamTiebreaks = defaultTiebreaks(teamTiebreaks, pairing_algorithm.DefaultTeamTiebreaks)
+	speakerTiebreaks = defaultTiebreaks(speakerTiebreaks, pairing_algorithm.DefaultSpeakerTiebreaks)
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -42,9 +58,23 @@ func (s *FormatService) CreateTournamentFormat(ctx context.Context, req *tournam
 
 	queries := models.New(tx)
 	format, err := queries.CreateTournamentFormat(ctx, models.CreateTournamentFormatParams{
-		Formatname:      req.GetFormatName(),
-		Description:     sql.NullString{String: req.GetDescription(), Valid: true},
-		Speakersperteam: req.GetSpeakersPerTeam(),
+		Formatname:         req.GetFormatName(),
+		Description:        sql.NullString{String: req.GetDescription(), Valid: true},
+		Speakersperteam:    req.GetSpeakersPerTeam(),
+		Teamsperdebate:     teamsPerDebate,
+		Minspeakerpoints:   formatPoints(rules.GetMinSpeakerPoints()),
+		Maxspeakerpoints:   formatPoints(rules.GetMaxSpeakerPoints()),
+		Speakerpointstep:   formatPoints(rules.GetSpeakerPointStep()),
+		Allowlowpointwins:  rules.GetAllowLowPointWins(),
+		Replyspeeches:      rules.GetReplySpeeches(),
+		Minreplypoints:     formatPoints(rules.GetMinReplyPoints()),
+		Maxreplypoints:     formatPoints(rules.GetMaxReplyPoints()),
+		Tiehandling:        rules.GetTieHandling(),
+		Teamtiebreaks:      teamTiebreaks,
+		Speakertiebreaks:   speakerTiebreaks,
+		Ironspeeches:       rules.GetIronSpeeches(),
+		Substitutespeeches: rules.GetSubstituteSpeeches(),
+		Byeresult:          rules.GetByeResult(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tournament format: %v", err)
@@ -54,12 +84,7 @@ func (s *FormatService) CreateTournamentFormat(ctx context.Context, req *tournam
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	return &tournament_management.TournamentFormat{
-		FormatId:        int32(format.Formatid),
-		FormatName:      format.Formatname,
-		Description:     format.Description.String,
-		SpeakersPerTeam: format.Speakersperteam,
-	}, nil
+	return convertTournamentFormat(format), nil
 }
 
 func (s *FormatService) GetTournamentFormat(ctx context.Context, req *tournament_management.GetTournamentFormatRequest) (*tournament_management.TournamentFormat, error) {
@@ -72,12 +97,7 @@ func (s *FormatService) GetTournamentFormat(ctx context.Context, req *tournament
 		return nil, fmt.Errorf("failed to get tournament format: %v", err)
 	}
 
-	return &tournament_management.TournamentFormat{
-		FormatId:        int32(format.Formatid),
-		FormatName:      format.Formatname,
-		Description:     format.Description.String,
-		SpeakersPerTeam: format.Speakersperteam,
-	}, nil
+	return convertTournamentFormat(format), nil
 }
 
 func (s *FormatService) ListTournamentFormats(ctx context.Context, req *tournament_management.ListTournamentFormatsRequest) (*tournament_management.ListTournamentFormatsResponse, error) {
@@ -97,12 +117,7 @@ func (s *FormatService) ListTournamentFormats(ctx context.Context, req *tourname
 
 	formatResponses := make([]*tournament_management.TournamentFormat, len(formats))
 	for i, format := range formats {
-		formatResponses[i] = &tournament_management.TournamentFormat{
-			FormatId:        int32(format.Formatid),
-			FormatName:      format.Formatname,
-			Description:     format.Description.String,
-			SpeakersPerTeam: format.Speakersperteam,
-		}
+		formatResponses[i] = convertTournamentFormat(format)
 	}
 
 	return &tournament_management.ListTournamentFormatsResponse{
@@ -129,6 +144,20 @@ func (s *FormatService) UpdateTournamentFormat(ctx context.Context, req *tournam
 	if req.GetDescription() == "" {
 		return nil, fmt.Errorf("description is required")
 	}
+	teamsPerDebate, err := validateTeamsPerDebate(req.GetTeamsPerDebate())
+	if err != nil {
+		return nil, err
+	}
+	rules, err := validateScoringRules(req.GetScoringRules())
+	if err != nil {
+		return nil, err
+	}
+	teamTiebreaks, speakerTiebreaks, err := validateTiebreakChains(req.GetTiebreaks())
+	if err != nil {
+		return nil, err
+	}
+	teamTiebreaks = defaultTiebreaks(teamTiebreaks, pairing_algorithm.DefaultTeamTiebreaks)
+	speakerTiebreaks = defaultTiebreaks(speakerTiebreaks, pairing_algorithm.DefaultSpeakerTiebreaks)
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -138,10 +167,24 @@ func (s *FormatService) UpdateTournamentFormat(ctx context.Context, req *tournam
 
 	queries := models.New(tx)
 	updatedFormat, err := queries.UpdateTournamentFormatDetails(ctx, models.UpdateTournamentFormatDetailsParams{
-		Formatid:        int32(req.GetFormatId()),
-		Formatname:      req.GetFormatName(),
-		Description:     sql.NullString{String: req.GetDescription(), Valid: true},
-		Speakersperteam: req.GetSpeakersPerTeam(),
+		Formatid:           int32(req.GetFormatId()),
+		Formatname:         req.GetFormatName(),
+		Description:        sql.NullString{String: req.GetDescription(), Valid: true},
+		Speakersperteam:    req.GetSpeakersPerTeam(),
+		Teamsperdebate:     teamsPerDebate,
+		Minspeakerpoints:   formatPoints(rules.GetMinSpeakerPoints()),
+		Maxspeakerpoints:   formatPoints(rules.GetMaxSpeakerPoints()),
+		Speakerpointstep:   formatPoints(rules.GetSpeakerPointStep()),
+		Allowlowpointwins:  rules.GetAllowLowPointWins(),
+		Replyspeeches:      rules.GetReplySpeeches(),
+		Minreplypoints:     formatPoints(rules.GetMinReplyPoints()),
+		Maxreplypoints:     formatPoints(rules.GetMaxReplyPoints()),
+		Tiehandling:        rules.GetTieHandling(),
+		Teamtiebreaks:      teamTiebreaks,
+		Speakertiebreaks:   speakerTiebreaks,
+		Ironspeeches:       rules.GetIronSpeeches(),
+		Substitutespeeches: rules.GetSubstituteSpeeches(),
+		Byeresult:          rules.GetByeResult(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update tournament format details: %v", err)
@@ -151,12 +194,7 @@ func (s *FormatService) UpdateTournamentFormat(ctx context.Context, req *tournam
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	return &tournament_management.TournamentFormat{
-		FormatId:        int32(updatedFormat.Formatid),
-		FormatName:      updatedFormat.Formatname,
-		Description:     updatedFormat.Description.String,
-		SpeakersPerTeam: updatedFormat.Speakersperteam,
-	}, nil
+	return convertTournamentFormat(updatedFormat), nil
 }
 
 func (s *FormatService) DeleteTournamentFormat(ctx context.Context, req *tournament_management.DeleteTournamentFormatRequest) error {
@@ -204,3 +242,142 @@ func (s *FormatService) validateAdminRole(token string) error {
 
 	return nil
 }
+
+// validateTeamsPerDebate defaults an unset value to two-team debates and only
+// accepts two-team formats or four-team British Parliamentary.
+func validateTeamsPerDebate(teamsPerDebate int32) (int32, error) {
+	switch teamsPerDebate {
+	case 0:
+		return 2, nil
+	case 2, 4:
+		return teamsPerDebate, nil
+	default:
+		return 0, fmt.Errorf("teams per debate must be 2 or 4")
+	}
+}
+
+// validateScoringRules defaults unset scoring rules to 0-100 speaker points
+// with no reply speeches and tied team totals rejected. In the speaker tab
+// only the best of a speaker's two speeches in a debate counts by default,
+// and substitutes' speeches count. A bye counts for the team's average.
+func validateScoringRules(rules *tournament_management.ScoringRules) (*tournament_management.ScoringRules, error) {
+	if rules == nil {
+		return &tournament_management.ScoringRules{
+			MaxSpeakerPoints:   100,
+			MaxReplyPoints:     50,
+			TieHandling:        "reject",
+			IronSpeeches:       "best",
+			SubstituteSpeeches: "count",
+			ByeResult:          string(pairing_algorithm.ByeAverage),
+		}, nil
+	}
+
+	if rules.GetMinSpeakerPoints() < 0 || rules.GetMaxSpeakerPoints() > 999 || rules.GetMinSpeakerPoints() > rules.GetMaxSpeakerPoints() {
+		return nil, fmt.Errorf("speaker points must range from a minimum to a maximum between 0 and 999")
+	}
+	if rules.GetSpeakerPointStep() < 0 || rules.GetSpeakerPointStep() > rules.GetMaxSpeakerPoints()-rules.GetMinSpeakerPoints() {
+		return nil, fmt.Errorf("speaker point step must be 0 or fit within the speaker point range")
+	}
+	if rules.GetMinReplyPoints() < 0 || rules.GetMaxReplyPoints() > 999 || rules.GetMinReplyPoints() > rules.GetMaxReplyPoints() {
+		return nil, fmt.Errorf("reply points must range from a minimum to a maximum between 0 and 999")
+	}
+	if rules.GetReplySpeeches() && rules.GetMaxReplyPoints() == 0 {
+		return nil, fmt.Errorf("formats with reply speeches need a maximum reply score")
+	}
+
+	switch rules.GetTieHandling() {
+	case "":
+		rules.TieHandling = "reject"
+	case "reject", "verdict":
+	default:
+		return nil, fmt.Errorf("tie handling must be \"reject\" or \"verdict\"")
+	}
+
+	switch rules.GetIronSpeeches() {
+	case "":
+		rules.IronSpeeches = "best"
+	case "all", "best", "exclude":
+	default:
+		return nil, fmt.Errorf("iron speeches must be \"all\", \"best\" or \"exclude\"")
+	}
+
+	switch rules.GetSubstituteSpeeches() {
+	case "":
+		rules.SubstituteSpeeches = "count"
+	case "count", "exclude":
+	default:
+		return nil, fmt.Errorf("substitute speeches must be \"count\" or \"exclude\"")
+	}
+
+	switch pairing_algorithm.ByeResult(rules.GetByeResult()) {
+	case "":
+		rules.ByeResult = string(pairing_algorithm.ByeAverage)
+	case pairing_algorithm.ByeAverage, pairing_algorithm.ByeWin, pairing_algorithm.ByeNone:
+	default:
+		return nil, fmt.Errorf("bye result must be \"average\", \"win\" or \"none\"")
+	}
+	return rules, nil
+}
+
+// validateTiebreakChains checks the team and speaker tiebreak chains. An empty
+// chain is returned empty for the caller to default.
+func validateTiebreakChains(chains *tournament_management.TiebreakChains) ([]string, []string, error) {
+	if len(chains.GetTeam()) > 0 {
+		if _, err := pairing_algorithm.ParseTiebreakChain(chains.GetTeam(), false); err != nil {
+			return nil, nil, fmt.Errorf("invalid team tiebreaks: %v", err)
+		}
+	}
+	if len(chains.GetSpeaker()) > 0 {
+		if _, err := pairing_algorithm.ParseTiebreakChain(chains.GetSpeaker(), true); err != nil {
+			return nil, nil, fmt.Errorf("invalid speaker tiebreaks: %v", err)
+		}
+	}
+	return chains.GetTeam(), chains.GetSpeaker(), nil
+}
+
+func defaultTiebreaks(names []string, defaults []pairing_algorithm.Tiebreak) []string {
+	if len(names) > 0 {
+		return names
+	}
+	names = make([]string, len(defaults))
+	for i, tiebreak := range defaults {
+		names[i] = string(tiebreak)
+	}
+	return names
+}
+
+func convertTournamentFormat(format models.Tournamentformat) *tournament_management.TournamentFormat {
+	return &tournament_management.TournamentFormat{
+		FormatId:        int32(format.Formatid),
+		FormatName:      format.Formatname,
+		Description:     format.Description.String,
+		SpeakersPerTeam: format.Speakersperteam,
+		TeamsPerDebate:  format.Teamsperdebate,
+		ScoringRules: &tournament_management.ScoringRules{
+			MinSpeakerPoints:   parsePoints(format.Minspeakerpoints),
+			MaxSpeakerPoints:   parsePoints(format.Maxspeakerpoints),
+			SpeakerPointStep:   parsePoints(format.Speakerpointstep),
+			AllowLowPointWins:  format.Allowlowpointwins,
+			ReplySpeeches:      format.Replyspeeches,
+			MinReplyPoints:     parsePoints(format.Minreplypoints),
+			MaxReplyPoints:     parsePoints(format.Maxreplypoints),
+			TieHandling:        format.Tiehandling,
+			IronSpeeches:       format.Ironspeeches,
+			SubstituteSpeeches: format.Substitutespeeches,
+			ByeResult:          format.Byeresult,
+		},
+		Tiebreaks: &tournament_management.TiebreakChains{
+			Team:    format.Teamtiebreaks,
+			Speaker: format.Speakertiebreaks,
+		},
+	}
+}
+
+func formatPoints(points float64) string {
+	return fmt.Sprintf("%.2f", points)
+}
+
+func parsePoints(points string) float64 {
+	value, _ := strconv.ParseFloat(points, 64)
+	return value
+}
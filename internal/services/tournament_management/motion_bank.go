@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/pqtype"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/tournament_management"
+	"github.com/iRankHub/backend/internal/models"
+)
+
+// CreateBankMotion adds a motion with its tags to the bank. The bank holds
+// motions not yet released, so only admins can use it.
+func (s *MotionService) CreateBankMotion(ctx context.Context, req *tournament_management.CreateBankMotionRequest) (*tournament_management.BankMotion, error) {
+	claims, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := claims["user_id"].(float64)
+
+	difficulty, tags, err := validateBankMotion(req.GetMotion())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	motion, err := queries.CreateBankMotion(ctx, models.CreateBankMotionParams{
+		Text:       req.GetMotion().GetText(),
+		Infoslide:  req.GetMotion().GetInfoSlide(),
+		Difficulty: difficulty,
+		Createdby:  sql.NullInt32{Int32: int32(userID), Valid: userID != 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bank motion: %v", err)
+	}
+
+	if err := replaceBankMotionTags(ctx, queries, motion.Motionid, tags); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return &tournament_management.BankMotion{
+		MotionId:   motion.Motionid,
+		Text:       motion.Text,
+		InfoSlide:  motion.Infoslide,
+		Difficulty: motion.Difficulty,
+		Tags:       tags,
+	}, nil
+}
+
+// UpdateBankMotion replaces a motion's text, info slide, difficulty and tags.
+// The text of a motion attached to a round is fixed, so its side balance keeps
+// describing the motion that was debated.
+func (s *MotionService) UpdateBankMotion(ctx context.Context, req *tournament_management.UpdateBankMotionRequest) (*tournament_management.BankMotion, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	difficulty, tags, err := validateBankMotion(req.GetMotion())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	existing, err := queries.GetBankMotion(ctx, req.GetMotion().GetMotionId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank motion: %v", err)
+	}
+	uses, err := queries.CountBankMotionUses(ctx, existing.Motionid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bank motion uses: %v", err)
+	}
+	if uses > 0 && existing.Text != req.GetMotion().GetText() {
+		return nil, fmt.Errorf("motion %d is attached to %d rounds, so its text cannot be changed", existing.Motionid, uses)
+	}
+
+	motion, err := queries.UpdateBankMotion(ctx, models.UpdateBankMotionParams{
+		Motionid:   existing.Motionid,
+		Text:       req.GetMotion().GetText(),
+		Infoslide:  req.GetMotion().GetInfoSlide(),
+		Difficulty: difficulty,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bank motion: %v", err)
+	}
+
+	if err := replaceBankMotionTags(ctx, queries, motion.Motionid, tags); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return &tournament_management.BankMotion{
+		MotionId:   motion.Motionid,
+		Text:       motion.Text,
+		InfoSlide:  motion.Infoslide,
+		Difficulty: motion.Difficulty,
+		Tags:       tags,
+		TimesUsed:  int32(uses),
+	}, nil
+}
+
+// DeleteBankMotion removes a motion from the bank. Motions attached to a round
+// are kept, since the round's side balance refers to them.
+func (s *MotionService) DeleteBankMotion(ctx context.Context, req *tournament_management.DeleteBankMotionRequest) (bool, string, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return false, "", err
+	}
+
+	queries := models.New(s.db)
+	deleted, err := queries.DeleteBankMotion(ctx, req.GetMotionId())
+	if err != nil {
+		return false, "", fmt.Errorf("failed to delete bank motion: %v", err)
+	}
+	if deleted == 0 {
+		return false, "Motion cannot be deleted because it does not exist or is attached to a round", nil
+	}
+
+	return true, "Motion deleted successfully", nil
+}
+
+// ListBankMotions returns the bank's motions with how often proposition won
+// them, filtered by tag, difficulty and text, and optionally without the ones
+// a league has already used.
+func (s *MotionService) ListBankMotions(ctx context.Context, req *tournament_management.ListBankMotionsRequest) (*tournament_management.ListBankMotionsResponse, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+	if req.GetExcludeUsedInLeague() && req.GetLeagueId() == 0 {
+		return nil, fmt.Errorf("league_id is required to exclude the motions a league has used")
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.ListBankMotions(ctx, models.ListBankMotionsParams{
+		LeagueID:            req.GetLeagueId(),
+		Tag:                 strings.TrimSpace(req.GetTag()),
+		Difficulty:          req.GetDifficulty(),
+		SearchQuery:         req.GetSearchQuery(),
+		ExcludeUsedInLeague: req.GetExcludeUsedInLeague(),
+		BalancedFirst:       req.GetBalancedFirst(),
+		PageOffset:          req.GetPageToken(),
+		PageSize:            req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bank motions: %v", err)
+	}
+
+	response := &tournament_management.ListBankMotionsResponse{
+		Motions:       make([]*tournament_management.BankMotion, len(rows)),
+		NextPageToken: req.GetPageToken() + req.GetPageSize(),
+	}
+	for i, row := range rows {
+		response.Motions[i] = &tournament_management.BankMotion{
+			MotionId:        row.Motionid,
+			Text:            row.Text,
+			InfoSlide:       row.Infoslide,
+			Difficulty:      row.Difficulty,
+			Tags:            row.Tags,
+			TimesUsed:       int32(row.Timesused),
+			UsedInLeague:    row.Usedinleague,
+			Debates:         row.Debates,
+			PropositionWins: row.Propositionwins,
+		}
+		if row.Debates > 0 {
+			response.Motions[i].PropositionWinRate = float64(row.Propositionwins) / float64(row.Debates)
+		}
+	}
+	return response, nil
+}
+
+// AttachBankMotion sets a round's motion to a bank motion, replacing the
+// motion the round had, and links the round to the bank motion for its side
+// balance. Released motions cannot be replaced.
+func (s *MotionService) AttachBankMotion(ctx context.Context, req *tournament_management.AttachBankMotionRequest) (*tournament_management.Motion, error) {
+	if _, err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	tournament, err := queries.GetTournamentByID(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+
+	rounds := tournament.Numberofpreliminaryrounds
+	if req.GetIsEliminationRound() {
+		rounds = tournament.Numberofeliminationrounds
+	}
+	if req.GetRoundNumber() < 1 || req.GetRoundNumber() > rounds {
+		return nil, fmt.Errorf("invalid round number: %d", req.GetRoundNumber())
+	}
+
+	roundName := motionRoundName(req.GetRoundNumber(), req.GetIsEliminationRound())
+	releases, err := queries.GetMotionReleases(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get motion releases: %v", err)
+	}
+	for _, release := range releases {
+		if release.Roundnumber == req.GetRoundNumber() && release.Iseliminationround == req.GetIsEliminationRound() {
+			return nil, fmt.Errorf("the motion of %s is already released", roundName)
+		}
+	}
+
+	bankMotion, err := queries.GetBankMotion(ctx, req.GetMotionId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank motion: %v", err)
+	}
+
+	motion := storedMotion{
+		Text:        bankMotion.Text,
+		InfoSlide:   bankMotion.Infoslide,
+		RoundNumber: req.GetRoundNumber(),
+	}
+	motionsJSON, err := setRoundMotion(tournament.Motions, motion, req.GetIsEliminationRound())
+	if err != nil {
+		return nil, err
+	}
+	err = queries.UpdateTournamentMotions(ctx, models.UpdateTournamentMotionsParams{
+		Tournamentid: req.GetTournamentId(),
+		Motions:      pqtype.NullRawMessage{RawMessage: motionsJSON, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tournament motions: %v", err)
+	}
+
+	err = queries.UpsertRoundMotion(ctx, models.UpsertRoundMotionParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsEliminationRound(),
+		Motionid:           bankMotion.Motionid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach bank motion: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return &tournament_management.Motion{
+		Text:        motion.Text,
+		InfoSlide:   motion.InfoSlide,
+		RoundNumber: motion.RoundNumber,
+	}, nil
+}
+
+// storedMotion is a motion as the tournament's Motions JSON holds it.
+type storedMotion struct {
+	Text        string `json:"text"`
+	InfoSlide   string `json:"infoSlide"`
+	RoundNumber int32  `json:"roundNumber"`
+}
+
+// setRoundMotion returns the tournament's motions with the motion of the
+// round replaced, or added when the round has none.
+func setRoundMotion(motionsJSON pqtype.NullRawMessage, motion storedMotion, isElimination bool) ([]byte, error) {
+	var motions struct {
+		Preliminary []storedMotion `json:"preliminary"`
+		Elimination []storedMotion `json:"elimination"`
+	}
+	if motionsJSON.Valid {
+		if err := json.Unmarshal(motionsJSON.RawMessage, &motions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal motions: %v", err)
+		}
+	}
+
+	roundMotions := &motions.Preliminary
+	if isElimination {
+		roundMotions = &motions.Elimination
+	}
+	replaced := false
+	for i := range *roundMotions {
+		if (*roundMotions)[i].RoundNumber == motion.RoundNumber {
+			(*roundMotions)[i] = motion
+			replaced = true
+		}
+	}
+	if !replaced {
+		*roundMotions = append(*roundMotions, motion)
+		sort.SliceStable(*roundMotions, func(i, j int) bool {
+			return (*roundMotions)[i].RoundNumber < (*roundMotions)[j].RoundNumber
+		})
+	}
+
+	if motions.Preliminary == nil {
+		motions.Preliminary = []storedMotion{}
+	}
+	if motions.Elimination == nil {
+		motions.Elimination = []storedMotion{}
+	}
+	result, err := json.Marshal(motions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal motions: %v", err)
+	}
+	return result, nil
+}
+
+// validateBankMotion checks a bank motion and returns its difficulty, medium
+// when unset, and its tags lowercased without duplicates.
+func validateBankMotion(motion *tournament_management.BankMotion) (string, []string, error) {
+	if strings.TrimSpace(motion.GetText()) == "" {
+		return "", nil, fmt.Errorf("motion text is required")
+	}
+
+	difficulty := motion.GetDifficulty()
+	switch difficulty {
+	case "":
+		difficulty = "medium"
+	case "easy", "medium", "hard":
+	default:
+		return "", nil, fmt.Errorf("invalid difficulty %q: must be easy, medium or hard", difficulty)
+	}
+
+	tags := make([]string, 0, len(motion.GetTags()))
+	seen := make(map[string]bool)
+	for _, tag := range motion.GetTags() {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if len(tag) > 50 {
+			return "", nil, fmt.Errorf("tag %q is longer than 50 characters", tag)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+
+	return difficulty, tags, nil
+}
+
+func replaceBankMotionTags(ctx context.Context, queries *models.Queries, motionID int32, tags []string) error {
+	if err := queries.DeleteBankMotionTags(ctx, motionID); err != nil {
+		return fmt.Errorf("failed to delete bank motion tags: %v", err)
+	}
+	for _, tag := range tags {
+		err := queries.AddBankMotionTag(ctx, models.AddBankMotionTagParams{
+			Motionid: motionID,
+			Tag:      tag,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add bank motion tag: %v", err)
+		}
+	}
+	return nil
+}
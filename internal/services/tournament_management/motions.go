@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/tournament_management"
+	"github.com/iRankHub/backend/internal/models"
+	notifications "github.com/iRankHub/backend/internal/services/notification"
+	"github.com/iRankHub/backend/internal/utils"
+	notification "github.com/iRankHub/backend/internal/utils/notifications"
+)
+
+// defaultPrepMinutes is the prep time of a released motion when the release
+// does not set one.
+const defaultPrepMinutes = 15
+
+type MotionService struct {
+	db *sql.DB
+}
+
+func NewMotionService(db *sql.DB) *MotionService {
+	return &MotionService{db: db}
+}
+
+// ReleaseMotion makes a round's motion visible to everyone and starts its prep
+// time. The tournament's students and volunteers are notified in-app.
+func (s *MotionService) ReleaseMotion(ctx context.Context, req *tournament_management.ReleaseMotionRequest) (*tournament_management.Motion, error) {
+	claims, err := s.validateAdminRole(req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := claims["user_id"].(float64)
+
+	prepMinutes := req.GetPrepMinutes()
+	if prepMinutes < 0 {
+		return nil, fmt.Errorf("prep time cannot be negative")
+	}
+	if prepMinutes == 0 {
+		prepMinutes = defaultPrepMinutes
+	}
+
+	queries := models.New(s.db)
+	tournament, err := queries.GetTournamentByID(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+
+	roundName := motionRoundName(req.GetRoundNumber(), req.GetIsEliminationRound())
+	var motion *tournament_management.Motion
+	if tournament.Motions.Valid {
+		motions, err := motionsJSONToProto(string(tournament.Motions.RawMessage))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert tournament motions: %v", err)
+		}
+		roundMotions := motions.GetPreliminaryMotions()
+		if req.GetIsEliminationRound() {
+			roundMotions = motions.GetEliminationMotions()
+		}
+		for _, m := range roundMotions {
+			if m.GetRoundNumber() == req.GetRoundNumber() {
+				motion = m
+				break
+			}
+		}
+	}
+	if motion == nil {
+		return nil, fmt.Errorf("%s has no motion", roundName)
+	}
+
+	releasedAt := time.Now()
+	release, err := queries.CreateMotionRelease(ctx, models.CreateMotionReleaseParams{
+		Tournamentid:       req.GetTournamentId(),
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsEliminationRound(),
+		Releasedat:         releasedAt,
+		Prependsat:         releasedAt.Add(time.Duration(prepMinutes) * time.Minute),
+		Releasedby:         sql.NullInt32{Int32: int32(userID), Valid: userID != 0},
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("the motion of %s is already released", roundName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to release motion: %v", err)
+	}
+
+	motion.IsReleased = true
+	motion.ReleasedAt = release.Releasedat.Format(scheduleTimeLayout)
+	motion.PrepEndsAt = release.Prependsat.Format(scheduleTimeLayout)
+
+	// Notify the participants asynchronously
+	go func() {
+		bgCtx := context.Background()
+		userIDs, err := models.New(s.db).GetTournamentParticipantUserIDs(bgCtx, req.GetTournamentId())
+		if err != nil {
+			log.Printf("Failed to get tournament participants: %v", err)
+			return
+		}
+
+		notificationService, err := notifications.NewNotificationService(s.db)
+		if err != nil {
+			log.Printf("Failed to create notification service: %v", err)
+			return
+		}
+
+		if err := notification.SendMotionReleaseNotifications(notificationService, userIDs, tournament.Name, roundName, motion.GetText(), release.Releasedat, release.Prependsat); err != nil {
+			log.Printf("Failed to send motion release notifications: %v", err)
+		}
+	}()
+
+	return motion, nil
+}
+
+// SearchMotionArchive searches the motions of the tournaments that have ended,
+// all of which are public whether or not they were released.
+func (s *MotionService) SearchMotionArchive(ctx context.Context, req *tournament_management.SearchMotionArchiveRequest) (*tournament_management.SearchMotionArchiveResponse, error) {
+	if err := validateAuthentication(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	rows, err := queries.SearchMotionArchive(ctx, models.SearchMotionArchiveParams{
+		SearchQuery: req.GetSearchQuery(),
+		PageSize:    req.GetPageSize(),
+		PageOffset:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search motion archive: %v", err)
+	}
+
+	response := &tournament_management.SearchMotionArchiveResponse{
+		Motions:       make([]*tournament_management.ArchivedMotion, len(rows)),
+		NextPageToken: req.GetPageToken() + req.GetPageSize(),
+	}
+	for i, row := range rows {
+		response.Motions[i] = &tournament_management.ArchivedMotion{
+			TournamentId:       row.Tournamentid,
+			TournamentName:     row.Tournamentname,
+			StartDate:          row.Startdate.Format("2006-01-02 15:04"),
+			RoundNumber:        row.Roundnumber,
+			IsEliminationRound: row.Iseliminationround,
+			Text:               row.Motiontext,
+			InfoSlide:          row.Infoslide,
+		}
+	}
+	return response, nil
+}
+
+func (s *MotionService) validateAdminRole(token string) (map[string]interface{}, error) {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return nil, fmt.Errorf("unauthorized: only admins can manage motions")
+	}
+
+	return claims, nil
+}
+
+// applyMotionReleases marks the released motions with their release and prep
+// times. Unless showUnreleased is set, the motions not yet released are left
+// out.
+func applyMotionReleases(motions *tournament_management.TournamentMotions, releases []models.GetMotionReleasesRow, showUnreleased bool) *tournament_management.TournamentMotions {
+	type roundKey struct {
+		number        int32
+		isElimination bool
+	}
+	released := make(map[roundKey]models.GetMotionReleasesRow, len(releases))
+	for _, release := range releases {
+		released[roundKey{release.Roundnumber, release.Iseliminationround}] = release
+	}
+
+	apply := func(roundMotions []*tournament_management.Motion, isElimination bool) []*tournament_management.Motion {
+		kept := make([]*tournament_management.Motion, 0, len(roundMotions))
+		for _, motion := range roundMotions {
+			release, ok := released[roundKey{motion.GetRoundNumber(), isElimination}]
+			if ok {
+				motion.IsReleased = true
+				motion.ReleasedAt = release.Releasedat.Format(scheduleTimeLayout)
+				motion.PrepEndsAt = release.Prependsat.Format(scheduleTimeLayout)
+			}
+			if ok || showUnreleased {
+				kept = append(kept, motion)
+			}
+		}
+		return kept
+	}
+
+	return &tournament_management.TournamentMotions{
+		PreliminaryMotions: apply(motions.GetPreliminaryMotions(), false),
+		EliminationMotions: apply(motions.GetEliminationMotions(), true),
+	}
+}
+
+func motionRoundName(roundNumber int32, isElimination bool) string {
+	if isElimination {
+		return fmt.Sprintf("elimination round %d", roundNumber)
+	}
+	return fmt.Sprintf("preliminary round %d", roundNumber)
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/iRankHub/backend/internal/grpc/proto/tournament_management"
+	"github.com/iRankHub/backend/internal/models"
+	"github.com/iRankHub/backend/internal/utils"
+	"github.com/iRankHub/backend/pkg/pairing_algorithm"
+)
+
+// scheduleTimeLayout is how the timetable's times are read and written, as
+// the tournament dates are.
+const scheduleTimeLayout = "2006-01-02 15:04"
+
+type ScheduleService struct {
+	db *sql.DB
+}
+
+func NewScheduleService(db *sql.DB) *ScheduleService {
+	return &ScheduleService{db: db}
+}
+
+// GetTournamentSchedule returns a tournament's timetable to any signed-in
+// user. Until it is saved, when the first round is drawn or an admin edits
+// it, the timetable is planned from the tournament's settings.
+func (s *ScheduleService) GetTournamentSchedule(ctx context.Context, req *tournament_management.GetTournamentScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	if err := validateAuthentication(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	queries := models.New(s.db)
+	settings, stored, err := loadSchedule(ctx, queries, req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stored) == int(settings.Numberofpreliminaryrounds+settings.Numberofeliminationrounds) {
+		response := scheduleToProto(settings, nil, nil)
+		for _, slot := range stored {
+			response.Rounds = append(response.Rounds, &tournament_management.RoundScheduleSlot{
+				RoundNumber:        slot.Roundnumber,
+				IsEliminationRound: slot.Iseliminationround,
+				StartTime:          slot.Starttime.Format(scheduleTimeLayout),
+				EndTime:            slot.Endtime.Format(scheduleTimeLayout),
+				IsPinned:           slot.Ispinned,
+			})
+		}
+		return response, nil
+	}
+
+	slots, err := planSchedule(settings, stored, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return scheduleToProto(settings, slots, stored), nil
+}
+
+// UpdateTournamentSchedule changes the settings a tournament is timetabled
+// with and plans its timetable again. Pinned rounds and rounds that have
+// started keep their times.
+func (s *ScheduleService) UpdateTournamentSchedule(ctx context.Context, req *tournament_management.UpdateTournamentScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	if err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	params, err := scheduleSettingsParams(req.GetTournamentId(), req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	if err := queries.UpdateTournamentScheduleSettings(ctx, params); err != nil {
+		return nil, fmt.Errorf("failed to update schedule settings: %v", err)
+	}
+
+	response, err := scheduleTournament(ctx, queries, req.GetTournamentId(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return response, nil
+}
+
+// UpdateRoundSchedule pins a round to the given times, or hands it back to the
+// planner when unpinned, and plans the rest of the timetable around it.
+func (s *ScheduleService) UpdateRoundSchedule(ctx context.Context, req *tournament_management.UpdateRoundScheduleRequest) (*tournament_management.TournamentScheduleResponse, error) {
+	if err := s.validateAdminRole(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	queries := models.New(s.db).WithTx(tx)
+
+	settings, err := queries.GetTournamentScheduleSettings(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+
+	rounds := settings.Numberofpreliminaryrounds
+	if req.GetIsEliminationRound() {
+		rounds = settings.Numberofeliminationrounds
+	}
+	if req.GetRoundNumber() < 1 || req.GetRoundNumber() > rounds {
+		return nil, fmt.Errorf("invalid round number: %d", req.GetRoundNumber())
+	}
+
+	update := models.GetTournamentScheduleRow{
+		Roundnumber:        req.GetRoundNumber(),
+		Iseliminationround: req.GetIsEliminationRound(),
+		Ispinned:           !req.GetUnpin(),
+	}
+	if update.Ispinned {
+		update.Starttime, err = time.Parse(scheduleTimeLayout, req.GetStartTime())
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time format: %v", err)
+		}
+		update.Endtime = update.Starttime.Add(time.Duration(settings.Roundminutes) * time.Minute)
+		if req.GetEndTime() != "" {
+			update.Endtime, err = time.Parse(scheduleTimeLayout, req.GetEndTime())
+			if err != nil {
+				return nil, fmt.Errorf("invalid end time format: %v", err)
+			}
+		}
+		if !update.Starttime.Before(update.Endtime) {
+			return nil, fmt.Errorf("the round must start before it ends")
+		}
+	}
+
+	response, err := scheduleTournament(ctx, queries, req.GetTournamentId(), &update)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return response, nil
+}
+
+// ScheduleTournament plans a tournament's timetable around its pinned rounds
+// and the rounds that have started, saves it and stamps the debates already
+// drawn with their round's times.
+func ScheduleTournament(ctx context.Context, queries *models.Queries, tournamentID int32) error {
+	_, err := scheduleTournament(ctx, queries, tournamentID, nil)
+	return err
+}
+
+// scheduleTournament plans and saves a tournament's timetable, first
+// replacing the stored slot of the round in update when given.
+func scheduleTournament(ctx context.Context, queries *models.Queries, tournamentID int32, update *models.GetTournamentScheduleRow) (*tournament_management.TournamentScheduleResponse, error) {
+	settings, stored, err := loadSchedule(ctx, queries, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update != nil {
+		replaced := false
+		for i, slot := range stored {
+			if slot.Roundnumber == update.Roundnumber && slot.Iseliminationround == update.Iseliminationround {
+				if !update.Ispinned {
+					// An unpinned round keeps its times only if it has started
+					update.Starttime, update.Endtime = slot.Starttime, slot.Endtime
+				}
+				stored[i] = *update
+				replaced = true
+			}
+		}
+		if !replaced && update.Ispinned {
+			stored = append(stored, *update)
+		}
+	}
+
+	slots, err := planSchedule(settings, stored, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := pinnedRounds(stored)
+	if err := queries.DeleteTournamentSchedule(ctx, tournamentID); err != nil {
+		return nil, fmt.Errorf("failed to clear schedule: %v", err)
+	}
+	for _, slot := range slots {
+		err := queries.CreateRoundSchedule(ctx, models.CreateRoundScheduleParams{
+			Tournamentid:       tournamentID,
+			Roundnumber:        int32(slot.RoundNumber),
+			Iseliminationround: slot.IsElimination,
+			Starttime:          slot.Start,
+			Endtime:            slot.End,
+			Ispinned:           pinned[scheduleKey{int32(slot.RoundNumber), slot.IsElimination}],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to save %s: %v", slot.Name(), err)
+		}
+	}
+
+	if err := queries.StampDebateTimes(ctx, tournamentID); err != nil {
+		return nil, fmt.Errorf("failed to update debate times: %v", err)
+	}
+
+	return scheduleToProto(settings, slots, stored), nil
+}
+
+func loadSchedule(ctx context.Context, queries *models.Queries, tournamentID int32) (models.GetTournamentScheduleSettingsRow, []models.GetTournamentScheduleRow, error) {
+	settings, err := queries.GetTournamentScheduleSettings(ctx, tournamentID)
+	if err != nil {
+		return settings, nil, fmt.Errorf("failed to get tournament: %v", err)
+	}
+
+	stored, err := queries.GetTournamentSchedule(ctx, tournamentID)
+	if err != nil {
+		return settings, nil, fmt.Errorf("failed to get schedule: %v", err)
+	}
+
+	return settings, stored, nil
+}
+
+// planSchedule plans a tournament's rounds around the stored slots that are
+// pinned or had started by now.
+func planSchedule(settings models.GetTournamentScheduleSettingsRow, stored []models.GetTournamentScheduleRow, now time.Time) ([]pairing_algorithm.RoundSlot, error) {
+	scheduleSettings, err := convertScheduleSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixed []pairing_algorithm.RoundSlot
+	for _, slot := range stored {
+		if slot.Ispinned || slot.Starttime.Before(now) {
+			fixed = append(fixed, pairing_algorithm.RoundSlot{
+				RoundNumber:   int(slot.Roundnumber),
+				IsElimination: slot.Iseliminationround,
+				Start:         slot.Starttime,
+				End:           slot.Endtime,
+			})
+		}
+	}
+
+	slots, err := pairing_algorithm.ScheduleRounds(scheduleSettings, int(settings.Numberofpreliminaryrounds), int(settings.Numberofeliminationrounds), fixed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan schedule: %v", err)
+	}
+	return slots, nil
+}
+
+type scheduleKey struct {
+	roundNumber   int32
+	isElimination bool
+}
+
+func pinnedRounds(stored []models.GetTournamentScheduleRow) map[scheduleKey]bool {
+	pinned := make(map[scheduleKey]bool)
+	for _, slot := range stored {
+		if slot.Ispinned {
+			pinned[scheduleKey{slot.Roundnumber, slot.Iseliminationround}] = true
+		}
+	}
+	return pinned
+}
+
+// scheduleSettingsParams validates the settings a tournament is to be
+// timetabled with. A lunch slot needs both its start and its length.
+func scheduleSettingsParams(tournamentID int32, settings *tournament_management.ScheduleSettings) (models.UpdateTournamentScheduleSettingsParams, error) {
+	params := models.UpdateTournamentScheduleSettingsParams{
+		Tournamentid: tournamentID,
+		Roundminutes: settings.GetRoundMinutes(),
+		Breakminutes: settings.GetBreakMinutes(),
+		Column4:      settings.GetDayStartTime(),
+		Column5:      settings.GetDayEndTime(),
+		Column6:      settings.GetLunchStartTime(),
+		Lunchminutes: settings.GetLunchMinutes(),
+	}
+	if params.Column6 == "" {
+		params.Lunchminutes = 0
+	}
+
+	_, err := convertScheduleSettings(models.GetTournamentScheduleSettingsRow{
+		Roundminutes:   params.Roundminutes,
+		Breakminutes:   params.Breakminutes,
+		Daystarttime:   params.Column4,
+		Dayendtime:     params.Column5,
+		Lunchstarttime: params.Column6,
+		Lunchminutes:   params.Lunchminutes,
+	})
+	if err != nil {
+		return params, fmt.Errorf("invalid schedule settings: %v", err)
+	}
+	return params, nil
+}
+
+func convertScheduleSettings(settings models.GetTournamentScheduleSettingsRow) (pairing_algorithm.ScheduleSettings, error) {
+	converted := pairing_algorithm.ScheduleSettings{
+		Start:       settings.Startdate,
+		End:         settings.Enddate,
+		RoundLength: time.Duration(settings.Roundminutes) * time.Minute,
+		Break:       time.Duration(settings.Breakminutes) * time.Minute,
+	}
+
+	var err error
+	if converted.DayStart, err = parseTimeOfDay(settings.Daystarttime); err != nil {
+		return converted, err
+	}
+	if converted.DayEnd, err = parseTimeOfDay(settings.Dayendtime); err != nil {
+		return converted, err
+	}
+	if settings.Lunchstarttime != "" && settings.Lunchminutes > 0 {
+		if converted.LunchStart, err = parseTimeOfDay(settings.Lunchstarttime); err != nil {
+			return converted, err
+		}
+		converted.Lunch = time.Duration(settings.Lunchminutes) * time.Minute
+	}
+
+	return converted, pairing_algorithm.ValidateScheduleSettings(converted)
+}
+
+// parseTimeOfDay reads a "15:04" time of day as the time since midnight.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: expected HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func scheduleToProto(settings models.GetTournamentScheduleSettingsRow, slots []pairing_algorithm.RoundSlot, stored []models.GetTournamentScheduleRow) *tournament_management.TournamentScheduleResponse {
+	response := &tournament_management.TournamentScheduleResponse{
+		TournamentId: settings.Tournamentid,
+		Settings: &tournament_management.ScheduleSettings{
+			RoundMinutes:   settings.Roundminutes,
+			BreakMinutes:   settings.Breakminutes,
+			DayStartTime:   settings.Daystarttime,
+			DayEndTime:     settings.Dayendtime,
+			LunchStartTime: settings.Lunchstarttime,
+			LunchMinutes:   settings.Lunchminutes,
+		},
+	}
+
+	pinned := pinnedRounds(stored)
+	for _, slot := range slots {
+		response.Rounds = append(response.Rounds, &tournament_management.RoundScheduleSlot{
+			RoundNumber:        int32(slot.RoundNumber),
+			IsEliminationRound: slot.IsElimination,
+			StartTime:          slot.Start.Format(scheduleTimeLayout),
+			EndTime:            slot.End.Format(scheduleTimeLayout),
+			IsPinned:           pinned[scheduleKey{int32(slot.RoundNumber), slot.IsElimination}],
+		})
+	}
+	return response
+}
+
+func (s *ScheduleService) validateAdminRole(token string) error {
+	claims, err := utils.ValidateToken(token)
+	if err != nil {
+		return fmt.Errorf("authentication failed: %v", err)
+	}
+
+	userRole, ok := claims["user_role"].(string)
+	if !ok || userRole != "admin" {
+		return fmt.Errorf("unauthorized: only admins can edit the schedule")
+	}
+
+	return nil
+}
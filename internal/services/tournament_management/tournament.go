@@ -57,6 +57,12 @@ func (s *TournamentService) CreateTournament(ctx context.Context, req *tournamen
 		return nil, fmt.Errorf("invalid motions: %v", err)
 	}
 
+	// Tiebreak chains left empty use the format's
+	teamTiebreaks, speakerTiebreaks, err := validateTiebreakChains(req.GetTiebreaks())
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %v", err)
@@ -128,6 +134,8 @@ func (s *TournamentService) CreateTournament(ctx context.Context, req *tournamen
 		Judgesperdebateelimination: int32(req.GetJudgesPerDebateElimination()),
 		Tournamentfee:              fmt.Sprintf("%.2f", req.GetTournamentFee()),
 		Motions:                    pqtype.NullRawMessage{RawMessage: motionsJSON, Valid: true},
+		Teamtiebreaks:              teamTiebreaks,
+		Speakertiebreaks:           speakerTiebreaks,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tournament: %v", err)
@@ -184,8 +192,9 @@ func (s *TournamentService) CreateTournament(ctx context.Context, req *tournamen
 }
 
 func (s *TournamentService) GetTournament(ctx context.Context, req *tournament_management.GetTournamentRequest) (*tournament_management.Tournament, error) {
-	if err := s.validateAuthentication(req.GetToken()); err != nil {
-		return nil, err
+	claims, err := utils.ValidateToken(req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %v", err)
 	}
 	queries := models.New(s.db)
 	tournament, err := queries.GetTournamentByID(ctx, req.GetTournamentId())
@@ -195,13 +204,20 @@ func (s *TournamentService) GetTournament(ctx context.Context, req *tournament_m
 
 	protoTournament := tournamentRowToProto(tournament)
 
-	// Convert JSONB motions to proto format
+	// Convert JSONB motions to proto format. Until the tournament ends, only
+	// admins see the motions not yet released.
 	if tournament.Motions.Valid {
 		motions, err := motionsJSONToProto(string(tournament.Motions.RawMessage))
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert tournament motions: %v", err)
 		}
-		protoTournament.Motions = motions
+		releases, err := queries.GetMotionReleases(ctx, tournament.Tournamentid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get motion releases: %v", err)
+		}
+		userRole, _ := claims["user_role"].(string)
+		showUnreleased := userRole == "admin" || tournament.Enddate.Before(time.Now())
+		protoTournament.Motions = applyMotionReleases(motions, releases, showUnreleased)
 	}
 
 	// Generate presigned URL for the image
@@ -398,6 +414,10 @@ func tournamentPaginatedRowToProto(t models.ListTournamentsPaginatedRow) *tourna
 		NumberOfSchools:            int32(t.Acceptedschoolscount),
 		NumberOfTeams:              int32(t.Teamscount),
 		LeagueName:                 t.Leaguename,
+		Tiebreaks: &tournament_management.TiebreakChains{
+			Team:    t.Teamtiebreaks,
+			Speaker: t.Speakertiebreaks,
+		},
 	}
 }
 
@@ -412,6 +432,12 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, req *tournamen
 		return nil, fmt.Errorf("invalid motions: %v", err)
 	}
 
+	// Tiebreak chains left empty use the format's
+	teamTiebreaks, speakerTiebreaks, err := validateTiebreakChains(req.GetTiebreaks())
+	if err != nil {
+		return nil, err
+	}
+
 	startDate, err := time.Parse("2006-01-02 15:04", req.GetStartDate())
 	if err != nil {
 		return nil, fmt.Errorf("invalid start date format: %v", err)
@@ -474,6 +500,8 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, req *tournamen
 		Tournamentfee:              fmt.Sprintf("%.2f", req.GetTournamentFee()),
 		Imageurl:                   sql.NullString{String: req.GetImageUrl(), Valid: req.GetImageUrl() != ""},
 		Motions:                    pqtype.NullRawMessage{RawMessage: motionsJSON, Valid: true},
+		Teamtiebreaks:              teamTiebreaks,
+		Speakertiebreaks:           speakerTiebreaks,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -487,6 +515,17 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, req *tournamen
 		return nil, errors.New(errMsg)
 	}
 
+	// The dates or rounds may have changed, so the timetable is planned again
+	// around its pinned rounds when it is next needed
+	if err := queries.DeletePlannedSchedule(ctx, req.GetTournamentId()); err != nil {
+		return nil, fmt.Errorf("failed to clear planned schedule: %v", err)
+	}
+
+	// Rounds whose motion was rewritten no longer use their bank motion
+	if err := queries.DeleteDetachedRoundMotions(ctx, req.GetTournamentId()); err != nil {
+		return nil, fmt.Errorf("failed to detach round motions: %v", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
@@ -506,6 +545,8 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, req *tournamen
 		Judgesperdebateelimination: result.Judgesperdebateelimination,
 		Tournamentfee:              result.Tournamentfee,
 		Motions:                    result.Motions,
+		Teamtiebreaks:              result.Teamtiebreaks,
+		Speakertiebreaks:           result.Speakertiebreaks,
 	})
 
 	// Parse the saved motions JSON back into the proto structure
@@ -840,6 +881,10 @@ func tournamentModelToProto(t models.Tournament) *tournament_management.Tourname
 		JudgesPerDebatePreliminary: int32(t.Judgesperdebatepreliminary),
 		JudgesPerDebateElimination: int32(t.Judgesperdebateelimination),
 		TournamentFee:              parseFloat64(t.Tournamentfee),
+		Tiebreaks: &tournament_management.TiebreakChains{
+			Team:    t.Teamtiebreaks,
+			Speaker: t.Speakertiebreaks,
+		},
 	}
 }
 
@@ -861,6 +906,10 @@ func tournamentRowToProto(t models.GetTournamentByIDRow) *tournament_management.
 		JudgesPerDebateElimination: int32(t.Judgesperdebateelimination),
 		TournamentFee:              parseFloat64(t.Tournamentfee),
 		LeagueName:                 t.Leaguename,
+		Tiebreaks: &tournament_management.TiebreakChains{
+			Team:    t.Teamtiebreaks,
+			Speaker: t.Speakertiebreaks,
+		},
 	}
 }
 
@@ -219,6 +219,22 @@ func SendCoordinatorAssignmentEmail(notificationService *notification.Notificati
 	return nil
 }
 
+// SendMotionReleaseNotifications tells the tournament's participants in-app
+// that a round's motion is out, with when it was released and when prep time
+// ends.
+func SendMotionReleaseNotifications(notificationService *notification.NotificationService, userIDs []int32, tournamentName, roundName, motion string, releasedAt, prepEndsAt time.Time) error {
+	subject := fmt.Sprintf("Motion released for %s of %s", roundName, tournamentName)
+	content := fmt.Sprintf("Motion: %s\nReleased at: %s\nPrep time ends at: %s",
+		motion, releasedAt.Format("2006-01-02 15:04"), prepEndsAt.Format("2006-01-02 15:04"))
+
+	for _, userID := range userIDs {
+		if err := SendNotification(notificationService, notification.InAppNotification, fmt.Sprintf("%d", userID), subject, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func PrepareSchoolInvitationContent(school models.School, tournament models.Tournament, league models.League, format models.Tournamentformat) string {
 	var currencySymbol string
 	if league.Leaguetype == "local" {
@@ -0,0 +1,206 @@
+package pairing_algorithm
+
+import (
+	"fmt"
+)
+
+// BracketMode decides how a break that is not a power of two is fitted into
+// the elimination rounds.
+type BracketMode string
+
+const (
+	// BracketByes fits the break into the configured elimination rounds. The
+	// top seeds skip the first round so that the second round is full.
+	BracketByes BracketMode = "byes"
+	// BracketPartialDoubleOctofinals keeps the configured elimination rounds
+	// full and adds a partial round in front of them for the teams below the
+	// top seeds, e.g. seeds 9-24 of a 24-team break debate before octofinals.
+	BracketPartialDoubleOctofinals BracketMode = "partial"
+)
+
+// BracketNode is one debate of an elimination bracket. Each side is filled
+// either straight from the break (a seeded team entering the bracket here) or
+// by the winner of a child node from an earlier round. The links between nodes
+// are left out of JSON, as they form cycles.
+type BracketNode struct {
+	Round      int
+	Position   int // Order within the round, top of the bracket first
+	Teams      [2]*Team
+	Seeds      [2]int
+	Children   [2]*BracketNode `json:"-"`
+	Parent     *BracketNode    `json:"-"`
+	ParentSlot int
+	Winner     *Team
+}
+
+// EliminationBracket is a single-elimination bracket stored as a tree. Rounds
+// holds the nodes of each round, Rounds[0] being the first elimination round.
+// The root is the final.
+type EliminationBracket struct {
+	Rounds [][]*BracketNode
+	Root   *BracketNode
+}
+
+// BuildEliminationBracket seeds the breaking teams, given best first, into a
+// bracket with the usual seeding (1 v 16, 8 v 9, ... for sixteen teams) so
+// that the top seeds can only meet in the last rounds. When the break is not
+// a power of two, the top seeds get byes: they enter the bracket in the second
+// round instead of debating a missing opponent.
+func BuildEliminationBracket(breaking []*Team, eliminationRounds int, mode BracketMode) (*EliminationBracket, error) {
+	if eliminationRounds < 1 {
+		return nil, fmt.Errorf("an elimination bracket needs at least one round")
+	}
+	if len(breaking) < 2 {
+		return nil, fmt.Errorf("an elimination bracket needs at least 2 teams: have %d", len(breaking))
+	}
+
+	rounds := eliminationRounds
+	switch mode {
+	case BracketByes, "":
+		if len(breaking) > 1<<eliminationRounds {
+			return nil, fmt.Errorf("a break of %d teams does not fit %d elimination rounds (at most %d teams); use partial double-octofinals or more rounds",
+				len(breaking), eliminationRounds, 1<<eliminationRounds)
+		}
+		if len(breaking) <= 1<<(eliminationRounds-1) {
+			return nil, fmt.Errorf("a break of %d teams needs fewer than %d elimination rounds (more than %d teams)",
+				len(breaking), eliminationRounds, 1<<(eliminationRounds-1))
+		}
+	case BracketPartialDoubleOctofinals:
+		if len(breaking) <= 1<<eliminationRounds || len(breaking) >= 1<<(eliminationRounds+1) {
+			return nil, fmt.Errorf("partial double-octofinals need a break between %d and %d teams for %d elimination rounds: have %d",
+				(1<<eliminationRounds)+1, (1<<(eliminationRounds+1))-1, eliminationRounds, len(breaking))
+		}
+		rounds++
+	default:
+		return nil, fmt.Errorf("unknown bracket mode %q", mode)
+	}
+
+	// An entry is what fills one line of the bracket: a seeded team, the
+	// winner of a node, or nothing when the line is a bye.
+	type entry struct {
+		team *Team
+		seed int
+		node *BracketNode
+	}
+
+	order := bracketSeedOrder(1 << rounds)
+	entries := make([]*entry, len(order))
+	for i, seed := range order {
+		if seed <= len(breaking) {
+			entries[i] = &entry{team: breaking[seed-1], seed: seed}
+		}
+	}
+
+	bracket := &EliminationBracket{Rounds: make([][]*BracketNode, rounds)}
+	for round := 1; round <= rounds; round++ {
+		next := make([]*entry, len(entries)/2)
+		for i := range next {
+			a, b := entries[2*i], entries[2*i+1]
+			if a == nil || b == nil {
+				// Bye: whoever fills the line moves up without a debate
+				if a != nil {
+					next[i] = a
+				} else {
+					next[i] = b
+				}
+				continue
+			}
+
+			node := &BracketNode{Round: round, Position: len(bracket.Rounds[round-1]) + 1}
+			for slot, e := range []*entry{a, b} {
+				if e.node != nil {
+					node.Children[slot] = e.node
+					e.node.Parent = node
+					e.node.ParentSlot = slot
+				} else {
+					node.Teams[slot] = e.team
+					node.Seeds[slot] = e.seed
+				}
+			}
+			bracket.Rounds[round-1] = append(bracket.Rounds[round-1], node)
+			next[i] = &entry{node: node}
+		}
+		entries = next
+	}
+	bracket.Root = entries[0].node
+
+	return bracket, nil
+}
+
+// Advance records the winner of the node's debate and moves them into their
+// place in the next node of the bracket.
+func (n *BracketNode) Advance(winner *Team) error {
+	slot := -1
+	for i, team := range n.Teams {
+		if team != nil && team.ID == winner.ID {
+			slot = i
+		}
+	}
+	if slot == -1 {
+		return fmt.Errorf("team %s is not in the round %d debate at position %d", winner.Name, n.Round, n.Position)
+	}
+
+	n.Winner = n.Teams[slot]
+	if n.Parent != nil {
+		n.Parent.Teams[n.ParentSlot] = n.Teams[slot]
+		n.Parent.Seeds[n.ParentSlot] = n.Seeds[slot]
+	}
+	return nil
+}
+
+// PairEliminationBracketRound turns the nodes of one bracket round into
+// debates, in node order, with the better seed as Team1. Every node must know
+// both of its teams, i.e. the earlier rounds feeding it have been decided.
+func PairEliminationBracketRound(nodes []*BracketNode, judges []*Judge, rooms []*Room, specs TournamentSpecs) ([]*Debate, error) {
+	debates := make([]*Debate, len(nodes))
+	for i, node := range nodes {
+		if node.Teams[0] == nil || node.Teams[1] == nil {
+			return nil, fmt.Errorf("round %d debate at position %d is waiting for the result of an earlier round", node.Round, node.Position)
+		}
+
+		first, second := 0, 1
+		if node.Seeds[1] < node.Seeds[0] {
+			first, second = 1, 0
+		}
+		node.Teams[first].EliminationRank = node.Seeds[first]
+		node.Teams[second].EliminationRank = node.Seeds[second]
+		debates[i] = &Debate{
+			Team1: node.Teams[first],
+			Team2: node.Teams[second],
+		}
+	}
+
+	if err := checkJudgesAndRooms(debates, judges, rooms, specs); err != nil {
+		return nil, err
+	}
+
+	return assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate, specs.randomSource())
+}
+
+// BracketRounds returns the elimination rounds a break of the given size is
+// built for in the given mode: the fewest rounds that fit the break with byes,
+// or one less when a partial round is added in front of them.
+func BracketRounds(breakSize int, mode BracketMode) int {
+	rounds := 0
+	for 1<<rounds < breakSize {
+		rounds++
+	}
+	if mode == BracketPartialDoubleOctofinals {
+		rounds--
+	}
+	return rounds
+}
+
+// bracketSeedOrder returns the seeds of a bracket of the given size from top
+// to bottom, so that seeds 1 and 2 can only meet in the final.
+func bracketSeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		expanded := make([]int, 0, 2*len(order))
+		for _, seed := range order {
+			expanded = append(expanded, seed, 2*len(order)+1-seed)
+		}
+		order = expanded
+	}
+	return order
+}
@@ -0,0 +1,148 @@
+package pairing_algorithm
+
+import (
+	"testing"
+)
+
+func TestBracketSeedOrder(t *testing.T) {
+	expected := []int{1, 8, 4, 5, 2, 7, 3, 6}
+	order := bracketSeedOrder(8)
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("Expected seed order %v, got %v", expected, order)
+		}
+	}
+}
+
+func TestBuildEliminationBracket(t *testing.T) {
+	testCases := []struct {
+		name              string
+		breakSize         int
+		eliminationRounds int
+		mode              BracketMode
+		debatesPerRound   []int
+		byes              int
+	}{
+		{"16-team break", 16, 4, BracketByes, []int{8, 4, 2, 1}, 0},
+		{"12-team break", 12, 4, BracketByes, []int{4, 4, 2, 1}, 4},
+		{"20-team break", 20, 5, BracketByes, []int{4, 8, 4, 2, 1}, 12},
+		{"24-team partial double-octofinals", 24, 4, BracketPartialDoubleOctofinals, []int{8, 8, 4, 2, 1}, 8},
+		{"20-team partial double-octofinals", 20, 4, BracketPartialDoubleOctofinals, []int{4, 8, 4, 2, 1}, 12},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			teams := createMockTeams(tc.breakSize)
+			bracket, err := BuildEliminationBracket(teams, tc.eliminationRounds, tc.mode)
+			if err != nil {
+				t.Fatalf("Error building bracket: %v", err)
+			}
+
+			if len(bracket.Rounds) != len(tc.debatesPerRound) {
+				t.Fatalf("Expected %d rounds, got %d", len(tc.debatesPerRound), len(bracket.Rounds))
+			}
+			for i, nodes := range bracket.Rounds {
+				if len(nodes) != tc.debatesPerRound[i] {
+					t.Errorf("Round %d: expected %d debates, got %d", i+1, tc.debatesPerRound[i], len(nodes))
+				}
+			}
+
+			// Every breaking team enters the bracket exactly once, and the top
+			// seeds are the ones that skip the first round
+			entered := make(map[int]int)
+			for _, nodes := range bracket.Rounds {
+				for _, node := range nodes {
+					for slot, team := range node.Teams {
+						if team != nil {
+							entered[node.Seeds[slot]] = node.Round
+						}
+					}
+				}
+			}
+			if len(entered) != tc.breakSize {
+				t.Errorf("Expected %d teams in the bracket, got %d", tc.breakSize, len(entered))
+			}
+			for seed := 1; seed <= tc.breakSize; seed++ {
+				skipsFirstRound := entered[seed] > 1
+				if skipsFirstRound != (seed <= tc.byes) {
+					t.Errorf("Seed %d enters in round %d, expected a bye: %v", seed, entered[seed], seed <= tc.byes)
+				}
+			}
+
+			if bracket.Root == nil || bracket.Root.Parent != nil || bracket.Root.Round != len(bracket.Rounds) {
+				t.Errorf("Bracket root is not the final")
+			}
+		})
+	}
+}
+
+func TestBuildEliminationBracketRejectsBreaks(t *testing.T) {
+	testCases := []struct {
+		name              string
+		breakSize         int
+		eliminationRounds int
+		mode              BracketMode
+	}{
+		{"Break too large for the rounds", 20, 4, BracketByes},
+		{"Break too small for the rounds", 6, 4, BracketByes},
+		{"Partial round not needed", 16, 4, BracketPartialDoubleOctofinals},
+		{"Partial round too large", 32, 4, BracketPartialDoubleOctofinals},
+		{"Single team", 1, 1, BracketByes},
+		{"Unknown mode", 8, 3, BracketMode("swiss")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := BuildEliminationBracket(createMockTeams(tc.breakSize), tc.eliminationRounds, tc.mode); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestEliminationBracketFollowsResults(t *testing.T) {
+	teams := createMockTeams(12)
+	bracket, err := BuildEliminationBracket(teams, 4, BracketByes)
+	if err != nil {
+		t.Fatalf("Error building bracket: %v", err)
+	}
+
+	rooms := createMockRooms(8)
+	for r, nodes := range bracket.Rounds {
+		judges := createMockJudges(len(nodes))
+		debates, err := PairEliminationBracketRound(nodes, judges, rooms, TournamentSpecs{JudgesPerDebate: 1})
+		if err != nil {
+			t.Fatalf("Round %d: error pairing: %v", r+1, err)
+		}
+
+		for i, debate := range debates {
+			if debate.Team1.EliminationRank >= debate.Team2.EliminationRank {
+				t.Errorf("Round %d: better seed is not Team1: %d vs %d", r+1, debate.Team1.EliminationRank, debate.Team2.EliminationRank)
+			}
+			// The lower seed wins every debate, so upsets carry through the bracket
+			if err := nodes[i].Advance(debate.Team2); err != nil {
+				t.Fatalf("Round %d: error advancing winner: %v", r+1, err)
+			}
+		}
+	}
+
+	// With the lower seed always winning, seed 12 beats 5, then 4 (who had a
+	// bye), then 9, and 11 in the final
+	if bracket.Root.Winner == nil || bracket.Root.Winner.ID != teams[11].ID {
+		t.Errorf("Expected seed 12 to win the bracket, got %v", bracket.Root.Winner)
+	}
+	if bracket.Root.Seeds != [2]int{12, 11} && bracket.Root.Seeds != [2]int{11, 12} {
+		t.Errorf("Expected seeds 11 and 12 in the final, got %v", bracket.Root.Seeds)
+	}
+}
+
+func TestPairEliminationBracketRoundWaitsForResults(t *testing.T) {
+	bracket, err := BuildEliminationBracket(createMockTeams(8), 3, BracketByes)
+	if err != nil {
+		t.Fatalf("Error building bracket: %v", err)
+	}
+	_, err = PairEliminationBracketRound(bracket.Rounds[1], createMockJudges(2), createMockRooms(2), TournamentSpecs{JudgesPerDebate: 1})
+	if err == nil {
+		t.Errorf("Expected an error pairing a round before the previous one is decided")
+	}
+}
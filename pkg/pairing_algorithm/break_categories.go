@@ -0,0 +1,60 @@
+package pairing_algorithm
+
+import (
+	"sort"
+)
+
+// BreakCategory is a separate break of a tournament, such as open, novice or
+// ESL, with its own elimination bracket.
+type BreakCategory struct {
+	ID        int
+	Name      string
+	BreakSize int
+	// Priority ranks the categories, 1 being the highest. A team that breaks
+	// in several categories goes to the one with the highest priority.
+	Priority int
+	// General categories are open to every team.
+	General bool
+}
+
+// eligible reports whether the team may break in the category.
+func (c BreakCategory) eligible(team *Team) bool {
+	return c.General || team.BreakCategories[c.ID]
+}
+
+// AllocateBreaks decides which teams break in each category. The standings
+// are given best first. Categories are filled from the highest priority down,
+// each taking its best eligible teams that have not broken in a higher
+// category yet, so a novice team that makes the open break debates there and
+// frees its novice place for the next novice team. The result maps each
+// category ID to its breaking teams, best first. A category may break fewer
+// teams than its break size when it runs out of eligible teams.
+func AllocateBreaks(standings []*Team, categories []BreakCategory) map[int][]*Team {
+	ordered := make([]BreakCategory, len(categories))
+	copy(ordered, categories)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	broken := make(map[int]bool)
+	breaks := make(map[int][]*Team, len(ordered))
+	for _, category := range ordered {
+		breaking := make([]*Team, 0, category.BreakSize)
+		for _, team := range standings {
+			if len(breaking) == category.BreakSize {
+				break
+			}
+			if broken[team.ID] || !category.eligible(team) {
+				continue
+			}
+			breaking = append(breaking, team)
+			broken[team.ID] = true
+		}
+		breaks[category.ID] = breaking
+	}
+
+	return breaks
+}
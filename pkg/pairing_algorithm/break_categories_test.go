@@ -0,0 +1,98 @@
+package pairing_algorithm
+
+import (
+	"testing"
+)
+
+func TestAllocateBreaks(t *testing.T) {
+	const (
+		open   = 1
+		novice = 2
+		esl    = 3
+	)
+
+	// Teams 2, 4, 5, 7 and 8 are novices, teams 4 and 6 speak English as a
+	// second language
+	teams := createMockTeams(8)
+	for _, i := range []int{1, 3, 4, 6, 7} {
+		teams[i].BreakCategories = map[int]bool{novice: true}
+	}
+	for _, i := range []int{3, 5} {
+		if teams[i].BreakCategories == nil {
+			teams[i].BreakCategories = make(map[int]bool)
+		}
+		teams[i].BreakCategories[esl] = true
+	}
+
+	categories := []BreakCategory{
+		{ID: esl, Name: "ESL", BreakSize: 2, Priority: 3},
+		{ID: novice, Name: "Novice", BreakSize: 2, Priority: 2},
+		{ID: open, Name: "Open", BreakSize: 2, Priority: 1, General: true},
+	}
+
+	breaks := AllocateBreaks(teams, categories)
+
+	expected := map[int][]int{
+		open:   {1, 2},
+		novice: {4, 5},
+		esl:    {6},
+	}
+	for categoryID, teamIDs := range expected {
+		breaking := breaks[categoryID]
+		if len(breaking) != len(teamIDs) {
+			t.Fatalf("Category %d: expected %d breaking teams, got %d", categoryID, len(teamIDs), len(breaking))
+		}
+		for i, team := range breaking {
+			if team.ID != teamIDs[i] {
+				t.Errorf("Category %d: expected team %d in place %d, got team %d", categoryID, teamIDs[i], i+1, team.ID)
+			}
+		}
+	}
+}
+
+func TestAllocateBreaksFollowsPriority(t *testing.T) {
+	// The same team tops both categories; it breaks in whichever ranks higher
+	teams := createMockTeams(4)
+	for _, team := range teams {
+		team.BreakCategories = map[int]bool{2: true}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		openPriority   int
+		novicePriority int
+		expectedOpen   int
+	}{
+		{"Open first", 1, 2, 1},
+		{"Novice first", 2, 1, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			breaks := AllocateBreaks(teams, []BreakCategory{
+				{ID: 1, BreakSize: 2, Priority: tc.openPriority, General: true},
+				{ID: 2, BreakSize: 2, Priority: tc.novicePriority},
+			})
+			if breaks[1][0].ID != tc.expectedOpen {
+				t.Errorf("Expected team %d to top the open break, got team %d", tc.expectedOpen, breaks[1][0].ID)
+			}
+		})
+	}
+}
+
+func TestBracketRounds(t *testing.T) {
+	testCases := []struct {
+		breakSize int
+		mode      BracketMode
+		expected  int
+	}{
+		{2, BracketByes, 1},
+		{8, BracketByes, 3},
+		{12, BracketByes, 4},
+		{24, BracketPartialDoubleOctofinals, 4},
+	}
+
+	for _, tc := range testCases {
+		if rounds := BracketRounds(tc.breakSize, tc.mode); rounds != tc.expected {
+			t.Errorf("Break of %d (%s): expected %d rounds, got %d", tc.breakSize, tc.mode, tc.expected, rounds)
+		}
+	}
+}
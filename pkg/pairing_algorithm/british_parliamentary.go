@@ -0,0 +1,187 @@
+package pairing_algorithm
+
+import (
+	"fmt"
+	"sort"
+)
+
+// British Parliamentary positions, in the order they map onto Debate.Team1-4.
+const (
+	OpeningGovernment = iota
+	OpeningOpposition
+	ClosingGovernment
+	ClosingOpposition
+)
+
+// TeamsPerBritishParliamentaryRoom is the number of teams in a BP room.
+const TeamsPerBritishParliamentaryRoom = 4
+
+// positionPermutations lists every way of seating four teams in a room.
+var positionPermutations = permutations([]int{0, 1, 2, 3})
+
+// GenerateBritishParliamentaryRound draws a single British Parliamentary
+// preliminary round. Teams are ordered by team points, then total speaker
+// points, and split into rooms of four from the top of the standings down, so
+// a room only mixes point brackets when a bracket does not fill it. Inside each
+// room, teams are seated to even out how often each team has held each
+// position, using Team.PositionCounts.
+func GenerateBritishParliamentaryRound(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs) ([]*Debate, error) {
+	if len(teams) == 0 || len(teams)%TeamsPerBritishParliamentaryRoom != 0 {
+		return nil, fmt.Errorf("british parliamentary rounds need a multiple of %d teams: have %d", TeamsPerBritishParliamentaryRoom, len(teams))
+	}
+
+	standings := make([]*Team, len(teams))
+	copy(standings, teams)
+	sortByTeamPoints(standings)
+
+	debates := make([]*Debate, 0, len(standings)/TeamsPerBritishParliamentaryRoom)
+	for i := 0; i < len(standings); i += TeamsPerBritishParliamentaryRoom {
+		seats := balancePositions(standings[i : i+TeamsPerBritishParliamentaryRoom])
+		debates = append(debates, newBritishParliamentaryDebate(seats))
+	}
+
+	if err := checkJudgesAndRooms(debates, judges, rooms, specs); err != nil {
+		return nil, err
+	}
+
+	return assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate, specs.randomSource())
+}
+
+// generateBritishParliamentaryEliminationPairings draws a BP elimination
+// round, where the top two teams of each room advance. The first round seeds
+// the break across rooms (1, 2k, 2k+1, 4k for k rooms, and so on); later rounds
+// keep the bracket by putting the advancing teams of two neighbouring rooms
+// together, in the order they are passed in.
+func generateBritishParliamentaryEliminationPairings(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs, roundNumber int) ([]*Debate, error) {
+	teamsNeeded := TeamsPerBritishParliamentaryRoom << (specs.EliminationRounds - roundNumber)
+	if len(teams) < teamsNeeded {
+		return nil, fmt.Errorf("not enough teams for elimination round %d: have %d, need %d", roundNumber, len(teams), teamsNeeded)
+	}
+
+	selectedTeams := teams
+	if roundNumber == 1 {
+		selectedTeams = make([]*Team, len(teams))
+		copy(selectedTeams, teams)
+		if !sortByStanding(selectedTeams) {
+			sortByTeamPoints(selectedTeams)
+		}
+	}
+	selectedTeams = selectedTeams[:teamsNeeded]
+	for i, team := range selectedTeams {
+		team.EliminationRank = i + 1
+	}
+
+	roomCount := teamsNeeded / TeamsPerBritishParliamentaryRoom
+	debates := make([]*Debate, roomCount)
+	for i := 0; i < roomCount; i++ {
+		var seats []*Team
+		if roundNumber == 1 {
+			seats = []*Team{
+				selectedTeams[i],
+				selectedTeams[2*roomCount-1-i],
+				selectedTeams[2*roomCount+i],
+				selectedTeams[4*roomCount-1-i],
+			}
+		} else {
+			seats = selectedTeams[i*TeamsPerBritishParliamentaryRoom : (i+1)*TeamsPerBritishParliamentaryRoom]
+		}
+		debates[i] = newBritishParliamentaryDebate(seats)
+	}
+
+	if err := checkJudgesAndRooms(debates, judges, rooms, specs); err != nil {
+		return nil, err
+	}
+
+	return assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate, specs.randomSource())
+}
+
+// Teams returns the teams in the debate in seating order, skipping the
+// positions a two-team debate does not use.
+func (d *Debate) Teams() []*Team {
+	teams := make([]*Team, 0, TeamsPerBritishParliamentaryRoom)
+	for _, team := range []*Team{d.Team1, d.Team2, d.Team3, d.Team4} {
+		if team != nil {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// IsBritishParliamentary reports whether the debate seats four teams.
+func (d *Debate) IsBritishParliamentary() bool {
+	return d.Team3 != nil && d.Team4 != nil
+}
+
+func newBritishParliamentaryDebate(seats []*Team) *Debate {
+	return &Debate{
+		Team1: seats[OpeningGovernment],
+		Team2: seats[OpeningOpposition],
+		Team3: seats[ClosingGovernment],
+		Team4: seats[ClosingOpposition],
+	}
+}
+
+func checkJudgesAndRooms(debates []*Debate, judges []*Judge, rooms []*Room, specs TournamentSpecs) error {
+	if len(judges) < specs.JudgesPerDebate {
+		return fmt.Errorf("not enough judges: have %d, need at least %d", len(judges), specs.JudgesPerDebate)
+	}
+	if len(rooms) < len(debates) {
+		return fmt.Errorf("not enough rooms: have %d, need at least %d", len(rooms), len(debates))
+	}
+	return nil
+}
+
+// sortByTeamPoints orders teams by BP team points, then total speaker points.
+// Team ID breaks the remaining ties so the draw is stable for the same input.
+func sortByTeamPoints(teams []*Team) {
+	sort.SliceStable(teams, func(i, j int) bool {
+		if teams[i].TeamPoints != teams[j].TeamPoints {
+			return teams[i].TeamPoints > teams[j].TeamPoints
+		}
+		if teams[i].TotalPoints != teams[j].TotalPoints {
+			return teams[i].TotalPoints > teams[j].TotalPoints
+		}
+		return teams[i].ID < teams[j].ID
+	})
+}
+
+// balancePositions seats the four teams of a room so that, summed over the
+// room, teams land on the positions they have held least often. The cost of a
+// seat is the square of the count it would produce, which prefers spreading a
+// team across all four positions over repeating any one of them.
+func balancePositions(room []*Team) []*Team {
+	best := positionPermutations[0]
+	bestCost := -1
+	for _, perm := range positionPermutations {
+		cost := 0
+		for teamIndex, position := range perm {
+			count := room[teamIndex].PositionCounts[position] + 1
+			cost += count * count
+		}
+		if bestCost == -1 || cost < bestCost {
+			best, bestCost = perm, cost
+		}
+	}
+
+	seats := make([]*Team, TeamsPerBritishParliamentaryRoom)
+	for teamIndex, position := range best {
+		seats[position] = room[teamIndex]
+	}
+	return seats
+}
+
+func permutations(values []int) [][]int {
+	if len(values) <= 1 {
+		return [][]int{append([]int(nil), values...)}
+	}
+	var result [][]int
+	for i := range values {
+		rest := make([]int, 0, len(values)-1)
+		rest = append(rest, values[:i]...)
+		rest = append(rest, values[i+1:]...)
+		for _, perm := range permutations(rest) {
+			result = append(result, append([]int{values[i]}, perm...))
+		}
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package pairing_algorithm
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerateBritishParliamentaryRound(t *testing.T) {
+	teams := createMockTeams(16)
+	for i, team := range teams {
+		team.TeamPoints = 15 - i
+	}
+	judges := createMockJudges(8)
+	rooms := createMockRooms(4)
+	specs := TournamentSpecs{JudgesPerDebate: 1, TeamsPerDebate: 4}
+
+	debates, err := GeneratePairings(teams, judges, rooms, specs, 1, false)
+	if err != nil {
+		t.Fatalf("Error generating BP round: %v", err)
+	}
+	if len(debates) != 4 {
+		t.Fatalf("Expected 4 rooms, got %d", len(debates))
+	}
+
+	seen := make(map[int]bool)
+	for i, debate := range debates {
+		if !debate.IsBritishParliamentary() {
+			t.Fatalf("Room %d does not seat four teams", i+1)
+		}
+		for _, team := range debate.Teams() {
+			if seen[team.ID] {
+				t.Errorf("Team %d is drawn more than once", team.ID)
+			}
+			seen[team.ID] = true
+			// Rooms are filled from the top of the standings down.
+			if team.ID < i*4+1 || team.ID > i*4+4 {
+				t.Errorf("Room %d: team %d is outside its bracket", i+1, team.ID)
+			}
+		}
+		if len(debate.Judges) != 1 {
+			t.Errorf("Room %d: expected 1 judge, got %d", i+1, len(debate.Judges))
+		}
+	}
+}
+
+func TestGenerateBritishParliamentaryRoundNeedsFullRooms(t *testing.T) {
+	teams := createMockTeams(10)
+	specs := TournamentSpecs{JudgesPerDebate: 1, TeamsPerDebate: 4}
+
+	if _, err := GeneratePairings(teams, createMockJudges(5), createMockRooms(5), specs, 1, false); err == nil {
+		t.Errorf("Expected an error for 10 teams")
+	}
+}
+
+func TestBritishParliamentaryPositionBalance(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	teams := createMockTeams(24)
+	judges := createMockJudges(6)
+	rooms := createMockRooms(6)
+	specs := TournamentSpecs{JudgesPerDebate: 1, TeamsPerDebate: 4}
+
+	for round := 1; round <= 4; round++ {
+		debates, err := GenerateBritishParliamentaryRound(teams, judges, rooms, specs)
+		if err != nil {
+			t.Fatalf("Round %d: error generating BP round: %v", round, err)
+		}
+		for _, debate := range debates {
+			order := rng.Perm(4)
+			for position, team := range debate.Teams() {
+				team.PositionCounts[position]++
+				team.TeamPoints += 3 - order[position]
+			}
+		}
+	}
+
+	for _, team := range teams {
+		for position, count := range team.PositionCounts {
+			if count > 2 {
+				t.Errorf("Team %d held position %d %d times in 4 rounds", team.ID, position, count)
+			}
+		}
+	}
+}
+
+func TestBritishParliamentaryEliminationSeeding(t *testing.T) {
+	teams := createMockTeams(20)
+	for i, team := range teams {
+		team.TeamPoints = 20 - i
+	}
+	specs := TournamentSpecs{EliminationRounds: 2, JudgesPerDebate: 3, TeamsPerDebate: 4}
+
+	debates, err := GeneratePairings(teams, createMockJudges(6), createMockRooms(2), specs, 1, true)
+	if err != nil {
+		t.Fatalf("Error generating BP elimination round: %v", err)
+	}
+
+	expected := [][]int{{1, 4, 5, 8}, {2, 3, 6, 7}}
+	if len(debates) != len(expected) {
+		t.Fatalf("Expected %d rooms, got %d", len(expected), len(debates))
+	}
+	for i, debate := range debates {
+		for position, team := range debate.Teams() {
+			if team.ID != expected[i][position] {
+				t.Errorf("Room %d position %d: expected team %d, got %d", i+1, position, expected[i][position], team.ID)
+			}
+		}
+	}
+}
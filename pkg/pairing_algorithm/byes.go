@@ -0,0 +1,191 @@
+package pairing_algorithm
+
+import "errors"
+
+// ByeTeamID is the ID of the placeholder a team is drawn against when an odd
+// number of teams leaves it without an opponent. A debate against the
+// placeholder is a bye: it is not held, so it gets no room or judges. A team
+// that already had a bye lists ByeTeamID in its Opponents, so it is not drawn
+// against the placeholder again.
+const ByeTeamID = -1
+
+// ErrSecondBye is returned when an odd number of teams is drawn for more rounds
+// than there are teams: every team has had its one bye, so a team would need a
+// second.
+var ErrSecondBye = errors.New("every team has already had a bye: an odd number of teams cannot be drawn for more rounds than there are teams")
+
+// ByeResult is what a team is credited with for a bye in the standings.
+type ByeResult string
+
+const (
+	// ByeAverage credits the team's average points and rank over the
+	// debates it held, without a win.
+	ByeAverage ByeResult = "average"
+	// ByeWin credits a win and its team point on top of the average points
+	// and rank.
+	ByeWin ByeResult = "win"
+	// ByeNone credits nothing: the bye does not count as a debate.
+	ByeNone ByeResult = "none"
+)
+
+// newByeTeam returns the placeholder a team with a bye is drawn against.
+func newByeTeam() *Team {
+	return &Team{ID: ByeTeamID, Name: "Bye", Opponents: make(map[int]bool)}
+}
+
+// IsBye reports whether the debate is a bye rather than a debate to hold.
+func (d *Debate) IsBye() bool {
+	return d.ByeTeam() != nil
+}
+
+// ByeTeam returns the team with the bye when the debate is a bye, or nil.
+func (d *Debate) ByeTeam() *Team {
+	if d.Team1 == nil || d.Team2 == nil || d.IsBritishParliamentary() {
+		return nil
+	}
+	switch {
+	case d.Team1.ID == ByeTeamID && d.Team2.ID != ByeTeamID:
+		return d.Team2
+	case d.Team2.ID == ByeTeamID && d.Team1.ID != ByeTeamID:
+		return d.Team1
+	}
+	return nil
+}
+
+// SplitByes separates the debates to hold from the teams with a bye, keeping
+// the order of both.
+func SplitByes(debates []*Debate) ([]*Debate, []*Team) {
+	held := make([]*Debate, 0, len(debates))
+	var byes []*Team
+	for _, debate := range debates {
+		if team := debate.ByeTeam(); team != nil {
+			byes = append(byes, team)
+			continue
+		}
+		held = append(held, debate)
+	}
+	return held, byes
+}
+
+// CreditBye returns the result a team is credited with for a bye, given its
+// results in the debates it held. It reports false when the bye counts for
+// nothing, which is also the case for an average before the team has held a
+// debate. A win without held debates is credited no points and ranks last.
+func CreditBye(result ByeResult, held []DebateResult) (DebateResult, bool) {
+	var credit DebateResult
+	switch result {
+	case ByeAverage, ByeWin:
+	default:
+		return credit, false
+	}
+
+	if len(held) == 0 {
+		if result != ByeWin {
+			return credit, false
+		}
+		credit.Rank = 99
+	} else {
+		for _, debate := range held {
+			credit.Points += debate.Points
+			credit.Rank += debate.Rank
+		}
+		credit.Points /= float64(len(held))
+		credit.Rank /= float64(len(held))
+	}
+
+	if result == ByeWin {
+		credit.Won = true
+		credit.TeamPoints = 1
+	}
+	return credit, true
+}
+
+// AddBye records a bye the team had before a power-paired round is drawn: the
+// team is not given another, and the bye adds to its wins and total points as
+// it does in the standings. The debates the team held are its
+// PropositionCount and OppositionCount.
+func (t *Team) AddBye(result ByeResult) {
+	if t.Opponents == nil {
+		t.Opponents = make(map[int]bool)
+	}
+	t.Opponents[ByeTeamID] = true
+
+	if result != ByeAverage && result != ByeWin {
+		return
+	}
+	if held := t.PropositionCount + t.OppositionCount; held > 0 {
+		t.TotalPoints += t.TotalPoints / float64(held)
+	}
+	if result == ByeWin {
+		t.Wins++
+		t.TeamPoints++
+	}
+}
+
+// rotateScheduleByes moves the byes of a preliminary schedule so that no team
+// has a second bye while another team has not had one. A team due a second bye
+// swaps places with a team that has not had one, preferring a team whose
+// opponent it has not met.
+func rotateScheduleByes(rounds [][][]int) {
+	met := make(map[[2]int]bool)
+	for _, round := range rounds {
+		for _, pair := range round {
+			if pair != nil {
+				met[[2]int{pair[0], pair[1]}] = true
+				met[[2]int{pair[1], pair[0]}] = true
+			}
+		}
+	}
+
+	hadBye := make(map[int]bool)
+	for _, round := range rounds {
+		for b, byePair := range round {
+			if byePair == nil {
+				continue
+			}
+			byeSlot := -1
+			switch {
+			case byePair[0] == ByeTeamID && byePair[1] != ByeTeamID:
+				byeSlot = 1
+			case byePair[1] == ByeTeamID && byePair[0] != ByeTeamID:
+				byeSlot = 0
+			default:
+				continue
+			}
+
+			team := byePair[byeSlot]
+			if hadBye[team] {
+				if i, slot, ok := byeSwap(round, b, team, hadBye, met); ok {
+					opponent := round[i][1-slot]
+					byePair[byeSlot], round[i][slot] = round[i][slot], team
+					met[[2]int{team, opponent}] = true
+					met[[2]int{opponent, team}] = true
+				}
+			}
+			hadBye[byePair[byeSlot]] = true
+		}
+	}
+}
+
+// byeSwap finds the team of the round that should take the bye from team,
+// returning its debate and slot.
+func byeSwap(round [][]int, byeDebate int, team int, hadBye map[int]bool, met map[[2]int]bool) (int, int, bool) {
+	fallback, fallbackSlot := -1, -1
+	for i, pair := range round {
+		if i == byeDebate || pair == nil || pair[0] == ByeTeamID || pair[1] == ByeTeamID {
+			continue
+		}
+		for slot := 0; slot < 2; slot++ {
+			if hadBye[pair[slot]] {
+				continue
+			}
+			if !met[[2]int{team, pair[1-slot]}] {
+				return i, slot, true
+			}
+			if fallback == -1 {
+				fallback, fallbackSlot = i, slot
+			}
+		}
+	}
+	return fallback, fallbackSlot, fallback != -1
+}
@@ -0,0 +1,149 @@
+package pairing_algorithm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestPreliminaryScheduleRotatesByes(t *testing.T) {
+	testCases := []struct {
+		teams  int
+		rounds int
+	}{
+		{5, 4},
+		{5, 5},
+		{7, 6},
+		{9, 5},
+		{21, 5},
+		{7, 7},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d teams, %d rounds", tc.teams, tc.rounds), func(t *testing.T) {
+			teams := make([]int, tc.teams)
+			for i := range teams {
+				teams[i] = i + 1
+			}
+
+			pairings, err := GeneratePreliminaryPairings(teams, tc.rounds)
+			if err != nil {
+				t.Fatalf("Error generating pairings: %v", err)
+			}
+
+			byes := make(map[int]int)
+			for r, round := range pairings {
+				drawn := make(map[int]int)
+				for _, pair := range round {
+					for _, team := range pair {
+						if team != ByeTeamID {
+							drawn[team]++
+						}
+					}
+				}
+				for _, team := range teams {
+					if drawn[team] != 1 {
+						t.Errorf("Team %d is drawn %d times in round %d", team, drawn[team], r+1)
+					}
+				}
+
+				for _, pair := range round {
+					switch {
+					case pair[0] == ByeTeamID && pair[1] != ByeTeamID:
+						byes[pair[1]]++
+					case pair[1] == ByeTeamID && pair[0] != ByeTeamID:
+						byes[pair[0]]++
+					}
+				}
+			}
+			for team, count := range byes {
+				if count > 1 {
+					t.Errorf("Team %d has %d byes", team, count)
+				}
+			}
+		})
+	}
+}
+
+func TestPreliminaryScheduleAllowsOneByePerTeam(t *testing.T) {
+	if _, err := GeneratePreliminaryPairings([]int{1, 2, 3, 4, 5, 6, 7}, 8); !errors.Is(err, ErrSecondBye) {
+		t.Errorf("Expected ErrSecondBye for 7 teams over 8 rounds, got %v", err)
+	}
+
+	teams := createMockTeams(3)
+	for _, team := range teams {
+		team.Opponents[ByeTeamID] = true
+	}
+	if _, err := PowerPairTeams(teams); !errors.Is(err, ErrSecondBye) {
+		t.Errorf("Expected ErrSecondBye once every team had a bye, got %v", err)
+	}
+}
+
+func TestPowerPairTeamsRotatesBye(t *testing.T) {
+	teams := createMockTeams(5)
+	for i, team := range teams {
+		team.Wins = len(teams) - i
+	}
+	// The bottom team already had a bye
+	teams[4].Opponents[ByeTeamID] = true
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+
+	_, byes := SplitByes(debates)
+	if len(byes) != 1 {
+		t.Fatalf("Expected one bye, got %d", len(byes))
+	}
+	if byes[0].ID != 4 {
+		t.Errorf("Expected team 4 to get the bye, got team %d", byes[0].ID)
+	}
+}
+
+func TestByesGetNoRoomOrJudges(t *testing.T) {
+	specs := TournamentSpecs{JudgesPerDebate: 1, Seed: 1}
+	debates, err := GeneratePowerPairedRound(createMockTeams(5), createMockJudges(2), createMockRooms(2), specs)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+
+	for _, debate := range debates {
+		if debate.IsBye() {
+			if debate.Room != 0 || len(debate.Judges) != 0 {
+				t.Errorf("Expected the bye to get no room or judges, got room %d and %d judges", debate.Room, len(debate.Judges))
+			}
+			continue
+		}
+		if debate.Room == 0 || len(debate.Judges) != 1 {
+			t.Errorf("Expected a room and a judge, got room %d and %d judges", debate.Room, len(debate.Judges))
+		}
+	}
+}
+
+func TestCreditBye(t *testing.T) {
+	held := []DebateResult{
+		{DebateID: 1, TeamPoints: 1, Won: true, Points: 150, Rank: 2},
+		{DebateID: 2, Points: 140, Rank: 3},
+	}
+
+	testCases := []struct {
+		result   ByeResult
+		held     []DebateResult
+		expected DebateResult
+		credited bool
+	}{
+		{ByeAverage, held, DebateResult{Points: 145, Rank: 2.5}, true},
+		{ByeWin, held, DebateResult{TeamPoints: 1, Won: true, Points: 145, Rank: 2.5}, true},
+		{ByeNone, held, DebateResult{}, false},
+		{ByeAverage, nil, DebateResult{}, false},
+		{ByeWin, nil, DebateResult{TeamPoints: 1, Won: true, Rank: 99}, true},
+	}
+
+	for _, tc := range testCases {
+		credit, credited := CreditBye(tc.result, tc.held)
+		if credited != tc.credited || fmt.Sprint(credit) != fmt.Sprint(tc.expected) {
+			t.Errorf("%s bye after %d debates: expected %+v (%v), got %+v (%v)", tc.result, len(tc.held), tc.expected, tc.credited, credit, credited)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package pairing_algorithm
+
+// DrawInput is everything a draw is generated from. With Specs.Seed set, the
+// same input always gives the same draw, so an input saved as JSON before
+// drawing can be drawn again later to check a disputed draw. Generating
+// changes the teams and judges, so the input has to be saved first.
+type DrawInput struct {
+	Teams  []*Team
+	Judges []*Judge
+	Rooms  []*Room
+	Specs  TournamentSpecs
+	// RoundNumber 0 draws the whole two-team preliminary schedule at once
+	RoundNumber   int
+	IsElimination bool
+	// Bracket holds the nodes of the elimination bracket round to pair; Teams
+	// is not used when it is set.
+	Bracket []*BracketNode
+}
+
+// Generate draws the input with GeneratePairings, GeneratePowerPairedRound,
+// GenerateBritishParliamentaryRound or PairEliminationBracketRound, whichever
+// the input calls for.
+func (in *DrawInput) Generate() ([]*Debate, error) {
+	if len(in.Bracket) > 0 {
+		return PairEliminationBracketRound(in.Bracket, in.Judges, in.Rooms, in.Specs)
+	}
+	if !in.IsElimination && in.RoundNumber > 0 {
+		if in.Specs.TeamsPerDebate == TeamsPerBritishParliamentaryRoom {
+			return GenerateBritishParliamentaryRound(in.Teams, in.Judges, in.Rooms, in.Specs)
+		}
+		return GeneratePowerPairedRound(in.Teams, in.Judges, in.Rooms, in.Specs)
+	}
+	return GeneratePairings(in.Teams, in.Judges, in.Rooms, in.Specs, in.RoundNumber, in.IsElimination)
+}
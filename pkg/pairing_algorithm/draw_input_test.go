@@ -0,0 +1,107 @@
+package pairing_algorithm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// drawFromJSON draws a copy of the input, as if it had been saved and loaded.
+func drawFromJSON(t *testing.T, saved []byte) []string {
+	t.Helper()
+	var input DrawInput
+	if err := json.Unmarshal(saved, &input); err != nil {
+		t.Fatalf("Error loading draw input: %v", err)
+	}
+	debates, err := input.Generate()
+	if err != nil {
+		t.Fatalf("Error generating draw: %v", err)
+	}
+	return summarizeDraw(debates)
+}
+
+func summarizeDraw(debates []*Debate) []string {
+	summary := make([]string, len(debates))
+	for i, debate := range debates {
+		teamIDs := make([]int, 0, 4)
+		for _, team := range debate.Teams() {
+			teamIDs = append(teamIDs, team.ID)
+		}
+		judgeIDs := make([]int, len(debate.Judges))
+		for j, judge := range debate.Judges {
+			judgeIDs[j] = judge.ID
+		}
+		summary[i] = fmt.Sprintf("teams %v room %d judges %v", teamIDs, debate.Room, judgeIDs)
+	}
+	return summary
+}
+
+func TestDrawInputIsReproducible(t *testing.T) {
+	teams := createMockTeams(12)
+	for i, team := range teams {
+		team.Wins = i % 3
+		team.SchoolIDs = []int{i % 4}
+	}
+	judges := createMockJudges(8)
+	judges[0].Conflicts = JudgeConflicts{SchoolIDs: map[int]bool{1: true}}
+
+	testCases := []struct {
+		name  string
+		input DrawInput
+	}{
+		{"power paired round", DrawInput{Teams: teams, Judges: judges, Rooms: createMockRooms(8), Specs: TournamentSpecs{JudgesPerDebate: 1}, RoundNumber: 2}},
+		{"preliminary schedule", DrawInput{Teams: teams, Judges: judges, Rooms: createMockRooms(8), Specs: TournamentSpecs{PreliminaryRounds: 3, JudgesPerDebate: 1}}},
+		{"british parliamentary round", DrawInput{Teams: teams, Judges: judges, Rooms: createMockRooms(4), Specs: TournamentSpecs{JudgesPerDebate: 2, TeamsPerDebate: 4}, RoundNumber: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			draws := make(map[string]bool)
+			for seed := int64(1); seed <= 10; seed++ {
+				tc.input.Specs.Seed = seed
+				saved, err := json.Marshal(tc.input)
+				if err != nil {
+					t.Fatalf("Error saving draw input: %v", err)
+				}
+
+				first := drawFromJSON(t, saved)
+				if second := drawFromJSON(t, saved); !reflect.DeepEqual(first, second) {
+					t.Fatalf("Seed %d gave two different draws:\n%v\n%v", seed, first, second)
+				}
+				draws[fmt.Sprint(first)] = true
+			}
+			if len(draws) == 1 {
+				t.Errorf("Expected different seeds to give different draws")
+			}
+		})
+	}
+}
+
+func TestDrawInputBracketRound(t *testing.T) {
+	bracket, err := BuildEliminationBracket(createMockTeams(8), 3, BracketByes)
+	if err != nil {
+		t.Fatalf("Error building bracket: %v", err)
+	}
+
+	input := DrawInput{
+		Judges:        createMockJudges(4),
+		Rooms:         createMockRooms(4),
+		Specs:         TournamentSpecs{EliminationRounds: 3, JudgesPerDebate: 1, Seed: 7},
+		RoundNumber:   1,
+		IsElimination: true,
+		Bracket:       bracket.Rounds[0],
+	}
+	saved, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("Error saving draw input: %v", err)
+	}
+
+	debates, err := input.Generate()
+	if err != nil {
+		t.Fatalf("Error generating draw: %v", err)
+	}
+	if original, replayed := summarizeDraw(debates), drawFromJSON(t, saved); !reflect.DeepEqual(original, replayed) {
+		t.Errorf("Saved bracket round drew differently:\n%v\n%v", original, replayed)
+	}
+}
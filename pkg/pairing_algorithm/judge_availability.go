@@ -0,0 +1,55 @@
+package pairing_algorithm
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AvailableJudges returns the judges who can sit the round.
+func AvailableJudges(judges []*Judge, round int) []*Judge {
+	available := make([]*Judge, 0, len(judges))
+	for _, judge := range judges {
+		if !judge.UnavailableRounds[round] {
+			available = append(available, judge)
+		}
+	}
+	return available
+}
+
+// ValidateJudgeAvailability reports the judges seated in a round they cannot
+// sit, typically because they were marked absent after the round was drawn.
+func ValidateJudgeAvailability(debates []*Debate, round int) []DrawIssue {
+	var issues []DrawIssue
+	for d, debate := range debates {
+		for _, judge := range debate.Judges {
+			if judge.UnavailableRounds[round] {
+				issues = append(issues, DrawIssue{IssueJudgeUnavailable, d, fmt.Sprintf("%s is not available for round %d", judge.Name, round)})
+			}
+		}
+	}
+	return issues
+}
+
+// ReplacementJudge picks the judge who takes a seat left empty in a drawn
+// debate: the highest-scoring candidate who can sit the round and has no
+// conflict with its teams, the lowest ID first among equal scores. It returns
+// nil when no candidate can take the seat.
+func ReplacementJudge(debate *Debate, candidates []*Judge, round int) *Judge {
+	eligible := make([]*Judge, 0, len(candidates))
+	for _, judge := range AvailableJudges(candidates, round) {
+		if judge.conflictWith(debate) == "" {
+			eligible = append(eligible, judge)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	sort.Slice(eligible, func(i, j int) bool {
+		if eligible[i].Score != eligible[j].Score {
+			return eligible[i].Score > eligible[j].Score
+		}
+		return eligible[i].ID < eligible[j].ID
+	})
+	return eligible[0]
+}
@@ -0,0 +1,80 @@
+package pairing_algorithm
+
+import "testing"
+
+func TestPreliminaryPairingsSkipUnavailableJudges(t *testing.T) {
+	teams := createMockTeams(4)
+	judges := createMockJudges(3)
+	judges[0].UnavailableRounds = map[int]bool{2: true}
+	judges[1].UnavailableRounds = map[int]bool{1: true, 3: true}
+
+	specs := TournamentSpecs{PreliminaryRounds: 3, JudgesPerDebate: 1, Seed: 1}
+	debates, err := GeneratePairings(teams, judges, createMockRooms(2), specs, 1, false)
+	if err != nil {
+		t.Fatalf("Error generating pairings: %v", err)
+	}
+
+	for i, debate := range debates {
+		round := i/2 + 1
+		for _, judge := range debate.Judges {
+			if judge.UnavailableRounds[round] {
+				t.Errorf("%s is seated in round %d, which they cannot sit", judge.Name, round)
+			}
+		}
+	}
+}
+
+func TestPreliminaryPairingsNeedJudgesInEveryRound(t *testing.T) {
+	teams := createMockTeams(4)
+	judges := createMockJudges(2)
+	for _, judge := range judges {
+		judge.UnavailableRounds = map[int]bool{2: true}
+	}
+
+	specs := TournamentSpecs{PreliminaryRounds: 2, JudgesPerDebate: 1, Seed: 1}
+	if _, err := GeneratePairings(teams, judges, createMockRooms(2), specs, 1, false); err == nil {
+		t.Error("Expected an error for a round without available judges")
+	}
+}
+
+func TestValidateJudgeAvailability(t *testing.T) {
+	teams := createMockTeams(4)
+	judges := createMockJudges(2)
+	judges[1].UnavailableRounds = map[int]bool{3: true}
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1], Judges: []*Judge{judges[0]}},
+		{Team1: teams[2], Team2: teams[3], Judges: []*Judge{judges[1]}},
+	}
+
+	issues := ValidateJudgeAvailability(debates, 3)
+	if len(issues) != 1 || issues[0].Type != IssueJudgeUnavailable || issues[0].Debate != 1 {
+		t.Errorf("Expected one judge_unavailable issue on debate 1, got %v", issues)
+	}
+	if issues := ValidateJudgeAvailability(debates, 2); len(issues) != 0 {
+		t.Errorf("Expected no issues in round 2, got %v", issues)
+	}
+}
+
+func TestReplacementJudge(t *testing.T) {
+	teams := createMockTeams(2)
+	teams[0].SchoolIDs = []int{7}
+	debate := &Debate{Team1: teams[0], Team2: teams[1]}
+
+	judges := createMockJudges(4)
+	judges[0].Score = 90
+	judges[0].Conflicts = JudgeConflicts{SchoolIDs: map[int]bool{7: true}}
+	judges[1].Score = 80
+	judges[1].UnavailableRounds = map[int]bool{2: true}
+	judges[2].Score = 60
+	judges[3].Score = 60
+
+	if judge := ReplacementJudge(debate, judges, 2); judge == nil || judge.ID != 3 {
+		t.Errorf("Expected judge 3 to replace, got %v", judge)
+	}
+	if judge := ReplacementJudge(debate, judges, 1); judge == nil || judge.ID != 2 {
+		t.Errorf("Expected judge 2 to replace in round 1, got %v", judge)
+	}
+	if judge := ReplacementJudge(debate, judges[:2], 2); judge != nil {
+		t.Errorf("Expected no replacement, got %s", judge.Name)
+	}
+}
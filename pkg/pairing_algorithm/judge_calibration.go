@@ -0,0 +1,185 @@
+package pairing_algorithm
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultOutlierThreshold is how many standard deviations a ballot's average
+// points may stray from the average ballot before it is flagged.
+const DefaultOutlierThreshold = 2.0
+
+// JudgeSpeech is the points one judge gave one speech.
+type JudgeSpeech struct {
+	JudgeID int
+	// BallotID identifies the judge's own ballot of the debate.
+	BallotID int
+	DebateID int
+	TeamID   int
+	Position int
+	Points   float64
+}
+
+// JudgeCalibration summarises the speaker points a judge gave.
+type JudgeCalibration struct {
+	JudgeID  int
+	Ballots  int
+	Speeches int
+	Mean     float64
+	StdDev   float64
+	Min      float64
+	Max      float64
+	// RoomDeviation is how far the judge's ballots are, on average, above
+	// the average points of their rooms over every judge on the panel. It is
+	// 0 for a judge who only judged alone.
+	RoomDeviation float64
+}
+
+// BallotOutlier is a judge's ballot whose average points stray from the
+// average ballot by more than the outlier threshold.
+type BallotOutlier struct {
+	BallotID    int
+	JudgeID     int
+	DebateID    int
+	Mean        float64
+	RoomAverage float64
+	// ZScore is the ballot's average points in standard deviations of
+	// every ballot's average points from their mean.
+	ZScore float64
+}
+
+// CalibrationReport is the calibration of every judge of a tournament.
+type CalibrationReport struct {
+	// Mean and StdDev are over every speech of the tournament.
+	Mean     float64
+	StdDev   float64
+	Judges   []JudgeCalibration
+	Outliers []BallotOutlier
+}
+
+// CalibrateJudges works out each judge's mean, spread and deviation from the
+// room average, and flags the ballots whose average points stray more than
+// threshold standard deviations from the average ballot. Judges are listed
+// by ID and outliers from the furthest out.
+func CalibrateJudges(speeches []JudgeSpeech, threshold float64) CalibrationReport {
+	var report CalibrationReport
+	if len(speeches) == 0 {
+		return report
+	}
+
+	allPoints := make([]float64, len(speeches))
+	byJudge := make(map[int][]float64)
+	byBallot := make(map[int][]float64)
+	byDebate := make(map[int][]float64)
+	ballotJudge := make(map[int]int)
+	ballotDebate := make(map[int]int)
+	var ballotOrder []int
+	for i, speech := range speeches {
+		allPoints[i] = speech.Points
+		byJudge[speech.JudgeID] = append(byJudge[speech.JudgeID], speech.Points)
+		if _, ok := byBallot[speech.BallotID]; !ok {
+			ballotOrder = append(ballotOrder, speech.BallotID)
+		}
+		byBallot[speech.BallotID] = append(byBallot[speech.BallotID], speech.Points)
+		byDebate[speech.DebateID] = append(byDebate[speech.DebateID], speech.Points)
+		ballotJudge[speech.BallotID] = speech.JudgeID
+		ballotDebate[speech.BallotID] = speech.DebateID
+	}
+	report.Mean, report.StdDev = meanAndStdDev(allPoints)
+
+	ballotMeans := make([]float64, len(ballotOrder))
+	judgeBallots := make(map[int]int)
+	roomDeviations := make(map[int]float64)
+	for i, ballotID := range ballotOrder {
+		ballotMeans[i], _ = meanAndStdDev(byBallot[ballotID])
+		roomAverage, _ := meanAndStdDev(byDebate[ballotDebate[ballotID]])
+		judgeBallots[ballotJudge[ballotID]]++
+		roomDeviations[ballotJudge[ballotID]] += ballotMeans[i] - roomAverage
+	}
+
+	for judgeID, points := range byJudge {
+		calibration := JudgeCalibration{
+			JudgeID:       judgeID,
+			Ballots:       judgeBallots[judgeID],
+			Speeches:      len(points),
+			Min:           points[0],
+			Max:           points[0],
+			RoomDeviation: roomDeviations[judgeID] / float64(judgeBallots[judgeID]),
+		}
+		calibration.Mean, calibration.StdDev = meanAndStdDev(points)
+		for _, p := range points {
+			calibration.Min = math.Min(calibration.Min, p)
+			calibration.Max = math.Max(calibration.Max, p)
+		}
+		report.Judges = append(report.Judges, calibration)
+	}
+	sort.Slice(report.Judges, func(i, j int) bool {
+		return report.Judges[i].JudgeID < report.Judges[j].JudgeID
+	})
+
+	meanOfBallots, spreadOfBallots := meanAndStdDev(ballotMeans)
+	if spreadOfBallots == 0 {
+		return report
+	}
+	for i, ballotID := range ballotOrder {
+		z := (ballotMeans[i] - meanOfBallots) / spreadOfBallots
+		if math.Abs(z) <= threshold {
+			continue
+		}
+		roomAverage, _ := meanAndStdDev(byDebate[ballotDebate[ballotID]])
+		report.Outliers = append(report.Outliers, BallotOutlier{
+			BallotID:    ballotID,
+			JudgeID:     ballotJudge[ballotID],
+			DebateID:    ballotDebate[ballotID],
+			Mean:        ballotMeans[i],
+			RoomAverage: roomAverage,
+			ZScore:      z,
+		})
+	}
+	sort.SliceStable(report.Outliers, func(i, j int) bool {
+		return math.Abs(report.Outliers[i].ZScore) > math.Abs(report.Outliers[j].ZScore)
+	})
+
+	return report
+}
+
+// NormaliseSpeeches rescales each judge's points to the tournament's mean
+// and spread, so a speech keeps its place within the judge's own range. The
+// points of a judge with a single ballot or no spread are only shifted to the
+// tournament's mean, since their spread says nothing yet. The result lines up
+// with speeches.
+func NormaliseSpeeches(speeches []JudgeSpeech, report CalibrationReport) []float64 {
+	judges := make(map[int]JudgeCalibration, len(report.Judges))
+	for _, calibration := range report.Judges {
+		judges[calibration.JudgeID] = calibration
+	}
+
+	normalised := make([]float64, len(speeches))
+	for i, speech := range speeches {
+		calibration := judges[speech.JudgeID]
+		scale := 1.0
+		if calibration.Ballots > 1 && calibration.StdDev > 0 {
+			scale = report.StdDev / calibration.StdDev
+		}
+		normalised[i] = report.Mean + (speech.Points-calibration.Mean)*scale
+	}
+	return normalised
+}
+
+// meanAndStdDev returns the mean and population standard deviation of values.
+func meanAndStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
@@ -0,0 +1,106 @@
+package pairing_algorithm
+
+import (
+	"math"
+	"testing"
+)
+
+func judgeBallot(judgeID, ballotID, debateID int, points ...float64) []JudgeSpeech {
+	speeches := make([]JudgeSpeech, len(points))
+	for i, p := range points {
+		speeches[i] = JudgeSpeech{JudgeID: judgeID, BallotID: ballotID, DebateID: debateID, TeamID: i%2 + 1, Position: i/2 + 1, Points: p}
+	}
+	return speeches
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCalibrateJudgesRoomDeviation(t *testing.T) {
+	var speeches []JudgeSpeech
+	// Judges 1 and 2 share a panel, judge 1 scoring two points higher
+	speeches = append(speeches, judgeBallot(1, 1, 1, 76, 74)...)
+	speeches = append(speeches, judgeBallot(2, 2, 1, 74, 72)...)
+	// Judge 3 judges alone
+	speeches = append(speeches, judgeBallot(3, 3, 2, 70, 80)...)
+
+	report := CalibrateJudges(speeches, DefaultOutlierThreshold)
+	if len(report.Judges) != 3 {
+		t.Fatalf("Expected 3 judges, got %d", len(report.Judges))
+	}
+	if !closeTo(report.Mean, 446.0/6) {
+		t.Errorf("Expected tournament mean %.3f, got %.3f", 446.0/6, report.Mean)
+	}
+
+	judge1, judge2, judge3 := report.Judges[0], report.Judges[1], report.Judges[2]
+	if !closeTo(judge1.RoomDeviation, 1) || !closeTo(judge2.RoomDeviation, -1) {
+		t.Errorf("Expected room deviations 1 and -1, got %.3f and %.3f", judge1.RoomDeviation, judge2.RoomDeviation)
+	}
+	if !closeTo(judge3.RoomDeviation, 0) {
+		t.Errorf("Expected a judge alone to have no room deviation, got %.3f", judge3.RoomDeviation)
+	}
+	if !closeTo(judge3.Mean, 75) || !closeTo(judge3.StdDev, 5) || judge3.Min != 70 || judge3.Max != 80 {
+		t.Errorf("Unexpected spread for judge 3: %+v", judge3)
+	}
+}
+
+func TestCalibrateJudgesFlagsOutliers(t *testing.T) {
+	var speeches []JudgeSpeech
+	for ballot := 1; ballot <= 9; ballot++ {
+		speeches = append(speeches, judgeBallot(ballot, ballot, ballot, 75, 75)...)
+	}
+	speeches = append(speeches, judgeBallot(10, 10, 10, 60, 62)...)
+
+	report := CalibrateJudges(speeches, DefaultOutlierThreshold)
+	if len(report.Outliers) != 1 {
+		t.Fatalf("Expected 1 outlier, got %d", len(report.Outliers))
+	}
+	outlier := report.Outliers[0]
+	if outlier.BallotID != 10 || outlier.JudgeID != 10 || outlier.ZScore >= 0 {
+		t.Errorf("Expected judge 10's low ballot to be flagged, got %+v", outlier)
+	}
+	if !closeTo(outlier.Mean, 61) || !closeTo(outlier.RoomAverage, 61) {
+		t.Errorf("Expected mean and room average 61, got %.3f and %.3f", outlier.Mean, outlier.RoomAverage)
+	}
+
+	if report := CalibrateJudges(speeches, 5); len(report.Outliers) != 0 {
+		t.Errorf("Expected no outliers at a threshold of 5, got %d", len(report.Outliers))
+	}
+}
+
+func TestNormaliseSpeeches(t *testing.T) {
+	var speeches []JudgeSpeech
+	// A generous judge with a narrow range and a harsh judge with a wide one
+	speeches = append(speeches, judgeBallot(1, 1, 1, 79, 81)...)
+	speeches = append(speeches, judgeBallot(1, 2, 2, 79, 81)...)
+	speeches = append(speeches, judgeBallot(2, 3, 3, 64, 76)...)
+	speeches = append(speeches, judgeBallot(2, 4, 4, 64, 76)...)
+
+	report := CalibrateJudges(speeches, DefaultOutlierThreshold)
+	normalised := NormaliseSpeeches(speeches, report)
+
+	// Both judges' best and worst speeches land on the same points
+	if !closeTo(normalised[0], normalised[4]) || !closeTo(normalised[1], normalised[5]) {
+		t.Errorf("Expected both judges' ranges to match after normalising, got %v", normalised)
+	}
+	var sum float64
+	for _, points := range normalised {
+		sum += points
+	}
+	if !closeTo(sum/float64(len(normalised)), report.Mean) {
+		t.Errorf("Expected normalised points to keep the tournament mean %.3f, got %.3f", report.Mean, sum/float64(len(normalised)))
+	}
+}
+
+func TestNormaliseSpeechesSingleBallotOnlyShifts(t *testing.T) {
+	var speeches []JudgeSpeech
+	speeches = append(speeches, judgeBallot(1, 1, 1, 70, 80)...)
+	speeches = append(speeches, judgeBallot(2, 2, 2, 74, 76)...)
+
+	report := CalibrateJudges(speeches, DefaultOutlierThreshold)
+	normalised := NormaliseSpeeches(speeches, report)
+	if !closeTo(normalised[1]-normalised[0], 10) || !closeTo(normalised[3]-normalised[2], 2) {
+		t.Errorf("Expected judges with one ballot to keep their spread, got %v", normalised)
+	}
+}
@@ -0,0 +1,161 @@
+package pairing_algorithm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrJudgeConflicts = errors.New("judge conflicts prevent a full panel")
+
+// JudgeConflicts are the hard constraints on where a judge may be allocated.
+// A judge is never placed in a room with a team from one of their schools, a
+// team they are conflicted with, or a team fielding a student they are
+// conflicted with.
+type JudgeConflicts struct {
+	SchoolIDs  map[int]bool
+	TeamIDs    map[int]bool
+	StudentIDs map[int]bool
+	// MaxTimesJudgingTeam caps how many debates of the same team the judge may
+	// adjudicate in a tournament. Zero means no limit.
+	MaxTimesJudgingTeam int
+}
+
+// conflictWith returns why the judge cannot adjudicate the debate, or an empty
+// string when the judge is free to. The bye placeholder never conflicts.
+func (j *Judge) conflictWith(debate *Debate) string {
+	for _, team := range debate.Teams() {
+		if team.ID == ByeTeamID {
+			continue
+		}
+		if j.Conflicts.TeamIDs[team.ID] {
+			return fmt.Sprintf("%s is conflicted with team %s", j.Name, team.Name)
+		}
+		for _, schoolID := range team.SchoolIDs {
+			if j.Conflicts.SchoolIDs[schoolID] {
+				return fmt.Sprintf("%s is affiliated with school %d of team %s", j.Name, schoolID, team.Name)
+			}
+		}
+		for _, studentID := range team.SpeakerIDs {
+			if j.Conflicts.StudentIDs[studentID] {
+				return fmt.Sprintf("%s is conflicted with student %d of team %s", j.Name, studentID, team.Name)
+			}
+		}
+		limit := j.Conflicts.MaxTimesJudgingTeam
+		if limit > 0 && j.JudgedTeams[team.ID] >= limit {
+			return fmt.Sprintf("%s has already judged team %s %d times (limit %d)", j.Name, team.Name, j.JudgedTeams[team.ID], limit)
+		}
+	}
+	return ""
+}
+
+// allocateJudgePanels seats up to judgesPerDebate judges in every debate
+// without breaking any judge conflict. Panel seats are matched to judges with
+// augmenting paths, so a judge already seated is moved to another room when
+// that frees them for a room nobody else can take. Seats are filled from the
+// most important room down and judges are tried from the highest score down,
+// so the strongest panels sit in the rooms with the most at stake. Each panel
+// lists its judges from the highest score down.
+//
+// Running out of judges still leaves panels short, as before. When a panel is
+// short while judges are left unallocated, the conflicts that kept those
+// judges out are returned as an error.
+func allocateJudgePanels(debates []*Debate, judges []*Judge, judgesPerDebate int, random RandomSource) error {
+	blocked := make([][]string, len(debates))
+	for d, debate := range debates {
+		blocked[d] = make([]string, len(judges))
+		for j, judge := range judges {
+			blocked[d][j] = judge.conflictWith(debate)
+		}
+	}
+
+	seatDebate := make([]int, 0, len(debates)*judgesPerDebate)
+	for _, d := range debatesByImportance(debates) {
+		for i := 0; i < judgesPerDebate; i++ {
+			seatDebate = append(seatDebate, d)
+		}
+	}
+
+	order := judgesByScore(judges, random)
+	judgeSeat := make([]int, len(judges))
+	for j := range judgeSeat {
+		judgeSeat[j] = -1
+	}
+
+	var seat func(s int, visited []bool) bool
+	seat = func(s int, visited []bool) bool {
+		// Take the best free judge before moving anyone already seated, so a
+		// room only loses a judge when another room cannot be filled otherwise.
+		for _, j := range order {
+			if judgeSeat[j] == -1 && blocked[seatDebate[s]][j] == "" {
+				judgeSeat[j] = s
+				return true
+			}
+		}
+		for _, j := range order {
+			if visited[j] || blocked[seatDebate[s]][j] != "" {
+				continue
+			}
+			visited[j] = true
+			if judgeSeat[j] == -1 || seat(judgeSeat[j], visited) {
+				judgeSeat[j] = s
+				return true
+			}
+		}
+		return false
+	}
+
+	for s := range seatDebate {
+		seat(s, make([]bool, len(judges)))
+	}
+
+	for _, debate := range debates {
+		debate.Judges = make([]*Judge, 0, judgesPerDebate)
+	}
+	for _, j := range order {
+		if judgeSeat[j] == -1 {
+			continue
+		}
+		debate := debates[seatDebate[judgeSeat[j]]]
+		debate.Judges = append(debate.Judges, judges[j])
+	}
+
+	for d, debate := range debates {
+		if len(debate.Judges) == judgesPerDebate {
+			continue
+		}
+		reasons := make([]string, 0)
+		for j := range judges {
+			if judgeSeat[j] == -1 && blocked[d][j] != "" {
+				reasons = append(reasons, blocked[d][j])
+			}
+		}
+		if len(reasons) > 0 {
+			sort.Strings(reasons)
+			return fmt.Errorf("%w: room with %s needs %d judges but only %d could be seated: %s",
+				ErrJudgeConflicts, debateTeamNames(debate), judgesPerDebate, len(debate.Judges), strings.Join(reasons, "; "))
+		}
+	}
+
+	for _, debate := range debates {
+		for _, judge := range debate.Judges {
+			if judge.JudgedTeams == nil {
+				judge.JudgedTeams = make(map[int]int)
+			}
+			for _, team := range debate.Teams() {
+				judge.JudgedTeams[team.ID]++
+			}
+		}
+	}
+
+	return nil
+}
+
+func debateTeamNames(debate *Debate) string {
+	names := make([]string, 0, TeamsPerBritishParliamentaryRoom)
+	for _, team := range debate.Teams() {
+		names = append(names, team.Name)
+	}
+	return strings.Join(names, " vs ")
+}
@@ -0,0 +1,114 @@
+package pairing_algorithm
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAssignJudgesRespectsConflicts(t *testing.T) {
+	for run := 0; run < 50; run++ {
+		teams := createMockTeams(6)
+		for i, team := range teams {
+			team.SchoolIDs = []int{i + 1}
+			team.SpeakerIDs = []int{100 + i}
+		}
+		debates := []*Debate{
+			{Team1: teams[0], Team2: teams[1]},
+			{Team1: teams[2], Team2: teams[3]},
+			{Team1: teams[4], Team2: teams[5]},
+		}
+
+		// Judges 1-3 can each only sit in one room, which forces judge 4 into
+		// the room none of them can take.
+		judges := createMockJudges(4)
+		judges[0].Conflicts = JudgeConflicts{SchoolIDs: map[int]bool{1: true, 3: true}}
+		judges[1].Conflicts = JudgeConflicts{TeamIDs: map[int]bool{1: true, 5: true}}
+		judges[2].Conflicts = JudgeConflicts{StudentIDs: map[int]bool{101: true, 104: true}}
+
+		assigned, err := assignJudgesAndRooms(debates, judges, createMockRooms(3), 1, createMockRandom(int64(run)))
+		if err != nil {
+			t.Fatalf("Error assigning judges: %v", err)
+		}
+
+		for _, debate := range assigned {
+			if len(debate.Judges) != 1 {
+				t.Fatalf("Expected 1 judge, got %d", len(debate.Judges))
+			}
+			if reason := debate.Judges[0].conflictWith(debate); reason != "" {
+				t.Errorf("Conflicted judge allocated: %s", reason)
+			}
+		}
+	}
+}
+
+func TestAssignJudgesReportsBlockingConflict(t *testing.T) {
+	teams := createMockTeams(4)
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1]},
+		{Team1: teams[2], Team2: teams[3]},
+	}
+	judges := createMockJudges(2)
+	judges[0].Conflicts = JudgeConflicts{TeamIDs: map[int]bool{1: true, 3: true}}
+
+	_, err := assignJudgesAndRooms(debates, judges, createMockRooms(2), 1, createMockRandom(1))
+	if !errors.Is(err, ErrJudgeConflicts) {
+		t.Fatalf("Expected ErrJudgeConflicts, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "Judge 1 is conflicted with team Team") {
+		t.Errorf("Error does not name the blocking conflict: %v", err)
+	}
+}
+
+func TestAssignJudgesTeamLimit(t *testing.T) {
+	teams := createMockTeams(4)
+	judges := createMockJudges(2)
+	for _, judge := range judges {
+		judge.Conflicts = JudgeConflicts{MaxTimesJudgingTeam: 1}
+	}
+
+	rounds := [][]*Debate{
+		{{Team1: teams[0], Team2: teams[1]}, {Team1: teams[2], Team2: teams[3]}},
+		{{Team1: teams[1], Team2: teams[0]}, {Team1: teams[3], Team2: teams[2]}},
+	}
+	for r, round := range rounds {
+		if _, err := assignJudgesAndRooms(round, judges, createMockRooms(2), 1, createMockRandom(1)); err != nil {
+			t.Fatalf("Round %d: error assigning judges: %v", r+1, err)
+		}
+	}
+
+	for _, judge := range judges {
+		for teamID, times := range judge.JudgedTeams {
+			if times > 1 {
+				t.Errorf("Judge %d judged team %d %d times, limit is 1", judge.ID, teamID, times)
+			}
+		}
+	}
+
+	// Both judges have now seen every team once, so a third round cannot be judged
+	third := []*Debate{{Team1: teams[0], Team2: teams[1]}, {Team1: teams[2], Team2: teams[3]}}
+	_, err := assignJudgesAndRooms(third, judges, createMockRooms(2), 1, createMockRandom(1))
+	if !errors.Is(err, ErrJudgeConflicts) {
+		t.Fatalf("Expected ErrJudgeConflicts, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "has already judged team") {
+		t.Errorf("Error does not name the team limit: %v", err)
+	}
+}
+
+func TestAssignJudgesShortageIsNotAConflict(t *testing.T) {
+	teams := createMockTeams(4)
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1]},
+		{Team1: teams[2], Team2: teams[3]},
+	}
+
+	assigned, err := assignJudgesAndRooms(debates, createMockJudges(3), createMockRooms(2), 2, createMockRandom(1))
+	if err != nil {
+		t.Fatalf("Running out of judges should not fail: %v", err)
+	}
+	seated := len(assigned[0].Judges) + len(assigned[1].Judges)
+	if seated != 3 {
+		t.Errorf("Expected all 3 judges seated, got %d", seated)
+	}
+}
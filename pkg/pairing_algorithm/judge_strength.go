@@ -0,0 +1,101 @@
+package pairing_algorithm
+
+import (
+	"sort"
+)
+
+const (
+	// neutralJudgeScore is the score of a judge nobody has rated yet.
+	neutralJudgeScore = 50.0
+	// judgeRatingPriorWeight is how many ratings a judge needs before their
+	// own average counts as much as the neutral score.
+	judgeRatingPriorWeight = 5
+	// maxExperienceBonus caps the points a judge earns for elimination
+	// debates already adjudicated, one point per debate.
+	maxExperienceBonus = 10
+)
+
+// JudgeScore rates a judge from 0 to 100 for panel allocation. The average
+// JudgeFeedback rating (already on a 0-100 scale) is pulled towards a neutral
+// 50 until the judge has a handful of ratings, so one glowing review does not
+// put a new judge on top. Judges who have adjudicated elimination debates get
+// up to ten extra points.
+func JudgeScore(averageRating float64, ratingCount int, eliminationDebates int) float64 {
+	score := (averageRating*float64(ratingCount) + neutralJudgeScore*judgeRatingPriorWeight) /
+		float64(ratingCount+judgeRatingPriorWeight)
+
+	if eliminationDebates > maxExperienceBonus {
+		eliminationDebates = maxExperienceBonus
+	}
+	score += float64(eliminationDebates)
+
+	if score > 100 {
+		return 100
+	}
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// importance orders debates by what is at stake. Elimination rooms with the
+// best seeds come first; preliminary rooms are ranked by the wins and team
+// points their teams bring in, so the top brackets come first.
+func (d *Debate) importance() float64 {
+	var seeds, record int
+	for _, team := range d.Teams() {
+		seeds += team.EliminationRank
+		record += team.Wins + team.TeamPoints
+	}
+	if seeds > 0 {
+		return 1e6 - float64(seeds)
+	}
+	return float64(record)
+}
+
+// debatesByImportance returns the indexes of the debates from the most to the
+// least important room. Rooms of equal importance keep their order.
+func debatesByImportance(debates []*Debate) []int {
+	order := make([]int, len(debates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return debates[order[i]].importance() > debates[order[j]].importance()
+	})
+	return order
+}
+
+// judgesByScore returns the indexes of the judges from the highest to the
+// lowest score. Judges with the same score are shuffled, so unrated judges
+// are still spread at random.
+func judgesByScore(judges []*Judge, random RandomSource) []int {
+	order := random.Perm(len(judges))
+	sort.SliceStable(order, func(i, j int) bool {
+		return judges[order[i]].Score > judges[order[j]].Score
+	})
+	return order
+}
+
+// chair returns the highest-scoring judge on the panel.
+func chair(panel []*Judge) *Judge {
+	best := panel[0]
+	for _, judge := range panel[1:] {
+		if judge.Score > best.Score {
+			best = judge
+		}
+	}
+	return best
+}
+
+// panelQuality is the average score of the judges on the panel.
+func panelQuality(panel []*Judge) float64 {
+	if len(panel) == 0 {
+		return 0
+	}
+	var total float64
+	for _, judge := range panel {
+		total += judge.Score
+	}
+	return total / float64(len(panel))
+}
@@ -0,0 +1,148 @@
+package pairing_algorithm
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJudgeScore(t *testing.T) {
+	testCases := []struct {
+		name               string
+		averageRating      float64
+		ratingCount        int
+		eliminationDebates int
+		expected           float64
+	}{
+		{"Unrated judge", 0, 0, 0, 50},
+		{"Single perfect rating", 100, 1, 0, 50 + 50.0/6},
+		{"Well rated veteran", 90, 45, 4, 86 + 4},
+		{"Experience bonus is capped", 50, 0, 25, 60},
+		{"Score is capped at 100", 100, 1000, 10, 100},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			score := JudgeScore(tc.averageRating, tc.ratingCount, tc.eliminationDebates)
+			if math.Abs(score-tc.expected) > 1e-9 {
+				t.Errorf("Expected score %.4f, got %.4f", tc.expected, score)
+			}
+		})
+	}
+}
+
+func TestStrongestPanelsInTopRooms(t *testing.T) {
+	teams := createMockTeams(6)
+	teams[0].Wins, teams[1].Wins = 2, 2
+	teams[2].Wins, teams[3].Wins = 1, 1
+	debates := []*Debate{
+		{Team1: teams[4], Team2: teams[5]},
+		{Team1: teams[2], Team2: teams[3]},
+		{Team1: teams[0], Team2: teams[1]},
+	}
+
+	judges := createMockJudges(6)
+	for i, judge := range judges {
+		judge.Score = float64(90 - 10*i)
+	}
+
+	assigned, err := assignJudgesAndRooms(debates, judges, createMockRooms(3), 2, createMockRandom(1))
+	if err != nil {
+		t.Fatalf("Error assigning judges: %v", err)
+	}
+
+	top, middle, bottom := assigned[2], assigned[1], assigned[0]
+	if !(top.PanelQuality > middle.PanelQuality && middle.PanelQuality > bottom.PanelQuality) {
+		t.Errorf("Panel quality does not follow the brackets: top %.1f, middle %.1f, bottom %.1f",
+			top.PanelQuality, middle.PanelQuality, bottom.PanelQuality)
+	}
+	if top.PanelQuality != 85 {
+		t.Errorf("Expected the top room to get the two strongest judges (85), got %.1f", top.PanelQuality)
+	}
+
+	for _, debate := range assigned {
+		for _, judge := range debate.Judges {
+			if judge.IsHeadJudge && judge != chair(debate.Judges) {
+				t.Errorf("Judge %d chairs a panel with a higher-rated judge", judge.ID)
+			}
+		}
+	}
+}
+
+func TestStrongestPanelsInTopEliminationSeeds(t *testing.T) {
+	teams := createMockTeams(8)
+	judges := createMockJudges(4)
+	for i, judge := range judges {
+		judge.Score = float64(80 - 10*i)
+	}
+	specs := TournamentSpecs{EliminationRounds: 2, JudgesPerDebate: 1}
+
+	debates, err := GeneratePairings(teams, judges, createMockRooms(4), specs, 1, true)
+	if err != nil {
+		t.Fatalf("Error generating elimination pairings: %v", err)
+	}
+
+	for _, debate := range debates {
+		seed := debate.Team1.EliminationRank
+		expected := float64(80 - 10*(seed-1))
+		if debate.PanelQuality != expected {
+			t.Errorf("Room with seed %d got panel quality %.1f, expected %.1f", seed, debate.PanelQuality, expected)
+		}
+	}
+}
+
+func TestStrongJudgeMovedOnlyForConflicts(t *testing.T) {
+	teams := createMockTeams(4)
+	teams[0].Wins, teams[1].Wins = 1, 1
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1]},
+		{Team1: teams[2], Team2: teams[3]},
+	}
+
+	// The strongest judge is conflicted with the top room, so it goes to the
+	// second strongest and the strongest judges the bottom room.
+	judges := createMockJudges(2)
+	judges[0].Score, judges[1].Score = 90, 70
+	judges[0].Conflicts = JudgeConflicts{TeamIDs: map[int]bool{teams[0].ID: true}}
+
+	assigned, err := assignJudgesAndRooms(debates, judges, createMockRooms(2), 1, createMockRandom(1))
+	if err != nil {
+		t.Fatalf("Error assigning judges: %v", err)
+	}
+	if assigned[0].Judges[0].ID != judges[1].ID || assigned[1].Judges[0].ID != judges[0].ID {
+		t.Errorf("Expected judge %d in the top room and judge %d in the bottom room", judges[1].ID, judges[0].ID)
+	}
+}
+
+func TestScheduleDrawChairsEachDebateOnce(t *testing.T) {
+	teams := createMockTeams(8)
+	judges := createMockJudges(8)
+	for i, judge := range judges {
+		judge.Score = float64(90 - 5*i)
+	}
+	specs := TournamentSpecs{PreliminaryRounds: 4, JudgesPerDebate: 2, Seed: 1}
+
+	debates, err := GeneratePairings(teams, judges, createMockRooms(4), specs, 1, false)
+	if err != nil {
+		t.Fatalf("Error generating pairings: %v", err)
+	}
+
+	for i, debate := range debates {
+		chairs := 0
+		for _, judge := range debate.Judges {
+			if judge.IsHeadJudge {
+				chairs++
+				if judge != chair(debate.Judges) {
+					t.Errorf("Round %d: judge %d chairs a panel with a higher-rated judge", i/4+1, judge.ID)
+				}
+			}
+		}
+		if chairs != 1 {
+			t.Errorf("Round %d: expected one head judge, got %d", i/4+1, chairs)
+		}
+	}
+	for _, judge := range judges {
+		if judge.IsHeadJudge {
+			t.Errorf("Judge %d passed to the draw was flagged as a head judge", judge.ID)
+		}
+	}
+}
@@ -9,28 +9,49 @@ import (
 )
 
 type Team struct {
-	ID              int
-	Name            string
-	Wins            int
-	TotalPoints     float64
-	AverageRank     float64
-	LastSide        int // 1 for affirmative, -1 for negative, 0 for no previous side
-	Opponents       map[int]bool
-	SpeakerIDs      []int
-	EliminationRank int
+	ID               int
+	Name             string
+	Wins             int
+	TotalPoints      float64
+	AverageRank      float64
+	LastSide         int // 1 for affirmative, -1 for negative, 0 for no previous side
+	PropositionCount int // Preliminary debates argued as Team1
+	OppositionCount  int // Preliminary debates argued as Team2
+	TeamPoints       int // British Parliamentary team points (3/2/1/0 per debate)
+	PositionCounts   [4]int
+	Opponents        map[int]bool
+	SpeakerIDs       []int
+	SchoolIDs        []int
+	EliminationRank  int
+	BreakCategories  map[int]bool // IDs of the break categories the team is eligible for
+	Standing         int          // Place in the preliminary standings by the tournament's tiebreak chain, 0 if not ranked
+	// NeedsAccessibleRoom places the team's debates in accessible rooms
+	NeedsAccessibleRoom bool
 }
 
 type Judge struct {
 	ID          int
 	Name        string
-	IsHeadJudge bool
+	IsHeadJudge bool    // Set on the judge chairing the debate they are seated in
+	Score       float64 // 0-100, see JudgeScore
+	Conflicts   JudgeConflicts
+	JudgedTeams map[int]int // Debates already judged in the tournament, by team ID
+	// UnavailableRounds are the rounds of the draw the judge cannot sit, by
+	// round number.
+	UnavailableRounds map[int]bool
 }
 
+// Debate is a single room. Two-team formats use Team1 (proposition) and Team2
+// (opposition). British Parliamentary rooms also fill Team3 and Team4, and the
+// four teams sit as OG, OO, CG and CO in that order.
 type Debate struct {
-	Team1  *Team
-	Team2  *Team
-	Judges []*Judge
-	Room   int
+	Team1        *Team
+	Team2        *Team
+	Team3        *Team
+	Team4        *Team
+	Judges       []*Judge
+	Room         int
+	PanelQuality float64 // Average score of the judges on the panel
 }
 
 type TournamentSpecs struct {
@@ -38,25 +59,52 @@ type TournamentSpecs struct {
 	EliminationRounds     int
 	JudgesPerDebate       int
 	TeamsAdvancingToElims int
+	TeamsPerDebate        int // 2 by default, 4 for British Parliamentary
+	// Seed makes the draw reproducible: the same input and seed always give
+	// the same draw. Random, when set, is used instead.
+	Seed   int64
+	Random RandomSource `json:"-"`
+}
+
+// RandomSource is where a draw gets its randomness from. *rand.Rand
+// implements it.
+type RandomSource interface {
+	Intn(n int) int
+	Perm(n int) []int
+}
+
+// randomSource returns the random source of a draw, seeded from Seed unless
+// Random is set.
+func (specs TournamentSpecs) randomSource() RandomSource {
+	if specs.Random != nil {
+		return specs.Random
+	}
+	return rand.New(rand.NewSource(specs.Seed))
 }
 
 var ErrUnableToPair = errors.New("unable to generate valid pairings")
 
-func GeneratePairings(teams []*Team, judges []*Judge, rooms []int, specs TournamentSpecs, roundNumber int, isElimination bool) ([]*Debate, error) {
+func GeneratePairings(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs, roundNumber int, isElimination bool) ([]*Debate, error) {
+	if specs.TeamsPerDebate == 4 {
+		if isElimination {
+			return generateBritishParliamentaryEliminationPairings(teams, judges, rooms, specs, roundNumber)
+		}
+		return GenerateBritishParliamentaryRound(teams, judges, rooms, specs)
+	}
 	if isElimination {
 		return generateEliminationPairings(teams, judges, rooms, specs, roundNumber)
 	}
 	return generatePreliminaryPairings(teams, judges, rooms, specs)
 }
 
-func generateEliminationPairings(teams []*Team, judges []*Judge, rooms []int, specs TournamentSpecs, roundNumber int) ([]*Debate, error) {
+func generateEliminationPairings(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs, roundNumber int) ([]*Debate, error) {
 	teamsNeeded := int(math.Pow(2, float64(specs.EliminationRounds-roundNumber+1)))
 	if len(teams) < teamsNeeded {
 
 		return nil, fmt.Errorf("not enough teams for elimination round %d: have %d, need %d", roundNumber, len(teams), teamsNeeded)
 	}
 
-	if roundNumber == 1 {
+	if roundNumber == 1 && !sortByStanding(teams) {
 		// First elimination round: sort based on preliminary performance
 		sort.Slice(teams, func(i, j int) bool {
 			if teams[i].Wins != teams[j].Wins {
@@ -85,12 +133,10 @@ func generateEliminationPairings(teams []*Team, judges []*Judge, rooms []int, sp
 	}
 
 	// Assign rooms and judges
-	debates = assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate)
-
-	return debates, nil
+	return assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate, specs.randomSource())
 }
 
-func generatePreliminaryPairings(teams []*Team, judges []*Judge, rooms []int, specs TournamentSpecs) ([]*Debate, error) {
+func generatePreliminaryPairings(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs) ([]*Debate, error) {
 	teamIDs := make([]int, len(teams))
 	for i, team := range teams {
 		teamIDs[i] = team.ID
@@ -101,15 +147,12 @@ func generatePreliminaryPairings(teams []*Team, judges []*Judge, rooms []int, sp
 		return nil, err
 	}
 
-	if len(judges) < specs.JudgesPerDebate {
-		return nil, fmt.Errorf("not enough judges: have %d, need at least %d", len(judges), specs.JudgesPerDebate)
-	}
-
 	if len(rooms) < len(teams)/2 {
 		return nil, fmt.Errorf("not enough rooms: have %d, need at least %d", len(rooms), len(teams)/2)
 	}
 
 	allDebates := make([]*Debate, 0, len(teams)/2*specs.PreliminaryRounds)
+	random := specs.randomSource()
 
 	for round := 0; round < specs.PreliminaryRounds; round++ {
 		roundPairings := prelimPairings[round]
@@ -127,7 +170,15 @@ func generatePreliminaryPairings(teams []*Team, judges []*Judge, rooms []int, sp
 			}
 		}
 
-		roundDebates = assignJudgesAndRooms(roundDebates, judges, rooms, specs.JudgesPerDebate)
+		roundJudges := AvailableJudges(judges, round+1)
+		if len(roundJudges) < specs.JudgesPerDebate {
+			return nil, fmt.Errorf("round %d: not enough judges: have %d, need at least %d", round+1, len(roundJudges), specs.JudgesPerDebate)
+		}
+
+		roundDebates, err = assignJudgesAndRooms(roundDebates, roundJudges, rooms, specs.JudgesPerDebate, random)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %w", round+1, err)
+		}
 		allDebates = append(allDebates, roundDebates...)
 	}
 
@@ -136,8 +187,11 @@ func generatePreliminaryPairings(teams []*Team, judges []*Judge, rooms []int, sp
 
 func GeneratePreliminaryPairings(teamIDs []int, rounds int) ([][][]int, error) {
 	originalLength := len(teamIDs)
+	if originalLength%2 != 0 && rounds > originalLength {
+		return nil, ErrSecondBye
+	}
 	if originalLength%2 != 0 {
-		teamIDs = append(teamIDs, -1) // Add a "Public Speaking" team with ID -1
+		teamIDs = append(teamIDs, ByeTeamID) // Whoever meets the placeholder has a bye
 	}
 
 	n := len(teamIDs) / 2
@@ -174,7 +228,7 @@ func GeneratePreliminaryPairings(teamIDs []int, rounds int) ([][][]int, error) {
 				if i < len(newProp) && i < len(newOpp) {
 					roundPairings[i] = []int{newProp[i], newOpp[i]}
 				} else if i < len(newProp) {
-					roundPairings[i] = []int{newProp[i], -1} // Handle odd number of teams
+					roundPairings[i] = []int{newProp[i], ByeTeamID} // Handle odd number of teams
 				}
 			}
 		} else {
@@ -189,11 +243,17 @@ func GeneratePreliminaryPairings(teamIDs []int, rounds int) ([][][]int, error) {
 				}
 			case 2: // Pair diagonal two by two
 				for i := 0; i < n; i += 2 {
-					if i+1 < n {
+					switch {
+					case n%2 != 0 && i == n-3: // Pair the last three diagonally in a cycle
+						roundPairings[i] = []int{proposition[i], opposition[i+1]}
+						roundPairings[i+1] = []int{proposition[i+1], opposition[i+2]}
+						roundPairings[i+2] = []int{proposition[i+2], opposition[i]}
+						i++
+					case i+1 < n:
 						roundPairings[i] = []int{proposition[i], opposition[i+1]}
 						roundPairings[i+1] = []int{proposition[i+1], opposition[i]}
-					} else {
-						roundPairings[i] = []int{proposition[i], -1} // Handle odd number of teams
+					default: // A single pair left
+						roundPairings[i] = []int{proposition[i], opposition[i]}
 					}
 				}
 			case 3: // Pair diagonal first and second last
@@ -211,50 +271,46 @@ func GeneratePreliminaryPairings(teamIDs []int, rounds int) ([][][]int, error) {
 		pairings[round] = roundPairings
 	}
 
+	if originalLength%2 != 0 {
+		rotateScheduleByes(pairings)
+	}
+	balanceScheduleSides(pairings)
+
 	return pairings, nil
 }
 
-func assignJudgesAndRooms(pairings []*Debate, judges []*Judge, rooms []int, judgesPerDebate int) []*Debate {
-	availableJudges := make([]*Judge, len(judges))
-	copy(availableJudges, judges)
-	availableRooms := make([]int, len(rooms))
-	copy(availableRooms, rooms)
-
-	for _, debate := range pairings {
-		// Assign judges
-		debate.Judges = make([]*Judge, 0, judgesPerDebate)
-		for i := 0; i < judgesPerDebate; i++ {
-			if len(availableJudges) > 0 {
-				judgeIndex := rand.Intn(len(availableJudges))
-				debate.Judges = append(debate.Judges, availableJudges[judgeIndex])
-				availableJudges = append(availableJudges[:judgeIndex], availableJudges[judgeIndex+1:]...)
-			} else {
-				// If we run out of judges, break the loop
-				break
-			}
-		}
+// assignJudgesAndRooms allocates the judge panels and the rooms of every
+// debate but the byes. It is the only random step of a draw, and all of its
+// randomness comes from random.
+func assignJudgesAndRooms(pairings []*Debate, judges []*Judge, rooms []*Room, judgesPerDebate int, random RandomSource) ([]*Debate, error) {
+	// Byes are not held, so they take no judges or room
+	held, _ := SplitByes(pairings)
 
-		// Assign head judge
-		if len(debate.Judges) > 0 {
-			headJudgeIndex := 0
-			if len(debate.Judges) > 1 {
-				headJudgeIndex = rand.Intn(len(debate.Judges))
-			}
-			debate.Judges[headJudgeIndex].IsHeadJudge = true
+	// Assign judges
+	if err := allocateJudgePanels(held, judges, judgesPerDebate, random); err != nil {
+		return nil, err
+	}
+
+	for _, debate := range held {
+		// A judge sits in a debate of every round of a schedule draw, so each
+		// seat gets its own copy of the judge to record whether it chairs
+		for i, judge := range debate.Judges {
+			seated := *judge
+			seated.IsHeadJudge = false
+			debate.Judges[i] = &seated
 		}
 
-		// Assign room
-		if len(availableRooms) > 0 {
-			roomIndex := rand.Intn(len(availableRooms))
-			debate.Room = availableRooms[roomIndex]
-			availableRooms = append(availableRooms[:roomIndex], availableRooms[roomIndex+1:]...)
-		} else {
-			// If we run out of rooms, assign a placeholder value
-			debate.Room = -1
+		// The highest-rated judge on the panel chairs it
+		if len(debate.Judges) > 0 {
+			chair(debate.Judges).IsHeadJudge = true
 		}
+		debate.PanelQuality = panelQuality(debate.Judges)
 	}
 
-	return pairings
+	// Rooms go to the debates once their panels are known, as judges take seats
+	allocateRooms(held, rooms, random)
+
+	return pairings, nil
 }
 
 func findTeamByID(teams []*Team, id int) *Team {
@@ -263,8 +319,8 @@ func findTeamByID(teams []*Team, id int) *Team {
 			return team
 		}
 	}
-	if id == -1 {
-		return &Team{ID: -1, Name: "Public Speaking"}
+	if id == ByeTeamID {
+		return newByeTeam()
 	}
 	return nil
 }
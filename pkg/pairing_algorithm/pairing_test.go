@@ -116,7 +116,7 @@ func TestAssignRoomsAndJudges(t *testing.T) {
 	testCases := []struct {
 		name     string
 		pairings [][]*Debate
-		rooms    []int
+		rooms    []*Room
 		judges   []*Judge
 	}{
 		{
@@ -128,7 +128,7 @@ func TestAssignRoomsAndJudges(t *testing.T) {
 					{Team1: &Team{ID: 5}, Team2: &Team{ID: 6}},
 				},
 			},
-			rooms:  []int{101, 102, 103},
+			rooms:  createMockRooms(3),
 			judges: []*Judge{{ID: 1}, {ID: 2}, {ID: 3}},
 		},
 		{
@@ -150,7 +150,7 @@ func TestAssignRoomsAndJudges(t *testing.T) {
 					{Team1: &Team{ID: 3}, Team2: &Team{ID: 5}},
 				},
 			},
-			rooms:  []int{101, 102, 103},
+			rooms:  createMockRooms(3),
 			judges: []*Judge{{ID: 1}, {ID: 2}, {ID: 3}},
 		},
 		{
@@ -171,7 +171,7 @@ func TestAssignRoomsAndJudges(t *testing.T) {
 					{Team1: &Team{ID: 8}, Team2: &Team{ID: 10}},
 				},
 			},
-			rooms:  []int{101, 102, 103, 104, 105},
+			rooms:  createMockRooms(5),
 			judges: []*Judge{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}},
 		},
 	}
@@ -179,7 +179,10 @@ func TestAssignRoomsAndJudges(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			for _, roundPairings := range tc.pairings {
-				assignedDebates := assignJudgesAndRooms(roundPairings, tc.judges, tc.rooms, 1)
+				assignedDebates, err := assignJudgesAndRooms(roundPairings, tc.judges, tc.rooms, 1, createMockRandom(1))
+				if err != nil {
+					t.Fatalf("Error assigning judges and rooms: %v", err)
+				}
 
 				if len(assignedDebates) != len(roundPairings) {
 					t.Errorf("Expected %d debates, got %d", len(roundPairings), len(assignedDebates))
@@ -385,10 +388,14 @@ func createMockJudges(count int) []*Judge {
 	return judges
 }
 
-func createMockRooms(count int) []int {
-	rooms := make([]int, count)
+func createMockRandom(seed int64) RandomSource {
+	return rand.New(rand.NewSource(seed))
+}
+
+func createMockRooms(count int) []*Room {
+	rooms := make([]*Room, count)
 	for i := 0; i < count; i++ {
-		rooms[i] = i + 101
+		rooms[i] = &Room{ID: i + 101}
 	}
 	return rooms
 }
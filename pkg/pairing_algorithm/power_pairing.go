@@ -0,0 +1,208 @@
+package pairing_algorithm
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// maxPowerPairingSteps bounds the backtracking search so a field with no valid
+// draw fails fast instead of exploring every permutation.
+const maxPowerPairingSteps = 200000
+
+// GeneratePowerPairedRound pairs a single preliminary round from the current
+// standings. It is meant to be called once per round, after the ballots of the
+// previous round are in, so that Team.Wins, Team.TotalPoints and Team.Opponents
+// reflect every debate held so far.
+func GeneratePowerPairedRound(teams []*Team, judges []*Judge, rooms []*Room, specs TournamentSpecs) ([]*Debate, error) {
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(judges) < specs.JudgesPerDebate {
+		return nil, fmt.Errorf("not enough judges: have %d, need at least %d", len(judges), specs.JudgesPerDebate)
+	}
+
+	held, _ := SplitByes(debates)
+	if len(rooms) < len(held) {
+		return nil, fmt.Errorf("not enough rooms: have %d, need at least %d", len(rooms), len(held))
+	}
+
+	return assignJudgesAndRooms(debates, judges, rooms, specs.JudgesPerDebate, specs.randomSource())
+}
+
+// PowerPairTeams builds the debates of one power-paired round without judges
+// or rooms. Teams are bracketed by wins and ordered by total points inside a
+// bracket. An odd bracket pulls up the strongest team of the bracket below, and
+// each bracket is then split in half so the top half meets the bottom half
+// (1 v n/2+1, 2 v n/2+2, ...). Teams listed in each other's Opponents are never
+// paired; when the ideal draw would cause a rematch, the closest available
+// opponent in the standings is used instead. Teams that are both owed the same
+// side are kept apart where possible, so that every team stays within one
+// debate of an even proposition/opposition split. With an odd number of teams,
+// the lowest team in the standings that has not had a bye yet gets the bye.
+func PowerPairTeams(teams []*Team) ([]*Debate, error) {
+	if len(teams) < 2 {
+		return nil, fmt.Errorf("not enough teams to pair: have %d, need at least 2", len(teams))
+	}
+
+	standings := make([]*Team, len(teams))
+	copy(standings, teams)
+	sortByStandings(standings)
+
+	if len(standings)%2 != 0 {
+		if !slices.ContainsFunc(standings, func(team *Team) bool { return !team.Opponents[ByeTeamID] }) {
+			return nil, ErrSecondBye
+		}
+		// Same convention as GeneratePreliminaryPairings: the bottom team meets
+		// the bye placeholder, unless it already had a bye.
+		standings = append(standings, newByeTeam())
+	}
+
+	ideal := idealPowerPairing(standings)
+
+	// Side balance is only given up when no draw can respect it.
+	pairs, ok := searchPowerPairing(standings, ideal, true)
+	if !ok {
+		pairs, ok = searchPowerPairing(standings, ideal, false)
+	}
+	if !ok {
+		return nil, ErrUnableToPair
+	}
+
+	position := make(map[*Team]int, len(standings))
+	for i, team := range standings {
+		position[team] = i
+	}
+
+	// Present the draw from the top of the standings down.
+	sort.Slice(pairs, func(i, j int) bool {
+		return position[pairs[i][0]] < position[pairs[j][0]]
+	})
+
+	debates := make([]*Debate, len(pairs))
+	for i, pair := range pairs {
+		team1, team2 := orientDebate(pair[0], pair[1])
+		debates[i] = &Debate{Team1: team1, Team2: team2}
+	}
+
+	return debates, nil
+}
+
+// searchPowerPairing runs the backtracking search for a draw without
+// rematches, optionally also refusing pairings whose sides clash.
+func searchPowerPairing(standings []*Team, ideal []int, balanceSides bool) ([][2]*Team, bool) {
+	pairs := make([][2]*Team, 0, len(standings)/2)
+	paired := make([]bool, len(standings))
+	steps := 0
+
+	var search func() bool
+	search = func() bool {
+		first := -1
+		for i := range standings {
+			if !paired[i] {
+				first = i
+				break
+			}
+		}
+		if first == -1 {
+			return true
+		}
+
+		steps++
+		if steps > maxPowerPairingSteps {
+			return false
+		}
+
+		team := standings[first]
+		paired[first] = true
+		for _, candidate := range powerPairingCandidates(first, ideal[first], paired) {
+			opponent := standings[candidate]
+			if haveMet(team, opponent) || (balanceSides && sidesClash(team, opponent)) {
+				continue
+			}
+			paired[candidate] = true
+			pairs = append(pairs, [2]*Team{team, opponent})
+			if search() {
+				return true
+			}
+			pairs = pairs[:len(pairs)-1]
+			paired[candidate] = false
+		}
+		paired[first] = false
+		return false
+	}
+
+	return pairs, search()
+}
+
+// sortByStandings orders teams by wins, then total points, then average rank.
+// Team ID breaks the remaining ties so the draw is stable for the same input.
+func sortByStandings(teams []*Team) {
+	sort.SliceStable(teams, func(i, j int) bool {
+		if teams[i].Wins != teams[j].Wins {
+			return teams[i].Wins > teams[j].Wins
+		}
+		if teams[i].TotalPoints != teams[j].TotalPoints {
+			return teams[i].TotalPoints > teams[j].TotalPoints
+		}
+		if teams[i].AverageRank != teams[j].AverageRank {
+			return teams[i].AverageRank < teams[j].AverageRank
+		}
+		return teams[i].ID < teams[j].ID
+	})
+}
+
+// idealPowerPairing returns, for every position in the sorted standings, the
+// position of its preferred opponent when rematches are ignored.
+func idealPowerPairing(standings []*Team) []int {
+	ideal := make([]int, len(standings))
+
+	start := 0
+	for start < len(standings) {
+		end := start
+		for end < len(standings) && standings[end].Wins == standings[start].Wins {
+			end++
+		}
+		// Pull up the strongest team of the next bracket into an odd bracket.
+		if (end-start)%2 != 0 && end < len(standings) {
+			end++
+		}
+
+		half := (end - start) / 2
+		for i := 0; i < half; i++ {
+			ideal[start+i] = start + half + i
+			ideal[start+half+i] = start + i
+		}
+		start = end
+	}
+
+	return ideal
+}
+
+// powerPairingCandidates lists the unpaired positions that the team at first
+// may meet, closest to its ideal opponent first.
+func powerPairingCandidates(first, ideal int, paired []bool) []int {
+	candidates := make([]int, 0, len(paired))
+	for i := range paired {
+		if i != first && !paired[i] {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return abs(candidates[i]-ideal) < abs(candidates[j]-ideal)
+	})
+	return candidates
+}
+
+func haveMet(a, b *Team) bool {
+	return a.Opponents[b.ID] || b.Opponents[a.ID]
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,196 @@
+package pairing_algorithm
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestPowerPairTeamsBrackets(t *testing.T) {
+	teams := createMockTeams(8)
+	// Two teams on 2 wins, four on 1 win, two on 0 wins.
+	wins := []int{2, 2, 1, 1, 1, 1, 0, 0}
+	for i, team := range teams {
+		team.Wins = wins[i]
+		team.TotalPoints = float64(100 - i)
+	}
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+
+	expected := [][2]int{{1, 2}, {3, 5}, {4, 6}, {7, 8}}
+	if len(debates) != len(expected) {
+		t.Fatalf("Expected %d debates, got %d", len(expected), len(debates))
+	}
+	for i, debate := range debates {
+		if !samePair(debate, expected[i][0], expected[i][1]) {
+			t.Errorf("Debate %d: expected %d v %d, got %d v %d", i+1, expected[i][0], expected[i][1], debate.Team1.ID, debate.Team2.ID)
+		}
+	}
+}
+
+func TestPowerPairTeamsPullUp(t *testing.T) {
+	teams := createMockTeams(6)
+	// Three teams on 1 win: the bracket is odd, so the best 0-win team is pulled up.
+	wins := []int{1, 1, 1, 0, 0, 0}
+	for i, team := range teams {
+		team.Wins = wins[i]
+		team.TotalPoints = float64(100 - i)
+	}
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+
+	expected := [][2]int{{1, 3}, {2, 4}, {5, 6}}
+	for i, debate := range debates {
+		if !samePair(debate, expected[i][0], expected[i][1]) {
+			t.Errorf("Debate %d: expected %d v %d, got %d v %d", i+1, expected[i][0], expected[i][1], debate.Team1.ID, debate.Team2.ID)
+		}
+	}
+}
+
+func TestPowerPairTeamsAvoidsRematches(t *testing.T) {
+	teams := createMockTeams(4)
+	for i, team := range teams {
+		team.Wins = 1
+		team.TotalPoints = float64(100 - i)
+	}
+	// The ideal draw is 1 v 3 and 2 v 4, but 1 and 3 have already met.
+	teams[0].Opponents[3] = true
+	teams[2].Opponents[1] = true
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+	for _, debate := range debates {
+		if samePair(debate, 1, 3) {
+			t.Errorf("Teams 1 and 3 were paired again")
+		}
+	}
+}
+
+func TestPowerPairTeamsImpossible(t *testing.T) {
+	teams := createMockTeams(2)
+	teams[0].Opponents[2] = true
+	teams[1].Opponents[1] = true
+
+	if _, err := PowerPairTeams(teams); !errors.Is(err, ErrUnableToPair) {
+		t.Errorf("Expected ErrUnableToPair, got %v", err)
+	}
+}
+
+func TestPowerPairTeamsOddField(t *testing.T) {
+	teams := createMockTeams(5)
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+	if len(debates) != 3 {
+		t.Fatalf("Expected 3 debates, got %d", len(debates))
+	}
+	byes := 0
+	for _, debate := range debates {
+		if debate.IsBye() {
+			byes++
+		}
+	}
+	if byes != 1 {
+		t.Errorf("Expected exactly one bye, got %d", byes)
+	}
+}
+
+func TestGeneratePowerPairedRoundsWithoutRematches(t *testing.T) {
+	testCases := []struct {
+		name   string
+		teams  int
+		rounds int
+	}{
+		{"8 teams, 3 rounds", 8, 3},
+		{"16 teams, 5 rounds", 16, 5},
+		{"20 teams, 5 rounds", 20, 5},
+		{"30 teams, 5 rounds", 30, 5},
+		{"100 teams, 6 rounds", 100, 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(int64(tc.teams)))
+			teams := createMockTeams(tc.teams)
+			judges := createMockJudges(tc.teams)
+			rooms := createMockRooms(tc.teams / 2)
+			specs := TournamentSpecs{PreliminaryRounds: tc.rounds, JudgesPerDebate: 1}
+
+			for round := 1; round <= tc.rounds; round++ {
+				for _, judge := range judges {
+					judge.IsHeadJudge = false
+				}
+
+				debates, err := GeneratePowerPairedRound(teams, judges, rooms, specs)
+				if err != nil {
+					t.Fatalf("Round %d: error generating power pairings: %v", round, err)
+				}
+				validateRoundPairings(t, debates, tc.teams/2, 1)
+
+				for _, debate := range debates {
+					if haveMet(debate.Team1, debate.Team2) {
+						t.Errorf("Round %d: teams %d and %d meet more than once", round, debate.Team1.ID, debate.Team2.ID)
+					}
+					debate.Team1.Opponents[debate.Team2.ID] = true
+					debate.Team2.Opponents[debate.Team1.ID] = true
+					debate.Team1.PropositionCount++
+					debate.Team1.LastSide = 1
+					debate.Team2.OppositionCount++
+					debate.Team2.LastSide = -1
+
+					winner, loser := debate.Team1, debate.Team2
+					if rng.Intn(2) == 0 {
+						winner, loser = loser, winner
+					}
+					winner.Wins++
+					winner.TotalPoints += float64(150 + rng.Intn(20))
+					loser.TotalPoints += float64(140 + rng.Intn(20))
+				}
+
+				for _, team := range teams {
+					if abs(team.PropositionCount-team.OppositionCount) > 1 {
+						t.Errorf("Round %d: team %d has %d proposition and %d opposition debates", round, team.ID, team.PropositionCount, team.OppositionCount)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPowerPairTeamsSideAllocation(t *testing.T) {
+	teams := createMockTeams(4)
+	for i, team := range teams {
+		team.Wins = 1
+		team.TotalPoints = float64(100 - i)
+	}
+	// The ideal draw is 1 v 3 and 2 v 4, but 1 and 3 both argued proposition
+	// last round, so one of them would end up two propositions ahead.
+	teams[0].PropositionCount, teams[0].LastSide = 1, 1
+	teams[2].PropositionCount, teams[2].LastSide = 1, 1
+	teams[1].OppositionCount, teams[1].LastSide = 1, -1
+	teams[3].OppositionCount, teams[3].LastSide = 1, -1
+
+	debates, err := PowerPairTeams(teams)
+	if err != nil {
+		t.Fatalf("Error generating power pairings: %v", err)
+	}
+	for _, debate := range debates {
+		if debate.Team1.PropositionCount != 0 || debate.Team2.OppositionCount != 0 {
+			t.Errorf("Team %d (prop) v team %d (opp) repeats a side", debate.Team1.ID, debate.Team2.ID)
+		}
+	}
+}
+
+func samePair(debate *Debate, a, b int) bool {
+	return (debate.Team1.ID == a && debate.Team2.ID == b) || (debate.Team1.ID == b && debate.Team2.ID == a)
+}
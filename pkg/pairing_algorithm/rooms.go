@@ -0,0 +1,176 @@
+package pairing_algorithm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Room is a room a debate can be held in.
+type Room struct {
+	ID int
+	// Capacity is how many people the room seats, 0 if unknown.
+	Capacity   int
+	Accessible bool
+}
+
+// UnmarshalJSON also reads a bare room ID, as draw inputs were saved before
+// rooms carried their capacity and accessibility.
+func (r *Room) UnmarshalJSON(data []byte) error {
+	var id int
+	if err := json.Unmarshal(data, &id); err == nil {
+		*r = Room{ID: id}
+		return nil
+	}
+	type room Room
+	return json.Unmarshal(data, (*room)(r))
+}
+
+// needsAccessibleRoom reports whether a team in the debate needs an
+// accessible room.
+func (d *Debate) needsAccessibleRoom() bool {
+	for _, team := range d.Teams() {
+		if team.NeedsAccessibleRoom {
+			return true
+		}
+	}
+	return false
+}
+
+// seatsNeeded is how many people the debate brings into its room: the
+// speakers of its teams and its judges.
+func (d *Debate) seatsNeeded() int {
+	seats := len(d.Judges)
+	for _, team := range d.Teams() {
+		seats += len(team.SpeakerIDs)
+	}
+	return seats
+}
+
+// fits reports whether the room seats the debate, taking an unknown capacity
+// to fit.
+func (r *Room) fits(debate *Debate) bool {
+	return r.Capacity == 0 || r.Capacity >= debate.seatsNeeded()
+}
+
+// allocateRooms gives every held debate a room. Debates with a team that needs
+// an accessible room are placed first, in accessible rooms. Then, from the most
+// to the least important debate, each takes the largest room left that seats
+// it, so elimination debates and the top brackets get the large rooms. Rooms of
+// the same capacity are taken in random order. When no room seats a debate, it
+// gets the largest room left, and a debate left without any room gets -1.
+//
+// Rooms without a capacity or accessibility, with no team needing an accessible
+// room, are drawn at random as they were before rooms carried either, which
+// keeps the draws saved before then replayable.
+func allocateRooms(held []*Debate, rooms []*Room, random RandomSource) {
+	if !roomsMatter(held, rooms) {
+		drawRooms(held, rooms, random)
+		return
+	}
+
+	available := make([]*Room, len(rooms))
+	for i, j := range random.Perm(len(rooms)) {
+		available[i] = rooms[j]
+	}
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].Capacity > available[j].Capacity
+	})
+
+	take := func(debate *Debate, suitable func(*Room) bool) bool {
+		fallback := -1
+		for i, room := range available {
+			if !suitable(room) {
+				continue
+			}
+			if fallback == -1 {
+				fallback = i
+			}
+			if room.fits(debate) {
+				fallback = i
+				break
+			}
+		}
+		if fallback == -1 {
+			return false
+		}
+		debate.Room = available[fallback].ID
+		available = append(available[:fallback], available[fallback+1:]...)
+		return true
+	}
+
+	order := debatesByImportance(held)
+	placed := make([]bool, len(held))
+	for _, d := range order {
+		if held[d].needsAccessibleRoom() {
+			placed[d] = take(held[d], func(room *Room) bool { return room.Accessible })
+		}
+	}
+	for _, d := range order {
+		if placed[d] {
+			continue
+		}
+		if !take(held[d], func(*Room) bool { return true }) {
+			held[d].Room = -1
+		}
+	}
+}
+
+// roomsMatter reports whether anything about the rooms or the debates should
+// decide which room a debate gets.
+func roomsMatter(held []*Debate, rooms []*Room) bool {
+	for _, room := range rooms {
+		if room.Capacity > 0 || room.Accessible {
+			return true
+		}
+	}
+	for _, debate := range held {
+		if debate.needsAccessibleRoom() {
+			return true
+		}
+	}
+	return false
+}
+
+// drawRooms gives every held debate a room at random.
+func drawRooms(held []*Debate, rooms []*Room, random RandomSource) {
+	available := make([]*Room, len(rooms))
+	copy(available, rooms)
+	for _, debate := range held {
+		if len(available) == 0 {
+			// If we run out of rooms, assign a placeholder value
+			debate.Room = -1
+			continue
+		}
+		roomIndex := random.Intn(len(available))
+		debate.Room = available[roomIndex].ID
+		available = append(available[:roomIndex], available[roomIndex+1:]...)
+	}
+}
+
+// ValidateRooms checks that the rooms of a drawn round seat their debates and
+// that the teams needing an accessible room have one. Debate is the index of
+// the offending debate in the draw, as in ValidateDraw.
+func ValidateRooms(debates []*Debate, rooms []*Room) []DrawIssue {
+	byID := make(map[int]*Room, len(rooms))
+	for _, room := range rooms {
+		byID[room.ID] = room
+	}
+
+	var issues []DrawIssue
+	for d, debate := range debates {
+		room, ok := byID[debate.Room]
+		if !ok {
+			continue
+		}
+		for _, team := range debate.Teams() {
+			if team.NeedsAccessibleRoom && !room.Accessible {
+				issues = append(issues, DrawIssue{IssueRoomNotAccessible, d, fmt.Sprintf("team %s needs an accessible room, but room %d is not", team.Name, room.ID)})
+			}
+		}
+		if !room.fits(debate) {
+			issues = append(issues, DrawIssue{IssueRoomTooSmall, d, fmt.Sprintf("room %d seats %d, but the debate needs %d seats", room.ID, room.Capacity, debate.seatsNeeded())})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,78 @@
+package pairing_algorithm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAllocateRoomsPlacesAccessibleTeams(t *testing.T) {
+	teams := createMockTeams(6)
+	teams[5].NeedsAccessibleRoom = true
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1]},
+		{Team1: teams[2], Team2: teams[3]},
+		{Team1: teams[4], Team2: teams[5]},
+	}
+	rooms := []*Room{
+		{ID: 101, Capacity: 40},
+		{ID: 102, Capacity: 12, Accessible: true},
+		{ID: 103, Capacity: 30},
+	}
+
+	allocateRooms(debates, rooms, createMockRandom(1))
+
+	if debates[2].Room != 102 {
+		t.Errorf("Expected the debate of team %s in accessible room 102, got room %d", teams[5].Name, debates[2].Room)
+	}
+	if issues := ValidateRooms(debates, rooms); len(issues) != 0 {
+		t.Errorf("Expected no room issues, got %v", issues)
+	}
+}
+
+func TestAllocateRoomsGivesLargeRoomsToEliminationDebates(t *testing.T) {
+	teams := createMockTeams(4)
+	for i, team := range teams {
+		team.EliminationRank = i + 1
+	}
+	debates := []*Debate{
+		{Team1: teams[1], Team2: teams[2]},
+		{Team1: teams[0], Team2: teams[3]},
+	}
+	rooms := []*Room{
+		{ID: 101, Capacity: 10},
+		{ID: 102, Capacity: 50},
+		{ID: 103, Capacity: 20},
+		{ID: 104, Capacity: 30},
+	}
+
+	allocateRooms(debates, rooms, createMockRandom(1))
+
+	used := map[int]bool{debates[0].Room: true, debates[1].Room: true}
+	if !used[102] || !used[104] {
+		t.Errorf("Expected the two largest rooms, 102 and 104, got %d and %d", debates[0].Room, debates[1].Room)
+	}
+}
+
+func TestValidateRooms(t *testing.T) {
+	teams := createMockTeams(2)
+	teams[0].NeedsAccessibleRoom = true
+	teams[0].SpeakerIDs = []int{1, 2}
+	teams[1].SpeakerIDs = []int{3, 4}
+	debates := []*Debate{{Team1: teams[0], Team2: teams[1], Judges: createMockJudges(3), Room: 101}}
+	rooms := []*Room{{ID: 101, Capacity: 4}}
+
+	issues := ValidateRooms(debates, rooms)
+	if len(issues) != 2 || issues[0].Type != IssueRoomNotAccessible || issues[1].Type != IssueRoomTooSmall {
+		t.Errorf("Expected an inaccessible and a too small room, got %v", issues)
+	}
+}
+
+func TestRoomReadsSavedRoomIDs(t *testing.T) {
+	var rooms []*Room
+	if err := json.Unmarshal([]byte(`[101, {"ID": 102, "Capacity": 20, "Accessible": true}]`), &rooms); err != nil {
+		t.Fatalf("Error reading rooms: %v", err)
+	}
+	if len(rooms) != 2 || *rooms[0] != (Room{ID: 101}) || *rooms[1] != (Room{ID: 102, Capacity: 20, Accessible: true}) {
+		t.Errorf("Unexpected rooms: %+v", rooms)
+	}
+}
@@ -0,0 +1,181 @@
+package pairing_algorithm
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduleSettings describe the days a tournament's rounds are timetabled in.
+// The times of day are offsets from midnight.
+type ScheduleSettings struct {
+	// Start and End are the tournament's start and end dates. No round
+	// starts before Start, and the last day is the day of End.
+	Start time.Time
+	End   time.Time
+	// RoundLength is how long a round takes and Break the gap between a
+	// round and the next.
+	RoundLength time.Duration
+	Break       time.Duration
+	// DayStart is when the first round of a day may start, and DayEnd the
+	// cutoff no round of the day may run past.
+	DayStart time.Duration
+	DayEnd   time.Duration
+	// LunchStart and Lunch are the lunch slot no round may overlap. A Lunch
+	// of 0 leaves the days without one.
+	LunchStart time.Duration
+	Lunch      time.Duration
+}
+
+// RoundSlot is when a round is timetabled.
+type RoundSlot struct {
+	RoundNumber   int
+	IsElimination bool
+	Start         time.Time
+	End           time.Time
+	// Fixed slots are kept as they are when the rest of the timetable is
+	// planned around them.
+	Fixed bool
+}
+
+// Name returns the round's name as the errors of the timetable use it.
+func (s RoundSlot) Name() string {
+	if s.IsElimination {
+		return fmt.Sprintf("elimination round %d", s.RoundNumber)
+	}
+	return fmt.Sprintf("preliminary round %d", s.RoundNumber)
+}
+
+// ValidateScheduleSettings reports why the settings cannot timetable a round.
+func ValidateScheduleSettings(settings ScheduleSettings) error {
+	switch {
+	case settings.RoundLength <= 0:
+		return fmt.Errorf("round length must be positive")
+	case settings.Break < 0:
+		return fmt.Errorf("break cannot be negative")
+	case settings.Lunch < 0:
+		return fmt.Errorf("lunch cannot be negative")
+	case settings.DayStart < 0 || settings.DayEnd > 24*time.Hour || settings.DayStart >= settings.DayEnd:
+		return fmt.Errorf("the day must start before its cutoff")
+	case settings.DayStart+settings.RoundLength > settings.DayEnd:
+		return fmt.Errorf("a round does not fit between the start of the day and its cutoff")
+	case settings.Lunch > 0 && (settings.LunchStart < settings.DayStart || settings.LunchStart+settings.Lunch > settings.DayEnd):
+		return fmt.Errorf("the lunch slot must fall within the day")
+	case settings.End.Before(settings.Start):
+		return fmt.Errorf("the tournament ends before it starts")
+	}
+	return nil
+}
+
+// ScheduleRounds timetables the preliminary rounds and then the elimination
+// rounds of a tournament, each as early as the settings allow after the round
+// before it and its break. A round that would overlap lunch starts after it,
+// and one that would run past the day's cutoff moves to the next day. The
+// fixed slots, such as rounds pinned by hand or already under way, are kept
+// and the other rounds planned around them. It fails when a round does not
+// fit before the tournament ends or before the next fixed round.
+func ScheduleRounds(settings ScheduleSettings, preliminary, elimination int, fixed []RoundSlot) ([]RoundSlot, error) {
+	if err := ValidateScheduleSettings(settings); err != nil {
+		return nil, err
+	}
+
+	rounds := make([]RoundSlot, 0, preliminary+elimination)
+	for i := 1; i <= preliminary; i++ {
+		rounds = append(rounds, RoundSlot{RoundNumber: i})
+	}
+	for i := 1; i <= elimination; i++ {
+		rounds = append(rounds, RoundSlot{RoundNumber: i, IsElimination: true})
+	}
+
+	type roundKey struct {
+		number        int
+		isElimination bool
+	}
+	fixedSlots := make(map[roundKey]RoundSlot, len(fixed))
+	for _, slot := range fixed {
+		slot.Fixed = true
+		fixedSlots[roundKey{slot.RoundNumber, slot.IsElimination}] = slot
+	}
+	for i, round := range rounds {
+		if slot, ok := fixedSlots[roundKey{round.RoundNumber, round.IsElimination}]; ok {
+			rounds[i] = slot
+		}
+	}
+
+	lastDay := midnight(settings.End)
+	cursor := settings.Start
+	var previous *RoundSlot
+	for i := range rounds {
+		round := &rounds[i]
+		if round.Fixed {
+			if !round.Start.Before(round.End) {
+				return nil, fmt.Errorf("%s must start before it ends", round.Name())
+			}
+			if previous != nil && round.Start.Before(previous.End) {
+				return nil, fmt.Errorf("%s starts before %s ends", round.Name(), previous.Name())
+			}
+			cursor = later(cursor, round.End.Add(settings.Break))
+			previous = round
+			continue
+		}
+
+		start, err := nextRoundStart(settings, cursor, lastDay)
+		if err != nil {
+			return nil, fmt.Errorf("%s %v", round.Name(), err)
+		}
+		round.Start, round.End = start, start.Add(settings.RoundLength)
+		for _, next := range rounds[i+1:] {
+			if next.Fixed {
+				if round.End.Add(settings.Break).After(next.Start) {
+					return nil, fmt.Errorf("%s does not fit before %s", round.Name(), next.Name())
+				}
+				break
+			}
+		}
+		cursor = round.End.Add(settings.Break)
+		previous = round
+	}
+
+	return rounds, nil
+}
+
+// nextRoundStart returns the earliest start from cursor at which a round
+// stays clear of lunch and ends by the day's cutoff.
+func nextRoundStart(settings ScheduleSettings, cursor time.Time, lastDay time.Time) (time.Time, error) {
+	start := cursor
+	for {
+		day := midnight(start)
+		if day.After(lastDay) {
+			return time.Time{}, fmt.Errorf("does not fit before the tournament ends")
+		}
+		if dayStart := day.Add(settings.DayStart); start.Before(dayStart) {
+			start = dayStart
+		}
+		end := start.Add(settings.RoundLength)
+
+		if settings.Lunch > 0 {
+			lunchStart := day.Add(settings.LunchStart)
+			lunchEnd := lunchStart.Add(settings.Lunch)
+			if start.Before(lunchEnd) && end.After(lunchStart) {
+				start = lunchEnd
+				continue
+			}
+		}
+		if end.After(day.Add(settings.DayEnd)) {
+			start = day.AddDate(0, 0, 1)
+			continue
+		}
+		return start, nil
+	}
+}
+
+func midnight(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
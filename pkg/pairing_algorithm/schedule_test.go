@@ -0,0 +1,133 @@
+package pairing_algorithm
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func scheduleSettings(days int) ScheduleSettings {
+	start := time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)
+	return ScheduleSettings{
+		Start:       start,
+		End:         start.AddDate(0, 0, days-1),
+		RoundLength: 90 * time.Minute,
+		Break:       15 * time.Minute,
+		DayStart:    8 * time.Hour,
+		DayEnd:      18 * time.Hour,
+		LunchStart:  12 * time.Hour,
+		Lunch:       time.Hour,
+	}
+}
+
+func slotTimes(slots []RoundSlot) []string {
+	times := make([]string, len(slots))
+	for i, slot := range slots {
+		times[i] = slot.Start.Format("Jan 2 15:04") + "-" + slot.End.Format("15:04")
+	}
+	return times
+}
+
+func TestScheduleRounds(t *testing.T) {
+	slots, err := ScheduleRounds(scheduleSettings(2), 5, 2, nil)
+	if err != nil {
+		t.Fatalf("Error scheduling rounds: %v", err)
+	}
+
+	expected := []string{
+		"May 4 08:00-09:30",
+		"May 4 09:45-11:15",
+		// Round 3 would overlap lunch
+		"May 4 13:00-14:30",
+		"May 4 14:45-16:15",
+		"May 4 16:30-18:00",
+		// The first elimination round would run past the cutoff
+		"May 5 08:00-09:30",
+		"May 5 09:45-11:15",
+	}
+	if got := slotTimes(slots); strings.Join(got, ", ") != strings.Join(expected, ", ") {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if !slots[5].IsElimination || slots[5].RoundNumber != 1 {
+		t.Errorf("Expected elimination round 1 after the preliminary rounds, got %s", slots[5].Name())
+	}
+}
+
+func TestScheduleRoundsKeepsFixedSlots(t *testing.T) {
+	settings := scheduleSettings(1)
+	pinned := RoundSlot{
+		RoundNumber: 2,
+		Start:       time.Date(2024, 5, 4, 10, 30, 0, 0, time.UTC),
+		End:         time.Date(2024, 5, 4, 11, 45, 0, 0, time.UTC),
+	}
+
+	slots, err := ScheduleRounds(settings, 3, 0, []RoundSlot{pinned})
+	if err != nil {
+		t.Fatalf("Error scheduling rounds: %v", err)
+	}
+
+	expected := []string{"May 4 08:00-09:30", "May 4 10:30-11:45", "May 4 13:00-14:30"}
+	if got := slotTimes(slots); strings.Join(got, ", ") != strings.Join(expected, ", ") {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if !slots[1].Fixed || slots[0].Fixed {
+		t.Errorf("Expected only round 2 to be fixed")
+	}
+}
+
+func TestScheduleRoundsFailures(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings ScheduleSettings
+		rounds   int
+		fixed    []RoundSlot
+		expected string
+	}{
+		{
+			name:     "too many rounds",
+			settings: scheduleSettings(1),
+			rounds:   6,
+			expected: "preliminary round 6 does not fit before the tournament ends",
+		},
+		{
+			name:     "round before a pinned round",
+			settings: scheduleSettings(1),
+			rounds:   2,
+			fixed: []RoundSlot{{
+				RoundNumber: 2,
+				Start:       time.Date(2024, 5, 4, 9, 0, 0, 0, time.UTC),
+				End:         time.Date(2024, 5, 4, 10, 30, 0, 0, time.UTC),
+			}},
+			expected: "preliminary round 1 does not fit before preliminary round 2",
+		},
+		{
+			name:     "overlapping pinned rounds",
+			settings: scheduleSettings(1),
+			rounds:   2,
+			fixed: []RoundSlot{
+				{RoundNumber: 1, Start: time.Date(2024, 5, 4, 9, 0, 0, 0, time.UTC), End: time.Date(2024, 5, 4, 10, 30, 0, 0, time.UTC)},
+				{RoundNumber: 2, Start: time.Date(2024, 5, 4, 10, 0, 0, 0, time.UTC), End: time.Date(2024, 5, 4, 11, 30, 0, 0, time.UTC)},
+			},
+			expected: "preliminary round 2 starts before preliminary round 1 ends",
+		},
+		{
+			name: "round longer than the day",
+			settings: func() ScheduleSettings {
+				settings := scheduleSettings(1)
+				settings.RoundLength = 11 * time.Hour
+				return settings
+			}(),
+			rounds:   1,
+			expected: "a round does not fit between the start of the day and its cutoff",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ScheduleRounds(tc.settings, tc.rounds, 0, tc.fixed)
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package pairing_algorithm
+
+import "sort"
+
+// maxSideImbalance is the largest difference between proposition and
+// opposition debates a team may carry across the preliminary rounds.
+const maxSideImbalance = 1
+
+// sideImbalance returns how many more proposition than opposition debates the
+// team has argued. The bye placeholder never carries a side.
+func (t *Team) sideImbalance() int {
+	if t.ID == ByeTeamID {
+		return 0
+	}
+	return t.PropositionCount - t.OppositionCount
+}
+
+// sidesClash reports whether two teams cannot meet without one of them going
+// over maxSideImbalance, which happens when both are already owed the same side.
+func sidesClash(a, b *Team) bool {
+	imbalanceA, imbalanceB := a.sideImbalance(), b.sideImbalance()
+	return (imbalanceA >= maxSideImbalance && imbalanceB >= maxSideImbalance) ||
+		(imbalanceA <= -maxSideImbalance && imbalanceB <= -maxSideImbalance)
+}
+
+// orientDebate puts the team owed proposition on Team1. When both teams are
+// level, the team that argued proposition last round is moved to opposition.
+func orientDebate(team1, team2 *Team) (*Team, *Team) {
+	imbalance1, imbalance2 := team1.sideImbalance(), team2.sideImbalance()
+	if imbalance1 > imbalance2 {
+		return team2, team1
+	}
+	if imbalance1 == imbalance2 && team1.LastSide == 1 && team2.LastSide != 1 {
+		return team2, team1
+	}
+	return team1, team2
+}
+
+// balanceScheduleSides orients every pairing of a full preliminary schedule
+// (pair[0] is proposition) so that no team ends the prelims more than
+// maxSideImbalance debates away from an even split.
+//
+// Each round is first oriented greedily, giving proposition to the team that
+// has argued it least. Any team still over the limit afterwards is repaired by
+// flipping a chain of debates that ends at a team with slack in the opposite
+// direction. Such a chain always exists because every flip keeps the total of
+// all imbalances at zero, and it leaves every team in the middle unchanged.
+func balanceScheduleSides(rounds [][][]int) {
+	imbalance := make(map[int]int)
+
+	for _, round := range rounds {
+		for _, pair := range round {
+			if imbalance[pair[0]] > imbalance[pair[1]] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			imbalance[pair[0]]++
+			imbalance[pair[1]]--
+		}
+	}
+
+	// Teams are repaired in ID order so the same schedule always comes out
+	teams := make([]int, 0, len(imbalance))
+	for team := range imbalance {
+		teams = append(teams, team)
+	}
+	sort.Ints(teams)
+
+	for {
+		repaired := false
+		for _, team := range teams {
+			value := imbalance[team]
+			if team == ByeTeamID || abs(value) <= maxSideImbalance {
+				continue
+			}
+			if flipSideChain(rounds, imbalance, team, value > 0) {
+				repaired = true
+			}
+		}
+		if !repaired {
+			return
+		}
+	}
+}
+
+// flipSideChain searches for a chain of debates starting at team, following
+// debates where the current team argued the side it has too many of, and
+// flips every debate along the first chain that reaches a team which can
+// absorb the extra side. It reports whether a chain was flipped.
+func flipSideChain(rounds [][][]int, imbalance map[int]int, team int, tooManyPropositions bool) bool {
+	type step struct {
+		team   int
+		round  int
+		pairID int
+	}
+
+	// from is the side index the chain leaves a team on: 0 (proposition) when
+	// the team has too many propositions, 1 (opposition) otherwise.
+	from, to, direction := 0, 1, 1
+	if !tooManyPropositions {
+		from, to, direction = 1, 0, -1
+	}
+
+	previous := map[int]step{team: {team: team, round: -1}}
+	queue := []int{team}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for r, round := range rounds {
+			for p, pair := range round {
+				if pair[from] != current {
+					continue
+				}
+				next := pair[to]
+				if _, seen := previous[next]; seen {
+					continue
+				}
+				previous[next] = step{team: current, round: r, pairID: p}
+
+				if next == ByeTeamID || imbalance[next]*direction < 0 {
+					for node := next; node != team; node = previous[node].team {
+						link := previous[node]
+						flipped := rounds[link.round][link.pairID]
+						flipped[0], flipped[1] = flipped[1], flipped[0]
+					}
+					imbalance[team] -= 2 * direction
+					imbalance[next] += 2 * direction
+					return true
+				}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return false
+}
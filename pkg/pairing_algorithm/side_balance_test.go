@@ -0,0 +1,56 @@
+package pairing_algorithm
+
+import "testing"
+
+func TestPreliminaryPairingsSideBalance(t *testing.T) {
+	for _, teamCount := range []int{8, 9, 16, 20, 31, 50, 100} {
+		for rounds := 3; rounds <= 6; rounds++ {
+			teams := make([]int, teamCount)
+			for i := range teams {
+				teams[i] = i + 1
+			}
+
+			pairings, err := GeneratePreliminaryPairings(teams, rounds)
+			if err != nil {
+				t.Fatalf("%d teams, %d rounds: error generating pairings: %v", teamCount, rounds, err)
+			}
+
+			imbalance := make(map[int]int)
+			for _, round := range pairings {
+				for _, pairing := range round {
+					imbalance[pairing[0]]++
+					imbalance[pairing[1]]--
+				}
+			}
+			for team, value := range imbalance {
+				if team != -1 && abs(value) > maxSideImbalance {
+					t.Errorf("%d teams, %d rounds: team %d has a side imbalance of %d", teamCount, rounds, team, value)
+				}
+			}
+		}
+	}
+}
+
+func TestOrientDebate(t *testing.T) {
+	ahead := &Team{ID: 1, PropositionCount: 2, OppositionCount: 1}
+	behind := &Team{ID: 2, PropositionCount: 1, OppositionCount: 2}
+
+	team1, team2 := orientDebate(ahead, behind)
+	if team1 != behind || team2 != ahead {
+		t.Errorf("Expected team %d on proposition, got team %d", behind.ID, team1.ID)
+	}
+
+	lastProp := &Team{ID: 3, PropositionCount: 1, OppositionCount: 1, LastSide: 1}
+	lastOpp := &Team{ID: 4, PropositionCount: 1, OppositionCount: 1, LastSide: -1}
+	team1, _ = orientDebate(lastProp, lastOpp)
+	if team1 != lastOpp {
+		t.Errorf("Expected team %d on proposition after arguing opposition last round, got team %d", lastOpp.ID, team1.ID)
+	}
+
+	if !sidesClash(ahead, &Team{ID: 5, PropositionCount: 1}) {
+		t.Errorf("Expected two teams owed opposition to clash")
+	}
+	if sidesClash(ahead, behind) {
+		t.Errorf("Expected teams owed opposite sides not to clash")
+	}
+}
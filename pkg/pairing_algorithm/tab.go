@@ -0,0 +1,106 @@
+package pairing_algorithm
+
+import "math"
+
+// BallotTeam is one team's result on a recorded ballot.
+type BallotTeam struct {
+	TeamID      int
+	TotalPoints float64
+	// SpeakerRank is the average rank of the team's speeches on the ballot, 0
+	// when none are recorded.
+	SpeakerRank float64
+	Won         bool
+}
+
+// Ballot is the recorded result of a debate, its teams in the order they sit.
+type Ballot struct {
+	DebateID      int
+	IsElimination bool
+	Teams         []BallotTeam
+}
+
+// TeamScore is what a ballot counts for one of its teams.
+type TeamScore struct {
+	TeamID        int
+	DebateID      int
+	IsElimination bool
+	TotalPoints   float64
+	// Rank is the team's placement in a British Parliamentary room, and its
+	// average speaker rank rounded to a whole rank otherwise.
+	Rank int
+	// TeamPoints are 3/2/1/0 by placement in British Parliamentary and 1 for
+	// a win otherwise.
+	TeamPoints int
+	Won        bool
+}
+
+// TeamTotals are a team's results summed over its recorded debates.
+type TeamTotals struct {
+	Wins          int
+	SpeakerPoints float64
+	// AverageRank is the average of the team's ranks, rounded to two decimals.
+	AverageRank float64
+	TeamPoints  int
+}
+
+// ScoreBallot works out the team scores of a recorded ballot. Teams level on
+// total points in a British Parliamentary room share the higher placement.
+func ScoreBallot(ballot Ballot) []TeamScore {
+	scores := make([]TeamScore, len(ballot.Teams))
+	isFourTeam := len(ballot.Teams) == 4
+	for i, team := range ballot.Teams {
+		score := TeamScore{
+			TeamID:        team.TeamID,
+			DebateID:      ballot.DebateID,
+			IsElimination: ballot.IsElimination,
+			TotalPoints:   team.TotalPoints,
+			Won:           team.Won,
+		}
+		if isFourTeam {
+			score.Rank = 1
+			for _, other := range ballot.Teams {
+				if other.TotalPoints > team.TotalPoints {
+					score.Rank++
+				}
+			}
+			score.TeamPoints = len(ballot.Teams) - score.Rank
+		} else {
+			score.Rank = int(math.RoundToEven(team.SpeakerRank))
+			if team.Won {
+				score.TeamPoints = 1
+			}
+		}
+		scores[i] = score
+	}
+	return scores
+}
+
+// SumTeamScores adds up the team scores of each team.
+func SumTeamScores(scores []TeamScore) map[int]*TeamTotals {
+	totals := make(map[int]*TeamTotals)
+	ranks := make(map[int][]int)
+	for _, score := range scores {
+		total, ok := totals[score.TeamID]
+		if !ok {
+			total = &TeamTotals{}
+			totals[score.TeamID] = total
+		}
+		if score.Won {
+			total.Wins++
+		}
+		total.SpeakerPoints += score.TotalPoints
+		total.TeamPoints += score.TeamPoints
+		ranks[score.TeamID] = append(ranks[score.TeamID], score.Rank)
+	}
+
+	for teamID, teamRanks := range ranks {
+		sum := 0
+		for _, rank := range teamRanks {
+			sum += rank
+		}
+		// Rounded half up in whole hundredths, as the database rounds numerics
+		n := len(teamRanks)
+		totals[teamID].AverageRank = float64((sum*200+n)/(2*n)) / 100
+	}
+	return totals
+}
@@ -0,0 +1,73 @@
+package pairing_algorithm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreBallotTwoTeams(t *testing.T) {
+	scores := ScoreBallot(Ballot{
+		DebateID: 7,
+		Teams: []BallotTeam{
+			{TeamID: 1, TotalPoints: 151.5, SpeakerRank: 1.5, Won: true},
+			{TeamID: 2, TotalPoints: 149, SpeakerRank: 3.5},
+		},
+	})
+
+	expected := []TeamScore{
+		{TeamID: 1, DebateID: 7, TotalPoints: 151.5, Rank: 2, TeamPoints: 1, Won: true},
+		{TeamID: 2, DebateID: 7, TotalPoints: 149, Rank: 4},
+	}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, scores)
+	}
+}
+
+func TestScoreBallotBritishParliamentary(t *testing.T) {
+	scores := ScoreBallot(Ballot{
+		DebateID:      3,
+		IsElimination: true,
+		Teams: []BallotTeam{
+			{TeamID: 1, TotalPoints: 150, SpeakerRank: 4},
+			{TeamID: 2, TotalPoints: 160, SpeakerRank: 1, Won: true},
+			{TeamID: 3, TotalPoints: 150, SpeakerRank: 5},
+			{TeamID: 4, TotalPoints: 140, SpeakerRank: 7},
+		},
+	})
+
+	ranks := make([]int, len(scores))
+	teamPoints := make([]int, len(scores))
+	for i, score := range scores {
+		ranks[i] = score.Rank
+		teamPoints[i] = score.TeamPoints
+		if !score.IsElimination {
+			t.Errorf("Expected team %d's score to be an elimination score", score.TeamID)
+		}
+	}
+	if !reflect.DeepEqual(ranks, []int{2, 1, 2, 4}) {
+		t.Errorf("Expected ranks [2 1 2 4], got %v", ranks)
+	}
+	if !reflect.DeepEqual(teamPoints, []int{2, 3, 2, 0}) {
+		t.Errorf("Expected team points [2 3 2 0], got %v", teamPoints)
+	}
+}
+
+func TestSumTeamScores(t *testing.T) {
+	totals := SumTeamScores([]TeamScore{
+		{TeamID: 1, DebateID: 1, TotalPoints: 150.25, Rank: 1, TeamPoints: 1, Won: true},
+		{TeamID: 1, DebateID: 2, TotalPoints: 148.5, Rank: 2},
+		{TeamID: 1, DebateID: 3, TotalPoints: 151, Rank: 2, TeamPoints: 1, Won: true},
+		{TeamID: 2, DebateID: 1, TotalPoints: 149, Rank: 3},
+	})
+
+	expected := map[int]*TeamTotals{
+		1: {Wins: 2, SpeakerPoints: 449.75, AverageRank: 1.67, TeamPoints: 2},
+		2: {SpeakerPoints: 149, AverageRank: 3},
+	}
+	if !reflect.DeepEqual(totals, expected) {
+		for teamID, total := range totals {
+			t.Logf("Team %d: %+v", teamID, *total)
+		}
+		t.Fatalf("Unexpected team totals")
+	}
+}
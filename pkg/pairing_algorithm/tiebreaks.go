@@ -0,0 +1,320 @@
+package pairing_algorithm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// Tiebreak is one step of a tiebreak chain. Competitors level on every
+// tiebreak before it in the chain are ordered by it.
+type Tiebreak string
+
+const (
+	// TiebreakTeamPoints is the sum of team points: 3/2/1/0 in British
+	// Parliamentary, 1 per win in two-team formats.
+	TiebreakTeamPoints Tiebreak = "team_points"
+	TiebreakWins       Tiebreak = "wins"
+	// TiebreakSpeakerPoints is the sum of a team's totals or a speaker's points.
+	TiebreakSpeakerPoints Tiebreak = "speaker_points"
+	// TiebreakAverageRank is the average speaker rank, lower first.
+	TiebreakAverageRank Tiebreak = "average_rank"
+	// TiebreakOppositionStrength is the sum of the wins of every team met.
+	TiebreakOppositionStrength Tiebreak = "opposition_strength"
+	// TiebreakMedianPoints is the median of the points of each debate.
+	TiebreakMedianPoints Tiebreak = "median_points"
+	// TiebreakHighLowPoints is the sum of the points of each debate without
+	// the highest and lowest, once there are at least three debates.
+	TiebreakHighLowPoints Tiebreak = "high_low_points"
+	// TiebreakHeadToHead counts the debates won against the other tied
+	// teams; a team beats the teams placed below it in the room.
+	TiebreakHeadToHead Tiebreak = "head_to_head"
+	// TiebreakDrawLots orders by lots drawn from the ranking's seed.
+	TiebreakDrawLots Tiebreak = "draw_lots"
+)
+
+// DefaultTeamTiebreaks and DefaultSpeakerTiebreaks are the chains used when a
+// format does not set its own.
+var (
+	DefaultTeamTiebreaks    = []Tiebreak{TiebreakTeamPoints, TiebreakWins, TiebreakSpeakerPoints, TiebreakAverageRank}
+	DefaultSpeakerTiebreaks = []Tiebreak{TiebreakSpeakerPoints, TiebreakWins, TiebreakAverageRank}
+)
+
+// teamOnlyTiebreaks compare teams with the teams they met and do not apply to speakers.
+var teamOnlyTiebreaks = map[Tiebreak]bool{
+	TiebreakTeamPoints:         true,
+	TiebreakOppositionStrength: true,
+	TiebreakHeadToHead:         true,
+}
+
+var knownTiebreaks = map[Tiebreak]bool{
+	TiebreakTeamPoints:         true,
+	TiebreakWins:               true,
+	TiebreakSpeakerPoints:      true,
+	TiebreakAverageRank:        true,
+	TiebreakOppositionStrength: true,
+	TiebreakMedianPoints:       true,
+	TiebreakHighLowPoints:      true,
+	TiebreakHeadToHead:         true,
+	TiebreakDrawLots:           true,
+}
+
+// ParseTiebreakChain checks a tiebreak chain for team or speaker rankings.
+// Each tiebreak may appear once, and drawing lots must come last since it
+// leaves no ties for later tiebreaks.
+func ParseTiebreakChain(names []string, speakers bool) ([]Tiebreak, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("a tiebreak chain needs at least one tiebreak")
+	}
+
+	chain := make([]Tiebreak, len(names))
+	seen := make(map[Tiebreak]bool, len(names))
+	for i, name := range names {
+		tiebreak := Tiebreak(name)
+		switch {
+		case !knownTiebreaks[tiebreak]:
+			return nil, fmt.Errorf("unknown tiebreak %q", name)
+		case speakers && teamOnlyTiebreaks[tiebreak]:
+			return nil, fmt.Errorf("tiebreak %q only applies to teams", name)
+		case seen[tiebreak]:
+			return nil, fmt.Errorf("tiebreak %q is used twice", name)
+		case tiebreak == TiebreakDrawLots && i != len(names)-1:
+			return nil, fmt.Errorf("tiebreak %q must come last", name)
+		}
+		seen[tiebreak] = true
+		chain[i] = tiebreak
+	}
+	return chain, nil
+}
+
+// DebateResult is how a team or speaker did in one debate.
+type DebateResult struct {
+	DebateID   int
+	TeamPoints int
+	Won        bool
+	// Points are the team's total or the speaker's points.
+	Points float64
+	// Rank is the speaker's rank, or the average rank of the team's speakers.
+	Rank float64
+	// OpponentWins are the wins of the other teams in the debate.
+	OpponentWins int
+	// Beat are the IDs of the teams placed below the team in the debate.
+	Beat []int
+}
+
+// Competitor is a team or speaker to rank with its results.
+type Competitor struct {
+	ID      int
+	Name    string
+	Results []DebateResult
+}
+
+func (c *Competitor) TeamPoints() int {
+	total := 0
+	for _, result := range c.Results {
+		total += result.TeamPoints
+	}
+	return total
+}
+
+func (c *Competitor) Wins() int {
+	wins := 0
+	for _, result := range c.Results {
+		if result.Won {
+			wins++
+		}
+	}
+	return wins
+}
+
+func (c *Competitor) TotalPoints() float64 {
+	total := 0.0
+	for _, result := range c.Results {
+		total += result.Points
+	}
+	return total
+}
+
+// AverageRank is 99 for a competitor without results, so it ranks last.
+func (c *Competitor) AverageRank() float64 {
+	if len(c.Results) == 0 {
+		return 99
+	}
+	total := 0.0
+	for _, result := range c.Results {
+		total += result.Rank
+	}
+	return total / float64(len(c.Results))
+}
+
+func (c *Competitor) points() []float64 {
+	points := make([]float64, len(c.Results))
+	for i, result := range c.Results {
+		points[i] = result.Points
+	}
+	sort.Float64s(points)
+	return points
+}
+
+// Standing is a competitor's place in a ranking. Competitors level on the
+// whole chain share a place. DecidedBy is the last tiebreak of the chain
+// needed to place the competitor between its neighbours, and is empty when
+// it shares its place.
+type Standing struct {
+	Competitor *Competitor
+	Place      int
+	DecidedBy  Tiebreak
+}
+
+// RankCompetitors ranks competitors by a tiebreak chain, best first. Lots are
+// drawn from the seed, so a ranking with the same seed draws the same lots.
+func RankCompetitors(competitors []*Competitor, chain []Tiebreak, seed int64) []*Standing {
+	ordered := make([]*Competitor, len(competitors))
+	copy(ordered, competitors)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	// splits[i] is the index in the chain of the tiebreak that separates
+	// competitor i from competitor i-1, or -1 if nothing does
+	splits := make([]int, len(ordered))
+	for i := range splits {
+		splits[i] = -1
+	}
+	rankGroup(ordered, splits, chain, 0, seed)
+
+	standings := make([]*Standing, len(ordered))
+	for i, competitor := range ordered {
+		standings[i] = &Standing{Competitor: competitor, Place: i + 1}
+		if i > 0 && splits[i] == -1 {
+			standings[i].Place = standings[i-1].Place
+		}
+
+		decidedBy := -1
+		tied := i > 0 && splits[i] == -1 || i+1 < len(ordered) && splits[i+1] == -1
+		if !tied {
+			if i > 0 {
+				decidedBy = splits[i]
+			}
+			if i+1 < len(ordered) {
+				decidedBy = max(decidedBy, splits[i+1])
+			}
+		}
+		if decidedBy >= 0 {
+			standings[i].DecidedBy = chain[decidedBy]
+		}
+	}
+	return standings
+}
+
+// rankGroup orders a group of competitors level on the chain up to level,
+// then ranks each run still level on the tiebreak at level by the rest of the
+// chain. splits is aligned with group.
+func rankGroup(group []*Competitor, splits []int, chain []Tiebreak, level int, seed int64) {
+	if len(group) < 2 || level == len(chain) {
+		return
+	}
+
+	values := tiebreakValues(group, chain[level], seed)
+	sort.SliceStable(group, func(i, j int) bool {
+		return values[group[i].ID]-values[group[j].ID] > tiebreakEpsilon
+	})
+
+	start := 0
+	for i := 1; i <= len(group); i++ {
+		if i < len(group) && math.Abs(values[group[i].ID]-values[group[start].ID]) <= tiebreakEpsilon {
+			continue
+		}
+		if i < len(group) {
+			splits[i] = level
+		}
+		rankGroup(group[start:i], splits[start:i], chain, level+1, seed)
+		start = i
+	}
+}
+
+// tiebreakEpsilon treats averages that differ only by rounding as level.
+const tiebreakEpsilon = 1e-9
+
+// tiebreakValues scores each competitor of a group on a tiebreak, higher
+// being better. Head-to-head depends on who else is in the group.
+func tiebreakValues(group []*Competitor, tiebreak Tiebreak, seed int64) map[int]float64 {
+	inGroup := make(map[int]bool, len(group))
+	for _, competitor := range group {
+		inGroup[competitor.ID] = true
+	}
+
+	values := make(map[int]float64, len(group))
+	for _, competitor := range group {
+		var value float64
+		switch tiebreak {
+		case TiebreakTeamPoints:
+			value = float64(competitor.TeamPoints())
+		case TiebreakWins:
+			value = float64(competitor.Wins())
+		case TiebreakSpeakerPoints:
+			value = competitor.TotalPoints()
+		case TiebreakAverageRank:
+			value = -competitor.AverageRank()
+		case TiebreakOppositionStrength:
+			for _, result := range competitor.Results {
+				value += float64(result.OpponentWins)
+			}
+		case TiebreakMedianPoints:
+			points := competitor.points()
+			switch n := len(points); {
+			case n == 0:
+			case n%2 == 1:
+				value = points[n/2]
+			default:
+				value = (points[n/2-1] + points[n/2]) / 2
+			}
+		case TiebreakHighLowPoints:
+			points := competitor.points()
+			if len(points) >= 3 {
+				points = points[1 : len(points)-1]
+			}
+			for _, p := range points {
+				value += p
+			}
+		case TiebreakHeadToHead:
+			for _, result := range competitor.Results {
+				for _, id := range result.Beat {
+					if inGroup[id] {
+						value++
+					}
+				}
+			}
+		case TiebreakDrawLots:
+			value = drawLot(seed, competitor.ID)
+		}
+		values[competitor.ID] = value
+	}
+	return values
+}
+
+// sortByStanding orders teams by their place in the standings when every
+// team has one, and reports whether it did.
+func sortByStanding(teams []*Team) bool {
+	for _, team := range teams {
+		if team.Standing == 0 {
+			return false
+		}
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].Standing < teams[j].Standing
+	})
+	return true
+}
+
+// drawLot is the competitor's lot for the seed, between 0 and 1.
+func drawLot(seed int64, id int) float64 {
+	hash := fnv.New64a()
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(seed))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(id))
+	hash.Write(buf[:])
+	return float64(hash.Sum64()>>11) / float64(1<<53)
+}
@@ -0,0 +1,182 @@
+package pairing_algorithm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func rankedIDs(standings []*Standing) []int {
+	ids := make([]int, len(standings))
+	for i, standing := range standings {
+		ids[i] = standing.Competitor.ID
+	}
+	return ids
+}
+
+func pointsResults(points ...float64) []DebateResult {
+	results := make([]DebateResult, len(points))
+	for i, p := range points {
+		results[i] = DebateResult{DebateID: i + 1, Points: p}
+	}
+	return results
+}
+
+func TestRankCompetitorsDefaultChain(t *testing.T) {
+	competitors := []*Competitor{
+		{ID: 1, Results: []DebateResult{{TeamPoints: 1, Won: true, Points: 150, Rank: 2}, {Points: 148, Rank: 3}}},
+		{ID: 2, Results: []DebateResult{{TeamPoints: 1, Won: true, Points: 152, Rank: 2}, {TeamPoints: 1, Won: true, Points: 149, Rank: 2}}},
+		{ID: 3, Results: []DebateResult{{TeamPoints: 1, Won: true, Points: 150, Rank: 1}, {Points: 148, Rank: 3}}},
+		{ID: 4, Results: []DebateResult{{TeamPoints: 1, Won: true, Points: 151, Rank: 2}, {Points: 149, Rank: 3}}},
+	}
+
+	standings := RankCompetitors(competitors, DefaultTeamTiebreaks, 1)
+	if ids := rankedIDs(standings); !reflect.DeepEqual(ids, []int{2, 4, 3, 1}) {
+		t.Fatalf("Expected order [2 4 3 1], got %v", ids)
+	}
+
+	expected := []struct {
+		place     int
+		decidedBy Tiebreak
+	}{
+		{1, TiebreakTeamPoints},
+		{2, TiebreakSpeakerPoints},
+		{3, TiebreakAverageRank},
+		{4, TiebreakAverageRank},
+	}
+	for i, want := range expected {
+		if standings[i].Place != want.place || standings[i].DecidedBy != want.decidedBy {
+			t.Errorf("Expected team %d at place %d decided by %q, got place %d decided by %q",
+				standings[i].Competitor.ID, want.place, want.decidedBy, standings[i].Place, standings[i].DecidedBy)
+		}
+	}
+}
+
+func TestRankCompetitorsSharesPlacesWhenLevel(t *testing.T) {
+	competitors := []*Competitor{
+		{ID: 1, Results: pointsResults(70)},
+		{ID: 2, Results: pointsResults(75)},
+		{ID: 3, Results: pointsResults(75)},
+	}
+
+	standings := RankCompetitors(competitors, []Tiebreak{TiebreakSpeakerPoints}, 1)
+	places := []int{standings[0].Place, standings[1].Place, standings[2].Place}
+	if !reflect.DeepEqual(places, []int{1, 1, 3}) {
+		t.Errorf("Expected places [1 1 3], got %v", places)
+	}
+	if standings[0].DecidedBy != "" || standings[2].DecidedBy != TiebreakSpeakerPoints {
+		t.Errorf("Expected tied speakers to have no deciding tiebreak, got %q and %q", standings[0].DecidedBy, standings[2].DecidedBy)
+	}
+}
+
+func TestRankCompetitorsTiebreaks(t *testing.T) {
+	testCases := []struct {
+		name        string
+		chain       []Tiebreak
+		competitors []*Competitor
+		expected    []int
+	}{
+		{
+			name:  "opposition strength",
+			chain: []Tiebreak{TiebreakWins, TiebreakOppositionStrength},
+			competitors: []*Competitor{
+				{ID: 1, Results: []DebateResult{{Won: true, OpponentWins: 1}, {OpponentWins: 2}}},
+				{ID: 2, Results: []DebateResult{{Won: true, OpponentWins: 3}, {OpponentWins: 2}}},
+			},
+			expected: []int{2, 1},
+		},
+		{
+			name:        "median points",
+			chain:       []Tiebreak{TiebreakMedianPoints},
+			competitors: []*Competitor{{ID: 1, Results: pointsResults(70, 72, 90)}, {ID: 2, Results: pointsResults(73, 74, 60)}},
+			expected:    []int{2, 1},
+		},
+		{
+			name:        "high-low dropped points",
+			chain:       []Tiebreak{TiebreakSpeakerPoints, TiebreakHighLowPoints},
+			competitors: []*Competitor{{ID: 1, Results: pointsResults(60, 70, 80, 90)}, {ID: 2, Results: pointsResults(70, 76, 76, 78)}},
+			expected:    []int{2, 1},
+		},
+		{
+			name:  "head to head among tied teams",
+			chain: []Tiebreak{TiebreakWins, TiebreakHeadToHead},
+			competitors: []*Competitor{
+				{ID: 1, Results: []DebateResult{{Won: true, Beat: []int{4}}, {}}},
+				{ID: 2, Results: []DebateResult{{Won: true, Beat: []int{1}}, {}}},
+				{ID: 3, Results: []DebateResult{{}, {}}},
+				{ID: 4, Results: []DebateResult{{}, {}}},
+			},
+			expected: []int{2, 1, 3, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if ids := rankedIDs(RankCompetitors(tc.competitors, tc.chain, 1)); !reflect.DeepEqual(ids, tc.expected) {
+				t.Errorf("Expected order %v, got %v", tc.expected, ids)
+			}
+		})
+	}
+}
+
+func TestRankCompetitorsDrawLots(t *testing.T) {
+	competitors := make([]*Competitor, 8)
+	for i := range competitors {
+		competitors[i] = &Competitor{ID: i + 1, Results: pointsResults(75)}
+	}
+	chain := []Tiebreak{TiebreakSpeakerPoints, TiebreakDrawLots}
+
+	first := RankCompetitors(competitors, chain, 42)
+	if second := RankCompetitors(competitors, chain, 42); !reflect.DeepEqual(rankedIDs(first), rankedIDs(second)) {
+		t.Errorf("Expected the same seed to draw the same lots, got %v and %v", rankedIDs(first), rankedIDs(second))
+	}
+	for i, standing := range first {
+		if standing.Place != i+1 || standing.DecidedBy != TiebreakDrawLots {
+			t.Errorf("Expected place %d decided by lots, got place %d decided by %q", i+1, standing.Place, standing.DecidedBy)
+		}
+	}
+}
+
+func TestParseTiebreakChain(t *testing.T) {
+	testCases := []struct {
+		names    []string
+		speakers bool
+		valid    bool
+	}{
+		{[]string{"team_points", "wins", "opposition_strength", "draw_lots"}, false, true},
+		{[]string{"speaker_points", "median_points", "average_rank"}, true, true},
+		{[]string{}, false, false},
+		{[]string{"wins", "coin_toss"}, false, false},
+		{[]string{"wins", "wins"}, false, false},
+		{[]string{"draw_lots", "wins"}, false, false},
+		{[]string{"speaker_points", "head_to_head"}, true, false},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseTiebreakChain(tc.names, tc.speakers)
+		if (err == nil) != tc.valid {
+			t.Errorf("ParseTiebreakChain(%v, speakers=%v): expected valid=%v, got error %v", tc.names, tc.speakers, tc.valid, err)
+		}
+	}
+}
+
+func TestBritishParliamentaryBreakFollowsStandings(t *testing.T) {
+	teams := createMockTeams(8)
+	for i, team := range teams {
+		// Team points would put the teams in the opposite order
+		team.TeamPoints = i
+		team.Standing = i + 1
+	}
+
+	specs := TournamentSpecs{EliminationRounds: 2, JudgesPerDebate: 1, TeamsPerDebate: 4}
+	debates, err := generateBritishParliamentaryEliminationPairings(teams, createMockJudges(2), createMockRooms(2), specs, 1)
+	if err != nil {
+		t.Fatalf("Error generating elimination round: %v", err)
+	}
+	for _, debate := range debates {
+		for _, team := range debate.Teams() {
+			if team.EliminationRank != team.Standing {
+				t.Errorf("Expected team %d to be seeded %d from the standings, got %d", team.ID, team.Standing, team.EliminationRank)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package pairing_algorithm
+
+import "fmt"
+
+// Issue types reported by ValidateDraw, ValidateRooms and
+// ValidateJudgeAvailability.
+const (
+	IssueRematch           = "rematch"
+	IssueSideImbalance     = "side_imbalance"
+	IssueJudgeConflict     = "judge_conflict"
+	IssueRoomDoubleBooked  = "room_double_booked"
+	IssueJudgeDoubleBooked = "judge_double_booked"
+	IssueTeamDoubleBooked  = "team_double_booked"
+	IssueRoomNotAccessible = "room_not_accessible"
+	IssueRoomTooSmall      = "room_too_small"
+	IssueJudgeUnavailable  = "judge_unavailable"
+)
+
+// DrawIssue is a problem found in a draw before it is released. Debate is the
+// index of the offending debate in the draw; a room, judge or team booked twice
+// is reported on its second debate.
+type DrawIssue struct {
+	Type    string
+	Debate  int
+	Message string
+}
+
+// ValidateDraw checks a drawn round, typically after it was edited by hand.
+// Teams carry their Opponents and side counts from the other rounds, and
+// judges their conflicts and the teams they judged in the other rounds. Side
+// balance is only checked in two-team debates. The bye placeholder is never
+// reported.
+func ValidateDraw(debates []*Debate) []DrawIssue {
+	var issues []DrawIssue
+	roomUsed := make(map[int]bool)
+	judgeSeated := make(map[int]bool)
+	teamDrawn := make(map[int]bool)
+
+	for d, debate := range debates {
+		teams := debate.Teams()
+		for i, team := range teams {
+			if team.ID == ByeTeamID {
+				continue
+			}
+			if teamDrawn[team.ID] {
+				issues = append(issues, DrawIssue{IssueTeamDoubleBooked, d, fmt.Sprintf("team %s is drawn in more than one debate", team.Name)})
+			}
+			teamDrawn[team.ID] = true
+			for _, opponent := range teams[i+1:] {
+				if opponent.ID != ByeTeamID && haveMet(team, opponent) {
+					issues = append(issues, DrawIssue{IssueRematch, d, fmt.Sprintf("teams %s and %s have already met", team.Name, opponent.Name)})
+				}
+			}
+		}
+
+		if !debate.IsBritishParliamentary() {
+			if debate.Team1.ID != ByeTeamID && debate.Team1.sideImbalance()+1 > maxSideImbalance {
+				issues = append(issues, DrawIssue{IssueSideImbalance, d, fmt.Sprintf("team %s would argue proposition %d more times than opposition", debate.Team1.Name, debate.Team1.sideImbalance()+1)})
+			}
+			if debate.Team2.ID != ByeTeamID && debate.Team2.sideImbalance()-1 < -maxSideImbalance {
+				issues = append(issues, DrawIssue{IssueSideImbalance, d, fmt.Sprintf("team %s would argue opposition %d more times than proposition", debate.Team2.Name, 1-debate.Team2.sideImbalance())})
+			}
+		}
+
+		if debate.Room != 0 {
+			if roomUsed[debate.Room] {
+				issues = append(issues, DrawIssue{IssueRoomDoubleBooked, d, fmt.Sprintf("room %d is used by more than one debate", debate.Room)})
+			}
+			roomUsed[debate.Room] = true
+		}
+
+		for _, judge := range debate.Judges {
+			if judgeSeated[judge.ID] {
+				issues = append(issues, DrawIssue{IssueJudgeDoubleBooked, d, fmt.Sprintf("%s is judging more than one debate", judge.Name)})
+			}
+			judgeSeated[judge.ID] = true
+			if reason := judge.conflictWith(debate); reason != "" {
+				issues = append(issues, DrawIssue{IssueJudgeConflict, d, reason})
+			}
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,81 @@
+package pairing_algorithm
+
+import "testing"
+
+func TestValidateDrawCleanDraw(t *testing.T) {
+	teams := createMockTeams(4)
+	judges := createMockJudges(2)
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1], Room: 101, Judges: []*Judge{judges[0]}},
+		{Team1: teams[2], Team2: teams[3], Room: 102, Judges: []*Judge{judges[1]}},
+	}
+
+	if issues := ValidateDraw(debates); len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+}
+
+func TestValidateDrawFlagsIssues(t *testing.T) {
+	teams := createMockTeams(4)
+	teams[0].Opponents[2] = true
+	teams[2].PropositionCount = 2
+	teams[2].OppositionCount = 1
+	teams[3].SchoolIDs = []int{7}
+
+	judges := createMockJudges(2)
+	judges[1].Conflicts = JudgeConflicts{SchoolIDs: map[int]bool{7: true}}
+
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1], Room: 101, Judges: []*Judge{judges[0]}},
+		{Team1: teams[2], Team2: teams[3], Room: 101, Judges: []*Judge{judges[0], judges[1]}},
+	}
+
+	expected := map[string]int{
+		IssueRematch:           0,
+		IssueSideImbalance:     1,
+		IssueRoomDoubleBooked:  1,
+		IssueJudgeDoubleBooked: 1,
+		IssueJudgeConflict:     1,
+	}
+	issues := ValidateDraw(debates)
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %d: %v", len(expected), len(issues), issues)
+	}
+	for _, issue := range issues {
+		debate, ok := expected[issue.Type]
+		if !ok {
+			t.Errorf("Unexpected issue %q: %s", issue.Type, issue.Message)
+			continue
+		}
+		if issue.Debate != debate {
+			t.Errorf("Issue %q reported on debate %d, expected %d", issue.Type, issue.Debate, debate)
+		}
+	}
+}
+
+func TestValidateDrawTeamDoubleBooked(t *testing.T) {
+	teams := createMockTeams(3)
+	debates := []*Debate{
+		{Team1: teams[0], Team2: teams[1], Room: 101},
+		{Team1: teams[2], Team2: teams[0], Room: 102},
+	}
+
+	issues := ValidateDraw(debates)
+	if len(issues) != 1 || issues[0].Type != IssueTeamDoubleBooked || issues[0].Debate != 1 {
+		t.Errorf("Expected team 1 to be reported double booked in debate 1, got %v", issues)
+	}
+}
+
+func TestValidateDrawIgnoresByePlaceholder(t *testing.T) {
+	teams := createMockTeams(2)
+	bye := &Team{ID: ByeTeamID, Name: "Bye", Opponents: map[int]bool{1: true}, PropositionCount: 3}
+
+	debates := []*Debate{
+		{Team1: bye, Team2: teams[0], Room: 101},
+		{Team1: teams[1], Team2: bye, Room: 102},
+	}
+
+	if issues := ValidateDraw(debates); len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+}